@@ -26,6 +26,7 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/metrics"
 	"github.com/algorand/go-algorand/util/timers"
 )
 
@@ -33,6 +34,8 @@ const (
 	defaultCadaverName = "agreement"
 )
 
+var agreementStepSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_agreement_step_seconds", Description: "Time spent in each agreement step, in seconds"}, nil)
+
 // Service represents an instance of an execution of Algorand's agreement protocol.
 type Service struct {
 	parameters
@@ -224,6 +227,7 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 		s.Clock = clock
 	}
 
+	stepStart := time.Now()
 	for {
 		output <- a
 		ready <- externalDemuxSignals{Deadline: status.Deadline, FastRecoveryDeadline: status.FastRecoveryDeadline, CurrentRound: status.Round}
@@ -232,7 +236,12 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 			break
 		}
 
+		prev := status
 		status, a = router.submitTop(s.tracer, status, e)
+		if status.Round != prev.Round || status.Period != prev.Period || status.Step != prev.Step {
+			agreementStepSeconds.ObserveSince(stepStart, map[string]string{"step": prev.Step.metricLabel()})
+			stepStart = time.Now()
+		}
 
 		if persistent(a) {
 			s.persistRouter = router
@@ -129,6 +129,26 @@ func (s step) threshold(proto config.ConsensusParams) uint64 {
 	}
 }
 
+// metricLabel names the step in metrics. Next steps share a single label.
+func (s step) metricLabel() string {
+	switch s {
+	case propose:
+		return "propose"
+	case soft:
+		return "soft"
+	case cert:
+		return "cert"
+	case late:
+		return "late"
+	case redo:
+		return "redo"
+	case down:
+		return "down"
+	default:
+		return "next"
+	}
+}
+
 // CommitteeSize returns the size of the committee required for the step
 func (s step) committeeSize(proto config.ConsensusParams) uint64 {
 	switch s {
@@ -495,13 +495,9 @@ func lookbackForStateproofsSupport(topBlock *bookkeeping.Block) uint64 {
 	return uint64(topBlock.Round().SubSaturate(lowestStateProofRound))
 }
 
-// processStageBlocksDownload is the fourth catchpoint catchup stage. It downloads all the reminder of the blocks, verifying each one of them against it's predecessor.
-func (cs *CatchpointCatchupService) processStageBlocksDownload() (err error) {
-	topBlock, err := cs.ledgerAccessor.EnsureFirstBlock(cs.ctx)
-	if err != nil {
-		return cs.abort(fmt.Errorf("processStageBlocksDownload failed, unable to ensure first block : %v", err))
-	}
-
+// CatchpointBlocksLookback returns the number of blocks preceding topBlock that need to be
+// stored alongside a catchpoint's balances so that the ledger trackers can be loaded.
+func CatchpointBlocksLookback(topBlock *bookkeeping.Block) uint64 {
 	// pick the lookback with the greater of
 	// either (MaxTxnLife+DeeperBlockHeaderHistory+CatchpointLookback) or MaxBalLookback
 	// Explanation:
@@ -514,7 +510,7 @@ func (cs *CatchpointCatchupService) processStageBlocksDownload() (err error) {
 		lookback = proto.MaxBalLookback
 	}
 
-	lookbackForStateProofSupport := lookbackForStateproofsSupport(&topBlock)
+	lookbackForStateProofSupport := lookbackForStateproofsSupport(topBlock)
 	if lookback < lookbackForStateProofSupport {
 		lookback = lookbackForStateProofSupport
 	}
@@ -525,6 +521,18 @@ func (cs *CatchpointCatchupService) processStageBlocksDownload() (err error) {
 		lookback = uint64(topBlock.Round() - 1)
 	}
 
+	return lookback
+}
+
+// processStageBlocksDownload is the fourth catchpoint catchup stage. It downloads all the reminder of the blocks, verifying each one of them against it's predecessor.
+func (cs *CatchpointCatchupService) processStageBlocksDownload() (err error) {
+	topBlock, err := cs.ledgerAccessor.EnsureFirstBlock(cs.ctx)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageBlocksDownload failed, unable to ensure first block : %v", err))
+	}
+
+	lookback := CatchpointBlocksLookback(&topBlock)
+
 	cs.statsMu.Lock()
 	cs.stats.TotalBlocks = uint64(lookback)
 	cs.stats.AcquiredBlocks = 0
@@ -35,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
 const catchupPeersForSync = 10
@@ -43,6 +44,8 @@ const blockQueryPeerLimit = 10
 // this should be at least the number of relays
 const catchupRetryLimit = 500
 
+var catchupBlockDownloadSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_catchup_block_download_seconds", Description: "Time spent downloading blocks during catchup, in seconds"}, nil)
+
 // ErrSyncRoundInvalid is returned when the sync round requested is behind the current ledger round
 var ErrSyncRoundInvalid = errors.New("requested sync round cannot be less than the latest round")
 
@@ -224,7 +227,14 @@ func (s *Service) innerFetch(r basics.Round, peer network.Peer) (blk *bookkeepin
 			cf()
 		}
 	}()
+	span := logging.StartSpan("catchup.fetchBlock")
+	span.SetAttribute("round", uint64(r))
 	blk, cert, ddur, err = fetcher.fetchBlock(ctx, r, peer)
+	span.SetError(err)
+	span.End()
+	if err == nil {
+		catchupBlockDownloadSeconds.Observe(ddur.Seconds(), nil)
+	}
 	// check to see if we aborted due to ledger.
 	if err != nil {
 		select {
@@ -223,6 +223,7 @@ func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data
 	defer requestCancel()
 	request = request.WithContext(requestCtx)
 	network.SetUserAgentHeader(request.Header)
+	request.Header.Set("Accept", rpcs.BlockResponseContentType+", "+rpcs.BlockResponseZstdContentType)
 	response, err := hf.client.Do(request)
 	if err != nil {
 		hf.log.Debugf("GET %#v : %s", blockURL, err)
@@ -259,6 +260,13 @@ func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data
 	// TODO: Temporarily allow old and new content types so we have time for lazy upgrades
 	// Remove this 'old' string after next release.
 	const blockResponseContentTypeOld = "application/algorand-block-v1"
+	if contentTypes[0] == rpcs.BlockResponseZstdContentType {
+		data, err = rpcs.ResponseBytes(response, hf.log, fetcherMaxBlockBytes)
+		if err != nil {
+			return nil, err
+		}
+		return rpcs.DecompressBlockCert(data, fetcherMaxBlockBytes)
+	}
 	if contentTypes[0] != rpcs.BlockResponseContentType && contentTypes[0] != blockResponseContentTypeOld {
 		hf.log.Warnf("http block fetcher response has an invalid content type : %s", contentTypes[0])
 		response.Body.Close()
@@ -189,7 +189,9 @@ func run() int {
 
 		// Apply telemetry override.
 		telemetryConfig.Enable = logging.TelemetryOverride(*telemetryOverride, &telemetryConfig)
-		remoteTelemetryEnabled = telemetryConfig.Enable
+		// SRV records only advertise Elasticsearch endpoints, so an OTLP
+		// exporter relies solely on its configured URI.
+		remoteTelemetryEnabled = telemetryConfig.Enable && telemetryConfig.Exporter != logging.TelemetryExporterOTLP
 
 		if telemetryConfig.Enable || telemetryConfig.SendToLog {
 			// If session GUID specified, use it.
@@ -310,8 +310,9 @@ func initTelemetry(genesis bookkeeping.Genesis, log logging.Logger, dataDirector
 
 			if log.GetTelemetryEnabled() {
 
-				// If the telemetry URI is not set, periodically check SRV records for new telemetry URI
-				if log.GetTelemetryURI() == "" {
+				// If the telemetry URI is not set, periodically check SRV records for new telemetry URI.
+				// SRV records only advertise Elasticsearch endpoints, so OTLP is skipped.
+				if log.GetTelemetryURI() == "" && telemetryConfig.Exporter != logging.TelemetryExporterOTLP {
 					network.StartTelemetryURIUpdateService(time.Minute, algodConfig, genesis.Network, log, abort)
 				}
 
@@ -0,0 +1,566 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// This file implements a minimal writer of the Arrow IPC streaming format, see
+// https://arrow.apache.org/docs/format/Columnar.html#ipc-streaming-format, supporting the few column types
+// the export command needs. The IPC metadata is encoded as flatbuffers, and the stream is laid out as the
+// Arrow Go writer does: arrow_test.go compares it with the golden streams of testdata, written by Arrow Go.
+
+func appendUint32(buf []byte, v uint32) []byte {
+	return append(buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	return appendUint32(appendUint32(buf, uint32(v)), uint32(v>>32))
+}
+
+// fbBuilder builds a flatbuffer back to front, aligning and sharing vtables the way the reference flatbuffers
+// builder does, so that the output is byte for byte the one of the Arrow implementations using it.
+// Positions are offsets from the end of the buffer, which do not change as the buffer grows.
+type fbBuilder struct {
+	// buf holds the end of the flatbuffer written so far.
+	buf      []byte
+	minAlign int
+
+	// vtable holds the position of each field of the object being built, 0 for absent fields.
+	vtable    []int
+	objectEnd int
+	// vtables holds the positions of the vtables written so far.
+	vtables []int
+}
+
+func makeFbBuilder() *fbBuilder {
+	return &fbBuilder{buf: make([]byte, 0, 1024), minAlign: 1}
+}
+
+func (b *fbBuilder) offset() int {
+	return len(b.buf)
+}
+
+// place prepends n bytes to the buffer, without aligning them, and returns them.
+func (b *fbBuilder) place(n int) []byte {
+	b.buf = append(b.buf, make([]byte, n)...)
+	copy(b.buf[n:], b.buf[:len(b.buf)-n])
+	for i := 0; i < n; i++ {
+		b.buf[i] = 0
+	}
+	return b.buf[:n]
+}
+
+// prep pads the buffer so that it is aligned to size once additional bytes are prepended.
+func (b *fbBuilder) prep(size int, additional int) {
+	if size > b.minAlign {
+		b.minAlign = size
+	}
+	b.place(-(len(b.buf) + additional) & (size - 1))
+}
+
+func (b *fbBuilder) prependUint8(v uint8) {
+	b.prep(1, 0)
+	b.place(1)[0] = v
+}
+
+func (b *fbBuilder) prependUint16(v uint16) {
+	b.prep(2, 0)
+	binary.LittleEndian.PutUint16(b.place(2), v)
+}
+
+func (b *fbBuilder) prependUint32(v uint32) {
+	b.prep(4, 0)
+	binary.LittleEndian.PutUint32(b.place(4), v)
+}
+
+func (b *fbBuilder) prependUint64(v uint64) {
+	b.prep(8, 0)
+	binary.LittleEndian.PutUint64(b.place(8), v)
+}
+
+// prependOffset prepends the offset to the object at the given position.
+func (b *fbBuilder) prependOffset(pos int) {
+	b.prep(4, 0)
+	off := b.offset() + 4 - pos
+	binary.LittleEndian.PutUint32(b.place(4), uint32(off))
+}
+
+func (b *fbBuilder) startObject(numFields int) {
+	b.vtable = make([]int, numFields)
+	b.objectEnd = b.offset()
+}
+
+// The object fields are only written when they differ from their default, which is zero for all the fields
+// the Arrow metadata needs.
+
+func (b *fbBuilder) addUint8(slot int, v uint8) {
+	if v != 0 {
+		b.prependUint8(v)
+		b.vtable[slot] = b.offset()
+	}
+}
+
+func (b *fbBuilder) addUint16(slot int, v uint16) {
+	if v != 0 {
+		b.prependUint16(v)
+		b.vtable[slot] = b.offset()
+	}
+}
+
+func (b *fbBuilder) addUint32(slot int, v uint32) {
+	if v != 0 {
+		b.prependUint32(v)
+		b.vtable[slot] = b.offset()
+	}
+}
+
+func (b *fbBuilder) addUint64(slot int, v uint64) {
+	if v != 0 {
+		b.prependUint64(v)
+		b.vtable[slot] = b.offset()
+	}
+}
+
+func (b *fbBuilder) addOffset(slot int, pos int) {
+	if pos != 0 {
+		b.prependOffset(pos)
+		b.vtable[slot] = b.offset()
+	}
+}
+
+// endObject writes the vtable of the object, or points the object to an identical vtable written earlier,
+// and returns the position of the object.
+func (b *fbBuilder) endObject() int {
+	b.prependUint32(0)
+	objectPos := b.offset()
+
+	// trailing absent fields are left out of the vtable.
+	n := len(b.vtable)
+	for n > 0 && b.vtable[n-1] == 0 {
+		n--
+	}
+	fields := b.vtable[:n]
+
+	vtablePos := 0
+	for i := len(b.vtables) - 1; i >= 0 && vtablePos == 0; i-- {
+		start := len(b.buf) - b.vtables[i]
+		vtableSize := int(binary.LittleEndian.Uint16(b.buf[start:]))
+		if vtableSize != 4+2*len(fields) {
+			continue
+		}
+		vtablePos = b.vtables[i]
+		for j, pos := range fields {
+			fieldOffset := int(binary.LittleEndian.Uint16(b.buf[start+4+2*j:]))
+			if fieldOffset == 0 && pos == 0 {
+				continue
+			}
+			if fieldOffset != objectPos-pos {
+				vtablePos = 0
+				break
+			}
+		}
+	}
+
+	if vtablePos == 0 {
+		for i := len(fields) - 1; i >= 0; i-- {
+			fieldOffset := 0
+			if fields[i] != 0 {
+				fieldOffset = objectPos - fields[i]
+			}
+			b.prependUint16(uint16(fieldOffset))
+		}
+		b.prependUint16(uint16(objectPos - b.objectEnd))
+		b.prependUint16(uint16(4 + 2*len(fields)))
+		vtablePos = b.offset()
+		b.vtables = append(b.vtables, vtablePos)
+	}
+	// the signed offset from the object to its vtable.
+	binary.LittleEndian.PutUint32(b.buf[len(b.buf)-objectPos:], uint32(int32(vtablePos-objectPos)))
+	b.vtable = nil
+	return objectPos
+}
+
+// startVector prepares the buffer for the given number of elements, to be prepended last to first.
+func (b *fbBuilder) startVector(elemSize int, numElems int, alignment int) {
+	b.prep(4, elemSize*numElems)
+	b.prep(alignment, elemSize*numElems)
+}
+
+// endVector writes the vector length and returns the position of the vector.
+func (b *fbBuilder) endVector(numElems int) int {
+	binary.LittleEndian.PutUint32(b.place(4), uint32(numElems))
+	return b.offset()
+}
+
+func (b *fbBuilder) createString(s string) int {
+	b.prep(4, len(s)+1)
+	b.place(1)
+	copy(b.place(len(s)), s)
+	return b.endVector(len(s))
+}
+
+func (b *fbBuilder) createOffsetVector(positions []int) int {
+	b.startVector(4, len(positions), 4)
+	for i := len(positions) - 1; i >= 0; i-- {
+		b.prependOffset(positions[i])
+	}
+	return b.endVector(len(positions))
+}
+
+// finish writes the offset to the root object and returns the flatbuffer.
+func (b *fbBuilder) finish(root int) []byte {
+	b.prep(b.minAlign, 4)
+	b.prependOffset(root)
+	return b.buf
+}
+
+// Arrow IPC constants, from Message.fbs and Schema.fbs.
+const (
+	arrowMetadataV5 = 4
+
+	arrowHeaderSchema      = 1
+	arrowHeaderRecordBatch = 3
+
+	arrowTypeInt    = 2
+	arrowTypeBinary = 4
+	arrowTypeUtf8   = 5
+	arrowTypeBool   = 6
+)
+
+// arrowType is the type of a column.
+type arrowType int
+
+const (
+	arrowUint64 arrowType = iota
+	arrowInt64
+	arrowBool
+	arrowBinary
+	arrowString
+)
+
+// arrowField describes a column of the exported table.
+type arrowField struct {
+	name     string
+	typ      arrowType
+	nullable bool
+}
+
+// schema writes the Field table describing the column and returns its position.
+func (f arrowField) schema(b *fbBuilder) int {
+	name := b.createString(f.name)
+
+	var typeID uint8
+	switch f.typ {
+	case arrowUint64, arrowInt64:
+		typeID = arrowTypeInt
+	case arrowBool:
+		typeID = arrowTypeBool
+	case arrowBinary:
+		typeID = arrowTypeBinary
+	case arrowString:
+		typeID = arrowTypeUtf8
+	}
+	b.startObject(2)
+	if typeID == arrowTypeInt {
+		// bit width, is signed
+		b.addUint32(0, 64)
+		if f.typ == arrowInt64 {
+			b.addUint8(1, 1)
+		}
+	}
+	typ := b.endObject()
+	children := b.createOffsetVector(nil)
+
+	nullable := uint8(0)
+	if f.nullable {
+		nullable = 1
+	}
+	// name, nullable, type type, type, dictionary, children, custom metadata
+	b.startObject(7)
+	b.addOffset(0, name)
+	b.addUint8(1, nullable)
+	b.addUint8(2, typeID)
+	b.addOffset(3, typ)
+	b.addOffset(5, children)
+	return b.endObject()
+}
+
+// arrowColumn accumulates the values of a column for the current record batch.
+type arrowColumn struct {
+	field     arrowField
+	validity  []byte
+	nullCount int
+	data      []byte
+	// offsets of the binary values, as int32.
+	offsets []byte
+}
+
+func (c *arrowColumn) reset() {
+	c.validity = c.validity[:0]
+	c.nullCount = 0
+	c.data = c.data[:0]
+	c.offsets = append(c.offsets[:0], 0, 0, 0, 0)
+}
+
+// size returns the number of bytes the column holds for the current record batch.
+func (c *arrowColumn) size() int {
+	return len(c.validity) + len(c.data) + len(c.offsets)
+}
+
+// appendVariable appends a binary value, whose end must fit in the int32 offsets.
+func (c *arrowColumn) appendVariable(v []byte) error {
+	if len(c.data)+len(v) > math.MaxInt32 {
+		return fmt.Errorf("column %s: record batch data exceeds %d bytes", c.field.name, math.MaxInt32)
+	}
+	c.data = append(c.data, v...)
+	c.offsets = appendUint32(c.offsets, uint32(len(c.data)))
+	return nil
+}
+
+func (c *arrowColumn) append(row int, value interface{}) error {
+	if row%8 == 0 {
+		c.validity = append(c.validity, 0)
+	}
+	if c.field.typ == arrowBool && row%8 == 0 {
+		c.data = append(c.data, 0)
+	}
+	if value == nil {
+		if !c.field.nullable {
+			return fmt.Errorf("column %s is not nullable", c.field.name)
+		}
+		c.nullCount++
+		switch c.field.typ {
+		case arrowUint64, arrowInt64:
+			c.data = append(c.data, make([]byte, 8)...)
+		case arrowBinary, arrowString:
+			c.offsets = appendUint32(c.offsets, uint32(len(c.data)))
+		}
+		return nil
+	}
+	c.validity[row/8] |= 1 << (row % 8)
+
+	var ok bool
+	var err error
+	switch c.field.typ {
+	case arrowUint64:
+		var v uint64
+		v, ok = value.(uint64)
+		c.data = appendUint64(c.data, v)
+	case arrowInt64:
+		var v int64
+		v, ok = value.(int64)
+		c.data = appendUint64(c.data, uint64(v))
+	case arrowBool:
+		var v bool
+		v, ok = value.(bool)
+		if v {
+			c.data[row/8] |= 1 << (row % 8)
+		}
+	case arrowBinary:
+		var v []byte
+		v, ok = value.([]byte)
+		err = c.appendVariable(v)
+	case arrowString:
+		var v string
+		v, ok = value.(string)
+		err = c.appendVariable([]byte(v))
+	}
+	if !ok {
+		return fmt.Errorf("unexpected value %T for column %s", value, c.field.name)
+	}
+	return err
+}
+
+// buffers returns the Arrow buffers of the column for a batch of rows rows, the validity bitmap being omitted
+// when there are no nulls. When all the values are null, the bitmap is zeroed and padded to 64 bytes, as the
+// Arrow Go writer does.
+func (c *arrowColumn) buffers(rows int) [][]byte {
+	validity := c.validity
+	switch c.nullCount {
+	case 0:
+		validity = nil
+	case rows:
+		validity = make([]byte, (len(c.validity)+63)&^63)
+	}
+	switch c.field.typ {
+	case arrowBinary, arrowString:
+		return [][]byte{validity, c.offsets, c.data}
+	default:
+		return [][]byte{validity, c.data}
+	}
+}
+
+// arrowBatchBytes is the size of the column data past which a record batch is flushed, whatever its number of
+// rows, so that large values such as boxes do not make record batches huge.
+const arrowBatchBytes = 64 << 20
+
+// arrowStreamWriter writes rows as an Arrow IPC stream, flushing a record batch every batchRows rows, or once
+// its data reaches batchBytes, so that the memory use is bounded regardless of the number of rows.
+type arrowStreamWriter struct {
+	w             io.Writer
+	columns       []*arrowColumn
+	rows          int
+	batchRows     int
+	batchBytes    int
+	schemaWritten bool
+}
+
+func makeArrowStreamWriter(w io.Writer, fields []arrowField, batchRows int) *arrowStreamWriter {
+	columns := make([]*arrowColumn, len(fields))
+	for i := range fields {
+		columns[i] = &arrowColumn{field: fields[i]}
+		columns[i].reset()
+	}
+	return &arrowStreamWriter{w: w, columns: columns, batchRows: batchRows, batchBytes: arrowBatchBytes}
+}
+
+// Append adds a row holding a value for each column, nil values being nulls.
+func (w *arrowStreamWriter) Append(values ...interface{}) error {
+	if len(values) != len(w.columns) {
+		return fmt.Errorf("expected %d values but got %d", len(w.columns), len(values))
+	}
+	for i, value := range values {
+		err := w.columns[i].append(w.rows, value)
+		if err != nil {
+			return err
+		}
+	}
+	w.rows++
+	if w.rows >= w.batchRows || w.size() >= w.batchBytes {
+		return w.flush()
+	}
+	return nil
+}
+
+// size returns the number of bytes held for the current record batch.
+func (w *arrowStreamWriter) size() int {
+	var size int
+	for _, c := range w.columns {
+		size += c.size()
+	}
+	return size
+}
+
+// Close writes the pending rows and the end of the stream.
+func (w *arrowStreamWriter) Close() error {
+	err := w.flush()
+	if err != nil {
+		return err
+	}
+	// end of stream marker: a continuation followed by a zero length.
+	_, err = w.w.Write([]byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0})
+	return err
+}
+
+// writeMessage finishes the metadata flatbuffer with the Message table and writes it, followed by the body.
+func (w *arrowStreamWriter) writeMessage(b *fbBuilder, headerType uint8, header int, body []byte) error {
+	// version, header type, header, body length, custom metadata
+	b.startObject(5)
+	b.addUint16(0, arrowMetadataV5)
+	b.addUint8(1, headerType)
+	b.addOffset(2, header)
+	b.addUint64(3, uint64(len(body)))
+	metadata := b.finish(b.endObject())
+
+	// the metadata is padded so that the body is aligned to 8 bytes.
+	padding := -len(metadata) & 7
+	prefix := make([]byte, 8)
+	binary.LittleEndian.PutUint32(prefix, 0xffffffff)
+	binary.LittleEndian.PutUint32(prefix[4:], uint32(len(metadata)+padding))
+	for _, data := range [][]byte{prefix, metadata, make([]byte, padding), body} {
+		_, err := w.w.Write(data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *arrowStreamWriter) flush() error {
+	if !w.schemaWritten {
+		b := makeFbBuilder()
+		fields := make([]int, len(w.columns))
+		for i, c := range w.columns {
+			fields[i] = c.field.schema(b)
+		}
+		fieldsVector := b.createOffsetVector(fields)
+		// endianness, fields, custom metadata, features
+		b.startObject(4)
+		b.addOffset(1, fieldsVector)
+		err := w.writeMessage(b, arrowHeaderSchema, b.endObject(), nil)
+		if err != nil {
+			return err
+		}
+		w.schemaWritten = true
+	}
+	if w.rows == 0 {
+		return nil
+	}
+
+	type arrowBuffer struct {
+		offset int
+		length int
+	}
+	var buffers []arrowBuffer
+	var body []byte
+	for _, c := range w.columns {
+		for _, buf := range c.buffers(w.rows) {
+			buffers = append(buffers, arrowBuffer{offset: len(body), length: len(buf)})
+			body = append(body, buf...)
+			for len(body)%8 != 0 {
+				body = append(body, 0)
+			}
+		}
+	}
+
+	// the field nodes and buffers are vectors of structs, written last to first.
+	b := makeFbBuilder()
+	b.startVector(16, len(w.columns), 8)
+	for i := len(w.columns) - 1; i >= 0; i-- {
+		b.prep(8, 16)
+		b.prependUint64(uint64(w.columns[i].nullCount))
+		b.prependUint64(uint64(w.rows))
+	}
+	nodes := b.endVector(len(w.columns))
+	b.startVector(16, len(buffers), 8)
+	for i := len(buffers) - 1; i >= 0; i-- {
+		b.prep(8, 16)
+		b.prependUint64(uint64(buffers[i].length))
+		b.prependUint64(uint64(buffers[i].offset))
+	}
+	buffersVector := b.endVector(len(buffers))
+	// length, nodes, buffers, compression
+	b.startObject(4)
+	b.addUint64(0, uint64(w.rows))
+	b.addOffset(1, nodes)
+	b.addOffset(2, buffersVector)
+	err := w.writeMessage(b, arrowHeaderRecordBatch, b.endObject(), body)
+	if err != nil {
+		return err
+	}
+
+	w.rows = 0
+	for _, c := range w.columns {
+		c.reset()
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var auditCatchpointFile string
+
+func init() {
+	auditCmd.Flags().StringVarP(&auditCatchpointFile, "tar", "t", "", "Specify the catchpoint file (either .tar or .tar.gz) to audit")
+}
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Verify the integrity of a catchpoint file",
+	Long: "Verify the integrity of a catchpoint file by recomputing the accounts merkle trie root, the account totals and the " +
+		"entries counts from the file contents, and checking them against the catchpoint label of the file header.",
+	Args:         validateNoPosArgsFn,
+	SilenceUsage: true, // prevent printing usage info on error
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if auditCatchpointFile == "" {
+			cmd.HelpFunc()(cmd, args)
+			return fmt.Errorf("catchpoint file not set")
+		}
+
+		tempDir, err := os.MkdirTemp("", "catchpointdump")
+		if err != nil {
+			reportErrorf("Unable to create temporary directory : %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		ledgerPrefix := filepath.Join(tempDir, "ledger")
+		mismatches, err := auditCatchpoint(context.Background(), auditCatchpointFile, ledgerPrefix, os.Stdout)
+		if err != nil {
+			reportErrorf("Unable to audit catchpoint file '%s' : %v", auditCatchpointFile, err)
+		}
+		if mismatches > 0 {
+			return fmt.Errorf("catchpoint file '%s' failed %d checks", auditCatchpointFile, mismatches)
+		}
+		return nil
+	},
+}
+
+// catchpointAudit holds the state recomputed from the contents of a catchpoint file.
+type catchpointAudit struct {
+	balancesHash  crypto.Digest
+	spverHash     crypto.Digest
+	totals        ledgercore.AccountTotals
+	totalAccounts uint64
+	totalKVs      uint64
+}
+
+// auditCatchpoint loads the catchpoint file into a ledger created with the given prefix, recomputes its state and
+// writes the result of each check to outFile. It returns the number of failed checks.
+func auditCatchpoint(ctx context.Context, catchpointFileName string, ledgerPrefix string, outFile io.Writer) (mismatches int, err error) {
+	l, catchupAccessor, fileHeader, err := loadCatchpointFile(ctx, catchpointFileName, ledgerPrefix)
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	reportInfof("Building merkle trie for catchpoint %s", fileHeader.Catchpoint)
+	err = catchupAccessor.BuildMerkleTrie(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("unable to build merkle trie : %w", err)
+	}
+	var audit catchpointAudit
+	audit.balancesHash, audit.spverHash, _, err = catchupAccessor.GetVerifyData(ctx)
+	if err != nil {
+		return 0, err
+	}
+	err = computeStagingTotals(ledgerPrefix+".tracker.sqlite", fileHeader.Totals.RewardsLevel, &audit)
+	if err != nil {
+		return 0, fmt.Errorf("unable to compute account totals : %w", err)
+	}
+
+	check := func(name string, expected, actual interface{}) {
+		if expected == actual {
+			fmt.Fprintf(outFile, "[ OK ] %s: %v\n", name, actual)
+			return
+		}
+		mismatches++
+		fmt.Fprintf(outFile, "[ MISMATCH ] %s: header %v, computed %v\n", name, expected, actual)
+	}
+
+	fmt.Fprintf(outFile, "Version: %d\n", fileHeader.Version)
+	fmt.Fprintf(outFile, "Balances Round: %d\n", fileHeader.BalancesRound)
+	fmt.Fprintf(outFile, "Block Round: %d\n", fileHeader.BlocksRound)
+	fmt.Fprintf(outFile, "Block Header Digest: %s\n", fileHeader.BlockHeaderDigest)
+	fmt.Fprintf(outFile, "Accounts Merkle Root: %s\n", audit.balancesHash)
+	if fileHeader.Version > ledger.CatchpointFileVersionV6 {
+		fmt.Fprintf(outFile, "State Proof Verification Data Hash: %s\n", audit.spverHash)
+	}
+
+	check("Total Accounts", fileHeader.TotalAccounts, audit.totalAccounts)
+	check("Total KVs", fileHeader.TotalKVs, audit.totalKVs)
+	check("AccountTotals - Online Money", fileHeader.Totals.Online.Money.Raw, audit.totals.Online.Money.Raw)
+	check("AccountTotals - Online RewardUnits", fileHeader.Totals.Online.RewardUnits, audit.totals.Online.RewardUnits)
+	check("AccountTotals - Offline Money", fileHeader.Totals.Offline.Money.Raw, audit.totals.Offline.Money.Raw)
+	check("AccountTotals - Offline RewardUnits", fileHeader.Totals.Offline.RewardUnits, audit.totals.Offline.RewardUnits)
+	check("AccountTotals - Not Participating Money", fileHeader.Totals.NotParticipating.Money.Raw, audit.totals.NotParticipating.Money.Raw)
+	check("AccountTotals - Not Participating RewardUnits", fileHeader.Totals.NotParticipating.RewardUnits, audit.totals.NotParticipating.RewardUnits)
+
+	// the label covers the totals of the header, any difference with the recomputed totals is reported above.
+	var labelMaker ledgercore.CatchpointLabelMaker
+	if fileHeader.Version <= ledger.CatchpointFileVersionV6 {
+		labelMaker = ledgercore.MakeCatchpointLabelMakerV6(fileHeader.BlocksRound, &fileHeader.BlockHeaderDigest, &audit.balancesHash, fileHeader.Totals)
+	} else {
+		labelMaker = ledgercore.MakeCatchpointLabelMakerCurrent(fileHeader.BlocksRound, &fileHeader.BlockHeaderDigest, &audit.balancesHash, fileHeader.Totals, &audit.spverHash)
+	}
+	check("Catchpoint", fileHeader.Catchpoint, ledgercore.MakeLabel(labelMaker))
+	return mismatches, nil
+}
+
+// computeStagingTotals counts the accounts and key value entries of the catchpoint staging tables, and sums
+// the account balances at the given rewards level.
+func computeStagingTotals(databaseName string, rewardsLevel uint64, audit *catchpointAudit) error {
+	dbAccessor, err := db.MakeAccessor(databaseName, true, false)
+	if err != nil {
+		return err
+	}
+	defer dbAccessor.Close()
+
+	// the catchpoint file header does not hold the protocol, the reward unit has never changed.
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	return dbAccessor.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, "SELECT count(*) FROM catchpointkvstore").Scan(&audit.totalKVs)
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, "SELECT data FROM catchpointbalances")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		audit.totals = ledgercore.AccountTotals{RewardsLevel: rewardsLevel}
+		var ot basics.OverflowTracker
+		for rows.Next() {
+			var buf []byte
+			err = rows.Scan(&buf)
+			if err != nil {
+				return err
+			}
+			var data trackerdb.BaseAccountData
+			err = protocol.Decode(buf, &data)
+			if err != nil {
+				return err
+			}
+			audit.totals.AddAccount(proto, data.GetLedgerCoreAccountData(), &ot)
+			audit.totalAccounts++
+		}
+		if ot.Overflowed {
+			return fmt.Errorf("overflow computing account totals")
+		}
+		// increase the deadline warning to disable the warning message.
+		_, _ = db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(5*time.Second))
+		return rows.Err()
+	})
+}
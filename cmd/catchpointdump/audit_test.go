@@ -0,0 +1,217 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/eval"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testCatchpointBalance is the balance of the second test account, which shows up as such in the catchpoint
+// balances chunks: the msgpack encoding of a uint32.
+const testCatchpointBalance = 0x0badcafe
+
+// testCatchpoint is the catchpoint file of a small ledger holding an asset, an application and a box.
+type testCatchpoint struct {
+	genesis bookkeeping.Genesis
+	// ledger is the source ledger of the catchpoint, closed at the end of the test.
+	ledger  *ledger.Ledger
+	addrs   []basics.Address
+	file    string
+	round   basics.Round
+	assetID basics.AssetIndex
+	appID   basics.AppIndex
+}
+
+// makeTestCatchpoint writes a ledger whose first rounds create an asset, an application and one of its boxes,
+// and adds payment rounds until a catchpoint file is generated.
+func makeTestCatchpoint(t *testing.T) testCatchpoint {
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "v1",
+		Network:     "catchpointdumptest",
+		Proto:       protocol.ConsensusCurrentVersion,
+		FeeSink:     genBalances.FeeSink.String(),
+		RewardsPool: genBalances.RewardsPool.String(),
+	}
+	for addr, data := range genBalances.Balances {
+		if addr == addrs[1] {
+			data.MicroAlgos.Raw = testCatchpointBalance
+		}
+		genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{Address: addr.String(), State: data})
+	}
+	genesisBalances, err := genesis.Balances()
+	require.NoError(t, err)
+	genesisBlock, err := genesis.Block()
+	require.NoError(t, err)
+	genesisInitState := ledgercore.InitState{
+		Block:       genesisBlock,
+		Accounts:    genesisBalances.Balances,
+		GenesisHash: genesis.Hash(),
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.MaxAcctLookback = 2
+	cfg.CatchpointInterval = 16
+	cfg.CatchpointTracking = 2
+	dbPrefix := filepath.Join(t.TempDir(), genesis.ID(), config.LedgerFilenamePrefix)
+	require.NoError(t, os.MkdirAll(filepath.Dir(dbPrefix), 0700))
+	l, err := ledger.OpenLedger(logging.TestingLog(t), dbPrefix, false, genesisInitState, cfg)
+	require.NoError(t, err)
+	t.Cleanup(l.Close)
+
+	tc := testCatchpoint{genesis: genesis, ledger: l, addrs: addrs}
+	proto := config.Consensus[genesis.Proto]
+	addRound := func(txn txntest.Txn) transactions.ApplyData {
+		prev, err := l.BlockHdr(l.Latest())
+		require.NoError(t, err)
+		blk := bookkeeping.MakeBlock(prev)
+		blockEval, err := eval.StartEvaluator(l, blk.BlockHeader, eval.EvaluatorOptions{Generate: true, MaxTxnBytesPerBlock: proto.MaxTxnBytesPerBlock})
+		require.NoError(t, err)
+		txn.Sender = addrs[0]
+		txn.FirstValid = prev.Round
+		txn.GenesisHash = genesis.Hash()
+		txn.FillDefaults(proto)
+		require.NoError(t, blockEval.Transaction(txn.SignedTxn(), transactions.ApplyData{}))
+		vb, err := blockEval.GenerateBlock()
+		require.NoError(t, err)
+		err = l.AddValidatedBlock(ledgercore.MakeValidatedBlock(vb.Block(), vb.Delta()), agreement.Certificate{Round: vb.Block().Round()})
+		require.NoError(t, err)
+		return vb.Block().Payset[0].ApplyData
+	}
+
+	tc.assetID = addRound(txntest.Txn{
+		Type:        protocol.AssetConfigTx,
+		AssetParams: basics.AssetParams{Total: 1000, UnitName: "tst", AssetName: "test"},
+	}).ConfigAsset
+	tc.appID = addRound(txntest.Txn{
+		Type: protocol.ApplicationCallTx,
+		ApprovalProgram: `#pragma version 8
+txn ApplicationID
+bz create
+byte "box"
+byte "value"
+box_put
+create:
+int 1`,
+	}).ApplicationID
+	addRound(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Receiver: tc.appID.Address(),
+		Amount:   1000000,
+	})
+	addRound(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		ApplicationID: tc.appID,
+		Boxes:         []transactions.BoxRef{{Name: []byte("box")}},
+	})
+
+	// the first stage of a catchpoint is skipped while the data file of the previous one is being written, so
+	// the round of the first catchpoint file depends on the timing of the tracker commits.
+	for i := 0; i < 2000; i++ {
+		addRound(txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Receiver: addrs[2+i%(len(addrs)-2)],
+			Amount:   uint64(1000 + i),
+		})
+		if uint64(l.Latest()) <= proto.CatchpointLookback {
+			continue
+		}
+		l.WaitForCommit(l.Latest())
+		label := l.GetLastCatchpointLabel()
+		if label == "" {
+			continue
+		}
+		tc.round, _, err = ledgercore.ParseCatchpointLabel(label)
+		require.NoError(t, err)
+		// the catchpoint file is written after the label is set.
+		var stream ledger.ReadCloseSizer
+		require.Eventually(t, func() bool {
+			stream, err = l.GetCatchpointStream(tc.round)
+			return err == nil
+		}, 10*time.Second, 10*time.Millisecond)
+		defer stream.Close()
+		tc.file = filepath.Join(t.TempDir(), "test.catchpoint")
+		f, err := os.Create(tc.file)
+		require.NoError(t, err)
+		defer f.Close()
+		_, err = io.Copy(f, stream)
+		require.NoError(t, err)
+		return tc
+	}
+	require.FailNow(t, "no catchpoint file was generated")
+	return tc
+}
+
+func TestAuditCatchpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tc := makeTestCatchpoint(t)
+	ctx := context.Background()
+
+	var out strings.Builder
+	mismatches, err := auditCatchpoint(ctx, tc.file, filepath.Join(t.TempDir(), "ledger"), &out)
+	require.NoError(t, err)
+	require.Zero(t, mismatches, out.String())
+	require.Contains(t, out.String(), "[ OK ] Catchpoint: "+tc.ledger.GetLastCatchpointLabel())
+	require.NotContains(t, out.String(), "MISMATCH")
+
+	// tamper with the balance of an account, in a decompressed copy of the catchpoint file.
+	compressed, err := os.ReadFile(tc.file)
+	require.NoError(t, err)
+	gzipReader, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	contents, err := io.ReadAll(gzipReader)
+	require.NoError(t, err)
+	balance := []byte{0xce, 0x0b, 0xad, 0xca, 0xfe}
+	require.Equal(t, 1, bytes.Count(contents, balance))
+	contents = bytes.Replace(contents, balance, []byte{0xce, 0x0b, 0xad, 0xca, 0xff}, 1)
+	tamperedFile := filepath.Join(t.TempDir(), "tampered.tar")
+	require.NoError(t, os.WriteFile(tamperedFile, contents, 0600))
+
+	out.Reset()
+	mismatches, err = auditCatchpoint(ctx, tamperedFile, filepath.Join(t.TempDir(), "ledger"), &out)
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "[ MISMATCH ] Catchpoint: header "+tc.ledger.GetLastCatchpointLabel())
+	require.Contains(t, out.String(), "[ MISMATCH ] AccountTotals - Offline Money")
+	require.Equal(t, 2, mismatches, out.String())
+}
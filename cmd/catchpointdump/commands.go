@@ -44,6 +44,10 @@ func init() {
 	rootCmd.AddCommand(fileCmd)
 	rootCmd.AddCommand(netCmd)
 	rootCmd.AddCommand(databaseCmd)
+	rootCmd.AddCommand(forkCmd)
+	rootCmd.AddCommand(diffCmd)
+	rootCmd.AddCommand(auditCmd)
+	rootCmd.AddCommand(exportCmd)
 }
 
 var rootCmd = &cobra.Command{
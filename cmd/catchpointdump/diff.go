@@ -0,0 +1,496 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/msgp/msgp"
+)
+
+var diffCatchpointFiles []string
+var diffTrackerFilename string
+var diffTrackerStaging bool
+
+func init() {
+	diffCmd.Flags().StringSliceVarP(&diffCatchpointFiles, "tar", "t", nil, "Specify a catchpoint file (either .tar or .tar.gz) to compare, can be repeated")
+	diffCmd.Flags().StringVarP(&diffTrackerFilename, "tracker", "d", "", "Specify a ledger tracker file name to compare against the catchpoint file ( i.e. ./ledger.tracker.sqlite )")
+	diffCmd.Flags().BoolVarP(&diffTrackerStaging, "staging", "s", false, "Specify whether to look in the catchpoint staging or regular tables of the tracker database. (default false)")
+	diffCmd.Flags().StringVarP(&outFileName, "output", "o", "", "Specify an outfile for the differences ( i.e. catchpoint.diff.txt )")
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Compare two catchpoint files, or a catchpoint file and a ledger tracker database",
+	Long: "Compare two catchpoint files, or a catchpoint file and a ledger tracker database, and report the accounts, resources and " +
+		"key value entries that differ. Lines starting with '<' belong to the first source and lines starting with '>' to the second one.",
+	Args:         validateNoPosArgsFn,
+	SilenceUsage: true, // prevent printing usage info on error
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		sourcesCount := len(diffCatchpointFiles)
+		if diffTrackerFilename != "" {
+			sourcesCount++
+		}
+		if len(diffCatchpointFiles) == 0 || sourcesCount != 2 {
+			cmd.HelpFunc()(cmd, args)
+			return fmt.Errorf("either two catchpoint files or a catchpoint file and a tracker database are needed")
+		}
+
+		tempDir, err := os.MkdirTemp("", "catchpointdump")
+		if err != nil {
+			reportErrorf("Unable to create temporary directory : %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		var sources [2]trackerSource
+		for i, catchpointFileName := range diffCatchpointFiles {
+			ledgerPrefix := filepath.Join(tempDir, fmt.Sprintf("ledger%d", i))
+			l, _, fileHeader, err := loadCatchpointFile(context.Background(), catchpointFileName, ledgerPrefix)
+			if err != nil {
+				reportErrorf("Unable to load catchpoint file '%s' : %v", catchpointFileName, err)
+			}
+			l.Close()
+			sources[i] = trackerSource{
+				name:     catchpointFileName,
+				database: ledgerPrefix + ".tracker.sqlite",
+				staging:  true,
+				round:    fileHeader.BalancesRound,
+			}
+		}
+		if diffTrackerFilename != "" {
+			round, err := getTrackerRound(diffTrackerFilename, diffTrackerStaging)
+			if err != nil {
+				reportErrorf("Unable to read tracker database round : %v", err)
+			}
+			sources[1] = trackerSource{
+				name:     diffTrackerFilename,
+				database: diffTrackerFilename,
+				staging:  diffTrackerStaging,
+				round:    round,
+			}
+		}
+
+		outFile := os.Stdout
+		if outFileName != "" {
+			outFile, err = os.OpenFile(outFileName, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0755)
+			if err != nil {
+				reportErrorf("Unable to create file '%s' : %v", outFileName, err)
+			}
+			defer outFile.Close()
+		}
+
+		var stats diffStats
+		stats, err = diffDatabases(sources[0], sources[1], outFile)
+		if err != nil {
+			reportErrorf("Unable to compare '%s' and '%s' : %v", sources[0].name, sources[1].name, err)
+		}
+		if stats.accounts+stats.resources+stats.kvs > 0 {
+			return fmt.Errorf("found %d account, %d resource and %d key value differences", stats.accounts, stats.resources, stats.kvs)
+		}
+		return nil
+	},
+}
+
+// trackerSource is a ledger tracker database holding the state to compare or export, either in its regular or catchpoint staging tables.
+type trackerSource struct {
+	name     string
+	database string
+	staging  bool
+	round    basics.Round
+}
+
+func (s trackerSource) tables() (balancesTable string, resourcesTable string, kvTable string) {
+	if s.staging {
+		return "catchpointbalances", "catchpointresources", "catchpointkvstore"
+	}
+	return "accountbase", "resources", "kvstore"
+}
+
+// diffStats counts the differences found for each kind of record.
+type diffStats struct {
+	accounts  uint64
+	resources uint64
+	kvs       uint64
+}
+
+// getTrackerRound returns the balances round of the tracker database.
+func getTrackerRound(filename string, staging bool) (round basics.Round, err error) {
+	dbAccessor, err := db.MakeAccessor(filename, true, false)
+	if err != nil {
+		return 0, err
+	}
+	defer dbAccessor.Close()
+	err = dbAccessor.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		if staging {
+			cw := sqlitedriver.NewCatchpointSQLReaderWriter(tx)
+			var rnd uint64
+			rnd, err = cw.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupBalancesRound)
+			round = basics.Round(rnd)
+			return err
+		}
+		round, err = sqlitedriver.NewAccountsSQLReaderWriter(tx).AccountsRound()
+		return err
+	})
+	return round, err
+}
+
+// diffRecord is a single record of a table, with its value normalized so that records holding the same state compare equal.
+type diffRecord struct {
+	key   []byte
+	value []byte
+}
+
+// diffCursor iterates over the records of a table ordered by key.
+type diffCursor interface {
+	// next returns the next record, or false once all the records have been read.
+	next() (diffRecord, bool, error)
+}
+
+// mergeRecords walks two cursors in key order and visits every key found in either of them. A nil value means the
+// key is missing from that side.
+func mergeRecords(left, right diffCursor, visit func(key, leftValue, rightValue []byte) error) error {
+	leftRecord, leftOk, err := left.next()
+	if err != nil {
+		return err
+	}
+	rightRecord, rightOk, err := right.next()
+	if err != nil {
+		return err
+	}
+	for leftOk || rightOk {
+		var cmp int
+		switch {
+		case !leftOk:
+			cmp = 1
+		case !rightOk:
+			cmp = -1
+		default:
+			cmp = bytes.Compare(leftRecord.key, rightRecord.key)
+		}
+
+		switch {
+		case cmp < 0:
+			err = visit(leftRecord.key, leftRecord.value, nil)
+		case cmp > 0:
+			err = visit(rightRecord.key, nil, rightRecord.value)
+		default:
+			err = visit(leftRecord.key, leftRecord.value, rightRecord.value)
+		}
+		if err != nil {
+			return err
+		}
+
+		if cmp <= 0 {
+			leftRecord, leftOk, err = left.next()
+			if err != nil {
+				return err
+			}
+		}
+		if cmp >= 0 {
+			rightRecord, rightOk, err = right.next()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sliceCursor iterates over records already loaded in memory.
+type sliceCursor []diffRecord
+
+func (c *sliceCursor) next() (diffRecord, bool, error) {
+	if len(*c) == 0 {
+		return diffRecord{}, false, nil
+	}
+	record := (*c)[0]
+	*c = (*c)[1:]
+	return record, true, nil
+}
+
+// accountCursor iterates over the accounts of a tracker database ordered by address, loading the resources of each account.
+type accountCursor struct {
+	rows          *sql.Rows
+	resourcesStmt *sql.Stmt
+	// resources of the last account returned, ordered by creatable index.
+	resources []diffRecord
+}
+
+func makeAccountCursor(ctx context.Context, tx *sql.Tx, source trackerSource) (*accountCursor, error) {
+	balancesTable, resourcesTable, _ := source.tables()
+	resourcesStmt, err := tx.PrepareContext(ctx, fmt.Sprintf("SELECT aidx, data FROM %s WHERE addrid = ? ORDER BY aidx", resourcesTable))
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT rowid, address, data FROM %s ORDER BY address", balancesTable))
+	if err != nil {
+		resourcesStmt.Close()
+		return nil, err
+	}
+	return &accountCursor{rows: rows, resourcesStmt: resourcesStmt}, nil
+}
+
+func (c *accountCursor) close() {
+	c.rows.Close()
+	c.resourcesStmt.Close()
+}
+
+func (c *accountCursor) next() (diffRecord, bool, error) {
+	if !c.rows.Next() {
+		return diffRecord{}, false, c.rows.Err()
+	}
+	var rowid int64
+	var addrbuf []byte
+	var buf []byte
+	err := c.rows.Scan(&rowid, &addrbuf, &buf)
+	if err != nil {
+		return diffRecord{}, false, err
+	}
+	if len(addrbuf) != len(basics.Address{}) {
+		return diffRecord{}, false, fmt.Errorf("account DB address length mismatch: %d != %d", len(addrbuf), len(basics.Address{}))
+	}
+
+	var data trackerdb.BaseAccountData
+	err = protocol.Decode(buf, &data)
+	if err != nil {
+		return diffRecord{}, false, err
+	}
+	// the round of the last update depends on the history of the database, not on the state.
+	data.UpdateRound = 0
+
+	c.resources, err = c.loadResources(rowid)
+	if err != nil {
+		return diffRecord{}, false, err
+	}
+	return diffRecord{key: addrbuf, value: protocol.Encode(&data)}, true, nil
+}
+
+func (c *accountCursor) loadResources(rowid int64) (resources []diffRecord, err error) {
+	rows, err := c.resourcesStmt.Query(rowid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var aidx int64
+		var buf []byte
+		err = rows.Scan(&aidx, &buf)
+		if err != nil {
+			return nil, err
+		}
+		var data trackerdb.ResourcesData
+		err = protocol.Decode(buf, &data)
+		if err != nil {
+			return nil, err
+		}
+		data.UpdateRound = 0
+
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, uint64(aidx))
+		resources = append(resources, diffRecord{key: key, value: protocol.Encode(&data)})
+	}
+	return resources, rows.Err()
+}
+
+// kvCursor iterates over the key value entries of a tracker database ordered by key.
+type kvCursor struct {
+	rows *sql.Rows
+}
+
+func (c kvCursor) next() (diffRecord, bool, error) {
+	if !c.rows.Next() {
+		return diffRecord{}, false, c.rows.Err()
+	}
+	var record diffRecord
+	err := c.rows.Scan(&record.key, &record.value)
+	if err != nil {
+		return diffRecord{}, false, err
+	}
+	// a nil value would be mistaken for a missing entry.
+	if record.value == nil {
+		record.value = []byte{}
+	}
+	return record, true, nil
+}
+
+// diffDatabases compares the accounts, resources and key value entries of the two sources, writing the differences to outFile.
+func diffDatabases(left, right trackerSource, outFile io.Writer) (stats diffStats, err error) {
+	fileWriter := bufio.NewWriterSize(outFile, 1024*1024)
+	defer fileWriter.Flush()
+
+	fmt.Fprintf(fileWriter, "< %s : round %d\n", left.name, left.round)
+	fmt.Fprintf(fileWriter, "> %s : round %d\n", right.name, right.round)
+	if left.round != right.round {
+		fmt.Fprintf(fileWriter, "Warning: comparing the state of different rounds\n")
+	}
+
+	leftAccessor, err := db.MakeAccessor(left.database, true, false)
+	if err != nil {
+		return diffStats{}, err
+	}
+	defer leftAccessor.Close()
+	rightAccessor, err := db.MakeAccessor(right.database, true, false)
+	if err != nil {
+		return diffStats{}, err
+	}
+	defer rightAccessor.Close()
+
+	err = leftAccessor.Atomic(func(ctx context.Context, leftTx *sql.Tx) error {
+		return rightAccessor.Atomic(func(ctx context.Context, rightTx *sql.Tx) (err error) {
+			err = diffAccounts(ctx, leftTx, rightTx, left, right, fileWriter, &stats)
+			if err != nil {
+				return err
+			}
+			err = diffKeyValues(ctx, leftTx, rightTx, left, right, fileWriter, &stats)
+			if err != nil {
+				return err
+			}
+			// increase the deadline warning to disable the warning message.
+			_, _ = db.ResetTransactionWarnDeadline(ctx, leftTx, time.Now().Add(5*time.Second))
+			_, _ = db.ResetTransactionWarnDeadline(ctx, rightTx, time.Now().Add(5*time.Second))
+			return nil
+		})
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	fmt.Fprintf(fileWriter, "Account differences: %d\n", stats.accounts)
+	fmt.Fprintf(fileWriter, "Resource differences: %d\n", stats.resources)
+	fmt.Fprintf(fileWriter, "Key value differences: %d\n", stats.kvs)
+	return stats, nil
+}
+
+func diffAccounts(ctx context.Context, leftTx, rightTx *sql.Tx, left, right trackerSource, writer io.Writer, stats *diffStats) error {
+	leftCursor, err := makeAccountCursor(ctx, leftTx, left)
+	if err != nil {
+		return err
+	}
+	defer leftCursor.close()
+	rightCursor, err := makeAccountCursor(ctx, rightTx, right)
+	if err != nil {
+		return err
+	}
+	defer rightCursor.close()
+
+	return mergeRecords(leftCursor, rightCursor, func(key, leftValue, rightValue []byte) error {
+		var addr basics.Address
+		copy(addr[:], key)
+		if !bytes.Equal(leftValue, rightValue) {
+			stats.accounts++
+			fmt.Fprintf(writer, "account %s\n", addr)
+			err := writeDiffValues(writer, leftValue, rightValue, func() msgp.Unmarshaler { return &trackerdb.BaseAccountData{} })
+			if err != nil {
+				return err
+			}
+		}
+
+		// the cursors are only advanced after the visit, so their resources belong to this account.
+		var leftResources, rightResources sliceCursor
+		if leftValue != nil {
+			leftResources = leftCursor.resources
+		}
+		if rightValue != nil {
+			rightResources = rightCursor.resources
+		}
+		return mergeRecords(&leftResources, &rightResources, func(key, leftValue, rightValue []byte) error {
+			if bytes.Equal(leftValue, rightValue) {
+				return nil
+			}
+			stats.resources++
+			fmt.Fprintf(writer, "resource %s %d\n", addr, binary.BigEndian.Uint64(key))
+			return writeDiffValues(writer, leftValue, rightValue, func() msgp.Unmarshaler { return &trackerdb.ResourcesData{} })
+		})
+	})
+}
+
+func diffKeyValues(ctx context.Context, leftTx, rightTx *sql.Tx, left, right trackerSource, writer io.Writer, stats *diffStats) error {
+	_, _, leftTable := left.tables()
+	_, _, rightTable := right.tables()
+	leftRows, err := leftTx.QueryContext(ctx, fmt.Sprintf("SELECT key, value FROM %s ORDER BY key", leftTable))
+	if err != nil {
+		return err
+	}
+	defer leftRows.Close()
+	rightRows, err := rightTx.QueryContext(ctx, fmt.Sprintf("SELECT key, value FROM %s ORDER BY key", rightTable))
+	if err != nil {
+		return err
+	}
+	defer rightRows.Close()
+
+	return mergeRecords(kvCursor{rows: leftRows}, kvCursor{rows: rightRows}, func(key, leftValue, rightValue []byte) error {
+		if bytes.Equal(leftValue, rightValue) && (leftValue == nil) == (rightValue == nil) {
+			return nil
+		}
+		stats.kvs++
+		ai, rest, err := apps.SplitBoxKey(string(key))
+		if err == nil {
+			fmt.Fprintf(writer, "kv box(%d, %s)\n", ai, base64.StdEncoding.EncodeToString([]byte(rest)))
+		} else {
+			fmt.Fprintf(writer, "kv %s\n", base64.StdEncoding.EncodeToString(key))
+		}
+		if leftValue != nil {
+			fmt.Fprintf(writer, "< %s\n", base64.StdEncoding.EncodeToString(leftValue))
+		}
+		if rightValue != nil {
+			fmt.Fprintf(writer, "> %s\n", base64.StdEncoding.EncodeToString(rightValue))
+		}
+		return nil
+	})
+}
+
+// writeDiffValues writes the differing msgpack encoded values as json, a nil value being missing from that side.
+func writeDiffValues(writer io.Writer, leftValue, rightValue []byte, makeObject func() msgp.Unmarshaler) error {
+	for _, side := range []struct {
+		prefix string
+		value  []byte
+	}{{"<", leftValue}, {">", rightValue}} {
+		if side.value == nil {
+			continue
+		}
+		obj := makeObject()
+		err := protocol.Decode(side.value, obj)
+		if err != nil {
+			return err
+		}
+		jsonData, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "%s %s\n", side.prefix, string(jsonData))
+	}
+	return nil
+}
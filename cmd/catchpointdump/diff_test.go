@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestDiffCatchpoints(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tc := makeTestCatchpoint(t)
+	ctx := context.Background()
+
+	var sources [2]trackerSource
+	for i := range sources {
+		ledgerPrefix := filepath.Join(t.TempDir(), fmt.Sprintf("ledger%d", i))
+		l, _, fileHeader, err := loadCatchpointFile(ctx, tc.file, ledgerPrefix)
+		require.NoError(t, err)
+		l.Close()
+		sources[i] = trackerSource{
+			name:     fmt.Sprintf("catchpoint%d", i),
+			database: ledgerPrefix + ".tracker.sqlite",
+			staging:  true,
+			round:    fileHeader.BalancesRound,
+		}
+	}
+
+	var out strings.Builder
+	stats, err := diffDatabases(sources[0], sources[1], &out)
+	require.NoError(t, err)
+	require.Equal(t, diffStats{}, stats, out.String())
+
+	// change an account balance, an asset holding and a box of the second source.
+	dbAccessor, err := db.MakeAccessor(sources[1].database, false, false)
+	require.NoError(t, err)
+	err = dbAccessor.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var buf []byte
+		err := tx.QueryRow("SELECT data FROM catchpointbalances WHERE address = ?", tc.addrs[1][:]).Scan(&buf)
+		if err != nil {
+			return err
+		}
+		var account trackerdb.BaseAccountData
+		err = protocol.Decode(buf, &account)
+		if err != nil {
+			return err
+		}
+		account.MicroAlgos.Raw++
+		_, err = tx.Exec("UPDATE catchpointbalances SET data = ? WHERE address = ?", protocol.Encode(&account), tc.addrs[1][:])
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow("SELECT data FROM catchpointresources WHERE aidx = ?", tc.assetID).Scan(&buf)
+		if err != nil {
+			return err
+		}
+		var resource trackerdb.ResourcesData
+		err = protocol.Decode(buf, &resource)
+		if err != nil {
+			return err
+		}
+		resource.Amount--
+		_, err = tx.Exec("UPDATE catchpointresources SET data = ? WHERE aidx = ?", protocol.Encode(&resource), tc.assetID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec("UPDATE catchpointkvstore SET value = ? WHERE key = ?", []byte("VALUE"), []byte(apps.MakeBoxKey(uint64(tc.appID), "box")))
+		return err
+	})
+	dbAccessor.Close()
+	require.NoError(t, err)
+
+	out.Reset()
+	stats, err = diffDatabases(sources[0], sources[1], &out)
+	require.NoError(t, err)
+	require.Equal(t, diffStats{accounts: 1, resources: 1, kvs: 1}, stats, out.String())
+	require.Contains(t, out.String(), "account "+tc.addrs[1].String()+"\n")
+	require.Contains(t, out.String(), fmt.Sprintf("resource %s %d\n", tc.addrs[0], tc.assetID))
+	require.Contains(t, out.String(), fmt.Sprintf("kv box(%d, Ym94)\n", tc.appID))
+}
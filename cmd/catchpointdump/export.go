@@ -0,0 +1,494 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var exportCatchpointFile string
+var exportTrackerFilename string
+var exportTrackerStaging bool
+var exportOutputDir string
+var exportBatchRows int
+
+func init() {
+	exportCmd.Flags().StringVarP(&exportCatchpointFile, "tar", "t", "", "Specify the catchpoint file (either .tar or .tar.gz) to export")
+	exportCmd.Flags().StringVarP(&exportTrackerFilename, "tracker", "d", "", "Specify the ledger tracker file name to export ( i.e. ./ledger.tracker.sqlite )")
+	exportCmd.Flags().BoolVarP(&exportTrackerStaging, "staging", "s", false, "Specify whether to look in the catchpoint staging or regular tables of the tracker database. (default false)")
+	exportCmd.Flags().StringVarP(&exportOutputDir, "output", "o", "", "Specify the directory to write the exported files to")
+	exportCmd.Flags().IntVarP(&exportBatchRows, "batch", "b", 65536, "Specify the number of rows of each record batch, which bounds the memory use")
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the state of a catchpoint file or a ledger tracker database as Arrow IPC stream files",
+	Long: "Export the accounts, asset params and holdings, app params, local states and key values, and boxes of a catchpoint file " +
+		"or a ledger tracker database into one Arrow IPC stream file per table, with typed columns. The files can be read with " +
+		"pyarrow.ipc.open_stream, polars.read_ipc_stream or any other Arrow implementation.",
+	Args:         validateNoPosArgsFn,
+	SilenceUsage: true, // prevent printing usage info on error
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if (exportCatchpointFile == "") == (exportTrackerFilename == "") || exportOutputDir == "" {
+			cmd.HelpFunc()(cmd, args)
+			return fmt.Errorf("either a catchpoint file or a tracker database, and an output directory are needed")
+		}
+		if exportBatchRows <= 0 {
+			return fmt.Errorf("the batch size must be positive")
+		}
+
+		source := trackerSource{
+			name:     exportTrackerFilename,
+			database: exportTrackerFilename,
+			staging:  exportTrackerStaging,
+		}
+		if exportCatchpointFile != "" {
+			tempDir, err := os.MkdirTemp("", "catchpointdump")
+			if err != nil {
+				reportErrorf("Unable to create temporary directory : %v", err)
+			}
+			defer os.RemoveAll(tempDir)
+
+			ledgerPrefix := filepath.Join(tempDir, "ledger")
+			l, _, fileHeader, err := loadCatchpointFile(context.Background(), exportCatchpointFile, ledgerPrefix)
+			if err != nil {
+				reportErrorf("Unable to load catchpoint file '%s' : %v", exportCatchpointFile, err)
+			}
+			l.Close()
+			source = trackerSource{
+				name:     exportCatchpointFile,
+				database: ledgerPrefix + ".tracker.sqlite",
+				staging:  true,
+				round:    fileHeader.BalancesRound,
+			}
+		} else {
+			source.round, err = getTrackerRound(exportTrackerFilename, exportTrackerStaging)
+			if err != nil {
+				reportErrorf("Unable to read tracker database round : %v", err)
+			}
+		}
+
+		err = os.MkdirAll(exportOutputDir, 0755)
+		if err != nil {
+			reportErrorf("Unable to create directory '%s' : %v", exportOutputDir, err)
+		}
+		reportInfof("Exporting %s at round %d to %s", source.name, source.round, exportOutputDir)
+		err = exportDatabase(source, exportOutputDir, exportBatchRows)
+		if err != nil {
+			reportErrorf("Unable to export '%s' : %v", source.name, err)
+		}
+		return nil
+	},
+}
+
+// The exported tables, each one written to a file of the same name.
+const (
+	exportAccounts       = "accounts"
+	exportAssetParams    = "asset_params"
+	exportAssetHoldings  = "asset_holdings"
+	exportAppParams      = "app_params"
+	exportAppLocalStates = "app_local_states"
+	exportAppState       = "app_state"
+	exportBoxes          = "boxes"
+)
+
+// exportFileExtension is the extension recommended for Arrow IPC stream files.
+const exportFileExtension = ".arrows"
+
+var exportTableNames = []string{exportAccounts, exportAssetParams, exportAssetHoldings, exportAppParams, exportAppLocalStates, exportAppState, exportBoxes}
+
+var exportTableFields = map[string][]arrowField{
+	exportAccounts: {
+		{name: "address", typ: arrowString},
+		{name: "status", typ: arrowString},
+		{name: "microalgos", typ: arrowUint64},
+		{name: "rewards_base", typ: arrowUint64},
+		{name: "rewarded_microalgos", typ: arrowUint64},
+		{name: "auth_addr", typ: arrowString, nullable: true},
+		{name: "vote_id", typ: arrowBinary, nullable: true},
+		{name: "selection_id", typ: arrowBinary, nullable: true},
+		{name: "state_proof_id", typ: arrowBinary, nullable: true},
+		{name: "vote_first_valid", typ: arrowUint64},
+		{name: "vote_last_valid", typ: arrowUint64},
+		{name: "vote_key_dilution", typ: arrowUint64},
+		{name: "total_app_schema_num_uint", typ: arrowUint64},
+		{name: "total_app_schema_num_byte_slice", typ: arrowUint64},
+		{name: "total_extra_app_pages", typ: arrowUint64},
+		{name: "total_boxes", typ: arrowUint64},
+		{name: "total_box_bytes", typ: arrowUint64},
+	},
+	exportAssetParams: {
+		{name: "asset_id", typ: arrowUint64},
+		{name: "creator", typ: arrowString},
+		{name: "total", typ: arrowUint64},
+		{name: "decimals", typ: arrowUint64},
+		{name: "default_frozen", typ: arrowBool},
+		// names are not required to be valid utf-8.
+		{name: "unit_name", typ: arrowBinary},
+		{name: "asset_name", typ: arrowBinary},
+		{name: "url", typ: arrowBinary},
+		{name: "metadata_hash", typ: arrowBinary, nullable: true},
+		{name: "manager", typ: arrowString, nullable: true},
+		{name: "reserve", typ: arrowString, nullable: true},
+		{name: "freeze", typ: arrowString, nullable: true},
+		{name: "clawback", typ: arrowString, nullable: true},
+	},
+	exportAssetHoldings: {
+		{name: "address", typ: arrowString},
+		{name: "asset_id", typ: arrowUint64},
+		{name: "amount", typ: arrowUint64},
+		{name: "frozen", typ: arrowBool},
+	},
+	exportAppParams: {
+		{name: "app_id", typ: arrowUint64},
+		{name: "creator", typ: arrowString},
+		{name: "approval_program", typ: arrowBinary},
+		{name: "clear_state_program", typ: arrowBinary},
+		{name: "global_num_uint", typ: arrowUint64},
+		{name: "global_num_byte_slice", typ: arrowUint64},
+		{name: "local_num_uint", typ: arrowUint64},
+		{name: "local_num_byte_slice", typ: arrowUint64},
+		{name: "extra_program_pages", typ: arrowUint64},
+	},
+	exportAppLocalStates: {
+		{name: "address", typ: arrowString},
+		{name: "app_id", typ: arrowUint64},
+		{name: "num_uint", typ: arrowUint64},
+		{name: "num_byte_slice", typ: arrowUint64},
+	},
+	// the global state has no address, and each value is either bytes or a uint.
+	exportAppState: {
+		{name: "app_id", typ: arrowUint64},
+		{name: "address", typ: arrowString, nullable: true},
+		{name: "key", typ: arrowBinary},
+		{name: "bytes", typ: arrowBinary, nullable: true},
+		{name: "uint", typ: arrowUint64, nullable: true},
+	},
+	exportBoxes: {
+		{name: "app_id", typ: arrowUint64},
+		{name: "name", typ: arrowBinary},
+		{name: "value", typ: arrowBinary},
+	},
+}
+
+// exportTable is an exported table being written to its file.
+type exportTable struct {
+	file   *os.File
+	writer *bufio.Writer
+	arrow  *arrowStreamWriter
+	rows   uint64
+}
+
+func (t *exportTable) append(values ...interface{}) error {
+	t.rows++
+	return t.arrow.Append(values...)
+}
+
+func (t *exportTable) close() error {
+	err := t.arrow.Close()
+	if err != nil {
+		return err
+	}
+	err = t.writer.Flush()
+	if err != nil {
+		return err
+	}
+	return t.file.Close()
+}
+
+// openExportTables creates, or truncates, the files of the exported tables.
+func openExportTables(outputDir string, batchRows int) (map[string]*exportTable, error) {
+	tables := make(map[string]*exportTable, len(exportTableNames))
+	for _, name := range exportTableNames {
+		fileName := filepath.Join(outputDir, name+exportFileExtension)
+		file, err := os.OpenFile(fileName, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0644)
+		if err != nil {
+			closeExportTables(tables)
+			return nil, err
+		}
+		writer := bufio.NewWriterSize(file, 1024*1024)
+		tables[name] = &exportTable{file: file, writer: writer, arrow: makeArrowStreamWriter(writer, exportTableFields[name], batchRows)}
+	}
+	return tables, nil
+}
+
+// closeExportTables closes the files of tables which were not written out.
+func closeExportTables(tables map[string]*exportTable) {
+	for _, t := range tables {
+		t.file.Close()
+	}
+}
+
+// exportDatabase writes the tables of the source to the output directory.
+func exportDatabase(source trackerSource, outputDir string, batchRows int) error {
+	dbAccessor, err := db.MakeAccessor(source.database, true, false)
+	if err != nil {
+		return err
+	}
+	defer dbAccessor.Close()
+
+	var tables map[string]*exportTable
+	err = dbAccessor.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		// Atomic retries on busy errors, so each attempt writes the files from scratch.
+		closeExportTables(tables)
+		tables, err = openExportTables(outputDir, batchRows)
+		if err != nil {
+			return err
+		}
+
+		// extend the deadline for every batch, so that large databases do not
+		// trigger the deadline warning.
+		exported := 0
+		extendDeadline := func() error {
+			exported++
+			if exported%batchRows != 0 {
+				return nil
+			}
+			_, err := db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(30*time.Second))
+			return err
+		}
+
+		balancesTable, resourcesTable, kvTable := source.tables()
+		arw := sqlitedriver.NewAccountsSQLReaderWriter(tx)
+		acctCb := func(addr basics.Address, data basics.AccountData) {
+			if err != nil {
+				return
+			}
+			err = exportAccount(tables, addr, data)
+			if err != nil {
+				return
+			}
+			err = extendDeadline()
+		}
+		_, loadErr := arw.LoadAllFullAccounts(ctx, balancesTable, resourcesTable, acctCb)
+		if loadErr != nil {
+			return loadErr
+		}
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT key, value FROM %s ORDER BY key", kvTable))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var key, value []byte
+			err = rows.Scan(&key, &value)
+			if err != nil {
+				return err
+			}
+			ai, name, err := apps.SplitBoxKey(string(key))
+			if err != nil {
+				reportWarnf("Skipping key value entry which is not a box : %v", err)
+				continue
+			}
+			if value == nil {
+				value = []byte{}
+			}
+			err = tables[exportBoxes].append(ai, []byte(name), value)
+			if err != nil {
+				return err
+			}
+			err = extendDeadline()
+			if err != nil {
+				return err
+			}
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		closeExportTables(tables)
+		return err
+	}
+
+	for _, name := range exportTableNames {
+		err = tables[name].close()
+		if err != nil {
+			closeExportTables(tables)
+			return err
+		}
+		reportInfof("%s : %d rows", name, tables[name].rows)
+	}
+	return nil
+}
+
+// nullIfZero returns nil for the zero value of the given bytes, to export it as a null.
+func nullIfZero(b []byte) interface{} {
+	for _, c := range b {
+		if c != 0 {
+			return b
+		}
+	}
+	return nil
+}
+
+func nullIfZeroAddress(addr basics.Address) interface{} {
+	if addr.IsZero() {
+		return nil
+	}
+	return addr.String()
+}
+
+func exportAccount(tables map[string]*exportTable, addr basics.Address, data basics.AccountData) error {
+	address := addr.String()
+	err := tables[exportAccounts].append(
+		address,
+		data.Status.String(),
+		data.MicroAlgos.Raw,
+		data.RewardsBase,
+		data.RewardedMicroAlgos.Raw,
+		nullIfZeroAddress(data.AuthAddr),
+		nullIfZero(data.VoteID[:]),
+		nullIfZero(data.SelectionID[:]),
+		nullIfZero(data.StateProofID[:]),
+		uint64(data.VoteFirstValid),
+		uint64(data.VoteLastValid),
+		data.VoteKeyDilution,
+		data.TotalAppSchema.NumUint,
+		data.TotalAppSchema.NumByteSlice,
+		uint64(data.TotalExtraAppPages),
+		data.TotalBoxes,
+		data.TotalBoxBytes,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the resources are held in maps, sort them to make the export deterministic.
+	assetIDs := make([]basics.AssetIndex, 0, len(data.AssetParams))
+	for aidx := range data.AssetParams {
+		assetIDs = append(assetIDs, aidx)
+	}
+	sort.Slice(assetIDs, func(i, j int) bool { return assetIDs[i] < assetIDs[j] })
+	for _, aidx := range assetIDs {
+		params := data.AssetParams[aidx]
+		err = tables[exportAssetParams].append(
+			uint64(aidx),
+			address,
+			params.Total,
+			uint64(params.Decimals),
+			params.DefaultFrozen,
+			[]byte(params.UnitName),
+			[]byte(params.AssetName),
+			[]byte(params.URL),
+			nullIfZero(params.MetadataHash[:]),
+			nullIfZeroAddress(params.Manager),
+			nullIfZeroAddress(params.Reserve),
+			nullIfZeroAddress(params.Freeze),
+			nullIfZeroAddress(params.Clawback),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	assetIDs = assetIDs[:0]
+	for aidx := range data.Assets {
+		assetIDs = append(assetIDs, aidx)
+	}
+	sort.Slice(assetIDs, func(i, j int) bool { return assetIDs[i] < assetIDs[j] })
+	for _, aidx := range assetIDs {
+		holding := data.Assets[aidx]
+		err = tables[exportAssetHoldings].append(address, uint64(aidx), holding.Amount, holding.Frozen)
+		if err != nil {
+			return err
+		}
+	}
+
+	appIDs := make([]basics.AppIndex, 0, len(data.AppParams))
+	for aidx := range data.AppParams {
+		appIDs = append(appIDs, aidx)
+	}
+	sort.Slice(appIDs, func(i, j int) bool { return appIDs[i] < appIDs[j] })
+	for _, aidx := range appIDs {
+		params := data.AppParams[aidx]
+		err = tables[exportAppParams].append(
+			uint64(aidx),
+			address,
+			params.ApprovalProgram,
+			params.ClearStateProgram,
+			params.GlobalStateSchema.NumUint,
+			params.GlobalStateSchema.NumByteSlice,
+			params.LocalStateSchema.NumUint,
+			params.LocalStateSchema.NumByteSlice,
+			uint64(params.ExtraProgramPages),
+		)
+		if err != nil {
+			return err
+		}
+		err = exportAppKeyValues(tables[exportAppState], aidx, nil, params.GlobalState)
+		if err != nil {
+			return err
+		}
+	}
+
+	appIDs = appIDs[:0]
+	for aidx := range data.AppLocalStates {
+		appIDs = append(appIDs, aidx)
+	}
+	sort.Slice(appIDs, func(i, j int) bool { return appIDs[i] < appIDs[j] })
+	for _, aidx := range appIDs {
+		localState := data.AppLocalStates[aidx]
+		err = tables[exportAppLocalStates].append(address, uint64(aidx), localState.Schema.NumUint, localState.Schema.NumByteSlice)
+		if err != nil {
+			return err
+		}
+		err = exportAppKeyValues(tables[exportAppState], aidx, address, localState.KeyValue)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportAppKeyValues writes the key values of an app state, the address being nil for the global state.
+func exportAppKeyValues(table *exportTable, aidx basics.AppIndex, address interface{}, kv basics.TealKeyValue) error {
+	keys := make([]string, 0, len(kv))
+	for key := range kv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := kv[key]
+		var err error
+		if value.Type == basics.TealBytesType {
+			err = table.append(uint64(aidx), address, []byte(key), []byte(value.Bytes), nil)
+		} else {
+			err = table.append(uint64(aidx), address, []byte(key), nil, value.Uint)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestExportCatchpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tc := makeTestCatchpoint(t)
+	ledgerPrefix := filepath.Join(t.TempDir(), "ledger")
+	l, _, fileHeader, err := loadCatchpointFile(context.Background(), tc.file, ledgerPrefix)
+	require.NoError(t, err)
+	l.Close()
+	source := trackerSource{
+		name:     tc.file,
+		database: ledgerPrefix + ".tracker.sqlite",
+		staging:  true,
+		round:    fileHeader.BalancesRound,
+	}
+
+	// a small batch size splits the accounts over several record batches.
+	outputDir := t.TempDir()
+	require.NoError(t, exportDatabase(source, outputDir, 2))
+	exported := make(map[string][]byte)
+	for _, name := range exportTableNames {
+		data, err := os.ReadFile(filepath.Join(outputDir, name+exportFileExtension))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(data, []byte{0xff, 0xff, 0xff, 0xff}), name)
+		require.True(t, bytes.HasSuffix(data, []byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0}), name)
+		exported[name] = data
+	}
+	require.Contains(t, string(exported[exportAccounts]), tc.addrs[1].String())
+	require.Contains(t, string(exported[exportAssetParams]), "test")
+	require.Contains(t, string(exported[exportBoxes]), "value")
+
+	// the files are rewritten from scratch, as when the export transaction is retried.
+	require.NoError(t, exportDatabase(source, outputDir, 2))
+	for _, name := range exportTableNames {
+		data, err := os.ReadFile(filepath.Join(outputDir, name+exportFileExtension))
+		require.NoError(t, err)
+		require.Equal(t, exported[name], data, name)
+	}
+}
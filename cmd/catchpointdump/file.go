@@ -143,6 +143,49 @@ var fileCmd = &cobra.Command{
 	},
 }
 
+// loadCatchpointFile creates a ledger with the given file name prefix and loads the catchpoint file into its
+// staging tables. The caller is responsible for closing the returned ledger.
+func loadCatchpointFile(ctx context.Context, catchpointFileName string, ledgerPrefix string) (*ledger.Ledger, ledger.CatchpointCatchupAccessor, ledger.CatchpointFileHeader, error) {
+	stats, err := os.Stat(catchpointFileName)
+	if err != nil {
+		return nil, nil, ledger.CatchpointFileHeader{}, err
+	}
+	if stats.Size() == 0 {
+		return nil, nil, ledger.CatchpointFileHeader{}, fmt.Errorf("empty file '%s'", catchpointFileName)
+	}
+	reader, err := os.Open(catchpointFileName)
+	if err != nil {
+		return nil, nil, ledger.CatchpointFileHeader{}, err
+	}
+	defer reader.Close()
+
+	// the catchpoint file header does not hold the protocol, use the current one as the file command does.
+	genesisInitState := ledgercore.InitState{
+		Block: bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusCurrentVersion,
+			},
+		}},
+	}
+	l, err := ledger.OpenLedger(logging.Base(), ledgerPrefix, false, genesisInitState, config.GetDefaultLocal())
+	if err != nil {
+		return nil, nil, ledger.CatchpointFileHeader{}, fmt.Errorf("unable to open ledger : %w", err)
+	}
+
+	catchupAccessor := ledger.MakeCatchpointCatchupAccessor(l, logging.Base())
+	err = catchupAccessor.ResetStagingBalances(ctx, true)
+	if err != nil {
+		l.Close()
+		return nil, nil, ledger.CatchpointFileHeader{}, fmt.Errorf("unable to initialize catchup database : %w", err)
+	}
+	fileHeader, err := loadCatchpointIntoDatabase(ctx, catchupAccessor, reader, stats.Size())
+	if err != nil {
+		l.Close()
+		return nil, nil, ledger.CatchpointFileHeader{}, fmt.Errorf("unable to load catchpoint file '%s' : %w", catchpointFileName, err)
+	}
+	return l, catchupAccessor, fileHeader, nil
+}
+
 func printLoadCatchpointProgressLine(progress int, barLength int, dld int64) {
 	if barLength == 0 {
 		fmt.Printf(escapeCursorUp + escapeDeleteLine + "[ Done ] Loaded\n")
@@ -0,0 +1,310 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/catchup"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/eval"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/util"
+)
+
+var forkCatchpointFile string
+var forkGenesisFile string
+var forkAlgodAddress string
+var forkAlgodToken string
+var forkDataDir string
+var forkNetworkName string
+
+func init() {
+	forkCmd.Flags().StringVarP(&forkCatchpointFile, "tar", "t", "", "Specify the catchpoint file (either .tar or .tar.gz) to fork from")
+	forkCmd.Flags().StringVarP(&forkGenesisFile, "genesis", "g", "", "Specify the genesis.json file of the network the catchpoint was taken from")
+	forkCmd.Flags().StringVarP(&forkAlgodAddress, "algod", "a", "", "Specify the REST endpoint of a node on the source network, used to retrieve the catchpoint blocks ( i.e. http://127.0.0.1:8080 )")
+	forkCmd.Flags().StringVarP(&forkAlgodToken, "token", "k", "", "Specify the API token of the source network node")
+	forkCmd.Flags().StringVarP(&forkDataDir, "datadir", "d", "", "Specify the data directory to create the forked DevMode node in")
+	forkCmd.Flags().StringVarP(&forkNetworkName, "network", "n", "", "Specify the network name of the fork ( defaults to <source network>-fork )")
+}
+
+var forkCmd = &cobra.Command{
+	Use:   "fork",
+	Short: "Create a DevMode node data directory starting from the state of a catchpoint file",
+	Long: "Create a DevMode node data directory starting from the state of a catchpoint file. The catchpoint balances are loaded, " +
+		"the blocks preceding the catchpoint round are retrieved from a node on the source network, and a new genesis is written " +
+		"so that transactions issued on the fork cannot be replayed on the source network.",
+	Args:         validateNoPosArgsFn,
+	SilenceUsage: true, // prevent printing usage info on error
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if forkCatchpointFile == "" || forkGenesisFile == "" || forkAlgodAddress == "" || forkDataDir == "" {
+			cmd.HelpFunc()(cmd, args)
+			return fmt.Errorf("catchpoint file, genesis file, algod address or data directory not set")
+		}
+
+		sourceGenesis, err := bookkeeping.LoadGenesisFromFile(forkGenesisFile)
+		if err != nil {
+			reportErrorf("Unable to load genesis file '%s' : %v", forkGenesisFile, err)
+		}
+		if forkNetworkName == "" {
+			forkNetworkName = string(sourceGenesis.Network) + "-fork"
+		}
+		forkGenesis := makeForkGenesis(sourceGenesis, protocol.NetworkID(forkNetworkName))
+		if forkGenesis.ID() == sourceGenesis.ID() {
+			reportErrorf("The fork network name must differ from the source network name '%s'", sourceGenesis.Network)
+		}
+
+		if util.FileExists(filepath.Join(forkDataDir, config.GenesisJSONFile)) {
+			reportErrorf("Data directory '%s' already contains a %s file", forkDataDir, config.GenesisJSONFile)
+		}
+		genesisDir := filepath.Join(forkDataDir, forkGenesis.ID())
+		err = os.MkdirAll(genesisDir, 0700)
+		if err != nil {
+			reportErrorf("Unable to create directory '%s' : %v", genesisDir, err)
+		}
+
+		blocks, err := makeRestBlockSource(forkAlgodAddress, forkAlgodToken)
+		if err != nil {
+			reportErrorf("Invalid algod address '%s' : %v", forkAlgodAddress, err)
+		}
+
+		forkRound, err := forkLedger(context.Background(), filepath.Join(genesisDir, config.LedgerFilenamePrefix), forkCatchpointFile, sourceGenesis, forkGenesis, blocks)
+		if err != nil {
+			os.RemoveAll(genesisDir)
+			reportErrorf("Unable to fork catchpoint '%s' : %v", forkCatchpointFile, err)
+		}
+
+		err = os.WriteFile(filepath.Join(forkDataDir, config.GenesisJSONFile), append(protocol.EncodeJSON(forkGenesis), '\n'), 0666)
+		if err != nil {
+			reportErrorf("Unable to write genesis file : %v", err)
+		}
+
+		// the forked ledger holds only the blocks following the catchpoint, which an archival node would discard.
+		cfg, err := config.LoadConfigFromDisk(forkDataDir)
+		if os.IsNotExist(err) {
+			cfg = config.GetDefaultLocal()
+		} else if err != nil {
+			reportErrorf("Unable to load config file : %v", err)
+		}
+		if cfg.Archival {
+			reportWarnf("Archival mode is enabled in '%s' and would reset the forked ledger; disabling it", forkDataDir)
+			cfg.Archival = false
+		}
+		cfg.EnableDeveloperAPI = true
+		err = cfg.SaveToDisk(forkDataDir)
+		if err != nil {
+			reportErrorf("Unable to write config file : %v", err)
+		}
+
+		reportInfof("Forked '%s' at round %d into network '%s' (genesis hash %s) in '%s'", sourceGenesis.ID(), forkRound, forkGenesis.ID(), forkGenesis.Hash(), forkDataDir)
+		return nil
+	},
+}
+
+// makeForkGenesis returns a DevMode copy of the source genesis under a different network name, which yields
+// a new genesis ID and hash for the forked network.
+func makeForkGenesis(source bookkeeping.Genesis, network protocol.NetworkID) bookkeeping.Genesis {
+	fork := source
+	fork.Network = network
+	fork.DevMode = true
+	fork.Comment = fmt.Sprintf("DevMode fork of %s", source.ID())
+	return fork
+}
+
+// blockSource retrieves a block of the source network.
+type blockSource func(rnd basics.Round) (bookkeeping.Block, error)
+
+func makeRestBlockSource(address string, token string) (blockSource, error) {
+	algodURL, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+	restClient := client.MakeRestClient(*algodURL, token)
+	return func(rnd basics.Round) (bookkeeping.Block, error) {
+		raw, err := restClient.RawBlock(uint64(rnd))
+		if err != nil {
+			return bookkeeping.Block{}, err
+		}
+		var blockCert rpcs.EncodedBlockCert
+		err = protocol.Decode(raw, &blockCert)
+		return blockCert.Block, err
+	}, nil
+}
+
+// forkLedger creates a ledger at ledgerPrefix holding the state of the catchpoint file, and appends a single empty
+// block which switches the ledger over to the fork genesis. It returns the round of that block.
+func forkLedger(ctx context.Context, ledgerPrefix string, catchpointFileName string, source bookkeeping.Genesis, fork bookkeeping.Genesis, blocks blockSource) (basics.Round, error) {
+	genesisBalances, err := source.Balances()
+	if err != nil {
+		return 0, err
+	}
+	genesisBlock, err := source.Block()
+	if err != nil {
+		return 0, err
+	}
+	genesisInitState := ledgercore.InitState{
+		Block:       genesisBlock,
+		Accounts:    genesisBalances.Balances,
+		GenesisHash: source.Hash(),
+	}
+	l, err := ledger.OpenLedger(logging.Base(), ledgerPrefix, false, genesisInitState, config.GetDefaultLocal())
+	if err != nil {
+		return 0, fmt.Errorf("unable to open ledger : %w", err)
+	}
+	defer l.Close()
+
+	err = loadCatchpointForFork(ctx, l, catchpointFileName, blocks)
+	if err != nil {
+		return 0, err
+	}
+
+	prev, err := l.BlockHdr(l.Latest())
+	if err != nil {
+		return 0, err
+	}
+	blk := bookkeeping.MakeBlock(prev)
+	blk.BlockHeader.GenesisID = fork.ID()
+	blk.BlockHeader.GenesisHash = fork.Hash()
+
+	// the evaluator stamps generated blocks with the ledger genesis hash, so present the fork genesis hash instead.
+	proto := config.Consensus[prev.CurrentProtocol]
+	blockEval, err := eval.StartEvaluator(forkEvalLedger{Ledger: l, genesisHash: blk.BlockHeader.GenesisHash}, blk.BlockHeader,
+		eval.EvaluatorOptions{
+			Generate:            true,
+			MaxTxnBytesPerBlock: proto.MaxTxnBytesPerBlock,
+		})
+	if err != nil {
+		return 0, fmt.Errorf("unable to start evaluator for fork block %d : %w", blk.Round(), err)
+	}
+	vb, err := blockEval.GenerateBlock()
+	if err != nil {
+		return 0, fmt.Errorf("unable to generate fork block %d : %w", blk.Round(), err)
+	}
+	forkBlock := vb.Block()
+	forkBlock.BlockHeader.Seed = committee.Seed(prev.Hash())
+	err = l.AddValidatedBlock(ledgercore.MakeValidatedBlock(forkBlock, vb.Delta()), agreement.Certificate{Round: forkBlock.Round()})
+	if err != nil {
+		return 0, fmt.Errorf("unable to add fork block %d : %w", forkBlock.Round(), err)
+	}
+	l.WaitForCommit(forkBlock.Round())
+	return forkBlock.Round(), nil
+}
+
+// loadCatchpointForFork performs the catchpoint catchup stages against a local catchpoint file, retrieving
+// the blocks the catchpoint refers to from the source network.
+func loadCatchpointForFork(ctx context.Context, l *ledger.Ledger, catchpointFileName string, blocks blockSource) error {
+	stats, err := os.Stat(catchpointFileName)
+	if err != nil {
+		return err
+	}
+	reader, err := os.Open(catchpointFileName)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	catchupAccessor := ledger.MakeCatchpointCatchupAccessor(l, logging.Base())
+	err = catchupAccessor.ResetStagingBalances(ctx, true)
+	if err != nil {
+		return fmt.Errorf("unable to initialize catchup database : %w", err)
+	}
+	fileHeader, err := loadCatchpointIntoDatabase(ctx, catchupAccessor, reader, stats.Size())
+	if err != nil {
+		return fmt.Errorf("unable to load catchpoint file : %w", err)
+	}
+	err = catchupAccessor.SetLabel(ctx, fileHeader.Catchpoint)
+	if err != nil {
+		return err
+	}
+	reportInfof("Building merkle trie for catchpoint %s", fileHeader.Catchpoint)
+	err = catchupAccessor.BuildMerkleTrie(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to build merkle trie : %w", err)
+	}
+
+	topBlock, err := blocks(fileHeader.BlocksRound)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve block %d : %w", fileHeader.BlocksRound, err)
+	}
+	if topBlock.GenesisHash() != l.GenesisHash() {
+		return fmt.Errorf("block %d genesis hash %v does not match the source genesis hash %v", topBlock.Round(), topBlock.GenesisHash(), l.GenesisHash())
+	}
+	if !topBlock.ContentsMatchHeader() {
+		return fmt.Errorf("block %d content does not match its header", topBlock.Round())
+	}
+	err = catchupAccessor.VerifyCatchpoint(ctx, &topBlock)
+	if err != nil {
+		return err
+	}
+	err = catchupAccessor.StoreBalancesRound(ctx, &topBlock)
+	if err != nil {
+		return err
+	}
+	err = catchupAccessor.StoreFirstBlock(ctx, &topBlock)
+	if err != nil {
+		return err
+	}
+
+	lookback := catchup.CatchpointBlocksLookback(&topBlock)
+	reportInfof("Retrieving %d blocks preceding round %d", lookback, topBlock.Round())
+	prev := topBlock
+	for i := uint64(1); i <= lookback; i++ {
+		blk, err := blocks(topBlock.Round() - basics.Round(i))
+		if err != nil {
+			return fmt.Errorf("unable to retrieve block %d : %w", topBlock.Round()-basics.Round(i), err)
+		}
+		if prev.Branch != blk.Hash() {
+			return fmt.Errorf("block %d does not match its successor block %d hash %v != %v", blk.Round(), prev.Round(), blk.Hash(), prev.Branch)
+		}
+		if !blk.ContentsMatchHeader() {
+			return fmt.Errorf("block %d content does not match its header", blk.Round())
+		}
+		err = catchupAccessor.StoreBlock(ctx, &blk)
+		if err != nil {
+			return err
+		}
+		prev = blk
+	}
+
+	return catchupAccessor.CompleteCatchup(ctx)
+}
+
+// forkEvalLedger exposes the ledger to the block evaluator under the fork genesis hash.
+type forkEvalLedger struct {
+	*ledger.Ledger
+	genesisHash crypto.Digest
+}
+
+func (l forkEvalLedger) GenesisHash() crypto.Digest {
+	return l.genesisHash
+}
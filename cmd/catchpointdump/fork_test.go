@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestForkCatchpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tc := makeTestCatchpoint(t)
+
+	// serve the blocks of the source ledger as algod does.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rnd, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/v2/blocks/"), 10, 64)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		blk, cert, err := tc.ledger.BlockCert(basics.Round(rnd))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(protocol.EncodeReflect(rpcs.EncodedBlockCert{Block: blk, Certificate: cert}))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	sourceGenesisFile := filepath.Join(dir, config.GenesisJSONFile)
+	require.NoError(t, os.WriteFile(sourceGenesisFile, protocol.EncodeJSON(tc.genesis), 0600))
+
+	forkCatchpointFile = tc.file
+	forkGenesisFile = sourceGenesisFile
+	forkAlgodAddress = server.URL
+	forkDataDir = filepath.Join(dir, "fork")
+	forkNetworkName = ""
+	require.NoError(t, forkCmd.RunE(forkCmd, nil))
+
+	forkGenesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(forkDataDir, config.GenesisJSONFile))
+	require.NoError(t, err)
+	require.Equal(t, "catchpointdumptest-fork-v1", forkGenesis.ID())
+	require.NotEqual(t, tc.genesis.Hash(), forkGenesis.Hash())
+	require.True(t, forkGenesis.DevMode)
+	cfg, err := config.LoadConfigFromDisk(forkDataDir)
+	require.NoError(t, err)
+	require.True(t, cfg.EnableDeveloperAPI)
+	require.False(t, cfg.Archival)
+
+	// the forked ledger opens under the fork genesis, as the node would open it, and not under the source one.
+	genesisBalances, err := forkGenesis.Balances()
+	require.NoError(t, err)
+	genesisBlock, err := forkGenesis.Block()
+	require.NoError(t, err)
+	genesisInitState := ledgercore.InitState{
+		Block:       genesisBlock,
+		Accounts:    genesisBalances.Balances,
+		GenesisHash: forkGenesis.Hash(),
+	}
+	ledgerPrefix := filepath.Join(forkDataDir, forkGenesis.ID(), config.LedgerFilenamePrefix)
+	l, err := ledger.OpenLedger(logging.TestingLog(t), ledgerPrefix, false, genesisInitState, cfg)
+	require.NoError(t, err)
+	require.Equal(t, tc.round+1, l.Latest())
+	hdr, err := l.BlockHdr(l.Latest())
+	require.NoError(t, err)
+	require.Equal(t, forkGenesis.Hash(), hdr.GenesisHash)
+	require.Equal(t, forkGenesis.ID(), hdr.GenesisID)
+	// the catchpoint state is carried over.
+	data, _, err := l.LookupWithoutRewards(l.Latest(), tc.addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(testCatchpointBalance), data.MicroAlgos.Raw)
+	l.Close()
+
+	genesisInitState.GenesisHash = tc.genesis.Hash()
+	_, err = ledger.OpenLedger(logging.TestingLog(t), ledgerPrefix, false, genesisInitState, cfg)
+	require.ErrorContains(t, err, "genesis hash")
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Command arrowgen writes the golden Arrow IPC streams of arrow_test.go with the
+// Arrow Go implementation. Run it from this directory with: go run . ..
+package main
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/ipc"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+)
+
+// the columns and rows of testArrowFields and testArrowRows, in arrow_test.go.
+var schema = arrow.NewSchema([]arrow.Field{
+	{Name: "uint64", Type: arrow.PrimitiveTypes.Uint64},
+	{Name: "int64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	{Name: "bool", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+	{Name: "binary", Type: arrow.BinaryTypes.Binary, Nullable: true},
+	{Name: "string", Type: arrow.BinaryTypes.String},
+	{Name: "nullable_string", Type: arrow.BinaryTypes.String, Nullable: true},
+}, nil)
+
+var rows = [][]interface{}{
+	{uint64(1), int64(-1), true, []byte{1, 2}, "a", nil},
+	{uint64(1<<64 - 1), nil, false, nil, "", nil},
+	{uint64(3), int64(3), nil, []byte{}, "xyz", nil},
+	{uint64(4), int64(4), true, []byte("hello"), "ü", "x"},
+	{uint64(5), int64(5), true, []byte{0}, "de", "yy"},
+	{uint64(6), nil, false, nil, "f", nil},
+	{uint64(7), int64(-7), nil, []byte{9, 9, 9, 9, 9, 9, 9, 9, 9}, "last", "z"},
+}
+
+const batchRows = 3
+
+// makeColumn builds the array of a column from buffers holding exactly its values, as arrowStreamWriter does,
+// since the Arrow Go writer keeps the (larger) buffers allocated by the array builders.
+func makeColumn(field arrow.Field, values []interface{}) arrow.Array {
+	n := len(values)
+	validity := make([]byte, (n+7)/8)
+	nulls := 0
+	var data, offsets []byte
+	offsets = binary.LittleEndian.AppendUint32(offsets, 0)
+	if field.Type.ID() == arrow.BOOL {
+		data = make([]byte, (n+7)/8)
+	}
+	for i, v := range values {
+		if v == nil {
+			nulls++
+		} else {
+			validity[i/8] |= 1 << (i % 8)
+		}
+		switch field.Type.ID() {
+		case arrow.UINT64, arrow.INT64:
+			var u uint64
+			switch v := v.(type) {
+			case uint64:
+				u = v
+			case int64:
+				u = uint64(v)
+			}
+			data = binary.LittleEndian.AppendUint64(data, u)
+		case arrow.BOOL:
+			if v == true {
+				data[i/8] |= 1 << (i % 8)
+			}
+		case arrow.BINARY, arrow.STRING:
+			switch v := v.(type) {
+			case []byte:
+				data = append(data, v...)
+			case string:
+				data = append(data, v...)
+			}
+			offsets = binary.LittleEndian.AppendUint32(offsets, uint32(len(data)))
+		}
+	}
+
+	buffers := []*memory.Buffer{memory.NewBufferBytes(validity), memory.NewBufferBytes(data)}
+	if field.Type.ID() == arrow.BINARY || field.Type.ID() == arrow.STRING {
+		buffers = []*memory.Buffer{buffers[0], memory.NewBufferBytes(offsets), buffers[1]}
+	}
+	return array.MakeFromData(array.NewData(field.Type, n, buffers, nil, nulls, 0))
+}
+
+func writeStream(fileName string, rows [][]interface{}) error {
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := ipc.NewWriter(f, ipc.WithSchema(schema))
+	for start := 0; start < len(rows); start += batchRows {
+		end := start + batchRows
+		if end > len(rows) {
+			end = len(rows)
+		}
+		columns := make([]arrow.Array, len(schema.Fields()))
+		for i, field := range schema.Fields() {
+			var values []interface{}
+			for _, row := range rows[start:end] {
+				values = append(values, row[i])
+			}
+			columns[i] = makeColumn(field, values)
+		}
+		rec := array.NewRecord(schema, columns, int64(end-start))
+		err = w.Write(rec)
+		rec.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+func main() {
+	dir := os.Args[1]
+	err := writeStream(filepath.Join(dir, "rows.arrows"), rows)
+	if err == nil {
+		err = writeStream(filepath.Join(dir, "empty.arrows"), nil)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
@@ -28,6 +28,7 @@ const (
 	metricDataDirectoryEmpty  = "no data directory was specified. Please use either -d or set environment variable ALGORAND_DATA"
 
 	telemetryConfigReadError = "Could not read telemetry config: %s\n"
+	telemetryUnknownExporter = "Unknown telemetry exporter '%s', expected elastic or otlp\n"
 
 	pathErrFormat = "%s: %s\n"
 )
@@ -28,8 +28,10 @@ import (
 )
 
 var (
-	nodeName string
-	uri      string
+	nodeName    string
+	uri         string
+	exporter    string
+	otlpHeaders string
 	//dataDir  string // declared in ./metric.go
 )
 
@@ -39,11 +41,15 @@ func init() {
 	telemetryCmd.AddCommand(telemetryDisableCmd)
 	telemetryCmd.AddCommand(telemetryNameCmd)
 	telemetryCmd.AddCommand(telemetryEndpointCmd)
+	telemetryCmd.AddCommand(telemetryExporterCmd)
 
 	telemetryCmd.PersistentFlags().StringVarP(&dataDir, "datadir", "d", "", "Data directory for the node")
 	// Enable Logging : node name
 	telemetryNameCmd.Flags().StringVarP(&nodeName, "name", "n", "", "Friendly-name to use for node")
 	telemetryEndpointCmd.Flags().StringVarP(&uri, "endpoint", "e", "", "Endpoint's URI")
+	telemetryExporterCmd.Flags().StringVarP(&exporter, "exporter", "x", "", "Telemetry backend: elastic or otlp")
+	telemetryExporterCmd.Flags().StringVar(&otlpHeaders, "headers", "", "Comma-separated key=value headers sent with OTLP requests")
+	telemetryExporterCmd.MarkFlagRequired("exporter")
 }
 
 // If we didn't get a value from -d, try $ALGORAND_DATA
@@ -178,3 +184,22 @@ var telemetryEndpointCmd = &cobra.Command{
 		fmt.Printf("Telemetry logging: Name = %s, Guid = %s, URI = %s\n", cfg.Name, cfg.GUID, cfg.URI)
 	},
 }
+
+var telemetryExporterCmd = &cobra.Command{
+	Use:   "exporter -x <elastic|otlp>",
+	Short: "Sets the \"Exporter\" property",
+	Long:  `Sets the "Exporter" property in the telemetry configuration. With otlp, the endpoint is the base URL of an OpenTelemetry collector's OTLP/HTTP receiver, e.g. http://localhost:4318. OTLP over gRPC is not supported.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if exporter != logging.TelemetryExporterElastic && exporter != logging.TelemetryExporterOTLP {
+			fmt.Fprintf(os.Stderr, telemetryUnknownExporter, exporter)
+			os.Exit(1)
+		}
+		cfg := readTelemetryConfigOrExit()
+		cfg.Exporter = exporter
+		if cmd.Flags().Changed("headers") {
+			cfg.OTLPHeaders = otlpHeaders
+		}
+		saveTelemetryConfig(cfg)
+		fmt.Printf("Telemetry logging: Name = %s, Guid = %s, Exporter = %s\n", cfg.Name, cfg.GUID, cfg.Exporter)
+	},
+}
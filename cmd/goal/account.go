@@ -66,6 +66,7 @@ var (
 	listAccountInfo    bool
 	onlyShowAssetIds   bool
 	partKeyIDToDelete  string
+	derivationPath     string
 )
 
 func init() {
@@ -106,6 +107,7 @@ func init() {
 
 	// New Account flag
 	newCmd.Flags().BoolVarP(&defaultAccount, "default", "f", false, "Set this account as the default one")
+	newCmd.Flags().StringVar(&derivationPath, "path", "", "Derive the account at this BIP32 path (e.g. m/44'/283'/0'/0/5) instead of the next one. Only supported by HD wallets")
 
 	// Delete account flag
 	deleteCmd.Flags().StringVarP(&accountAddress, "address", "a", "", "Address of account to delete")
@@ -333,7 +335,13 @@ var newCmd = &cobra.Command{
 
 		// Generate a new address in the default wallet
 		client := ensureKmdClient(dataDir)
-		genAddr, err := client.GenerateAddress(wh)
+		var genAddr string
+		var err error
+		if derivationPath != "" {
+			genAddr, err = client.DeriveAddress(wh, derivationPath)
+		} else {
+			genAddr, err = client.GenerateAddress(wh)
+		}
 		if err != nil {
 			reportErrorf(errorRequestFail, err)
 		}
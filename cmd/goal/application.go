@@ -217,12 +217,12 @@ type boxRef struct {
 	name  apps.AppCallBytes `codec:"name"`
 }
 
-// newBoxRef parses a command-line box ref, which is an optional appId, a comma,
+// parseBoxRef parses a command-line box ref, which is an optional appId, a comma,
 // and then the same format as an app call arg.
-func newBoxRef(arg string) boxRef {
+func parseBoxRef(arg string) (boxRef, error) {
 	parts := strings.SplitN(arg, ":", 2)
 	if len(parts) != 2 {
-		reportErrorf("box refs should be of the form '[<app>,]encoding:value'")
+		return boxRef{}, fmt.Errorf("box refs should be of the form '[<app>,]encoding:value'")
 	}
 	encoding := parts[0] // tentative, may be <app>,<encoding>
 	value := parts[1]
@@ -234,13 +234,26 @@ func newBoxRef(arg string) boxRef {
 		var err error
 		appID, err = strconv.ParseUint(parts[0], 10, 64)
 		if err != nil {
-			reportErrorf("Could not parse app id in box ref: %v", err)
+			return boxRef{}, fmt.Errorf("Could not parse app id in box ref: %v", err)
 		}
 	}
+	name, err := apps.NewAppCallBytes(encoding + ":" + value)
+	if err != nil {
+		return boxRef{}, err
+	}
 	return boxRef{
 		appID: appID,
-		name:  newAppCallBytes(encoding + ":" + value),
+		name:  name,
+	}, nil
+}
+
+// newBoxRef is parseBoxRef for command-line flags, exiting on error.
+func newBoxRef(arg string) boxRef {
+	br, err := parseBoxRef(arg)
+	if err != nil {
+		reportErrorf(err.Error())
 	}
+	return br
 }
 
 func stringsToUint64(strs []string) []uint64 {
@@ -374,24 +387,31 @@ var appCmd = &cobra.Command{
 	},
 }
 
-func mustParseOnCompletion(ocString string) (oc transactions.OnCompletion) {
+func parseOnCompletion(ocString string) (transactions.OnCompletion, error) {
 	switch strings.ToLower(ocString) {
 	case "noop":
-		return transactions.NoOpOC
+		return transactions.NoOpOC, nil
 	case "optin":
-		return transactions.OptInOC
+		return transactions.OptInOC, nil
 	case "closeout":
-		return transactions.CloseOutOC
+		return transactions.CloseOutOC, nil
 	case "clearstate":
-		return transactions.ClearStateOC
+		return transactions.ClearStateOC, nil
 	case "updateapplication":
-		return transactions.UpdateApplicationOC
+		return transactions.UpdateApplicationOC, nil
 	case "deleteapplication":
-		return transactions.DeleteApplicationOC
+		return transactions.DeleteApplicationOC, nil
 	default:
-		reportErrorf("unknown value for --on-completion: %s (possible values: {NoOp, OptIn, CloseOut, ClearState, UpdateApplication, DeleteApplication})", ocString)
-		return
+		return transactions.NoOpOC, fmt.Errorf("unknown value for --on-completion: %s (possible values: {NoOp, OptIn, CloseOut, ClearState, UpdateApplication, DeleteApplication})", ocString)
+	}
+}
+
+func mustParseOnCompletion(ocString string) (oc transactions.OnCompletion) {
+	oc, err := parseOnCompletion(ocString)
+	if err != nil {
+		reportErrorf(err.Error())
 	}
+	return oc
 }
 
 func getDataDirAndClient() (dataDir string, client libgoal.Client) {
@@ -68,6 +68,7 @@ var (
 	rawOutput          bool
 	requestFilename    string
 	requestOutFilename string
+	includePaths       []string
 
 	simulateAllowEmptySignatures  bool
 	simulateAllowMoreLogging      bool
@@ -140,6 +141,7 @@ func init() {
 	compileCmd.Flags().BoolVarP(&signProgram, "sign", "s", false, "Sign program, output is a binary signed LogicSig record")
 	compileCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename to write program bytes or signed LogicSig to")
 	compileCmd.Flags().StringVarP(&account, "account", "a", "", "Account address to sign the program (If not specified, uses default account)")
+	compileCmd.Flags().StringSliceVarP(&includePaths, "include", "I", nil, "Directories to search for #include sources, after the directory of the including file")
 
 	dryrunCmd.Flags().StringVarP(&txFilename, "txfile", "t", "", "Transaction or transaction-group to test")
 	dryrunCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "Consensus protocol version id string")
@@ -961,7 +963,7 @@ func assembleFileImpl(fname string, printWarnings bool) *logic.OpStream {
 	if err != nil {
 		reportErrorf("%s: %s", fname, err)
 	}
-	ops, err := logic.AssembleString(string(text))
+	ops, err := logic.AssembleWithIncludes(fname, string(text), logic.DirLoader{Paths: includePaths})
 	if err != nil {
 		ops.ReportMultipleErrors(fname, os.Stderr)
 		reportErrorf("%s: %s", fname, err)
@@ -998,7 +1000,7 @@ func assembleFile(fname string, printWarnings bool) (program []byte) {
 
 func assembleFileWithMap(fname string, printWarnings bool) ([]byte, logic.SourceMap) {
 	ops := assembleFileImpl(fname, printWarnings)
-	return ops.Program, logic.GetSourceMap([]string{fname}, ops.OffsetToLine)
+	return ops.Program, logic.GetMultiSourceMap(ops.Sources, ops.OffsetToSource, ops.OffsetToLine)
 }
 
 func disassembleFile(fname, outname string) {
@@ -86,6 +86,9 @@ func init() {
 	// ledger.go
 	rootCmd.AddCommand(ledgerCmd)
 
+	// stateproof.go
+	rootCmd.AddCommand(stateproofCmd)
+
 	// completion.go
 	rootCmd.AddCommand(completionCmd)
 
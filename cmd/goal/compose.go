@@ -0,0 +1,891 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	composeInFile       string
+	composeSimulateOnly bool
+	composeSkipSimulate bool
+)
+
+func init() {
+	clerkCmd.AddCommand(composeCmd)
+
+	composeCmd.Flags().StringVarP(&composeInFile, "infile", "i", "", "YAML or JSON file describing the transaction group")
+	composeCmd.Flags().Uint64Var(&firstValid, "firstvalid", 0, "The first round where the transactions may be committed to the ledger")
+	composeCmd.Flags().Uint64Var(&numValidRounds, "validrounds", 0, "The number of rounds for which the transactions will be valid")
+	composeCmd.Flags().Uint64Var(&lastValid, "lastvalid", 0, "The last round where the transactions may be committed to the ledger")
+	composeCmd.Flags().StringVarP(&outFilename, "out", "o", "", "Write the transaction group to this file instead of sending it")
+	composeCmd.Flags().BoolVarP(&sign, "sign", "s", false, "Use with -o to indicate that the dumped transactions should be signed")
+	composeCmd.Flags().BoolVar(&composeSimulateOnly, "simulate-only", false, "Simulate the unsigned group and report the results without sending it")
+	composeCmd.Flags().BoolVar(&composeSkipSimulate, "skip-simulate", false, "Send the group without simulating it first")
+	composeCmd.Flags().BoolVarP(&noWaitAfterSend, "no-wait", "N", false, "Don't wait for the transaction group to commit")
+	composeCmd.MarkFlagRequired("infile")
+}
+
+// abiReturnPrefix is the 4-byte prefix for logged return values, from
+// https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
+var abiReturnPrefix = []byte{0x15, 0x1f, 0x7c, 0x75}
+
+// composeGroup is the description of a transaction group read by `goal clerk compose`.
+type composeGroup struct {
+	// Contracts maps a name to an ARC-4 contract JSON file. Relative paths
+	// are resolved against the directory of the description file.
+	Contracts map[string]string `yaml:"contracts"`
+	// PoolFees names the transaction that pays the fees of the whole group.
+	PoolFees string       `yaml:"pool-fees"`
+	Txns     []composeTxn `yaml:"txns"`
+}
+
+// composeTxn describes a single payment, asset transfer or application call.
+type composeTxn struct {
+	Name string  `yaml:"name"`
+	Type string  `yaml:"type"`
+	From string  `yaml:"from"`
+	Fee  *uint64 `yaml:"fee"`
+	Note string  `yaml:"note"`
+
+	// pay and axfer
+	To      string `yaml:"to"`
+	Amount  uint64 `yaml:"amount"`
+	CloseTo string `yaml:"close-to"`
+	Asset   uint64 `yaml:"asset"`
+
+	// appl
+	AppID        uint64    `yaml:"app-id"`
+	Contract     string    `yaml:"contract"`
+	Method       string    `yaml:"method"`
+	Args         yaml.Node `yaml:"args"`
+	AppArgs      []string  `yaml:"app-args"`
+	OnCompletion string    `yaml:"on-completion"`
+	Accounts     []string  `yaml:"accounts"`
+	Apps         []uint64  `yaml:"apps"`
+	Assets       []uint64  `yaml:"assets"`
+	Boxes        []string  `yaml:"boxes"`
+	// InnerTxns is the number of inner transactions the call issues, whose
+	// fees are added to the fee of the call.
+	InnerTxns uint64 `yaml:"inner-txns"`
+}
+
+// arc4Contract is the subset of an ARC-4 contract description used by compose.
+type arc4Contract struct {
+	Name     string                 `json:"name"`
+	Desc     string                 `json:"desc"`
+	Networks map[string]arc4Network `json:"networks"`
+	Methods  []arc4Method           `json:"methods"`
+}
+
+type arc4Network struct {
+	AppID uint64 `json:"appID"`
+}
+
+type arc4Method struct {
+	Name    string      `json:"name"`
+	Desc    string      `json:"desc"`
+	Args    []arc4Arg   `json:"args"`
+	Returns arc4Returns `json:"returns"`
+}
+
+type arc4Arg struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Desc string `json:"desc"`
+}
+
+type arc4Returns struct {
+	Type string `json:"type"`
+	Desc string `json:"desc"`
+}
+
+func (m arc4Method) signature() string {
+	argTypes := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		argTypes[i] = arg.Type
+	}
+	retType := m.Returns.Type
+	if retType == "" {
+		retType = abi.VoidReturnType
+	}
+	return fmt.Sprintf("%s(%s)%s", m.Name, strings.Join(argTypes, ","), retType)
+}
+
+// findMethod looks up a method by name or by full signature.
+func (c arc4Contract) findMethod(name string) (arc4Method, error) {
+	var found []arc4Method
+	for _, m := range c.Methods {
+		if m.Name == name || m.signature() == name {
+			found = append(found, m)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return arc4Method{}, fmt.Errorf("contract %s has no method %s", c.Name, name)
+	case 1:
+		return found[0], nil
+	default:
+		return arc4Method{}, fmt.Errorf("method %s is overloaded in contract %s, use its full signature", name, c.Name)
+	}
+}
+
+func parseComposeGroup(data []byte) (composeGroup, error) {
+	var group composeGroup
+	err := yaml.Unmarshal(data, &group)
+	if err != nil {
+		return composeGroup{}, err
+	}
+	if len(group.Txns) == 0 {
+		return composeGroup{}, fmt.Errorf("no transactions in group")
+	}
+	return group, nil
+}
+
+func loadComposeContracts(paths map[string]string, baseDir string) (map[string]arc4Contract, error) {
+	contracts := make(map[string]arc4Contract, len(paths))
+	for name, path := range paths {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var contract arc4Contract
+		err = json.Unmarshal(data, &contract)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse contract %s: %v", path, err)
+		}
+		if contract.Name == "" {
+			contract.Name = name
+		}
+		contracts[name] = contract
+	}
+	return contracts, nil
+}
+
+// composeBuiltTxn is a transaction of the group along with what is needed to
+// finish it once the node's suggested parameters are known.
+type composeBuiltTxn struct {
+	name      string
+	from      string
+	txn       transactions.Transaction
+	fee       *uint64
+	innerTxns uint64
+
+	// method is the signature of the ABI method called, if any, and retType
+	// its return type, nil for void methods.
+	method  string
+	retType *abi.Type
+}
+
+type composeBuilder struct {
+	client      libgoal.Client
+	contracts   map[string]arc4Contract
+	genesisHash string
+	txns        []composeBuiltTxn
+	names       map[string]bool
+}
+
+// buildComposeGroup turns a group description into unsigned transaction
+// templates. Transaction arguments of ABI calls are placed right before the
+// call, in argument order.
+func buildComposeGroup(client libgoal.Client, group composeGroup, contracts map[string]arc4Contract, genesisHash string) ([]composeBuiltTxn, error) {
+	b := composeBuilder{
+		client:      client,
+		contracts:   contracts,
+		genesisHash: genesisHash,
+		names:       make(map[string]bool),
+	}
+	for i, t := range group.Txns {
+		if t.Name == "" {
+			t.Name = fmt.Sprintf("txn%d", i)
+		}
+		err := b.add(t)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b.txns, nil
+}
+
+func (b *composeBuilder) add(t composeTxn) error {
+	if b.names[t.Name] {
+		return fmt.Errorf("duplicate transaction name %s", t.Name)
+	}
+	b.names[t.Name] = true
+	if t.From == "" {
+		return fmt.Errorf("%s: from is required", t.Name)
+	}
+
+	built := composeBuiltTxn{
+		name:      t.Name,
+		from:      t.From,
+		fee:       t.Fee,
+		innerTxns: t.InnerTxns,
+	}
+
+	var err error
+	switch t.Type {
+	case string(protocol.PaymentTx):
+		built.txn, err = makeComposePayment(t)
+	case string(protocol.AssetTransferTx):
+		if t.Asset == 0 {
+			return fmt.Errorf("%s: asset is required", t.Name)
+		}
+		if t.To == "" {
+			return fmt.Errorf("%s: to is required", t.Name)
+		}
+		built.txn, err = b.client.MakeUnsignedAssetSendTx(t.Asset, t.Amount, t.To, t.CloseTo, "")
+	case string(protocol.ApplicationCallTx):
+		err = b.addAppCall(t, &built)
+	default:
+		return fmt.Errorf("%s: unsupported transaction type '%s' (possible values: {pay, axfer, appl})", t.Name, t.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %v", t.Name, err)
+	}
+
+	if t.Note != "" {
+		built.txn.Note = []byte(t.Note)
+	}
+	b.txns = append(b.txns, built)
+	return nil
+}
+
+func makeComposePayment(t composeTxn) (tx transactions.Transaction, err error) {
+	if t.To == "" {
+		return tx, fmt.Errorf("to is required")
+	}
+	tx.Type = protocol.PaymentTx
+	tx.Amount = basics.MicroAlgos{Raw: t.Amount}
+	tx.Receiver, err = basics.UnmarshalChecksumAddress(t.To)
+	if err != nil {
+		return tx, err
+	}
+	if t.CloseTo != "" {
+		tx.CloseRemainderTo, err = basics.UnmarshalChecksumAddress(t.CloseTo)
+		if err != nil {
+			return tx, err
+		}
+	}
+	return tx, nil
+}
+
+func (b *composeBuilder) addAppCall(t composeTxn, built *composeBuiltTxn) error {
+	appID := t.AppID
+	if appID == 0 && t.Contract != "" {
+		if contract, ok := b.contracts[t.Contract]; ok {
+			appID = contract.Networks[b.genesisHash].AppID
+		}
+	}
+	if appID == 0 {
+		return fmt.Errorf("app-id is required")
+	}
+
+	onCompletionEnum := transactions.NoOpOC
+	if t.OnCompletion != "" {
+		var err error
+		onCompletionEnum, err = parseOnCompletion(t.OnCompletion)
+		if err != nil {
+			return err
+		}
+	}
+
+	accounts := append([]string(nil), t.Accounts...)
+	foreignApps := append([]uint64(nil), t.Apps...)
+	foreignAssets := append([]uint64(nil), t.Assets...)
+
+	var applicationArgs [][]byte
+	if t.Method != "" {
+		if len(t.AppArgs) > 0 {
+			return fmt.Errorf("method and app-args are mutually exclusive")
+		}
+		err := b.addMethodArgs(t, appID, built, &applicationArgs, &accounts, &foreignApps, &foreignAssets)
+		if err != nil {
+			return err
+		}
+	} else {
+		if t.Args.Kind != 0 {
+			return fmt.Errorf("args require a method, use app-args for non-ABI calls")
+		}
+		for _, arg := range t.AppArgs {
+			appBytes, err := apps.NewAppCallBytes(arg)
+			if err != nil {
+				return err
+			}
+			raw, err := appBytes.Raw()
+			if err != nil {
+				return err
+			}
+			applicationArgs = append(applicationArgs, raw)
+		}
+	}
+
+	boxes := make([]transactions.BoxRef, len(t.Boxes))
+	for i, arg := range t.Boxes {
+		br, err := parseBoxRef(arg)
+		if err != nil {
+			return err
+		}
+		raw, err := br.name.Raw()
+		if err != nil {
+			return fmt.Errorf("could not decode box name %s: %v", arg, err)
+		}
+		index := uint64(0)
+		if br.appID != 0 && br.appID != appID {
+			found := false
+			for a, id := range foreignApps {
+				if br.appID == id {
+					index = uint64(a + 1)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("box ref with app id (%d) not in apps", br.appID)
+			}
+		}
+		boxes[i] = transactions.BoxRef{Index: index, Name: raw}
+	}
+
+	var err error
+	built.txn, err = b.client.MakeUnsignedApplicationCallTx(
+		appID, applicationArgs, accounts, foreignApps, foreignAssets, boxes,
+		onCompletionEnum, nil, nil, basics.StateSchema{}, basics.StateSchema{}, 0)
+	return err
+}
+
+// addMethodArgs encodes the selector and arguments of an ABI method call.
+// Transaction arguments are added to the group as they are met.
+func (b *composeBuilder) addMethodArgs(t composeTxn, appID uint64, built *composeBuiltTxn, applicationArgs *[][]byte, accounts *[]string, foreignApps *[]uint64, foreignAssets *[]uint64) error {
+	method, argNames, err := b.resolveMethod(t)
+	if err != nil {
+		return err
+	}
+
+	_, argTypes, retTypeStr, err := abi.ParseMethodSignature(method)
+	if err != nil {
+		return fmt.Errorf("cannot parse method signature: %v", err)
+	}
+	built.method = method
+	if retTypeStr != abi.VoidReturnType {
+		retType, typeErr := abi.TypeOf(retTypeStr)
+		if typeErr != nil {
+			return fmt.Errorf("cannot cast %s to abi type: %v", retTypeStr, typeErr)
+		}
+		built.retType = &retType
+	}
+
+	argNodes, err := composeArgNodes(&t.Args, argNames)
+	if err != nil {
+		return err
+	}
+
+	hash := sha512.Sum512_256([]byte(method))
+	*applicationArgs = append(*applicationArgs, hash[0:4])
+
+	var basicArgTypes []string
+	var basicArgValues []string
+	var refArgTypes []string
+	var refArgValues []string
+	refArgIndexToBasicArgIndex := make(map[int]int)
+	for i, argType := range argTypes {
+		node := argNodes[i]
+		if abi.IsTransactionType(argType) {
+			err = b.addTxnArg(t, i, argType, argNames[i], node)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if abi.IsReferenceType(argType) {
+			if node.Kind != yaml.ScalarNode {
+				return fmt.Errorf("argument %d: %s reference must be a scalar", i, argType)
+			}
+			refArgIndexToBasicArgIndex[len(refArgTypes)] = len(basicArgTypes)
+			refArgTypes = append(refArgTypes, argType)
+			refArgValues = append(refArgValues, node.Value)
+			// treat the reference as a uint8 for encoding purposes
+			basicArgTypes = append(basicArgTypes, "uint8")
+			basicArgValues = append(basicArgValues, "")
+			continue
+		}
+		value, err := yamlNodeToJSON(node)
+		if err != nil {
+			return fmt.Errorf("argument %d: %v", i, err)
+		}
+		basicArgTypes = append(basicArgTypes, argType)
+		basicArgValues = append(basicArgValues, value)
+	}
+
+	refArgsResolved, err := populateMethodCallReferenceArgs(t.From, appID, refArgTypes, refArgValues, accounts, foreignApps, foreignAssets)
+	if err != nil {
+		return fmt.Errorf("error populating reference arguments: %v", err)
+	}
+	for i, resolved := range refArgsResolved {
+		basicArgValues[refArgIndexToBasicArgIndex[i]] = strconv.Itoa(resolved)
+	}
+
+	err = parseMethodArgJSONtoByteSlice(basicArgTypes, basicArgValues, applicationArgs)
+	if err != nil {
+		return fmt.Errorf("cannot parse arguments to ABI encoding: %v", err)
+	}
+	return nil
+}
+
+// addTxnArg adds the inline transaction given for a transaction argument of
+// an ABI call. It defaults to the type of the argument and the sender of the
+// call.
+func (b *composeBuilder) addTxnArg(call composeTxn, index int, argType string, argName string, node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("argument %d: %s argument must be a transaction", index, argType)
+	}
+	var arg composeTxn
+	err := node.Decode(&arg)
+	if err != nil {
+		return fmt.Errorf("argument %d: %v", index, err)
+	}
+	if arg.Type == "" && argType != abi.AnyTransactionType {
+		arg.Type = argType
+	}
+	if argType != abi.AnyTransactionType && arg.Type != argType {
+		return fmt.Errorf("argument %d: expected a %s transaction but got %s", index, argType, arg.Type)
+	}
+	if arg.From == "" {
+		arg.From = call.From
+	}
+	if arg.Name == "" {
+		if argName == "" {
+			argName = strconv.Itoa(index)
+		}
+		arg.Name = call.Name + "." + argName
+	}
+	return b.add(arg)
+}
+
+// resolveMethod returns the signature and argument names of the method
+// called. Argument names are only known for methods found in a contract.
+func (b *composeBuilder) resolveMethod(t composeTxn) (string, []string, error) {
+	if t.Contract == "" {
+		if !strings.Contains(t.Method, "(") {
+			return "", nil, fmt.Errorf("method %s needs either a full signature or a contract", t.Method)
+		}
+		_, argTypes, _, err := abi.ParseMethodSignature(t.Method)
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot parse method signature: %v", err)
+		}
+		return t.Method, make([]string, len(argTypes)), nil
+	}
+
+	contract, ok := b.contracts[t.Contract]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown contract %s", t.Contract)
+	}
+	m, err := contract.findMethod(t.Method)
+	if err != nil {
+		return "", nil, err
+	}
+	argNames := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		argNames[i] = arg.Name
+	}
+	return m.signature(), argNames, nil
+}
+
+// composeArgNodes returns the method arguments in order. Arguments are either
+// a sequence, or a mapping keyed by the argument names of the contract.
+func composeArgNodes(args *yaml.Node, argNames []string) ([]*yaml.Node, error) {
+	switch args.Kind {
+	case 0:
+		if len(argNames) != 0 {
+			return nil, fmt.Errorf("incorrect number of arguments, method expected %d but got 0", len(argNames))
+		}
+		return nil, nil
+	case yaml.SequenceNode:
+		if len(args.Content) != len(argNames) {
+			return nil, fmt.Errorf("incorrect number of arguments, method expected %d but got %d", len(argNames), len(args.Content))
+		}
+		return args.Content, nil
+	case yaml.MappingNode:
+		byName := make(map[string]*yaml.Node, len(args.Content)/2)
+		for i := 0; i+1 < len(args.Content); i += 2 {
+			byName[args.Content[i].Value] = args.Content[i+1]
+		}
+		nodes := make([]*yaml.Node, len(argNames))
+		for i, name := range argNames {
+			if name == "" {
+				return nil, fmt.Errorf("arguments given by name need a method from a contract with named arguments")
+			}
+			node, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("missing argument %s", name)
+			}
+			nodes[i] = node
+			delete(byName, name)
+		}
+		for name := range byName {
+			return nil, fmt.Errorf("unknown argument %s", name)
+		}
+		return nodes, nil
+	default:
+		return nil, fmt.Errorf("args must be a list or a mapping")
+	}
+}
+
+// yamlNodeToJSON converts a YAML value into JSON for ABI encoding. Integers
+// are kept as written so that values above 2^53 are not rounded.
+func yamlNodeToJSON(node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) != 1 {
+			return "", fmt.Errorf("unexpected YAML document at line %d", node.Line)
+		}
+		return yamlNodeToJSON(node.Content[0])
+	case yaml.AliasNode:
+		return yamlNodeToJSON(node.Alias)
+	case yaml.SequenceNode:
+		elems := make([]string, len(node.Content))
+		for i, elem := range node.Content {
+			value, err := yamlNodeToJSON(elem)
+			if err != nil {
+				return "", err
+			}
+			elems[i] = value
+		}
+		return "[" + strings.Join(elems, ",") + "]", nil
+	case yaml.MappingNode:
+		pairs := make([]string, 0, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, err := json.Marshal(node.Content[i].Value)
+			if err != nil {
+				return "", err
+			}
+			value, err := yamlNodeToJSON(node.Content[i+1])
+			if err != nil {
+				return "", err
+			}
+			pairs = append(pairs, string(key)+":"+value)
+		}
+		return "{" + strings.Join(pairs, ",") + "}", nil
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!int":
+			value, ok := new(big.Int).SetString(node.Value, 0)
+			if !ok {
+				return "", fmt.Errorf("invalid integer %s at line %d", node.Value, node.Line)
+			}
+			return value.String(), nil
+		case "!!float":
+			if !json.Valid([]byte(node.Value)) {
+				return "", fmt.Errorf("invalid number %s at line %d", node.Value, node.Line)
+			}
+			return node.Value, nil
+		case "!!bool":
+			var value bool
+			err := node.Decode(&value)
+			if err != nil {
+				return "", err
+			}
+			return strconv.FormatBool(value), nil
+		case "!!null":
+			return "null", nil
+		default:
+			value, err := json.Marshal(node.Value)
+			return string(value), err
+		}
+	}
+	return "", fmt.Errorf("unsupported YAML value at line %d", node.Line)
+}
+
+// applyComposeFees sets the final fee of every transaction. Calls that issue
+// inner transactions pay an extra minimum fee for each of them. When poolName
+// is set, the named transaction pays the fees of all transactions without an
+// explicit fee, which then pay nothing.
+func applyComposeFees(txns []composeBuiltTxn, poolName string, minFee uint64) error {
+	pool := -1
+	if poolName != "" {
+		for i := range txns {
+			if txns[i].name == poolName {
+				pool = i
+				break
+			}
+		}
+		if pool < 0 {
+			return fmt.Errorf("pool-fees: no transaction named %s", poolName)
+		}
+		if txns[pool].fee != nil {
+			return fmt.Errorf("pool-fees: transaction %s must not set an explicit fee", poolName)
+		}
+	}
+
+	var pooled uint64
+	for i := range txns {
+		if txns[i].fee != nil {
+			txns[i].txn.Fee = basics.MicroAlgos{Raw: *txns[i].fee}
+			continue
+		}
+		needed := basics.AddSaturate(txns[i].txn.Fee.Raw, basics.MulSaturate(txns[i].innerTxns, minFee))
+		if pool < 0 {
+			txns[i].txn.Fee = basics.MicroAlgos{Raw: needed}
+			continue
+		}
+		pooled = basics.AddSaturate(pooled, needed)
+		txns[i].txn.Fee = basics.MicroAlgos{}
+	}
+	if pool >= 0 {
+		txns[pool].txn.Fee = basics.MicroAlgos{Raw: pooled}
+	}
+	return nil
+}
+
+// decodeMethodReturn decodes the ABI return value logged by a method call as JSON.
+func decodeMethodReturn(retType abi.Type, logs [][]byte) (string, error) {
+	if len(logs) == 0 || !bytes.HasPrefix(logs[len(logs)-1], abiReturnPrefix) {
+		return "", fmt.Errorf("did not log a return value")
+	}
+	rawReturnValue := logs[len(logs)-1][len(abiReturnPrefix):]
+	decoded, err := retType.Decode(rawReturnValue)
+	if err != nil {
+		return "", fmt.Errorf("return value %s could not be decoded: %v", hex.EncodeToString(rawReturnValue), err)
+	}
+	decodedJSON, err := retType.MarshalToJSON(decoded)
+	if err != nil {
+		return "", fmt.Errorf("return value %s could not be converted to JSON: %v", hex.EncodeToString(rawReturnValue), err)
+	}
+	return string(decodedJSON), nil
+}
+
+func reportComposeReturns(txns []composeBuiltTxn, logs func(i int) [][]byte) {
+	for i, built := range txns {
+		if built.method == "" {
+			continue
+		}
+		if built.retType == nil {
+			reportInfof("%s: method %s succeeded", built.name, built.method)
+			continue
+		}
+		output, err := decodeMethodReturn(*built.retType, logs(i))
+		if err != nil {
+			reportWarnf("%s: method %s succeeded but %v", built.name, built.method, err)
+			continue
+		}
+		reportInfof("%s: method %s succeeded with output: %s", built.name, built.method, output)
+	}
+}
+
+func simulateComposeGroup(client libgoal.Client, txns []composeBuiltTxn, stxns []transactions.SignedTxn, allowEmptySignatures bool) v2.PreEncodedSimulateTxnGroupResult {
+	resp, err := client.SimulateTransactions(v2.PreEncodedSimulateRequest{
+		TxnGroups:            []v2.PreEncodedSimulateRequestTransactionGroup{{Txns: stxns}},
+		AllowEmptySignatures: allowEmptySignatures,
+	})
+	if err != nil {
+		reportErrorf("simulation error: %v", err)
+	}
+	if len(resp.TxnGroups) != 1 {
+		reportErrorf("simulation error: expected 1 group result but got %d", len(resp.TxnGroups))
+	}
+	result := resp.TxnGroups[0]
+	if result.FailureMessage != nil && *result.FailureMessage != "" {
+		failedTxn := "group"
+		if result.FailedAt != nil && len(*result.FailedAt) > 0 && (*result.FailedAt)[0] < uint64(len(txns)) {
+			failedTxn = txns[(*result.FailedAt)[0]].name
+		}
+		reportErrorf("simulation failed at %s: %s", failedTxn, *result.FailureMessage)
+	}
+	return result
+}
+
+var composeCmd = &cobra.Command{
+	Use:   "compose",
+	Short: "Build, sign and send a transaction group described in a YAML or JSON file",
+	Long: `Build an atomic transaction group of payments, asset transfers and application calls described in a YAML or JSON file.
+ABI method calls are resolved against ARC-4 contract descriptions, their transaction arguments are added to the group right before the call, and reference arguments are placed in the foreign arrays.
+The group is signed with kmd, simulated, sent, and the ABI return values are reported.`,
+	Example: `  goal clerk compose -i swap.yaml
+
+where swap.yaml is:
+
+  contracts:
+    amm: amm.arc4.json
+  pool-fees: fund
+  txns:
+    - name: fund
+      type: pay
+      from: SENDER
+      to: SENDER
+      amount: 0
+    - name: swap
+      type: appl
+      from: SENDER
+      contract: amm
+      method: swap
+      inner-txns: 1
+      args:
+        deposit: {type: axfer, to: APPADDR, asset: 31566704, amount: 1000000}
+        min_out: 990000`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		if composeSimulateOnly && (composeSkipSimulate || outFilename != "") {
+			reportErrorf("--simulate-only cannot be used with --skip-simulate or --out")
+		}
+
+		data, err := readFile(composeInFile)
+		if err != nil {
+			reportErrorf(fileReadError, composeInFile, err)
+		}
+		group, err := parseComposeGroup(data)
+		if err != nil {
+			reportErrorf("Cannot parse %s: %v", composeInFile, err)
+		}
+		contracts, err := loadComposeContracts(group.Contracts, filepath.Dir(composeInFile))
+		if err != nil {
+			reportErrorf("Cannot load contract: %v", err)
+		}
+
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureFullClient(dataDir)
+		params, err := client.SuggestedParams()
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		txns, err := buildComposeGroup(client, group, contracts, base64.StdEncoding.EncodeToString(params.GenesisHash))
+		if err != nil {
+			reportErrorf("Cannot build transaction group: %v", err)
+		}
+
+		fv, lv, _, err := client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
+		if err != nil {
+			reportErrorf("Cannot determine last valid round: %s", err)
+		}
+		for i := range txns {
+			txns[i].txn, err = client.FillUnsignedTxTemplate(txns[i].from, fv, lv, 0, txns[i].txn)
+			if err != nil {
+				reportErrorf("Cannot construct transaction %s: %s", txns[i].name, err)
+			}
+		}
+		err = applyComposeFees(txns, group.PoolFees, params.MinFee)
+		if err != nil {
+			reportErrorf(err.Error())
+		}
+
+		if len(txns) > 1 {
+			txnGroup := make([]transactions.Transaction, len(txns))
+			for i := range txns {
+				txnGroup[i] = txns[i].txn
+			}
+			groupID, gidErr := client.GroupID(txnGroup)
+			if gidErr != nil {
+				reportErrorf("Cannot assign transaction group ID: %s", gidErr)
+			}
+			for i := range txns {
+				txns[i].txn.Group = groupID
+			}
+		}
+
+		if composeSimulateOnly {
+			stxns := make([]transactions.SignedTxn, len(txns))
+			for i := range txns {
+				stxns[i] = transactions.SignedTxn{Txn: txns[i].txn}
+			}
+			result := simulateComposeGroup(client, txns, stxns, true)
+			for _, built := range txns {
+				reportInfof("%s: transaction from account %s, txid %s (fee %d)", built.name, built.txn.Sender, built.txn.ID(), built.txn.Fee.Raw)
+			}
+			reportComposeReturns(txns, func(i int) [][]byte {
+				if i >= len(result.Txns) || result.Txns[i].Txn.Logs == nil {
+					return nil
+				}
+				return *result.Txns[i].Txn.Logs
+			})
+			return
+		}
+
+		shouldSign := sign || outFilename == ""
+		stxns := make([]transactions.SignedTxn, len(txns))
+		for i := range txns {
+			stxns[i], err = createSignedTransaction(client, shouldSign, dataDir, walletName, txns[i].txn, basics.Address{})
+			if err != nil {
+				reportErrorf(errorSigningTX, err)
+			}
+		}
+
+		if outFilename != "" {
+			err = writeSignedTxnsToFile(stxns, outFilename)
+			if err != nil {
+				reportErrorf(err.Error())
+			}
+			return
+		}
+
+		if !composeSkipSimulate {
+			simulateComposeGroup(client, txns, stxns, false)
+		}
+
+		err = client.BroadcastTransactionGroup(stxns)
+		if err != nil {
+			reportErrorf(errorBroadcastingTX, err)
+		}
+
+		reportInfof("Issued %d transaction(s):", len(stxns))
+		var txid string
+		for i, stxn := range stxns {
+			txid = stxn.Txn.ID().String()
+			reportInfof("%s: issued transaction from account %s, txid %s (fee %d)", txns[i].name, stxn.Txn.Sender, txid, stxn.Txn.Fee.Raw)
+		}
+
+		if noWaitAfterSend {
+			return
+		}
+		_, err = waitForCommit(client, txid, lv)
+		if err != nil {
+			reportErrorf(err.Error())
+		}
+		reportComposeReturns(txns, func(i int) [][]byte {
+			resp, err := client.PendingTransactionInformation(stxns[i].Txn.ID().String())
+			if err != nil || resp.Logs == nil {
+				return nil
+			}
+			return *resp.Logs
+		})
+	},
+}
@@ -0,0 +1,285 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const composeTestContract = `{
+  "name": "amm",
+  "networks": {"Z2VuZXNpcw==": {"appID": 1234}},
+  "methods": [
+    {"name": "swap", "args": [{"type": "axfer", "name": "deposit"}, {"type": "uint64", "name": "min_out"}, {"type": "account", "name": "to"}], "returns": {"type": "uint64"}},
+    {"name": "bootstrap", "args": [{"type": "pay", "name": "seed"}, {"type": "asset", "name": "asset"}], "returns": {"type": "void"}},
+    {"name": "get", "args": [{"type": "string", "name": "key"}], "returns": {"type": "byte[]"}},
+    {"name": "get", "args": [{"type": "uint64", "name": "index"}], "returns": {"type": "byte[]"}}
+  ]
+}`
+
+func composeTestSelector(sig string) []byte {
+	hash := sha512.Sum512_256([]byte(sig))
+	return hash[:4]
+}
+
+func composeTestUint64(v uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	return b[:]
+}
+
+func buildComposeTestGroup(t *testing.T, description string) ([]composeBuiltTxn, error) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "amm.json"), []byte(composeTestContract), 0600))
+
+	group, err := parseComposeGroup([]byte(description))
+	require.NoError(t, err)
+	contracts, err := loadComposeContracts(group.Contracts, dir)
+	require.NoError(t, err)
+	return buildComposeGroup(libgoal.Client{}, group, contracts, "Z2VuZXNpcw==")
+}
+
+func TestComposeBuildGroup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender := basics.Address{1}
+	other := basics.Address{2}
+	appAddr := basics.AppIndex(1234).Address()
+
+	description := fmt.Sprintf(`
+contracts:
+  amm: amm.json
+txns:
+  - name: tip
+    type: pay
+    from: %[1]s
+    to: %[2]s
+    amount: 5
+    note: thanks
+  - name: swap
+    type: appl
+    from: %[1]s
+    contract: amm
+    method: swap
+    boxes: ["str:pool"]
+    args:
+      deposit: {to: %[3]s, asset: 7, amount: 1000}
+      min_out: 18446744073709551615
+      to: %[2]s
+`, sender, other, appAddr)
+
+	txns, err := buildComposeTestGroup(t, description)
+	require.NoError(t, err)
+	require.Len(t, txns, 3)
+
+	require.Equal(t, "tip", txns[0].name)
+	require.Equal(t, protocol.PaymentTx, txns[0].txn.Type)
+	require.Equal(t, other, txns[0].txn.Receiver)
+	require.Equal(t, uint64(5), txns[0].txn.Amount.Raw)
+	require.Equal(t, []byte("thanks"), txns[0].txn.Note)
+
+	// the transaction argument comes right before the call and defaults to its sender
+	require.Equal(t, "swap.deposit", txns[1].name)
+	require.Equal(t, sender.String(), txns[1].from)
+	require.Equal(t, protocol.AssetTransferTx, txns[1].txn.Type)
+	require.Equal(t, basics.AssetIndex(7), txns[1].txn.XferAsset)
+	require.Equal(t, appAddr, txns[1].txn.AssetReceiver)
+
+	call := txns[2]
+	require.Equal(t, "swap(axfer,uint64,account)uint64", call.method)
+	require.NotNil(t, call.retType)
+	require.Equal(t, protocol.ApplicationCallTx, call.txn.Type)
+	require.Equal(t, basics.AppIndex(1234), call.txn.ApplicationID)
+	require.Equal(t, [][]byte{
+		composeTestSelector(call.method),
+		composeTestUint64(18446744073709551615),
+		{1},
+	}, call.txn.ApplicationArgs)
+	require.Equal(t, []basics.Address{other}, call.txn.Accounts)
+	require.Equal(t, []transactions.BoxRef{{Index: 0, Name: []byte("pool")}}, call.txn.Boxes)
+}
+
+func TestComposeBuildGroupJSON(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender := basics.Address{1}
+	description := fmt.Sprintf(`{
+  "txns": [
+    {
+      "type": "appl",
+      "from": "%[1]s",
+      "app-id": 55,
+      "method": "add(uint64,uint64,asset,application)uint64",
+      "args": [1, 2, 9, 55],
+      "on-completion": "OptIn"
+    },
+    {
+      "type": "appl",
+      "from": "%[1]s",
+      "app-id": 55,
+      "app-args": ["str:hello", "int:3"],
+      "apps": [66],
+      "boxes": ["66,str:b"]
+    }
+  ]
+}`, sender)
+
+	txns, err := buildComposeTestGroup(t, description)
+	require.NoError(t, err)
+	require.Len(t, txns, 2)
+
+	require.Equal(t, "txn0", txns[0].name)
+	require.Equal(t, transactions.OptInOC, txns[0].txn.OnCompletion)
+	require.Equal(t, [][]byte{
+		composeTestSelector("add(uint64,uint64,asset,application)uint64"),
+		composeTestUint64(1),
+		composeTestUint64(2),
+		{0}, // first foreign asset
+		{0}, // the called app itself
+	}, txns[0].txn.ApplicationArgs)
+	require.Equal(t, []basics.AssetIndex{9}, txns[0].txn.ForeignAssets)
+	require.Empty(t, txns[0].txn.ForeignApps)
+
+	require.Empty(t, txns[1].method)
+	require.Equal(t, [][]byte{[]byte("hello"), composeTestUint64(3)}, txns[1].txn.ApplicationArgs)
+	require.Equal(t, []transactions.BoxRef{{Index: 1, Name: []byte("b")}}, txns[1].txn.Boxes)
+}
+
+func TestComposeBuildGroupErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender := basics.Address{1}
+	tests := []struct {
+		txn string
+		err string
+	}{
+		{txn: `{type: pay, to: %[1]s}`, err: "from is required"},
+		{txn: `{type: keyreg, from: %[1]s}`, err: "unsupported transaction type"},
+		{txn: `{type: appl, from: %[1]s, method: "noop()void"}`, err: "app-id is required"},
+		{txn: `{type: appl, from: %[1]s, contract: amm, method: get}`, err: "overloaded"},
+		{txn: `{type: appl, from: %[1]s, contract: amm, method: missing}`, err: "has no method missing"},
+		{txn: `{type: appl, from: %[1]s, app-id: 1, method: noop}`, err: "needs either a full signature or a contract"},
+		{txn: `{type: appl, from: %[1]s, contract: amm, method: "get(string)byte[]", args: [a, b]}`, err: "method expected 1 but got 2"},
+		{txn: `{type: appl, from: %[1]s, contract: amm, method: "get(uint64)byte[]", args: {key: 1}}`, err: "missing argument index"},
+		{txn: `{type: appl, from: %[1]s, app-id: 1, method: "f(uint64)void", args: {x: 1}}`, err: "need a method from a contract"},
+		{txn: `{type: appl, from: %[1]s, contract: amm, method: bootstrap, args: [{type: axfer}, 1]}`, err: "expected a pay transaction"},
+		{txn: `{type: appl, from: %[1]s, app-id: 1, app-args: ["int:1"], boxes: ["2,str:x"]}`, err: "not in apps"},
+	}
+
+	for _, test := range tests {
+		description := "contracts: {amm: amm.json}\ntxns:\n  - " + fmt.Sprintf(test.txn, sender)
+		_, err := buildComposeTestGroup(t, description)
+		require.ErrorContains(t, err, test.err, test.txn)
+	}
+}
+
+func TestComposeApplyFees(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	explicit := uint64(3000)
+	makeTxns := func() []composeBuiltTxn {
+		txns := []composeBuiltTxn{
+			{name: "fund"},
+			{name: "call", innerTxns: 2},
+			{name: "fixed", fee: &explicit},
+		}
+		for i := range txns {
+			txns[i].txn.Fee = basics.MicroAlgos{Raw: 1000}
+		}
+		return txns
+	}
+
+	txns := makeTxns()
+	require.NoError(t, applyComposeFees(txns, "", 1000))
+	require.Equal(t, uint64(1000), txns[0].txn.Fee.Raw)
+	require.Equal(t, uint64(3000), txns[1].txn.Fee.Raw)
+	require.Equal(t, uint64(3000), txns[2].txn.Fee.Raw)
+
+	txns = makeTxns()
+	require.NoError(t, applyComposeFees(txns, "fund", 1000))
+	require.Equal(t, uint64(4000), txns[0].txn.Fee.Raw)
+	require.Equal(t, uint64(0), txns[1].txn.Fee.Raw)
+	require.Equal(t, uint64(3000), txns[2].txn.Fee.Raw)
+
+	require.ErrorContains(t, applyComposeFees(makeTxns(), "nope", 1000), "no transaction named nope")
+	require.ErrorContains(t, applyComposeFees(makeTxns(), "fixed", 1000), "must not set an explicit fee")
+}
+
+func TestYamlNodeToJSON(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		yaml string
+		json string
+	}{
+		{yaml: `18446744073709551615`, json: `18446744073709551615`},
+		{yaml: `0x10`, json: `16`},
+		{yaml: `1.25`, json: `1.25`},
+		{yaml: `true`, json: `true`},
+		{yaml: `~`, json: `null`},
+		{yaml: `hello`, json: `"hello"`},
+		{yaml: `"42"`, json: `"42"`},
+		{yaml: `[1, [a, false]]`, json: `[1,["a",false]]`},
+		{yaml: `{a: 1, b: [x]}`, json: `{"a":1,"b":["x"]}`},
+		{yaml: "a: &v [1, 2]\nb: *v", json: `{"a":[1,2],"b":[1,2]}`},
+	}
+
+	for _, test := range tests {
+		var node yaml.Node
+		require.NoError(t, yaml.Unmarshal([]byte(test.yaml), &node))
+		out, err := yamlNodeToJSON(&node)
+		require.NoError(t, err, test.yaml)
+		require.Equal(t, test.json, out, test.yaml)
+	}
+}
+
+func TestDecodeMethodReturn(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	retType, err := abi.TypeOf("(uint64,string)")
+	require.NoError(t, err)
+	encoded, err := retType.Encode([]interface{}{uint64(7), "hi"})
+	require.NoError(t, err)
+
+	out, err := decodeMethodReturn(retType, [][]byte{[]byte("other"), append(abiReturnPrefix, encoded...)})
+	require.NoError(t, err)
+	require.Equal(t, `[7,"hi"]`, out)
+
+	_, err = decodeMethodReturn(retType, [][]byte{append(abiReturnPrefix, encoded...), []byte("last")})
+	require.ErrorContains(t, err, "did not log a return value")
+}
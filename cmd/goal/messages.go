@@ -92,6 +92,23 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	infoNodeSnapshotSaved                   = "Saved ledger snapshot '%s' at round %d"
+	infoNodeSnapshotReverted                = "Reverted ledger to snapshot '%s' at round %d"
+	infoNodeNoSnapshots                     = "No ledger snapshots saved"
+	errorNodeSnapshotSave                   = "Unable to save ledger snapshot: %v"
+	errorNodeSnapshotRevert                 = "Unable to revert ledger snapshot: %v"
+	errorNodeSnapshotList                   = "Unable to list ledger snapshots: %v"
+	infoNodeRepairConsistent                = "The tracker database is consistent with the block database."
+	infoNodeRepairReplay                    = "Replayed block %d of %d"
+	infoNodeRepairCatchpoint                = "Loading catchpoint file %s"
+	infoNodeRepairRebuilt                   = "Rebuilt the tracker database up to round %d. The previous tracker database was moved to %s"
+	warnNodeRepairCatchpoint                = "Unable to rebuild from catchpoint file %s: %v"
+	errorNodeRepairRunning                  = "Node must be stopped before its ledger can be checked or repaired"
+	errorNodeRepairGenesis                  = "Unable to load the genesis file: %v"
+	errorNodeRepairVerify                   = "Unable to verify the tracker database: %v"
+	errorNodeRepairInconsistent             = "Found %d inconsistencies in the tracker database. Run again with --rebuild to rebuild it."
+	errorNodeRepairRebuild                  = "Unable to rebuild the tracker database: %v"
+	errorNodeRepairNoCatchpoints            = "No catchpoint files were found in %s"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -146,6 +163,9 @@ const (
 	infoNetworkStarted       = "Network Started under %s"
 	infoNetworkStopped       = "Network Stopped under %s"
 	infoNetworkDeleted       = "Network Deleted under %s"
+	errorLoadingTimeline     = "Error loading network timeline: %s"
+	errorRunningTimeline     = "Error running network timeline: %s"
+	infoTimelineCompleted    = "Network timeline completed under %s"
 
 	multisigProgramCollision = "should have at most one of --program/-p | --program-bytes/-P | --lsig/-L"
 
@@ -170,6 +190,8 @@ const (
 
 	// Wallet
 	infoRecoveryPrompt           = "Please type your recovery mnemonic below, and hit return when you are done: "
+	infoBIP39RecoveryPrompt      = "Please type your 24 word BIP39 mnemonic below, and hit return when you are done: "
+	infoBIP39PassphrasePrompt    = "Please type the BIP39 passphrase the mnemonic was used with, or hit return if there was none: "
 	infoChoosePasswordPrompt     = "Please choose a password for wallet '%s': "
 	infoPasswordConfirmation     = "Please confirm the password: "
 	infoCreatingWallet           = "Creating wallet..."
@@ -182,6 +204,7 @@ const (
 	errorCouldntInitializeWallet = "Couldn't initialize wallet: %s"
 	errorCouldntExportMDK        = "Couldn't export master derivation key: %s"
 	errorCouldntMakeMnemonic     = "Couldn't make mnemonic: %s"
+	errorCouldntExportMnemonic   = "Couldn't export BIP39 mnemonic: %s"
 	errorCouldntListWallets      = "Couldn't list wallets: %s"
 	errorPasswordConfirmation    = "Password confirmation did not match"
 	errorBadMnemonic             = "Problem with mnemonic: %s"
@@ -207,4 +230,15 @@ const (
 	errParsingRoundNumber  = "Error parsing round number: %s"
 	errBadBlockArgs        = "Cannot combine --b32=true or --strict=true with --raw"
 	errEncodingBlockAsJSON = "Error encoding block as json: %s"
+
+	// State proofs
+	errDecodingStateProof      = "Error decoding state proof: %s"
+	errStateProofSourceArgs    = "Exactly one of --round or --in must be specified"
+	errBadVotersCommitment     = "Cannot decode voters commitment: %s"
+	errProvenWeightArgs        = "Exactly one of --ln-proven-weight or --proven-weight must be specified"
+	errBadProvenWeight         = "Invalid proven weight: %s"
+	errStateProofVerifyFailed  = "State proof verification failed: %s"
+	errLightHeaderVerifyFailed = "Light block header for round %d failed verification: %s"
+	infoStateProofVerified     = "State proof for rounds %d - %d verified"
+	infoLightHeaderVerified    = "Light block header for round %d is committed to by the state proof for rounds %d - %d"
 )
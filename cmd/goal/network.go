@@ -17,6 +17,7 @@
 package main
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	"io"
@@ -40,6 +41,7 @@ var noImportKeys bool
 var noClean bool
 var devModeOverride bool
 var startOnCreation bool
+var timelineFile string
 
 func init() {
 	networkCmd.AddCommand(networkCreateCmd)
@@ -60,6 +62,10 @@ func init() {
 	networkCmd.AddCommand(networkStopCmd)
 	networkCmd.AddCommand(networkStatusCmd)
 	networkCmd.AddCommand(networkDeleteCmd)
+	networkCmd.AddCommand(networkTimelineCmd)
+
+	networkTimelineCmd.Flags().StringVarP(&timelineFile, "file", "f", "", "Specify the path to the timeline file")
+	networkTimelineCmd.MarkFlagRequired("file")
 }
 
 var networkCmd = &cobra.Command{
@@ -246,3 +252,30 @@ var networkDeleteCmd = &cobra.Command{
 		reportInfof(infoNetworkDeleted, networkRootDir)
 	},
 }
+
+var networkTimelineCmd = &cobra.Command{
+	Use:   "timeline",
+	Short: "Apply a timeline of topology changes to a running private network",
+	Long: `Apply the events of a timeline file to a running private network, each at its scheduled time. Events update the latency, bandwidth, loss or state of the links declared in the network template, or stop and start nodes. The command returns once the last event is applied.
+
+Example timeline:
+{"Events": [
+  {"At": "30s", "Link": {"From": "Node1", "To": "Relay1", "Down": true}},
+  {"At": "1m", "StopNode": "Relay2"},
+  {"At": "2m", "StartNode": "Relay2"},
+  {"At": "2m", "Link": {"From": "Node1", "To": "Relay1", "LatencyMs": 200}}
+]}`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		network, binDir := getNetworkAndBinDir()
+		timeline, err := netdeploy.LoadTimeline(timelineFile)
+		if err != nil {
+			reportErrorf(errorLoadingTimeline, err)
+		}
+		err = network.RunTimeline(context.Background(), binDir, timeline, false, os.Stdout)
+		if err != nil {
+			reportErrorf(errorRunningTimeline, err)
+		}
+		reportInfof(infoTimelineCompleted, networkRootDir)
+	},
+}
@@ -77,6 +77,10 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(snapshotCmd)
+	snapshotCmd.AddCommand(snapshotSaveCmd)
+	snapshotCmd.AddCommand(snapshotRestoreCmd)
+	snapshotCmd.AddCommand(snapshotListCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 
@@ -185,6 +189,75 @@ var catchupCmd = &cobra.Command{
 	},
 }
 
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Save and restore ledger snapshots of a node running in dev mode",
+	Long:  "Ledger snapshots capture the ledger of a dev mode node at its latest round. Restoring a snapshot discards every block added after it was saved, along with the pending transactions, so that tests can run against the same ledger state over and over again.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//Fall back
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var snapshotSaveCmd = &cobra.Command{
+	Use:     "save [name]",
+	Short:   "Save a snapshot of the ledger at the latest round",
+	Long:    "Save a snapshot of the ledger at the latest round under the given name, replacing any previous snapshot with the same name.",
+	Example: "goal node snapshot save baseline",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			resp, err := client.SaveLedgerSnapshot(args[0])
+			if err != nil {
+				reportErrorf(errorNodeSnapshotSave, err)
+			}
+			reportInfof(infoNodeSnapshotSaved, resp.Name, resp.Round)
+		})
+	},
+}
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:     "restore [name]",
+	Short:   "Revert the ledger to a saved snapshot",
+	Long:    "Revert the ledger to a saved snapshot, discarding every block added after it along with the pending transactions. The snapshot is kept, so it can be restored again.",
+	Example: "goal node snapshot restore baseline",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			resp, err := client.RevertLedgerSnapshot(args[0])
+			if err != nil {
+				reportErrorf(errorNodeSnapshotRevert, err)
+			}
+			reportInfof(infoNodeSnapshotReverted, resp.Name, resp.Round)
+		})
+	},
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the saved ledger snapshots",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			resp, err := client.LedgerSnapshots()
+			if err != nil {
+				reportErrorf(errorNodeSnapshotList, err)
+			}
+			if len(resp.Snapshots) == 0 {
+				reportInfoln(infoNodeNoSnapshots)
+				return
+			}
+			for _, snapshot := range resp.Snapshots {
+				fmt.Printf("%s\t%d\n", snapshot.Name, snapshot.Round)
+			}
+		})
+	},
+}
+
 func catchpointCmdArgument(cmd *cobra.Command, args []string) error {
 	catchpointsCount := 0
 	for _, arg := range args {
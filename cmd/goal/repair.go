@@ -0,0 +1,452 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/agreement"
+	catchupservice "github.com/algorand/go-algorand/catchup"
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/nodecontrol"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var repairRebuild bool
+var repairForce bool
+var repairCatchpointFile string
+var repairLatestCatchpoint bool
+
+func init() {
+	nodeCmd.AddCommand(repairCmd)
+
+	repairCmd.Flags().BoolVar(&repairRebuild, "rebuild", false, "Rebuild the tracker database if it is inconsistent with the block database")
+	repairCmd.Flags().BoolVar(&repairForce, "force", false, "Rebuild the tracker database even if no inconsistencies were found")
+	repairCmd.Flags().StringVar(&repairCatchpointFile, "catchpoint", "", "Rebuild starting from the given catchpoint file instead of replaying every block since genesis")
+	repairCmd.Flags().BoolVar(&repairLatestCatchpoint, "latest-catchpoint", false, "Rebuild starting from the latest catchpoint file generated by the node that can be loaded")
+}
+
+var repairCmd = &cobra.Command{
+	Use:   "repair",
+	Short: "Check the ledger of a stopped node, and optionally rebuild its tracker database",
+	Long: "Check the tracker database of a stopped node against its block database: the merkle trie root and the account totals are recomputed from the accounts, " +
+		"and the block database is checked to hold the blocks needed to bring the trackers up to date. With --rebuild, an inconsistent tracker database is rebuilt " +
+		"by replaying the blocks of the block database, either from genesis or from a catchpoint file. The network is never contacted, and the previous tracker database is kept as a backup.",
+	Example: "goal node repair -d <datadir> --rebuild --latest-catchpoint",
+	Args:    validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		if repairCatchpointFile != "" && repairLatestCatchpoint {
+			reportErrorln("Only one of --catchpoint or --latest-catchpoint can be used")
+		}
+		binDir, err := util.ExeDir()
+		if err != nil {
+			panic(err)
+		}
+		datadir.OnDataDirs(func(dataDir string) {
+			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			if _, err := nc.GetAlgodPID(); err == nil {
+				reportErrorln(errorNodeRepairRunning)
+			}
+
+			genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(dataDir, config.GenesisJSONFile))
+			if err != nil {
+				reportErrorf(errorNodeRepairGenesis, err)
+			}
+			genesisDir := filepath.Join(dataDir, genesis.ID())
+			dbPrefix := filepath.Join(genesisDir, config.LedgerFilenamePrefix)
+
+			ctx := context.Background()
+			report, err := ledger.VerifyTrackerDB(ctx, dbPrefix)
+			if err != nil {
+				reportErrorf(errorNodeRepairVerify, err)
+			}
+			printTrackerDBReport(report)
+			if len(report.Problems) == 0 {
+				reportInfoln(infoNodeRepairConsistent)
+				if !repairForce {
+					return
+				}
+			}
+			if !repairRebuild {
+				if len(report.Problems) > 0 {
+					reportErrorf(errorNodeRepairInconsistent, len(report.Problems))
+				}
+				return
+			}
+
+			var catchpointFiles []string
+			if repairCatchpointFile != "" {
+				catchpointFiles = []string{repairCatchpointFile}
+			} else if repairLatestCatchpoint {
+				catchpointsDir := filepath.Join(genesisDir, trackerdb.CatchpointDirName)
+				catchpointFiles, err = listCatchpointFiles(catchpointsDir)
+				if err != nil {
+					reportErrorf(errorNodeRepairRebuild, err)
+				}
+				if len(catchpointFiles) == 0 {
+					reportErrorf(errorNodeRepairNoCatchpoints, catchpointsDir)
+				}
+			}
+
+			cfg, err := config.LoadConfigFromDisk(dataDir)
+			if err != nil && !os.IsNotExist(err) {
+				reportErrorf(errLoadingConfig, dataDir, err)
+			}
+			rnd, backup, err := rebuildTrackerDB(ctx, cfg, genesis, dbPrefix, catchpointFiles, report)
+			if err != nil {
+				reportErrorf(errorNodeRepairRebuild, err)
+			}
+			reportInfof(infoNodeRepairRebuilt, rnd, backup)
+		})
+	},
+}
+
+func printTrackerDBReport(report ledger.TrackerDBReport) {
+	fmt.Printf("Tracker database round: %d\n", report.TrackerRound)
+	fmt.Printf("Block database rounds: %d - %d\n", report.EarliestBlock, report.LatestBlock)
+	fmt.Printf("Accounts: %d, resources: %d, kv pairs: %d\n", report.Accounts, report.Resources, report.KVs)
+	if report.TrieChecked {
+		fmt.Printf("Merkle trie root: %v\n", report.ComputedRoot)
+	} else {
+		fmt.Printf("Merkle trie root: not checked, the trie was last updated at round %d\n", report.HashRound)
+	}
+	fmt.Printf("Total online stake: %d, offline stake: %d, not participating stake: %d\n",
+		report.ComputedTotals.Online.Money.Raw, report.ComputedTotals.Offline.Money.Raw, report.ComputedTotals.NotParticipating.Money.Raw)
+	for _, problem := range report.Problems {
+		fmt.Printf("[ MISMATCH ] %s\n", problem)
+	}
+}
+
+// listCatchpointFiles returns the catchpoint files written by the node in catchpointsDir, latest round first.
+func listCatchpointFiles(catchpointsDir string) ([]string, error) {
+	type catchpointFile struct {
+		path  string
+		round uint64
+	}
+	var files []catchpointFile
+	err := filepath.Walk(catchpointsDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == catchpointsDir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".catchpoint") {
+			return nil
+		}
+		round, err := strconv.ParseUint(strings.TrimSuffix(info.Name(), ".catchpoint"), 10, 64)
+		if err != nil {
+			return nil
+		}
+		files = append(files, catchpointFile{path: path, round: round})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].round > files[j].round })
+	paths := make([]string, len(files))
+	for i, f := range files {
+		paths[i] = f.path
+	}
+	return paths, nil
+}
+
+// localBlocks reads blocks from the block database of the node being repaired, which holds the rounds
+// earliest to latest.
+type localBlocks struct {
+	dbs      db.Accessor
+	earliest basics.Round
+	latest   basics.Round
+}
+
+func (lb localBlocks) get(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
+	err = lb.dbs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var err0 error
+		blk, cert, err0 = blockdb.BlockGetCert(tx, rnd)
+		return err0
+	})
+	return
+}
+
+// makeRepairGenesisInitState returns the initial ledger state of the genesis the same way the node computes it.
+func makeRepairGenesisInitState(genesis bookkeeping.Genesis) (ledgercore.InitState, error) {
+	genesisBalances, err := genesis.Balances()
+	if err != nil {
+		return ledgercore.InitState{}, err
+	}
+	genesisBlock, err := genesis.Block()
+	if err != nil {
+		return ledgercore.InitState{}, err
+	}
+	// the node marks the fee sink as not participating when the genesis protocol requires it, see data.LoadLedger.
+	if config.Consensus[genesis.Proto].ForceNonParticipatingFeeSink {
+		sinkData := genesisBalances.Balances[genesisBalances.FeeSink]
+		sinkData.Status = basics.NotParticipating
+		genesisBalances.Balances[genesisBalances.FeeSink] = sinkData
+	}
+	return ledgercore.InitState{
+		Block:       genesisBlock,
+		Accounts:    genesisBalances.Balances,
+		GenesisHash: genesis.Hash(),
+	}, nil
+}
+
+// rebuildTrackerDB builds a new tracker database by replaying the blocks of the block database at dbPrefix, starting
+// from the first of catchpointFiles that can be loaded, or from genesis when no catchpoint files are given. The blocks
+// replayed are the ones report, the verification of the existing tracker database, found in the block database. Once
+// the new database is verified, it replaces the existing tracker database, which is kept as a backup whose name is
+// returned along with the round the new database was built up to. The block database is left untouched.
+func rebuildTrackerDB(ctx context.Context, cfg config.Local, genesis bookkeeping.Genesis, dbPrefix string, catchpointFiles []string, report ledger.TrackerDBReport) (basics.Round, string, error) {
+	genesisInitState, err := makeRepairGenesisInitState(genesis)
+	if err != nil {
+		return 0, "", err
+	}
+
+	blockDBs, err := db.MakeAccessor(dbPrefix+".block.sqlite", true, false)
+	if err != nil {
+		return 0, "", err
+	}
+	defer blockDBs.Close()
+	blocks := localBlocks{dbs: blockDBs, earliest: report.EarliestBlock, latest: report.LatestBlock}
+
+	// the new tracker database maintains the merkle trie, so that it can be verified, but does not write catchpoint files.
+	cfg.Archival = false
+	cfg.CatchpointTracking = 1
+	if cfg.CatchpointInterval == 0 {
+		cfg.CatchpointInterval = config.GetDefaultLocal().CatchpointInterval
+	}
+
+	tempDir, err := os.MkdirTemp(filepath.Dir(dbPrefix), "repair-")
+	if err != nil {
+		return 0, "", err
+	}
+	defer os.RemoveAll(tempDir)
+
+	var rnd basics.Round
+	var tempPrefix string
+	if len(catchpointFiles) == 0 {
+		tempPrefix = filepath.Join(tempDir, "genesis", config.LedgerFilenamePrefix)
+		rnd, err = replayTrackerDB(ctx, cfg, genesisInitState, tempPrefix, "", blocks)
+	}
+	for i, catchpointFile := range catchpointFiles {
+		tempPrefix = filepath.Join(tempDir, strconv.Itoa(i), config.LedgerFilenamePrefix)
+		reportInfof(infoNodeRepairCatchpoint, catchpointFile)
+		rnd, err = replayTrackerDB(ctx, cfg, genesisInitState, tempPrefix, catchpointFile, blocks)
+		if err == nil {
+			break
+		}
+		reportWarnf(warnNodeRepairCatchpoint, catchpointFile, err)
+	}
+	if err != nil {
+		return 0, "", err
+	}
+
+	rebuiltReport, err := ledger.VerifyTrackerDB(ctx, tempPrefix)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(rebuiltReport.Problems) > 0 {
+		return 0, "", fmt.Errorf("the rebuilt tracker database is inconsistent: %s", strings.Join(rebuiltReport.Problems, "; "))
+	}
+
+	// sqlite looks for the journal files next to the database file, so the backup keeps them under its own name.
+	trackerDBFilename := dbPrefix + ".tracker.sqlite"
+	backupFilename := fmt.Sprintf("%s.%d.bak", trackerDBFilename, time.Now().Unix())
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		err = os.Rename(trackerDBFilename+suffix, backupFilename+suffix)
+		if err != nil && !os.IsNotExist(err) {
+			return 0, "", err
+		}
+	}
+	err = os.Rename(tempPrefix+".tracker.sqlite", trackerDBFilename)
+	if err != nil {
+		return 0, "", err
+	}
+	return rnd, backupFilename, nil
+}
+
+// replayTrackerDB creates a ledger at dbPrefix, loads catchpointFile into it when one is given, and adds the blocks
+// that follow up to the latest block. It returns the latest round of the new ledger.
+func replayTrackerDB(ctx context.Context, cfg config.Local, genesisInitState ledgercore.InitState, dbPrefix string, catchpointFile string, blocks localBlocks) (basics.Round, error) {
+	err := os.MkdirAll(filepath.Dir(dbPrefix), 0700)
+	if err != nil {
+		return 0, err
+	}
+	ledgerLog := logging.NewLogger()
+	ledgerLog.SetLevel(logging.Warn)
+	l, err := ledger.OpenLedger(ledgerLog, dbPrefix, false, genesisInitState, cfg)
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	if catchpointFile != "" {
+		err = loadRepairCatchpoint(ctx, l, catchpointFile, blocks)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	earliest, latest := blocks.earliest, blocks.latest
+	if l.Latest()+1 < earliest {
+		return 0, fmt.Errorf("the block database starts at round %d, blocks %d to %d are needed to replay from round %d; try rebuilding from a catchpoint file", earliest, l.Latest()+1, earliest-1, l.Latest())
+	}
+	lastReport := time.Now()
+	for rnd := l.Latest() + 1; rnd <= latest; rnd++ {
+		blk, cert, err := blocks.get(rnd)
+		if err != nil {
+			return 0, fmt.Errorf("unable to read block %d : %w", rnd, err)
+		}
+		err = l.AddBlock(blk, cert)
+		if err != nil {
+			return 0, fmt.Errorf("unable to replay block %d : %w", rnd, err)
+		}
+		if time.Since(lastReport) > 5*time.Second {
+			reportInfof(infoNodeRepairReplay, rnd, latest)
+			lastReport = time.Now()
+		}
+	}
+	l.WaitForCommit(l.Latest())
+	return l.Latest(), nil
+}
+
+// loadRepairCatchpoint performs the catchpoint catchup stages against a catchpoint file, using the
+// blocks of the local block database both to verify the catchpoint label and as the catchpoint blocks.
+func loadRepairCatchpoint(ctx context.Context, l *ledger.Ledger, catchpointFile string, blocks localBlocks) error {
+	catchupAccessor := ledger.MakeCatchpointCatchupAccessor(l, logging.Base())
+	err := catchupAccessor.ResetStagingBalances(ctx, true)
+	if err != nil {
+		return fmt.Errorf("unable to initialize catchup database : %w", err)
+	}
+	fileHeader, err := loadCatchpointFileIntoStaging(ctx, catchupAccessor, catchpointFile)
+	if err != nil {
+		return fmt.Errorf("unable to load catchpoint file : %w", err)
+	}
+	err = catchupAccessor.SetLabel(ctx, fileHeader.Catchpoint)
+	if err != nil {
+		return err
+	}
+	err = catchupAccessor.BuildMerkleTrie(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to build merkle trie : %w", err)
+	}
+
+	topBlock, _, err := blocks.get(fileHeader.BlocksRound)
+	if err != nil {
+		return fmt.Errorf("unable to read block %d : %w", fileHeader.BlocksRound, err)
+	}
+	err = catchupAccessor.VerifyCatchpoint(ctx, &topBlock)
+	if err != nil {
+		return err
+	}
+	err = catchupAccessor.StoreBalancesRound(ctx, &topBlock)
+	if err != nil {
+		return err
+	}
+	err = catchupAccessor.StoreFirstBlock(ctx, &topBlock)
+	if err != nil {
+		return err
+	}
+	lookback := catchupservice.CatchpointBlocksLookback(&topBlock)
+	for i := uint64(1); i <= lookback; i++ {
+		blk, _, err := blocks.get(topBlock.Round() - basics.Round(i))
+		if err != nil {
+			return fmt.Errorf("unable to read block %d : %w", topBlock.Round()-basics.Round(i), err)
+		}
+		err = catchupAccessor.StoreBlock(ctx, &blk)
+		if err != nil {
+			return err
+		}
+	}
+	return catchupAccessor.CompleteCatchup(ctx)
+}
+
+// loadCatchpointFileIntoStaging feeds the sections of a catchpoint file, compressed or not, to the catchup accessor.
+func loadCatchpointFileIntoStaging(ctx context.Context, catchupAccessor ledger.CatchpointCatchupAccessor, catchpointFile string) (fileHeader ledger.CatchpointFileHeader, err error) {
+	f, err := os.Open(catchpointFile)
+	if err != nil {
+		return fileHeader, err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	var tarReader *tar.Reader
+	if prefix, err := reader.Peek(2); err == nil && prefix[0] == 0x1F && prefix[1] == 0x8B {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return fileHeader, err
+		}
+		tarReader = tar.NewReader(gzipReader)
+	} else {
+		tarReader = tar.NewReader(reader)
+	}
+
+	var progress ledger.CatchpointCatchupAccessorProgress
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fileHeader, err
+		}
+		section, err := io.ReadAll(tarReader)
+		if err != nil {
+			return fileHeader, err
+		}
+		err = catchupAccessor.ProcessStagingBalances(ctx, header.Name, section, &progress)
+		if err != nil {
+			return fileHeader, err
+		}
+		if header.Name == ledger.CatchpointContentFileName {
+			// the accessor already validated the header.
+			err = protocol.Decode(section, &fileHeader)
+			if err != nil {
+				return fileHeader, err
+			}
+		}
+	}
+	if fileHeader.Catchpoint == "" {
+		return fileHeader, fmt.Errorf("%s is missing from the catchpoint file", ledger.CatchpointContentFileName)
+	}
+	return fileHeader, nil
+}
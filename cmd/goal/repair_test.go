@@ -0,0 +1,212 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/eval"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func TestListCatchpointFiles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	files, err := listCatchpointFiles(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	for _, rnd := range []basics.Round{1000, 300000, 20000} {
+		path := filepath.Join(dir, trackerdb.MakeCatchpointFilePath(rnd))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, nil, 0600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.catchpoint"), nil, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1234.data"), nil, 0600))
+
+	files, err = listCatchpointFiles(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, trackerdb.MakeCatchpointFilePath(300000)),
+		filepath.Join(dir, trackerdb.MakeCatchpointFilePath(20000)),
+		filepath.Join(dir, trackerdb.MakeCatchpointFilePath(1000)),
+	}, files)
+}
+
+// makeRepairTestLedger writes a ledger of the given number of rounds, each holding a payment, into a data
+// directory layout and returns its genesis, path prefix and configuration.
+func makeRepairTestLedger(t *testing.T, rounds int, catchpointInterval uint64) (bookkeeping.Genesis, ledgercore.InitState, string, config.Local) {
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "v1",
+		Network:     "repairtest",
+		Proto:       protocol.ConsensusCurrentVersion,
+		FeeSink:     genBalances.FeeSink.String(),
+		RewardsPool: genBalances.RewardsPool.String(),
+	}
+	for addr, data := range genBalances.Balances {
+		genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{Address: addr.String(), State: data})
+	}
+	genesisInitState, err := makeRepairGenesisInitState(genesis)
+	require.NoError(t, err)
+
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.MaxAcctLookback = 2
+	if catchpointInterval > 0 {
+		cfg.CatchpointInterval = catchpointInterval
+		cfg.CatchpointTracking = 2
+	}
+	dbPrefix := filepath.Join(t.TempDir(), genesis.ID(), config.LedgerFilenamePrefix)
+	require.NoError(t, os.MkdirAll(filepath.Dir(dbPrefix), 0700))
+	l, err := ledger.OpenLedger(logging.TestingLog(t), dbPrefix, false, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	proto := config.Consensus[genesis.Proto]
+	for i := 1; i <= rounds; i++ {
+		prev, err := l.BlockHdr(l.Latest())
+		require.NoError(t, err)
+		blk := bookkeeping.MakeBlock(prev)
+		blockEval, err := eval.StartEvaluator(l, blk.BlockHeader, eval.EvaluatorOptions{Generate: true, MaxTxnBytesPerBlock: proto.MaxTxnBytesPerBlock})
+		require.NoError(t, err)
+		pay := txntest.Txn{
+			Type:        protocol.PaymentTx,
+			Sender:      addrs[0],
+			Receiver:    addrs[i%len(addrs)],
+			Amount:      uint64(1000 * i),
+			Fee:         proto.MinTxnFee,
+			FirstValid:  prev.Round,
+			LastValid:   prev.Round + 10,
+			GenesisHash: genesis.Hash(),
+		}
+		require.NoError(t, blockEval.Transaction(pay.SignedTxn(), transactions.ApplyData{}))
+		vb, err := blockEval.GenerateBlock()
+		require.NoError(t, err)
+		err = l.AddValidatedBlock(ledgercore.MakeValidatedBlock(vb.Block(), vb.Delta()), agreement.Certificate{Round: vb.Block().Round()})
+		require.NoError(t, err)
+	}
+	l.WaitForCommit(l.Latest())
+	return genesis, genesisInitState, dbPrefix, cfg
+}
+
+func TestRebuildTrackerDB(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesis, genesisInitState, dbPrefix, cfg := makeRepairTestLedger(t, 12, 0)
+
+	ctx := context.Background()
+	report, err := ledger.VerifyTrackerDB(ctx, dbPrefix)
+	require.NoError(t, err)
+	require.Empty(t, report.Problems)
+
+	// lose an account behind the back of the trie and the totals
+	trackerDBs, err := db.MakeAccessor(dbPrefix+".tracker.sqlite", false, false)
+	require.NoError(t, err)
+	err = trackerDBs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM accountbase WHERE rowid = (SELECT max(rowid) FROM accountbase)")
+		return err
+	})
+	trackerDBs.Close()
+	require.NoError(t, err)
+	report, err = ledger.VerifyTrackerDB(ctx, dbPrefix)
+	require.NoError(t, err)
+	require.NotEmpty(t, report.Problems)
+
+	rnd, backup, err := rebuildTrackerDB(ctx, cfg, genesis, dbPrefix, nil, report)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(12), rnd)
+	require.FileExists(t, backup)
+
+	report, err = ledger.VerifyTrackerDB(ctx, dbPrefix)
+	require.NoError(t, err)
+	require.Empty(t, report.Problems)
+	require.True(t, report.TrieChecked)
+	require.LessOrEqual(t, report.TrackerRound, basics.Round(12))
+
+	// the rebuilt ledger opens and catches up with the block database
+	l, err := ledger.OpenLedger(logging.TestingLog(t), dbPrefix, false, genesisInitState, cfg)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(12), l.Latest())
+	l.Close()
+
+	// the temporary ledger of the rebuild is gone
+	entries, err := os.ReadDir(filepath.Dir(dbPrefix))
+	require.NoError(t, err)
+	for _, entry := range entries {
+		require.False(t, strings.HasPrefix(entry.Name(), "repair-"), entry.Name())
+	}
+
+	// without the early blocks the tracker database can only be rebuilt from a catchpoint
+	blockDBs, err := db.MakeAccessor(dbPrefix+".block.sqlite", false, false)
+	require.NoError(t, err)
+	err = blockDBs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM blocks WHERE rnd < 4")
+		return err
+	})
+	blockDBs.Close()
+	require.NoError(t, err)
+	report, err = ledger.VerifyTrackerDB(ctx, dbPrefix)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(4), report.EarliestBlock)
+	_, _, err = rebuildTrackerDB(ctx, cfg, genesis, dbPrefix, nil, report)
+	require.ErrorContains(t, err, "try rebuilding from a catchpoint file")
+}
+
+func TestRebuildTrackerDBFromCatchpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesis, _, dbPrefix, cfg := makeRepairTestLedger(t, 700, 200)
+	catchpointFiles, err := listCatchpointFiles(filepath.Join(filepath.Dir(dbPrefix), trackerdb.CatchpointDirName))
+	require.NoError(t, err)
+	require.NotEmpty(t, catchpointFiles)
+	t.Log(catchpointFiles)
+
+	ctx := context.Background()
+	report, err := ledger.VerifyTrackerDB(ctx, dbPrefix)
+	require.NoError(t, err)
+	rnd, _, err := rebuildTrackerDB(ctx, cfg, genesis, dbPrefix, append([]string{filepath.Join(t.TempDir(), "bad.catchpoint")}, catchpointFiles...), report)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(700), rnd)
+	report, err = ledger.VerifyTrackerDB(ctx, dbPrefix)
+	require.NoError(t, err)
+	require.Empty(t, report.Problems)
+}
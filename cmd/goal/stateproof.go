@@ -0,0 +1,298 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	sp "github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	stateProofRound          uint64
+	stateProofFilename       string
+	stateProofInFilename     string
+	stateProofVotersCommit   string
+	stateProofLnProvenWeight uint64
+	stateProofProvenWeight   uint64
+	stateProofStrengthTarget uint64
+)
+
+func init() {
+	stateproofCmd.AddCommand(stateproofGetCmd)
+	stateproofCmd.AddCommand(stateproofVerifyCmd)
+	stateproofCmd.AddCommand(stateproofHeaderCmd)
+
+	stateproofGetCmd.Flags().Uint64VarP(&stateProofRound, "round", "r", 0, "A round covered by the state proof")
+	stateproofGetCmd.Flags().StringVarP(&stateProofFilename, "out", "o", "", "Write the state proof as JSON to this file instead of printing a summary")
+	stateproofGetCmd.MarkFlagRequired("round")
+
+	stateproofVerifyCmd.Flags().Uint64VarP(&stateProofRound, "round", "r", 0, "Fetch the state proof covering this round from the node")
+	stateproofVerifyCmd.Flags().StringVarP(&stateProofInFilename, "in", "i", "", "Read the state proof from a file written by 'goal stateproof get --out'")
+	stateproofVerifyCmd.Flags().StringVar(&stateProofVotersCommit, "voters-commitment", "", "Trusted voters commitment (base64) for the interval, taken from the previous state proof message")
+	stateproofVerifyCmd.Flags().Uint64Var(&stateProofLnProvenWeight, "ln-proven-weight", 0, "Trusted ln(provenWeight) for the interval, taken from the previous state proof message")
+	stateproofVerifyCmd.Flags().Uint64Var(&stateProofProvenWeight, "proven-weight", 0, "Trusted proven weight for the interval, used instead of --ln-proven-weight")
+	stateproofVerifyCmd.Flags().Uint64Var(&stateProofStrengthTarget, "strength-target", config.Consensus[protocol.ConsensusCurrentVersion].StateProofStrengthTarget, "State proof strength target")
+	stateproofVerifyCmd.MarkFlagRequired("voters-commitment")
+
+	stateproofHeaderCmd.Flags().Uint64VarP(&stateProofRound, "round", "r", 0, "The round whose light block header should be checked")
+	stateproofHeaderCmd.Flags().StringVarP(&stateProofInFilename, "in", "i", "", "Check against the state proof in this file instead of fetching it from the node")
+	stateproofHeaderCmd.MarkFlagRequired("round")
+}
+
+var stateproofCmd = &cobra.Command{
+	Use:   "stateproof",
+	Short: "Inspect and verify state proofs",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		// If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var stateproofGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Fetch the state proof covering a round",
+	Long:  "Fetch the state proof covering a round. Prints the attested message and the decoded reveals (signed participants and their weights), or writes the proof as JSON with --out.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+		resp, err := client.StateProof(stateProofRound)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		if stateProofFilename != "" {
+			err = writeFile(stateProofFilename, protocol.EncodeJSON(&resp), 0600)
+			if err != nil {
+				reportErrorf(fileWriteError, stateProofFilename, err)
+			}
+			return
+		}
+
+		msg, proof, err := decodeStateProofResponse(resp)
+		if err != nil {
+			reportErrorf(errDecodingStateProof, err)
+		}
+		printStateProof(msg, proof)
+	},
+}
+
+var stateproofVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify a state proof against a trusted voters commitment",
+	Long: `Verify a state proof against a trusted voters commitment and proven weight. ` +
+		`The trusted values for the interval are the voters commitment and ln(provenWeight) carried by the message of the previous state proof. ` +
+		`With --in, verification is done entirely offline.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		resp := loadStateProof(cmd)
+
+		votersCommitment, err := base64.StdEncoding.DecodeString(stateProofVotersCommit)
+		if err != nil {
+			reportErrorf(errBadVotersCommitment, err)
+		}
+
+		lnProvenWeight := stateProofLnProvenWeight
+		if cmd.Flags().Changed("proven-weight") {
+			if cmd.Flags().Changed("ln-proven-weight") {
+				reportErrorf(errProvenWeightArgs)
+			}
+			lnProvenWeight, err = sp.LnIntApproximation(stateProofProvenWeight)
+			if err != nil {
+				reportErrorf(errBadProvenWeight, err)
+			}
+		} else if !cmd.Flags().Changed("ln-proven-weight") {
+			reportErrorf(errProvenWeightArgs)
+		}
+
+		msg, err := verifyStateProofResponse(resp, votersCommitment, lnProvenWeight, stateProofStrengthTarget)
+		if err != nil {
+			reportErrorf(errStateProofVerifyFailed, err)
+		}
+		reportInfof(infoStateProofVerified, msg.FirstAttestedRound, msg.LastAttestedRound)
+	},
+}
+
+var stateproofHeaderCmd = &cobra.Command{
+	Use:   "header",
+	Short: "Check a block header against the state proof covering its round",
+	Long:  "Fetch the light block header proof and the block for a round, and check them against the block headers commitment of the state proof covering that round.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+
+		var resp model.StateProof
+		var err error
+		if stateProofInFilename != "" {
+			resp = readStateProofFile(stateProofInFilename)
+		} else {
+			resp, err = client.StateProof(stateProofRound)
+			if err != nil {
+				reportErrorf(errorRequestFail, err)
+			}
+		}
+		msg, _, err := decodeStateProofResponse(resp)
+		if err != nil {
+			reportErrorf(errDecodingStateProof, err)
+		}
+
+		proofResp, err := client.LightBlockHeaderProof(stateProofRound)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		blk, err := client.BookkeepingBlock(stateProofRound)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		err = verifyLightBlockHeader(msg, stateProofRound, proofResp, blk.ToLightBlockHeader())
+		if err != nil {
+			reportErrorf(errLightHeaderVerifyFailed, stateProofRound, err)
+		}
+		reportInfof(infoLightHeaderVerified, stateProofRound, msg.FirstAttestedRound, msg.LastAttestedRound)
+	},
+}
+
+// loadStateProof returns the state proof named by --in, or fetches the one
+// covering --round from the node.
+func loadStateProof(cmd *cobra.Command) model.StateProof {
+	if stateProofInFilename != "" {
+		if cmd.Flags().Changed("round") {
+			reportErrorf(errStateProofSourceArgs)
+		}
+		return readStateProofFile(stateProofInFilename)
+	}
+	if !cmd.Flags().Changed("round") {
+		reportErrorf(errStateProofSourceArgs)
+	}
+
+	dataDir := datadir.EnsureSingleDataDir()
+	client := ensureAlgodClient(dataDir)
+	resp, err := client.StateProof(stateProofRound)
+	if err != nil {
+		reportErrorf(errorRequestFail, err)
+	}
+	return resp
+}
+
+func readStateProofFile(filename string) (resp model.StateProof) {
+	data, err := readFile(filename)
+	if err != nil {
+		reportErrorf(fileReadError, filename, err)
+	}
+	err = protocol.DecodeJSON(data, &resp)
+	if err != nil {
+		reportErrorf(errDecodingStateProof, err)
+	}
+	return
+}
+
+// decodeStateProofResponse converts the REST representation of a state proof
+// into the message it attests to and the msgpack-decoded proof.
+func decodeStateProofResponse(resp model.StateProof) (stateproofmsg.Message, sp.StateProof, error) {
+	msg := stateproofmsg.Message{
+		BlockHeadersCommitment: resp.Message.BlockHeadersCommitment,
+		VotersCommitment:       resp.Message.VotersCommitment,
+		LnProvenWeight:         resp.Message.LnProvenWeight,
+		FirstAttestedRound:     resp.Message.FirstAttestedRound,
+		LastAttestedRound:      resp.Message.LastAttestedRound,
+	}
+
+	var proof sp.StateProof
+	err := protocol.Decode(resp.StateProof, &proof)
+	if err != nil {
+		return stateproofmsg.Message{}, sp.StateProof{}, err
+	}
+	return msg, proof, nil
+}
+
+// verifyStateProofResponse checks the state proof against the trusted voters
+// commitment and ln(provenWeight) of its interval. The proof's signatures are
+// verified for the last attested round, matching the stateproof worker.
+func verifyStateProofResponse(resp model.StateProof, votersCommitment []byte, lnProvenWeight uint64, strengthTarget uint64) (stateproofmsg.Message, error) {
+	msg, proof, err := decodeStateProofResponse(resp)
+	if err != nil {
+		return stateproofmsg.Message{}, err
+	}
+	if len(votersCommitment) != sp.HashSize {
+		return stateproofmsg.Message{}, fmt.Errorf("voters commitment is %d bytes, expected %d", len(votersCommitment), sp.HashSize)
+	}
+
+	verifier := sp.MkVerifierWithLnProvenWeight(votersCommitment, lnProvenWeight, strengthTarget)
+	err = verifier.Verify(msg.LastAttestedRound, msg.Hash(), &proof)
+	if err != nil {
+		return stateproofmsg.Message{}, err
+	}
+	return msg, nil
+}
+
+// verifyLightBlockHeader checks that hdr, the light block header for round,
+// is committed to by the block headers commitment of msg.
+func verifyLightBlockHeader(msg stateproofmsg.Message, round uint64, proofResp model.LightBlockHeaderProof, hdr bookkeeping.LightBlockHeader) error {
+	if round < msg.FirstAttestedRound || round > msg.LastAttestedRound {
+		return fmt.Errorf("round %d is not attested by the state proof for rounds %d-%d", round, msg.FirstAttestedRound, msg.LastAttestedRound)
+	}
+	if proofResp.Index != round-msg.FirstAttestedRound {
+		return fmt.Errorf("proof index %d does not match round %d", proofResp.Index, round)
+	}
+
+	proof, err := merklearray.ProofDataToSingleLeafProof(crypto.Sha256.String(), proofResp.Treedepth, proofResp.Proof)
+	if err != nil {
+		return err
+	}
+
+	elems := make(map[uint64]crypto.Hashable)
+	elems[proofResp.Index] = &hdr
+	return merklearray.VerifyVectorCommitment(msg.BlockHeadersCommitment, elems, proof.ToProof())
+}
+
+func printStateProof(msg stateproofmsg.Message, proof sp.StateProof) {
+	fmt.Printf("Attested rounds: %d - %d\n", msg.FirstAttestedRound, msg.LastAttestedRound)
+	fmt.Printf("Block headers commitment: %s\n", base64.StdEncoding.EncodeToString(msg.BlockHeadersCommitment))
+	fmt.Printf("Next voters commitment: %s\n", base64.StdEncoding.EncodeToString(msg.VotersCommitment))
+	fmt.Printf("Next ln(proven weight): %d\n", msg.LnProvenWeight)
+	fmt.Printf("Signed weight: %d\n", proof.SignedWeight)
+	fmt.Printf("Merkle signature salt version: %d\n", proof.MerkleSignatureSaltVersion)
+	fmt.Printf("Reveals: %d\n", len(proof.Reveals))
+
+	positions := make([]uint64, 0, len(proof.Reveals))
+	for pos := range proof.Reveals {
+		positions = append(positions, pos)
+	}
+	sort.Slice(positions, func(i, j int) bool { return positions[i] < positions[j] })
+
+	for _, pos := range positions {
+		r := proof.Reveals[pos]
+		fmt.Printf("  [%d] weight: %d, key lifetime: %d, lower sigs weight: %d, key: %s\n",
+			pos, r.Part.Weight, r.Part.PK.KeyLifetime, r.SigSlot.L, base64.StdEncoding.EncodeToString(r.Part.PK.Commitment[:]))
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	sp "github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type testLightHeaders []bookkeeping.LightBlockHeader
+
+func (b testLightHeaders) Length() uint64 {
+	return uint64(len(b))
+}
+
+func (b testLightHeaders) Marshal(pos uint64) (crypto.Hashable, error) {
+	return &b[pos], nil
+}
+
+func TestStateProofDecode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proof := sp.StateProof{
+		SignedWeight: 1000,
+		Reveals: map[uint64]sp.Reveal{
+			3: {Part: basics.Participant{Weight: 600}},
+			7: {Part: basics.Participant{Weight: 400}},
+		},
+		PositionsToReveal: []uint64{3, 7},
+	}
+	resp := model.StateProof{
+		Message: model.StateProofMessage{
+			BlockHeadersCommitment: []byte{1, 2, 3},
+			VotersCommitment:       []byte{4, 5, 6},
+			LnProvenWeight:         42,
+			FirstAttestedRound:     257,
+			LastAttestedRound:      512,
+		},
+		StateProof: protocol.Encode(&proof),
+	}
+
+	// the --out file format must round trip
+	var decodedResp model.StateProof
+	require.NoError(t, protocol.DecodeJSON(protocol.EncodeJSON(&resp), &decodedResp))
+	require.Equal(t, resp, decodedResp)
+
+	msg, decoded, err := decodeStateProofResponse(decodedResp)
+	require.NoError(t, err)
+	require.Equal(t, uint64(257), msg.FirstAttestedRound)
+	require.Equal(t, uint64(512), msg.LastAttestedRound)
+	require.Equal(t, uint64(42), msg.LnProvenWeight)
+	require.Equal(t, proof.SignedWeight, decoded.SignedWeight)
+	require.Len(t, decoded.Reveals, 2)
+	require.Equal(t, uint64(600), decoded.Reveals[3].Part.Weight)
+
+	resp.StateProof = []byte{0xff, 0x00}
+	_, _, err = decodeStateProofResponse(resp)
+	require.Error(t, err)
+
+	// the trusted commitment must have the right size before we try to verify
+	resp.StateProof = protocol.Encode(&proof)
+	_, err = verifyStateProofResponse(resp, []byte{1, 2}, 42, 256)
+	require.ErrorContains(t, err, "voters commitment")
+}
+
+func TestStateProofVerifyLightBlockHeader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const first = 9
+	headers := make(testLightHeaders, 8)
+	for i := range headers {
+		headers[i].Round = basics.Round(first + i)
+		crypto.RandBytes(headers[i].Seed[:])
+		crypto.RandBytes(headers[i].GenesisHash[:])
+	}
+	tree, err := merklearray.BuildVectorCommitmentTree(headers, crypto.HashFactory{HashType: crypto.Sha256})
+	require.NoError(t, err)
+
+	msg := stateproofmsg.Message{
+		BlockHeadersCommitment: tree.Root(),
+		FirstAttestedRound:     first,
+		LastAttestedRound:      first + uint64(len(headers)) - 1,
+	}
+
+	const idx = 5
+	proof, err := tree.ProveSingleLeaf(idx)
+	require.NoError(t, err)
+	proofResp := model.LightBlockHeaderProof{
+		Index:     idx,
+		Proof:     proof.GetConcatenatedProof(),
+		Treedepth: uint64(proof.TreeDepth),
+	}
+
+	require.NoError(t, verifyLightBlockHeader(msg, first+idx, proofResp, headers[idx]))
+
+	// a header that was not committed to
+	tampered := headers[idx]
+	tampered.Seed[0]++
+	require.Error(t, verifyLightBlockHeader(msg, first+idx, proofResp, tampered))
+
+	// a proof for another position
+	require.ErrorContains(t, verifyLightBlockHeader(msg, first+idx-1, proofResp, headers[idx]), "does not match")
+
+	// rounds outside of the attested interval
+	require.ErrorContains(t, verifyLightBlockHeader(msg, first-1, proofResp, headers[idx]), "not attested")
+	require.ErrorContains(t, verifyLightBlockHeader(msg, msg.LastAttestedRound+1, proofResp, headers[idx]), "not attested")
+}
@@ -33,6 +33,7 @@ import (
 
 var (
 	recoverWallet     bool
+	hdWallet          bool
 	defaultWalletName string
 )
 
@@ -45,6 +46,9 @@ func init() {
 
 	// Should we recover the wallet?
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
+
+	// Should the wallet derive its keys from a BIP39 mnemonic?
+	newWalletCmd.Flags().BoolVar(&hdWallet, "hd", false, "Create an HD wallet whose accounts are derived from a BIP39 mnemonic along BIP32-Ed25519 paths (m/44'/283'/0'/0/i). With -r, recover it from an existing BIP39 mnemonic")
 }
 
 var walletCmd = &cobra.Command{
@@ -94,7 +98,26 @@ var newWalletCmd = &cobra.Command{
 
 		// Check if we should recover the wallet from a mnemonic
 		var mdk crypto.MasterDerivationKey
-		if recoverWallet {
+		var bip39Mnemonic, bip39Passphrase string
+		if recoverWallet && hdWallet {
+			fmt.Println(infoBIP39RecoveryPrompt)
+			resp, err := reader.ReadString('\n')
+			if err != nil {
+				reportErrorf(errorFailedToReadResponse, err)
+			}
+			bip39Mnemonic = strings.Join(strings.Fields(resp), " ")
+			_, err = passphrase.BIP39MnemonicToEntropy(bip39Mnemonic)
+			if err != nil {
+				reportErrorf(errorBadMnemonic, err)
+			}
+
+			fmt.Println(infoBIP39PassphrasePrompt)
+			bip39Passphrase, err = reader.ReadString('\n')
+			if err != nil {
+				reportErrorf(errorFailedToReadResponse, err)
+			}
+			bip39Passphrase = strings.TrimRight(bip39Passphrase, "\r\n")
+		} else if recoverWallet {
 			fmt.Println(infoRecoveryPrompt)
 			resp, err := reader.ReadString('\n')
 			resp = strings.TrimSpace(resp)
@@ -128,7 +151,12 @@ var newWalletCmd = &cobra.Command{
 
 		// Create the wallet
 		reportInfoln(infoCreatingWallet)
-		walletID, err := client.CreateWallet(walletName, walletPassword, mdk)
+		var walletID []byte
+		if hdWallet {
+			walletID, err = client.CreateHDWallet(walletName, walletPassword, bip39Mnemonic, bip39Passphrase)
+		} else {
+			walletID, err = client.CreateWallet(walletName, walletPassword, mdk)
+		}
 		if err != nil {
 			reportErrorf(errorCouldntCreateWallet, err)
 		}
@@ -153,16 +181,25 @@ var newWalletCmd = &cobra.Command{
 				// Invalidate the handle when we're done with it
 				defer client.ReleaseWalletHandle(token)
 
-				// Export the master derivation key
-				mdk, err := client.ExportMasterDerivationKey(token, walletPassword)
-				if err != nil {
-					reportErrorf(errorCouldntExportMDK, err)
-				}
+				var mnemonic string
+				if hdWallet {
+					// HD wallets are backed up by their BIP39 mnemonic
+					mnemonic, err = client.ExportMnemonic(token, walletPassword)
+					if err != nil {
+						reportErrorf(errorCouldntExportMnemonic, err)
+					}
+				} else {
+					// Export the master derivation key
+					mdk, err := client.ExportMasterDerivationKey(token, walletPassword)
+					if err != nil {
+						reportErrorf(errorCouldntExportMDK, err)
+					}
 
-				// Convert the key to a mnemonic
-				mnemonic, err := passphrase.KeyToMnemonic(mdk[:])
-				if err != nil {
-					reportErrorf(errorCouldntMakeMnemonic, err)
+					// Convert the key to a mnemonic
+					mnemonic, err = passphrase.KeyToMnemonic(mdk[:])
+					if err != nil {
+						reportErrorf(errorCouldntMakeMnemonic, err)
+					}
 				}
 
 				// Display the mnemonic to the user
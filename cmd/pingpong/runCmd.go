@@ -23,10 +23,12 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"runtime/pprof"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -91,6 +93,8 @@ var generatedAccountsOffset uint64
 var generatedAccountSampleMethod string
 var configPath string
 var latencyPath string
+var scenarioPath string
+var reportPath string
 
 func init() {
 	rootCmd.AddCommand(runCmd)
@@ -113,6 +117,8 @@ func init() {
 	runCmd.Flags().StringVar(&logicProg, "program", "", "File containing the compiled program to include as a logic sig")
 	runCmd.Flags().StringVar(&configPath, "config", "", "path to read config json from, or json literal")
 	runCmd.Flags().StringVar(&latencyPath, "latency", "", "path to write txn latency log to (.gz for compressed)")
+	runCmd.Flags().StringVar(&scenarioPath, "scenario", "", "path to a scenario json file, whose phases are run instead of the configured traffic")
+	runCmd.Flags().StringVar(&reportPath, "report", "", "path to write the json report of the scenario to, instead of stdout")
 	runCmd.Flags().BoolVar(&saveConfig, "save", false, "Save the effective configuration to disk")
 	runCmd.Flags().BoolVar(&useDefault, "reset", false, "Reset to the default configuration (not read from disk)")
 	runCmd.Flags().BoolVar(&quietish, "quiet", false, "quietish stdout logging")
@@ -454,6 +460,16 @@ var runCmd = &cobra.Command{
 			reportErrorf("%v", err)
 		}
 
+		var scenario *pingpong.Scenario
+		if scenarioPath != "" {
+			scenario, err = pingpong.LoadScenarioFromFile(scenarioPath)
+			if err != nil {
+				reportErrorf("Error loading scenario: %v\n", err)
+			}
+		} else if reportPath != "" {
+			reportErrorf("--report requires --scenario\n")
+		}
+
 		reportInfof("Preparing to initialize PingPong with config:\n")
 		cfg.Dump(os.Stdout)
 
@@ -475,11 +491,39 @@ var runCmd = &cobra.Command{
 		reportInfof("Preparing to run PingPong with config:\n")
 		cfg.Dump(os.Stdout)
 
+		if scenario != nil {
+			runScenario(pps, &ac, scenario)
+			return
+		}
+
 		// Kick off the real processing
 		pps.RunPingPong(context.Background(), &ac)
 	},
 }
 
+// runScenario runs the scenario until it completes or is interrupted, and writes its report.
+func runScenario(pps *pingpong.WorkerState, ac *libgoal.Client, scenario *pingpong.Scenario) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	report, err := pps.RunScenario(ctx, ac, scenario)
+	if report == nil {
+		reportErrorf("Error running scenario: %v\n", err)
+	}
+	if err != nil {
+		reportInfof("Scenario interrupted: %v\n", err)
+	}
+	if reportPath == "" {
+		report.Dump(os.Stdout)
+		return
+	}
+	err = report.Save(reportPath)
+	if err != nil {
+		reportErrorf("%s: could not save scenario report, %v\n", reportPath, err)
+	}
+	reportInfof("Scenario report written to %s\n", reportPath)
+}
+
 func reportErrorf(format string, args ...interface{}) {
 	fmt.Printf(format, args...)
 	os.Exit(1)
@@ -19,9 +19,11 @@ package mocks
 import (
 	"context"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 )
 
 // MockCatchpointCatchupAccessor is a dummy CatchpointCatchupAccessor implementation which doesn't do anything.
@@ -67,6 +69,12 @@ func (m *MockCatchpointCatchupAccessor) GetCatchupBlockRound(ctx context.Context
 	return basics.Round(0), nil
 }
 
+// GetVerifyData returns the balances merkle trie root hash, the state proof verification data hash and the
+// account totals of the staged catchpoint, which make up its label.
+func (m *MockCatchpointCatchupAccessor) GetVerifyData(ctx context.Context) (balancesHash crypto.Digest, spverHash crypto.Digest, totals ledgercore.AccountTotals, err error) {
+	return crypto.Digest{}, crypto.Digest{}, ledgercore.AccountTotals{}, nil
+}
+
 // VerifyCatchpoint verifies that the catchpoint is valid by reconstructing the label.
 func (m *MockCatchpointCatchupAccessor) VerifyCatchpoint(ctx context.Context, blk *bookkeeping.Block) (err error) {
 	return nil
@@ -516,6 +516,37 @@ type Local struct {
 	// - sqlite (default)
 	// - pebbledb (experimental, in development)
 	StorageEngine string `version[28]:"sqlite"`
+
+	// EnableAccountHistory keeps a history of account, resource and box changes on archival nodes,
+	// allowing account state lookups for rounds older than the MaxAcctLookback window.
+	EnableAccountHistory bool `version[28]:"false"`
+
+	// AccountHistoryRetentionRounds is the number of rounds the account history is retained for.
+	// A value of 0 retains the history since it was first enabled.
+	AccountHistoryRetentionRounds uint64 `version[28]:"0"`
+
+	// BlockRetentionRounds is the minimum number of recent blocks a non-archival node keeps in its block database.
+	// Blocks that the ledger still requires are always kept, so a value of 0 prunes blocks as soon as they are no
+	// longer needed. Archival nodes keep every block regardless of this setting.
+	BlockRetentionRounds uint64 `version[28]:"0"`
+
+	// EnableBlockCompression stores blocks and certificates compressed with zstd in the block database.
+	// Existing blocks are converted in the background on startup, and converted back when this is disabled again.
+	// Compressed blocks cannot be read by earlier versions, so disable this and let the conversion complete before downgrading.
+	EnableBlockCompression bool `version[28]:"false"`
+
+	// EnableTxnLifecycleTracing records the time transactions spend in each stage between submission and commit,
+	// keyed by txid. Recent traces are served on the admin /debug/txntrace endpoint, and exported as spans when
+	// telemetry uses the OTLP exporter.
+	EnableTxnLifecycleTracing bool `version[28]:"false"`
+
+	// TxnLifecycleTraceSize is the number of recent transactions whose lifecycle traces are kept.
+	TxnLifecycleTraceSize int `version[28]:"10000"`
+
+	// LinkEmulationFile is the absolute path of a JSON file describing the latency, bandwidth and message loss to emulate
+	// on the connections made to specific peers, and which of these peers are unreachable. The file is reloaded whenever
+	// it changes. This is intended for testing network topologies on a single machine and SHOULD NOT be used otherwise.
+	LinkEmulationFile string `version[28]:""`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
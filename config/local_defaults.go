@@ -21,6 +21,7 @@ package config
 
 var defaultLocal = Local{
 	Version:                                    28,
+	AccountHistoryRetentionRounds:              0,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AgreementIncomingBundlesQueueLength:        15,
@@ -29,6 +30,7 @@ var defaultLocal = Local{
 	AnnounceParticipationKey:                   true,
 	Archival:                                   false,
 	BaseLoggerDebugLevel:                       4,
+	BlockRetentionRounds:                       0,
 	BlockServiceCustomFallbackEndpoints:        "",
 	BroadcastConnectionsLimit:                  -1,
 	CadaverDirectory:                           "",
@@ -53,10 +55,12 @@ var defaultLocal = Local{
 	DisableLocalhostConnectionRateLimit:        true,
 	DisableNetworking:                          false,
 	DisableOutgoingConnectionThrottling:        false,
+	EnableAccountHistory:                       false,
 	EnableAccountUpdatesStats:                  false,
 	EnableAgreementReporting:                   false,
 	EnableAgreementTimeMetrics:                 false,
 	EnableAssembleStats:                        false,
+	EnableBlockCompression:                     false,
 	EnableBlockService:                         false,
 	EnableBlockServiceFallbackToArchiver:       true,
 	EnableCatchupFromArchiveServers:            false,
@@ -76,6 +80,7 @@ var defaultLocal = Local{
 	EnableTopAccountsReporting:                 false,
 	EnableTxBacklogRateLimiting:                false,
 	EnableTxnEvalTracer:                        false,
+	EnableTxnLifecycleTracing:                  false,
 	EnableUsageLog:                             false,
 	EnableVerbosedTransactionSyncLogging:       false,
 	EndpointAddress:                            "127.0.0.1:0",
@@ -89,6 +94,7 @@ var defaultLocal = Local{
 	IncomingMessageFilterBucketSize:            512,
 	IsIndexerActive:                            false,
 	LedgerSynchronousMode:                      2,
+	LinkEmulationFile:                          "",
 	LogArchiveMaxAge:                           "",
 	LogArchiveName:                             "node.archive.log",
 	LogSizeLimit:                               1073741824,
@@ -136,6 +142,7 @@ var defaultLocal = Local{
 	TxSyncIntervalSeconds:                      60,
 	TxSyncServeResponseSize:                    1000000,
 	TxSyncTimeoutSeconds:                       30,
+	TxnLifecycleTraceSize:                      10000,
 	UseXForwardedForAddressField:               "",
 	VerifiedTranscationsCacheSize:              150000,
 }
@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+// #include <stdint.h>
+// #include "sodium.h"
+//
+// // sc25519_muladd computes (a*b + c) mod l. It is part of libsodium but is
+// // not exported through its public headers.
+// void sc25519_muladd(unsigned char s[32], const unsigned char a[32],
+//                     const unsigned char b[32], const unsigned char c[32]);
+import "C"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// HDHardened is added to a BIP32 path index to select hardened derivation
+const HDHardened uint32 = 1 << 31
+
+// AlgorandCoinType is Algorand's SLIP-44 coin type
+const AlgorandCoinType uint32 = 283
+
+// HDPrivateKey is a BIP32-Ed25519 extended private key, as described in
+// "BIP32-Ed25519: Hierarchical Deterministic Keys over a Non-linear Keyspace"
+// by Khovratovich and Law, with Peikert's amendment (g = 9). This is the
+// derivation specified by ARC-52 for Algorand HD wallets.
+//
+// Extended private keys are not Ed25519 seeds: kL is used directly as the
+// signing scalar, and kR as the nonce key, so they cannot be turned into a
+// SignatureSecrets. Signatures they produce are regular Ed25519 signatures.
+type HDPrivateKey struct {
+	kL        [32]byte
+	kR        [32]byte
+	chainCode [32]byte
+	pk        PublicKey
+}
+
+// HDRootKey computes the root extended private key for a BIP39 seed.
+func HDRootKey(seed []byte) *HDPrivateKey {
+	k := sha512.Sum512(seed)
+	// keys whose third highest bit is set are discarded and re-hashed, so
+	// that kL keeps its top bits clear through derivation
+	for k[31]&0x20 != 0 {
+		mac := hmac.New(sha512.New, k[:32])
+		mac.Write(k[32:])
+		copy(k[:], mac.Sum(nil))
+	}
+
+	var key HDPrivateKey
+	copy(key.kL[:], k[:32])
+	copy(key.kR[:], k[32:])
+	key.kL[0] &= 0xf8
+	key.kL[31] &= 0x7f
+	key.kL[31] |= 0x40
+	key.chainCode = sha256.Sum256(append([]byte{0x01}, seed...))
+	key.pk = hdScalarToPublicKey(key.kL)
+	return &key
+}
+
+// Child derives the child extended private key at index. Indexes of
+// HDHardened and above are derived with the hardened scheme.
+func (k *HDPrivateKey) Child(index uint32) *HDPrivateKey {
+	var idx [4]byte
+	binary.LittleEndian.PutUint32(idx[:], index)
+
+	var zPrefix, cPrefix byte
+	var data []byte
+	if index >= HDHardened {
+		zPrefix, cPrefix = 0x00, 0x01
+		data = append(append(data, k.kL[:]...), k.kR[:]...)
+	} else {
+		zPrefix, cPrefix = 0x02, 0x03
+		data = append(data, k.pk[:]...)
+	}
+	data = append(data, idx[:]...)
+
+	zMac := hmac.New(sha512.New, k.chainCode[:])
+	zMac.Write([]byte{zPrefix})
+	zMac.Write(data)
+	z := zMac.Sum(nil)
+
+	cMac := hmac.New(sha512.New, k.chainCode[:])
+	cMac.Write([]byte{cPrefix})
+	cMac.Write(data)
+	c := cMac.Sum(nil)
+
+	var child HDPrivateKey
+
+	// kL = 8*zL + kL, where zL is the low 256-g = 247 bits of z[:32]
+	var zl [32]byte
+	copy(zl[:31], z[:31])
+	zl[30] &= 0x7f
+	var carry uint16
+	var prev byte
+	for i := 0; i < 32; i++ {
+		shifted := uint16(zl[i]<<3) | uint16(prev>>5)
+		prev = zl[i]
+		sum := uint16(k.kL[i]) + shifted + carry
+		child.kL[i] = byte(sum)
+		carry = sum >> 8
+	}
+
+	// kR = zR + kR mod 2^256
+	carry = 0
+	for i := 0; i < 32; i++ {
+		sum := uint16(k.kR[i]) + uint16(z[32+i]) + carry
+		child.kR[i] = byte(sum)
+		carry = sum >> 8
+	}
+
+	copy(child.chainCode[:], c[32:])
+	child.pk = hdScalarToPublicKey(child.kL)
+	return &child
+}
+
+// Derive derives the extended private key at path, relative to k
+func (k *HDPrivateKey) Derive(path []uint32) *HDPrivateKey {
+	key := k
+	for _, index := range path {
+		key = key.Child(index)
+	}
+	return key
+}
+
+// PublicKey returns the Ed25519 public key of k
+func (k *HDPrivateKey) PublicKey() PublicKey {
+	return k.pk
+}
+
+// Sign produces a cryptographic Signature of a Hashable message.
+func (k *HDPrivateKey) Sign(message Hashable) Signature {
+	return k.SignBytes(HashRep(message))
+}
+
+// SignBytes signs a message directly, without first hashing.
+// Caller is responsible for domain separation.
+func (k *HDPrivateKey) SignBytes(message []byte) (sig Signature) {
+	// r = H(kR || M) mod l
+	h := sha512.New()
+	h.Write(k.kR[:])
+	h.Write(message)
+	r := hdReduce(h.Sum(nil))
+
+	var rPoint [32]byte
+	C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&rPoint[0]), (*C.uchar)(&r[0]))
+
+	// S = H(R || A || M) * kL + r mod l
+	h.Reset()
+	h.Write(rPoint[:])
+	h.Write(k.pk[:])
+	h.Write(message)
+	hram := hdReduce(h.Sum(nil))
+
+	var kl [64]byte
+	copy(kl[:], k.kL[:])
+	a := hdReduce(kl[:])
+
+	copy(sig[:32], rPoint[:])
+	C.sc25519_muladd((*C.uchar)(&sig[32]), (*C.uchar)(&hram[0]), (*C.uchar)(&a[0]), (*C.uchar)(&r[0]))
+	return sig
+}
+
+// hdReduce reduces a 64-byte little-endian integer modulo the group order
+func hdReduce(in []byte) (out [32]byte) {
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&out[0]), (*C.uchar)(&in[0]))
+	return
+}
+
+func hdScalarToPublicKey(kL [32]byte) (pk PublicKey) {
+	C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&pk[0]), (*C.uchar)(&kL[0]))
+	return
+}
+
+// AlgorandHDPath returns the BIP44 path m/44'/283'/account'/change/index
+func AlgorandHDPath(account, change, index uint32) []uint32 {
+	return []uint32{44 + HDHardened, AlgorandCoinType + HDHardened, account + HDHardened, change, index}
+}
+
+// ParseHDPath parses a BIP32 path such as m/44'/283'/0'/0/0. Hardened
+// indexes are marked with ' or h.
+func ParseHDPath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if len(parts) == 0 || parts[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path %q: must start with m", path)
+	}
+
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+		index, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path %q: %v", path, err)
+		}
+		if hardened {
+			index += uint64(HDHardened)
+		}
+		indexes = append(indexes, uint32(index))
+	}
+	return indexes, nil
+}
+
+// FormatHDPath formats a BIP32 path as parsed by ParseHDPath
+func FormatHDPath(path []uint32) string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, index := range path {
+		if index >= HDHardened {
+			fmt.Fprintf(&b, "/%d'", index-HDHardened)
+		} else {
+			fmt.Fprintf(&b, "/%d", index)
+		}
+	}
+	return b.String()
+}
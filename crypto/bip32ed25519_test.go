@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestHDDerivation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Vectors from the ARC-52 reference implementation tests
+	// (github.com/algorandfoundation/xHD-Wallet-API, x.hd.wallet.api.crypto.spec.ts),
+	// for the BIP39 seed of "salon zoo engage submit smile frost later decide wing
+	// sight chaos renew lizard rely canal coral scene hobby scare step bus leaf
+	// tobacco slice" with an empty passphrase.
+	seed, err := hex.DecodeString("3aff2db416b895ec3cf9a4f8d1e970bc9819920e7bf44a5e350477af0ef557b1511b0986debf78dd38c7c520cd44ff7c7231618f958e21ef0250733a8c1915ea")
+	require.NoError(t, err)
+	root := HDRootKey(seed)
+
+	vectors := []struct {
+		path []uint32
+		pk   string
+	}{
+		{AlgorandHDPath(0, 0, 0), "7bda7ac12627b2c259f1df6875d30c10b35f55b33ad2cc8ea2736eaa3ebcfab9"},
+		{AlgorandHDPath(0, 0, 1), "5bae8828f111064637ac5061bd63bc4fcfe4a833252305f25eeab9c64ecdf519"},
+		{AlgorandHDPath(1, 0, 0), "358d8c4382992849a764438e02b1c45c2ca4e86bbcfe10fd5b963f3610012bc9"},
+		// identity key context, m/44'/0'/0'/0/0
+		{[]uint32{44 + HDHardened, HDHardened, HDHardened, 0, 0}, "ff8b1863ef5e40d0a48c245f26a6dbdf5da94dc75a1851f51d8a04e547bd5f5a"},
+	}
+	for _, v := range vectors {
+		pk := root.Derive(v.path).PublicKey()
+		require.Equal(t, v.pk, hex.EncodeToString(pk[:]), FormatHDPath(v.path))
+	}
+
+	key := root.Derive(AlgorandHDPath(0, 0, 0))
+	sig := key.SignBytes([]byte("hello"))
+	require.True(t, SignatureVerifier(key.PublicKey()).VerifyBytes([]byte("hello"), sig))
+	require.False(t, SignatureVerifier(key.PublicKey()).VerifyBytes([]byte("hellO"), sig))
+}
+
+func TestHDSign(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	seed := make([]byte, 64)
+	for i := 0; i < 20; i++ {
+		RandBytes(seed)
+		key := HDRootKey(seed).Derive(AlgorandHDPath(uint32(i), 0, uint32(i)))
+		msg := TestingHashable{data: seed}
+		require.True(t, SignatureVerifier(key.PublicKey()).Verify(msg, key.Sign(msg)))
+	}
+}
+
+func TestHDPath(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path, err := ParseHDPath("m/44'/283'/5'/0/7")
+	require.NoError(t, err)
+	require.Equal(t, AlgorandHDPath(5, 0, 7), path)
+	require.Equal(t, "m/44'/283'/5'/0/7", FormatHDPath(path))
+
+	path, err = ParseHDPath("m/44h/283h/0h/1/2")
+	require.NoError(t, err)
+	require.Equal(t, AlgorandHDPath(0, 1, 2), path)
+
+	path, err = ParseHDPath("m")
+	require.NoError(t, err)
+	require.Empty(t, path)
+
+	for _, bad := range []string{"", "44'/283'", "m/x", "m/2147483648", "m/-1", "m//1"} {
+		_, err = ParseHDPath(bad)
+		require.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// BIP39 mnemonics use the same English word list as our own mnemonics, but
+// encode between 128 and 256 bits of entropy followed by a checksum of
+// len(entropy)/4 bits taken from the SHA-256 of the entropy.
+const (
+	bip39MinEntropyBytes = 16
+	bip39MaxEntropyBytes = 32
+	bip39SeedIterations  = 2048
+	bip39SeedLenBytes    = 64
+)
+
+// EntropyToBIP39Mnemonic encodes entropy as a BIP39 mnemonic. The entropy
+// must be 16, 20, 24, 28 or 32 bytes long, giving a mnemonic of 12 to 24 words.
+func EntropyToBIP39Mnemonic(entropy []byte) (string, error) {
+	if len(entropy) < bip39MinEntropyBytes || len(entropy) > bip39MaxEntropyBytes || len(entropy)%4 != 0 {
+		return "", errWrongBIP39EntropyLen
+	}
+
+	// BIP39 reads the entropy and checksum as one big-endian bit string
+	checksumBits := len(entropy) / 4
+	hash := sha256.Sum256(entropy)
+	bits := append(append([]byte{}, entropy...), hash[0])
+
+	numWords := (len(entropy)*8 + checksumBits) / bitsPerWord
+	words := make([]string, numWords)
+	for i := range words {
+		var idx uint32
+		for b := i * bitsPerWord; b < (i+1)*bitsPerWord; b++ {
+			idx = idx<<1 | uint32(bits[b/8]>>(7-b%8))&1
+		}
+		words[i] = wordlist[idx]
+	}
+	return strings.Join(words, sepStr), nil
+}
+
+// BIP39MnemonicToEntropy decodes a BIP39 mnemonic into the entropy used to
+// create it, checking its length, words and checksum.
+func BIP39MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, errWrongBIP39MnemonicLen
+	}
+
+	totalBits := len(words) * bitsPerWord
+	checksumBits := totalBits / 33
+	bits := make([]byte, (totalBits+7)/8)
+	for i, word := range words {
+		idx := indexOf(wordlist, word)
+		if idx == -1 {
+			return nil, errUnknownWord
+		}
+		for j := 0; j < bitsPerWord; j++ {
+			b := i*bitsPerWord + j
+			if idx&(1<<(bitsPerWord-1-j)) != 0 {
+				bits[b/8] |= 1 << (7 - b%8)
+			}
+		}
+	}
+
+	entropy := bits[:(totalBits-checksumBits)/8]
+	hash := sha256.Sum256(entropy)
+	mask := byte(0xff) << (8 - checksumBits)
+	if bits[len(entropy)]&mask != hash[0]&mask {
+		return nil, errWrongChecksum
+	}
+	return entropy, nil
+}
+
+// BIP39Seed derives the 64-byte BIP39 seed from a mnemonic and an optional
+// passphrase. It does not validate the mnemonic; use BIP39MnemonicToEntropy
+// for that.
+func BIP39Seed(mnemonic string, passphrase string) []byte {
+	m := norm.NFKD.String(strings.Join(strings.Fields(mnemonic), sepStr))
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+	return pbkdf2.Key([]byte(m), []byte(salt), bip39SeedIterations, bip39SeedLenBytes, sha512.New)
+}
@@ -23,3 +23,6 @@ import (
 var errWrongKeyLen = fmt.Errorf("key length must be %d bytes", keyLenBytes)
 var errWrongMnemonicLen = fmt.Errorf("mnemonic must be %d words", mnemonicLenWords)
 var errWrongChecksum = fmt.Errorf("checksum failed to validate")
+var errWrongBIP39EntropyLen = fmt.Errorf("bip39 entropy must be 16, 20, 24, 28 or 32 bytes")
+var errWrongBIP39MnemonicLen = fmt.Errorf("bip39 mnemonic must be 12, 15, 18, 21 or 24 words")
+var errUnknownWord = fmt.Errorf("mnemonic contains a word that is not in the word list")
@@ -18,6 +18,7 @@ package passphrase
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"strings"
 	"testing"
 
@@ -158,3 +159,70 @@ func TestUint11Array(t *testing.T) {
 		require.True(t, len(b)*8 >= len(a)*11)
 	}
 }
+
+func TestBIP39Vectors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// test vectors from the BIP39 reference implementation, using the
+	// passphrase "TREZOR"
+	vectors := []struct {
+		entropy  string
+		mnemonic string
+		seed     string
+	}{
+		{
+			"00000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			"legal winner thank year wave sausage worth useful legal winner thank yellow",
+			"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+		},
+		{
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+			"dd48c104698c30cfe2b6142103248622fb7bb0ff692eebb00089b32d22484e1613912f0a5b694407be899ffd31ed3992c456cdf60f5d4564b8ba3f05a69890ad",
+		},
+	}
+
+	for _, v := range vectors {
+		entropy, err := hex.DecodeString(v.entropy)
+		require.NoError(t, err)
+		m, err := EntropyToBIP39Mnemonic(entropy)
+		require.NoError(t, err)
+		require.Equal(t, v.mnemonic, m)
+
+		recovered, err := BIP39MnemonicToEntropy(v.mnemonic)
+		require.NoError(t, err)
+		require.Equal(t, entropy, recovered)
+
+		require.Equal(t, v.seed, hex.EncodeToString(BIP39Seed(v.mnemonic, "TREZOR")))
+	}
+}
+
+func TestBIP39Invalid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, n := range []int{16, 20, 24, 28, 32} {
+		entropy := make([]byte, n)
+		crypto.RandBytes(entropy)
+		m, err := EntropyToBIP39Mnemonic(entropy)
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(m), n*3/4)
+		recovered, err := BIP39MnemonicToEntropy(m)
+		require.NoError(t, err)
+		require.Equal(t, entropy, recovered)
+	}
+
+	_, err := EntropyToBIP39Mnemonic(make([]byte, 17))
+	require.Error(t, err)
+
+	_, err = BIP39MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon")
+	require.Error(t, err)
+	_, err = BIP39MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	require.Error(t, err)
+	_, err = BIP39MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon algorand")
+	require.Error(t, err)
+}
@@ -765,3 +765,45 @@ func (client RestClient) GetBlockTimestampOffset() (response model.GetBlockTimeS
 	err = client.get(&response, "/v2/devmode/blocks/offset", nil)
 	return
 }
+
+// SetNextBlockTimestamp sets the timestamp of the next block when in devmode
+func (client RestClient) SetNextBlockTimestamp(timestamp uint64) (err error) {
+	err = client.post(nil, fmt.Sprintf("/v2/devmode/blocks/timestamp/%d", timestamp), nil, nil, true)
+	return
+}
+
+// SetBlockInterval sets the interval in seconds at which blocks are written when in devmode
+func (client RestClient) SetBlockInterval(interval uint64) (err error) {
+	err = client.post(nil, fmt.Sprintf("/v2/devmode/blocks/interval/%d", interval), nil, nil, true)
+	return
+}
+
+// GetBlockInterval gets the interval in seconds at which blocks are written when in devmode
+func (client RestClient) GetBlockInterval() (response model.GetBlockIntervalResponse, err error) {
+	err = client.get(&response, "/v2/devmode/blocks/interval", nil)
+	return
+}
+
+// AdvanceRounds writes the given number of blocks when in devmode
+func (client RestClient) AdvanceRounds(rounds uint64) (response model.AdvanceRoundsResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/devmode/blocks/advance/%d", rounds), nil, nil, "POST", false, true, false)
+	return
+}
+
+// SaveLedgerSnapshot saves a snapshot of the ledger at the latest round when in devmode
+func (client RestClient) SaveLedgerSnapshot(name string) (response model.LedgerSnapshotResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/devmode/snapshots/%s", name), nil, nil, "POST", false, true, false)
+	return
+}
+
+// RevertLedgerSnapshot reverts the ledger to a snapshot saved with SaveLedgerSnapshot when in devmode
+func (client RestClient) RevertLedgerSnapshot(name string) (response model.LedgerSnapshotResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/devmode/snapshots/%s/revert", name), nil, nil, "POST", false, true, false)
+	return
+}
+
+// LedgerSnapshots lists the ledger snapshots saved when in devmode
+func (client RestClient) LedgerSnapshots() (response model.LedgerSnapshotsResponse, err error) {
+	err = client.get(&response, "/v2/devmode/snapshots", nil)
+	return
+}
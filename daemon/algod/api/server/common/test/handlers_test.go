@@ -17,6 +17,7 @@
 package test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -27,6 +28,8 @@ import (
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server/common"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntrace"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -86,3 +89,58 @@ func TestReadyEndpoint(t *testing.T) {
 	mockNodeInstance.catchupStatus = StoppedAtUnsupported
 	readyEndpointTestHelper(t, mockNodeInstance, http.StatusInternalServerError)
 }
+
+func txnTraceTestHelper(t *testing.T, handler func(lib.ReqContext, echo.Context), target string, txid string) *httptest.ResponseRecorder {
+	reqCtx := lib.ReqContext{Log: logging.NewLogger()}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if txid != "" {
+		c.SetParamNames("txid")
+		c.SetParamValues(txid)
+	}
+	handler(reqCtx, c)
+	return rec
+}
+
+func TestTxnTraceEndpoints(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var stxn transactions.SignedTxn
+	stxn.Txn.Type = "pay"
+	txid := stxn.ID()
+
+	txntrace.Disable()
+	defer txntrace.Disable()
+	rec := txnTraceTestHelper(t, common.TxnTrace, "/debug/txntrace/"+txid.String(), txid.String())
+	a.Equal(http.StatusNotFound, rec.Code)
+
+	txntrace.Enable(10)
+	rec = txnTraceTestHelper(t, common.TxnTrace, "/debug/txntrace/"+txid.String(), txid.String())
+	a.Equal(http.StatusNotFound, rec.Code)
+
+	txntrace.Event(txntrace.StageRemember, []transactions.SignedTxn{stxn}, nil)
+
+	rec = txnTraceTestHelper(t, common.TxnTrace, "/debug/txntrace/"+txid.String(), txid.String())
+	a.Equal(http.StatusOK, rec.Code)
+	var trace txntrace.Trace
+	a.NoError(json.Unmarshal(rec.Body.Bytes(), &trace))
+	a.Equal(txid, trace.TxID)
+	a.Len(trace.Stages, 1)
+	a.Equal(txntrace.StageRemember, trace.Stages[0].Stage)
+
+	rec = txnTraceTestHelper(t, common.TxnTrace, "/debug/txntrace/bad", "bad")
+	a.Equal(http.StatusBadRequest, rec.Code)
+
+	rec = txnTraceTestHelper(t, common.RecentTxnTraces, "/debug/txntrace?max=5", "")
+	a.Equal(http.StatusOK, rec.Code)
+	var traces []txntrace.Trace
+	a.NoError(json.Unmarshal(rec.Body.Bytes(), &traces))
+	a.Len(traces, 1)
+	a.Equal(txid, traces[0].TxID)
+
+	rec = txnTraceTestHelper(t, common.RecentTxnTraces, "/debug/txntrace?max=x", "")
+	a.Equal(http.StatusBadRequest, rec.Code)
+}
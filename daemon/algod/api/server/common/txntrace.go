@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntrace"
+)
+
+// defaultRecentTxnTraces is the number of traces returned by RecentTxnTraces when max is not given
+const defaultRecentTxnTraces = 100
+
+// DebugRoutes are admin-only debugging routes, registered when transaction lifecycle tracing is enabled
+var DebugRoutes = lib.Routes{
+	lib.Route{
+		Name:        "txntrace-recent",
+		Method:      "GET",
+		Path:        "/debug/txntrace",
+		HandlerFunc: RecentTxnTraces,
+	},
+
+	lib.Route{
+		Name:        "txntrace",
+		Method:      "GET",
+		Path:        "/debug/txntrace/:txid",
+		HandlerFunc: TxnTrace,
+	},
+}
+
+// RecentTxnTraces is an httpHandler for route GET /debug/txntrace
+// It returns the lifecycle traces of the most recently seen transactions,
+// newest first. The optional max query parameter limits how many are returned.
+func RecentTxnTraces(ctx lib.ReqContext, context echo.Context) {
+	tracer := txntrace.Active()
+	if tracer == nil {
+		writeJSONError(context, http.StatusNotFound, "transaction lifecycle tracing is not enabled")
+		return
+	}
+
+	max := defaultRecentTxnTraces
+	if maxStr := context.QueryParam("max"); maxStr != "" {
+		var err error
+		max, err = strconv.Atoi(maxStr)
+		if err != nil || max < 0 {
+			writeJSONError(context, http.StatusBadRequest, "invalid max: "+maxStr)
+			return
+		}
+	}
+
+	writeJSON(context, http.StatusOK, tracer.Recent(max))
+}
+
+// TxnTrace is an httpHandler for route GET /debug/txntrace/:txid
+// It returns the lifecycle trace of a single transaction.
+func TxnTrace(ctx lib.ReqContext, context echo.Context) {
+	tracer := txntrace.Active()
+	if tracer == nil {
+		writeJSONError(context, http.StatusNotFound, "transaction lifecycle tracing is not enabled")
+		return
+	}
+
+	var txid transactions.Txid
+	if err := txid.UnmarshalText([]byte(context.Param("txid"))); err != nil {
+		writeJSONError(context, http.StatusBadRequest, "invalid txid: "+err.Error())
+		return
+	}
+
+	trace, ok := tracer.Lookup(txid)
+	if !ok {
+		writeJSONError(context, http.StatusNotFound, "no trace recorded for transaction "+txid.String())
+		return
+	}
+	writeJSON(context, http.StatusOK, trace)
+}
+
+func writeJSON(context echo.Context, code int, obj interface{}) {
+	w := context.Response().Writer
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(obj)
+}
+
+func writeJSONError(context echo.Context, code int, message string) {
+	writeJSON(context, code, struct {
+		Message string `json:"message"`
+	}{message})
+}
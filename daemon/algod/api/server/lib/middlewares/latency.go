@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var restHandlerSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_rest_handler_seconds", Description: "Time spent handling REST API requests, in seconds"}, nil)
+
+// MakeLatencyMetrics initializes the middleware that records handler latency,
+// labeled by route and method.
+func MakeLatencyMetrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			err := next(ctx)
+			// use the route template rather than the request URI so that
+			// path parameters do not create a label set per request
+			restHandlerSeconds.ObserveSince(start, map[string]string{
+				"route":  ctx.Path(),
+				"method": ctx.Request().Method,
+			})
+			return err
+		}
+	}
+}
@@ -92,6 +92,7 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 		middleware.RemoveTrailingSlash())
 	e.Use(
 		middlewares.MakeLogger(logger),
+		middlewares.MakeLatencyMetrics(),
 		middlewares.MakeCORS(TokenHeader),
 		middleware.BodyLimit(maxRequestBodyBytes),
 	)
@@ -110,6 +111,10 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 	// Registering common routes (no auth)
 	registerHandlers(e, "", common.Routes, ctx)
 
+	if node.Config().EnableTxnLifecycleTracing {
+		registerHandlers(e, "", common.DebugRoutes, ctx, adminAuthenticator)
+	}
+
 	// Registering v1 routes
 	registerHandlers(e, apiV1Tag, routes.V1Routes, ctx, apiAuthenticator)
 
@@ -29,6 +29,13 @@ var (
 	errFailedRetrievingLatestBlockHeaderStatus = "failed retrieving latest block header"
 	errFailedRetrievingTimeStampOffset         = "failed retrieving timestamp offset from node: %v"
 	errFailedSettingTimeStampOffset            = "failed to set timestamp offset on the node: %v"
+	errFailedSettingNextBlockTimeStamp         = "failed to set next block timestamp on the node: %v"
+	errFailedRetrievingBlockInterval           = "failed retrieving block interval from node: %v"
+	errFailedSettingBlockInterval              = "failed to set block interval on the node: %v"
+	errFailedAdvancingRounds                   = "failed to advance rounds: %v"
+	errFailedListingLedgerSnapshots            = "failed to list ledger snapshots: %v"
+	errFailedSavingLedgerSnapshot              = "failed to save ledger snapshot: %v"
+	errFailedRevertingLedgerSnapshot           = "failed to revert ledger snapshot: %v"
 	errFailedRetrievingSyncRound               = "failed retrieving sync round from ledger"
 	errFailedSettingSyncRound                  = "failed to set sync round on the ledger"
 	errFailedParsingFormatOption               = "failed to parse the format option"
@@ -47,5 +54,6 @@ var (
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
+	errRoundNotAvailable                       = "account state is not available for the given round"
 	errFailedRetrievingTracer                  = "failed retrieving the expected tracer from ledger"
 )
@@ -114,185 +114,198 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a5PcNpLgX0HUboQsXbFbL3tHipjYa0u2p8+yrVC3Pbcr6WwUmVWFaRbAAcDuKuv0",
-	"3y+QCZAgCVaxH5ZmLuaT1EU8EolEIl/I/DDL1aZSEqQ1s+cfZhXXfAMWNP7F81zV0maicH8VYHItKiuU",
-	"nD0P35ixWsjVbD4T7teK2/VsPpN8A20b138+0/D3WmgoZs+trmE+M/kaNtwNbHeVa92MtM1WKvNDnNAQ",
-	"py9nH/d84EWhwZghlD/JcseEzMu6AGY1l4bn7pNhV8KumV0Lw3xnJiRTEphaMrvuNGZLAWVhjsIi/16D",
-	"3kWr9JOPL+ljC2KmVQlDOF+ozUJICFBBA1SzIcwqVsASG625ZW4GB2toaBUzwHW+ZkulD4BKQMTwgqw3",
-	"s+dvZwZkARp3Kwdxif9daoDfIbNcr8DO3s9Ti1ta0JkVm8TSTj32NZi6tIZhW1zjSlyCZK7XEfuhNpYt",
-	"gHHJ3nz7gj158uSZW8iGWwuFJ7LRVbWzx2ui7rPns4JbCJ+HtMbLldJcFlnT/s23L3D+M7/Aqa24MZA+",
-	"LCfuCzt9ObaA0DFBQkJaWOE+dKjf9UgcivbnBSyVhol7Qo3vdFPi+T/rruTc5utKCWkT+8LwK6PPSR4W",
-	"dd/HwxoAOu0rhyntBn37MHv2/sOj+aOHH//t7Un23/7PL598nLj8F824BzCQbJjXWoPMd9lKA8fTsuZy",
-	"iI83nh7MWtVlwdb8Ejefb5DV+77M9SXWecnL2tGJyLU6KVfKMO7JqIAlr0vLwsSslqVjU240T+1MGFZp",
-	"dSkKKOaO+16tRb5mOTc0BLZjV6IsHQ3WBooxWkuvbs9h+hijxMF1I3zggv5xkdGu6wAmYIvcIMtLZSCz",
-	"6sD1FG4cLgsWXyjtXWWud1mx8zUwnNx9oMsWcScdTZfljlnc14JxwzgLV9OciSXbqZpd4eaU4gL7+9U4",
-	"rG2YQxpuTucedYd3DH0DZCSQt1CqBC4ReeHcDVEml2JVazDsag127e88DaZS0gBTi79Bbt22/6+zn35k",
-	"SrMfwBi+gtc8v2Agc1VAccROl0wqG5GGpyXEoes5tg4PV+qS/5tRjiY2ZlXx/CJ9o5diIxKr+oFvxabe",
-	"MFlvFqDdloYrxCqmwdZajgFEIx4gxQ3fDic917XMcf/baTuynKM2YaqS7xBhG77988O5B8cwXpasAlkI",
-	"uWJ2K0flODf3YfAyrWpZTBBzrNvT6GI1FeRiKaBgzSh7IPHTHIJHyOvB0wpfEThhkFFwmlkOgCNhm6AZ",
-	"d7rdF1bxFUQkc8R+9swNv1p1AbIhdLbY4adKw6VQtWk6jcCIU++XwKWykFUaliJBY2ceHY7BUBvPgTde",
-	"BsqVtFxIKBxzRqCVBWJWozBFE+7Xd4a3+IIb+Orp2B3ffp24+0vV3/W9Oz5pt7FRRkcycXW6r/7ApiWr",
-	"Tv8J+mE8txGrjH4ebKRYnbvbZilKvIn+5vYvoKE2yAQ6iAh3kxEryW2t4fk7+cD9xTJ2ZrksuC7cLxv6",
-	"6Ye6tOJMrNxPJf30Sq1EfiZWI8hsYE0qXNhtQ/+48dLs2G6TesUrpS7qKl5Q3lFcFzt2+nJsk2nM6xLm",
-	"SaPtxorH+TYoI9ftYbfNRo4AOYq7iruGF7DT4KDl+RL/2S6RnvhS/+7+qarS9bbVMoVaR8f+SkbzgTcr",
-	"nFRVKXLukPjGf3ZfHRMAUiR42+IYL9TnHyIQK60q0FbQoLyqslLlvMyM5RZH+ncNy9nz2b8dt/aXY+pu",
-	"jqPJX7leZ9jJiawkBmW8qq4xxmsn+pg9zMIxaPyEbILYHgpNQtImOlISjgWXcMmlPWpVlg4/aA7wWz9T",
-	"i2+SdgjfPRVsFOGMGi7AkARMDe8ZFqGeIVoZohUF0lWpFs0PX5xUVYtB/H5SVYQPlB5BoGAGW2GsuY/L",
-	"5+1Jiuc5fXnEvovHRlFcyXLnLgcSNdzdsPS3lr/FGtuSX0M74j3DcDuVPnJbE9DgxPy7oDhUK9aqdFLP",
-	"QVpxjf/i28Zk5n6f1Pmfg8Ri3I4TFypaHnOk4+AvkXLzRY9yhoTjzT1H7KTf92Zk40ZJE8yNaGXvftK4",
-	"e/DYoPBK84oA9F/oLhUSlTRqRLDekptOZHRJmKMzHNEaQnXjs3bwPCQhQVLowfB1qfKLv3CzvoMzvwhj",
-	"DY8fTsPWwAvQbM3N+miWkjLi49WONuWIuYao4LNFNNVRs8S7Wt6BpRXc8mhpHt60WEKox37I9EAndJef",
-	"8D+8ZO6zO9uO9dOwR+wcGZih4+ydDIXT9klBoJlcA7RCKLYhBZ85rftaUL5oJ0/v06Q9+oZsCn6H/CJw",
-	"h9T2zo/B12qbguFrtR0cAbUFcxf04cZBMdLCxkyA76WHTOH+e/RxrfluiGQcewqS3QKd6GrwNMj4xnez",
-	"tMbZk4XSN+M+PbYiWWtyZtyNGjHfeQ9J2LSuMk+KCbMVNegN1Hr59jON/vApjHWwcGb5H4AF40a9Cyx0",
-	"B7prLKhNJUq4A9JfJ5n+ght48pid/eXky0ePf3385VeOJCutVppv2GJnwbAvvG7GjN2VcH+4MtSO6tKm",
-	"R//qaTBUdsdNjWNUrXPY8Go4FBlASQSiZsy1G2Kti2ZcdQPglMN5Do6TE9oZ2fYdaC+FcRLWZnEnmzGG",
-	"sKKdpWAekgIOEtN1l9dOs4uXqHe6vgtVFrRWOmFfwyNmVa7K7BK0ESrhTXntWzDfIoi3Vf93gpZdccPc",
-	"3Gj6rSUKFAnKsls5ne/T0Odb2eJmL+en9SZW5+edsi9d5AdLomEV6MxuJStgUa86mtBSqw3jrMCOeEd/",
-	"BxZFgXOxgTPLN9VPy+XdqIoKB0qobGIDxs3EqIWT6w3kSlIkxAHtzI86BT19xAQTnR0HwGPkbCdztDPe",
-	"xbEdV1w3QqLTw+xkHmmxDsYSilWHLG+vrY6hg6a6ZxLgOHS8ws9o6HgJpeXfKn3eWgK/06qu7lzI6885",
-	"dTncL8abUgrXN+jQQq7KbvTNysF+lFrjZ1nQi3B8/RoQeqTIV2K1tpFa8Vortbx7GFOzpADFD6SUla7P",
-	"UDX7URWOmdja3IEI1g7WcjhHtzFf4wtVW8aZVAXg5tcmLZyNxGugoxj92zaW9+ya9KwFOOrKee1WW1cM",
-	"vbeD+6LtmPGcTmiGqDEjvqvG6UitaDqKBSg18GLHFgCSqYV3EHnXFS6So+vZBvHGi4YJftGBq9IqB2Og",
-	"yLxh6iBooR1dHXYPnhBwBLiZhRnFllzfGtiLy4NwXsAuw0AJw774/hdz/zPAa5Xl5QHEYpsUehs133sB",
-	"h1BPm34fwfUnj8mOa2DhXmFWoTRbgoUxFF4LJ6P714dosIu3R8slaPTH/aEUHya5HQE1oP7B9H5baOtq",
-	"JPzPq7dOwnMbJrlUQbBKDVZyY7NDbNk16ujgbgURJ0xxYhx4RPB6xY0lH7KQBZq+6DrBeUgIc1OMAzyq",
-	"hriRfwkayHDs3N2D0tSmUUdMXVVKWyhSa5Cw3TPXj7Bt5lLLaOxG57GK1QYOjTyGpWh8jyxaCSGI28bV",
-	"4oMshotDh4S753dJVHaAaBGxD5Cz0CrCbhwCNQKIMC2iiXCE6VFOE3c1nxmrqspxC5vVsuk3hqYzan1i",
-	"f27bDomL2/beLhQYjLzy7T3kV4RZCn5bc8M8HGzDL5zsgWYQcnYPYXaHMTNC5pDto3xU8Vyr+AgcPKR1",
-	"tdK8gKyAku+Gg/5Mnxl93jcA7nir7ioLGUUxpTe9peQQNLJnaIXjmZTwyPALy90RdKpASyC+94GRC8Cx",
-	"U8zJ09G9ZiicK7lFYTxcNm11YkS8DS+VdTvu6QFB9hx9CsAjeGiGvjkqsHPW6p79Kf4LjJ+gkSOuP8kO",
-	"zNgS2vGvtYARG6oPEI/OS4+99zhwkm2OsrEDfGTsyI4YdF9zbUUuKtR1vofdnat+/QmSbkZWgOWihIJF",
-	"H0gNrOL+jOJv+mPeTBWcZHsbgj8wviWWUwqDIk8X+AvYoc79mgI7I1PHXeiyiVHd/cQlQ0BDuJgTweMm",
-	"sOW5LXdOULNr2LEr0MBMvdgIaylgu6vqWlVl8QBJv8aeGb0Tj4Iiww5M8Sqe4VDR8oZbMZ+RTrAfvvOe",
-	"YtBBh9cFKqXKCRayATKSEEyK92CVcrsufOx4iB4OlNQB0jNt9OA21/8900EzroD9l6pZziWqXLWFRqZR",
-	"GgUFFCDdDE4Ea+b0kR0thqCEDZAmiV8ePOgv/MEDv+fCsCVchQcXrmEfHQ8eoB3ntTK2c7juwB7qjttp",
-	"4vpAh4+7+LwW0ucphyML/MhTdvJ1b/DGS+TOlDGecN3yb80AeidzO2XtMY1Mi6rAcSf5cqKhU+vGfT8T",
-	"m7rk9i68VnDJy0xdgtaigIOc3E8slPzmkpc/Nd3wMQnkjkZzyHJ8AjFxLDh3fejVxCHdsI0mE5sNFIJb",
-	"KHes0pADRfk7kc80MB4xiv/L11yuUNLXql75ADQaBzl1bcimoms5GCIpDdmtzNA6neLcPug4PPRwchBw",
-	"p4v1TdukeVzxZj7/tmfKlRohr2/qT3q35rNRVdUh9bJVVQk53dcqE7h4R1CL8NNOPNEHgqhzQssQX/G2",
-	"uFPgNvePsbW3Q6egHE4chcS1H8ei4pyeXO7uQFqhgZiGSoPBuyW2Lxn6qpbxyzR/+ZidsbAZmuCp668j",
-	"x+/NqKKnZCkkZBslYZd8jC0k/IAfk8cJ77eRzihpjPXtKw8d+HtgdeeZQo23xS/udv+E9l1N5lul78qX",
-	"SQNOlssnuA4P+sn9lDd1cPKyTPgE/buVPgMw8+advNCMG6NygcLWaWHmdNC8G9E/cumi/3UTjXsHZ68/",
-	"bs/5FT+JROMulBXjLC8Fmn6VNFbXuX0nORqXoqUmopaCFj1ubnwRmqTtmwnzox/qneQYsdaYnJKRFktI",
-	"2Fe+BQhWR1OvVmBsT0lZAryTvpWQrJbC4lwbd1wyOi8VaAwdOqKWG75jS0cTVrHfQSu2qG1XbMdnWcaK",
-	"svSeODcNU8t3kltWAjeW/SDk+RaHC976cGQl2CulLxospG/3FUgwwmTp6Krv6CsGvvrlr30QLD6jp8/k",
-	"u3Hjt2+3dmh7ap+G/58v/vP525Psv3n2+8Ps2f84fv/h6cf7DwY/Pv745z//3+5PTz7++f5//ntqpwLs",
-	"qUdDHvLTl16lPX2JekvrvBnA/skM9xshsySRxWEYPdpiX+ADWU9A97tWLbuGd9JupSOkS16KwvGWm5BD",
-	"/4YZnEU6HT2q6WxEz4oV1npNbeAWXIYlmEyPNd5YihoGJKaf56E30b+4w/OyrCVtZZC+6fVJCAxTy3nz",
-	"BJOyszxn+D5vzUNUo//z8Zdfzebtu7rm+2w+81/fJyhZFNvU68kCtiklzx8QPBj3DKv4zoBNcw+EPRkD",
-	"R0EZ8bAb2CxAm7WoPj2nMFYs0hwuxPR7Y9FWnkoKtnfnB32TO+/yUMtPD7fVAAVUdp3K2tAR1LBVu5sA",
-	"vXiRSqtLkHMmjuCob6wpnL7oo/FK4EvMHoDap5qiDTXngAgtUEWE9XghkywiKfpBkcdz64/zmb/8zZ2r",
-	"Q37gFFz9ORtHZPjbKnbvu2/O2bFnmOYePeSloaOnlwlV2r8u6kQSOW5GuWpIyHsn38mXsBRSuO/P38mC",
-	"W3684Ebk5rg2oL/mJZc5HK0Uex4eLL3klr+TA0lrNJ1U9FSMVfWiFDm7iBWSljwpRchwhHfv3vJypd69",
-	"ez8IqhiqD36qJH+hCTInCKvaZj7BQabhiuuU08o0D9xxZMpgsm9WErJVTZbNkEDBj5/mebyqTP+h63D5",
-	"VVW65UdkaPwzTrdlzFilgyziBBSCBvf3R+UvBs2vgl2lNmDYbxtevRXSvmfZu/rhwyfAOi8/f/NXvqPJ",
-	"XQWTrSujD3H7RhVcOKmVsLWaZxVfpXxj7969tcAr3H2Ulzdo4yhLht06L05DRD0O1S4g4GN8AwiOa7+e",
-	"w8WdUa+QzCq9BPyEW4htnLjReuxvul/RG9Qbb1fvHetgl2q7ztzZTq7KOBIPO9PkuFk5ISuEURixQm3V",
-	"pwNaAMvXkF/4PC2wqexu3ukeInW8oBlYhzCUwYdekGEOCfQsLIDVVcG9KM7lrv+Y34C1IR74DVzA7ly1",
-	"KSiu83q/+5jcjB1UpNRIunTEGh9bP0Z/8304GCr2VRXeZOPjvEAWzxu6CH3GDzKJvHdwiFNE0XnsPIYI",
-	"rhOIIOIfQcENFurGuxXpp5bntIwF3XyJbD6B9zPfpFWefORWvBq0utP3DWA6MHVl2II7uV35TFb0YDri",
-	"YrXhKxiRkGPnzsRnyR2HEA5y6N5L3nRq2b/QBvdNEmRqnLk1JykF3BdHKqjM9OL1wkzkP/SeCUxQ6RG2",
-	"KFFMagIbielw3XGyUca9MdDSBAxatgJHAKOLkViyWXMTkmxhLrJwlifJAH9gAoB9aV9Oo1CzKOFYk9Ql",
-	"8Nz+OR1olz75S8j4EtK8xKrlhJQtTsLH6PbUdiiJAlABJaxo4dQ4EEqbjKDdIAfHT8tlKSSwLBW1FplB",
-	"o2vGzwFOPn7AGFng2eQRUmQcgY1+cRyY/ajisylX1wFS+mQKPIyNHvXob0i/+6I4bifyqMqxcDHi1coD",
-	"B+A+1LG5v3oBtzgME3LOHJu75KVjc17jawcZZB9BsbWXa8RHZtwfE2f3OEDoYrnWmugquslqYpkpAJ0W",
-	"6PZAvFDbjB5+JiXexXbh6D0Z2o7PUFMHk/K83DNsobYY7YNXC4VSH4BlHI4ARqThb4VBesV+Y7c5AbNv",
-	"2v3SVIoKDZKMN+c15DImTkyZekSCGSOXL6LULTcCoGfsaPMge+X3oJLaFU+Gl3l7q83blGTh1VDq+I8d",
-	"oeQujeBvaIVpkq287kssSTtFN2ilm2cmEiFTRO/YxNBJM3QFGSgBlYKsI0RlFynPqdNtAG+cs9AtMl5g",
-	"Nhsud/ejSCgNK2EstEb0ECfxOcyTHJPoKbUcX52t9NKt741SzTVFbkTs2FnmJ18BhhIvhTY2Qw9Ecgmu",
-	"0bcGlepvXdO0rNSNtaKUs6JI8wac9gJ2WSHKOk2vft7vX7ppf2xYoqkXyG+FpICVBaZITkZg7pmagnT3",
-	"LvgVLfgVv7P1TjsNrqmbWDty6c7xT3Iuepx3HztIEGCKOIa7NorSPQwyejk75I6R3BT5+I/2WV8Hh6kI",
-	"Yx+M2gnvd8fuKBopuZbIYLB3FQLdRE4sETbKMDx80jpyBnhViWLbs4XSqKMaM7+WwSPkZethAXfXD3YA",
-	"A5HdM/WqRoPppuBrBXzKFd3JgHM0CTPn3UR5MUOIpxImVDoYIqp5dXcIV+fAy+9h94tri8uZfZzPbmc6",
-	"TeHaj3gA16+b7U3iGV3zZErreEKuiXJeVVpd8jLzBuYx0tTq0pMmNg/26E/M6tJmzPNvTl699uB/nM/y",
-	"ErjOGlFhdFXYrvqnWRVl+xs5ICGTutP5gsxOomS0+U2KstgofbUGn5I6kkYHuTNbh0N0FL2RepmOEDpo",
-	"cva+EVriHh8JVI2LpDXfkYek6xXhl1yUwW4WoB2J5sHFTUvAmuQK8QC39q5ETrLsTtnN4HSnT0dLXQd4",
-	"UjzXnqTZG8oLb5iSfRc6xjzvKu9133DMfElWkSFzkvUGLQmZKUWetrHKhXHEIcl35hozbDwijLoRazHi",
-	"ipW1iMZyzabktukBGc2RRKZJptdpcbdQvuZPLcXfa2CiAGndJ42nsndQMU2Kt7YPr1MnOwzn8gOThb4d",
-	"/jYyRpz1tX/jIRD7BYzYUzcA92WjMoeFNhYp90PkkriGwz+ecXAl7nHWe/rw1EzBi+uuxy0u0TPkf44w",
-	"KFf74fpAQXn16WdH5kjW+xEmW2r1O6T1PFSPEw+WQp5bgVEuv0P80CGuctFhMY11py1b1M4+ut1j0k1s",
-	"heoGKYxQPe585JbDhJvBQs0lbTU9JOnEuqUJJo4qPabxW4LxMA8icUt+teCpbKROyHAwnbQO4I4t3SoW",
-	"Ogfcm+a1Bc3OIl9y01bQY/QKdPuWcJjY5oYCA007WVRoJQOk2lgmmJP/rzQqMUwtr7ikKi6uHx0l39sA",
-	"Gb9cryulMZWESZv9C8jFhpdpyaHIhybeQqwEFSipDUQVMPxAVPyJqMhXEWneEHnUnC7Zw3lUhsfvRiEu",
-	"hRGLErDFI2qx4AY5eWOIarq45YG0a4PNH09ovq5loaGwa0OINYo1Qh2qN43zagH2CkCyh9ju0TP2Bbrt",
-	"jLiE+w6L/n6ePX/0DI2u9MfD1AXgC8zs4yYFspO/enaSpmP0W9IYjnH7UY+Sr+6pwtw449pzmqjrlLOE",
-	"LT2vO3yWNlzyFaQjRTYHYKK+uJtoSOvhRRZUHslYrXZM2PT8YLnjTyPR5479ERgsV5uNsBvv3DFq4+ip",
-	"LW9Bk4bhqNaSz0wc4Aof0UdaBRdRT4n8tEZTut9Sq0ZP9o98A120zhmn/CGlaKMXQr50dhrSE2Gq5iZD",
-	"M+HGzeWWjmIOBjMsWaWFtKhY1HaZ/Ynla6557tjf0Ri42eKrp4n01N00qfJ6gH9yvGswoC/TqNcjZB9k",
-	"CN+XfSGVzDaOoxT329ce0akcdeam3XZjvsP9Q08Vytwo2Si51R1y4xGnvhXhyT0D3pIUm/Vcix6vvbJP",
-	"Tpm1TpMHr90O/fzmlZcyNkqncg62x91LHBqsFnCJsXvpTXJj3nIvdDlpF24D/ef1PASRMxLLwllOKQJf",
-	"q4R2GlKmN5Z0H6uesA6MHVP3wZHBwg81Z9301J+ej95NFFTa0xUM20PHlvsS8IB/9BHxmckFN7D15dNK",
-	"RgglSs+fJJmi+R752Dn7Wm2nEk7vFAbi+QdAURIltSiLX9qXn73qB5rLfJ30mS1cx1/bOm3N4ugOTKYP",
-	"XHMpoUwOR/Lmr0EuTUjOf1NT59kIObFtvyADLbe3uBbwLpgBqDChQ6+wpZsgxmr3UV0TtF2uVMFwnjZX",
-	"XXtch4U8onTrf6/B2NQDJfxAgWNoG3XsgLJ9M5AFaqRH7DsqxbwG1klEhJpgyBTRfTVdV6XixRwzWJx/",
-	"c/KK0azUh6oNUbbxFSpC3VX0bGJRGs5pIcihcFD6ecT0cfbHa7tVG5s1ycFTD1BdizZ9uej5CVBFirFz",
-	"xF5GRVXpraobgmECE71xWl0zGslHSBPuP9byfI1qX4e1jpP89DT5gSpNVJqyKTHV5KbEc+fg9pnyKVH+",
-	"nCmnm18JQxV44RK6b16bB+De7BDewHaXp2spiVKOrnHLNZkor4v2ABxdkcGVkISsh/hrCv1UZeK6VQPO",
-	"sFcyVVa/BMGgJiW9oGxKB4XK6jmXSoocE1WlrmhfqneKn21CTq++ITcccX9CE4crWfigCcXzWBwthRAY",
-	"oUfc0NAffXWbStRBf1qsCbvmlq3AGs/ZoJiH+h3e1iikAZ9rFAs7R3xS6Y7vEjlk0h2eNW6Ta5IRPr0Z",
-	"UR6/dd9+9KYFjEm/EBKVCI82L/iRNRAriVqneQjLVgqMX0/3/bF56/oc4VPcArbvj0LlURyDXH9u2eTn",
-	"Hg51Erze3svs2r5wbX2CpObnTpQzTXpSVX7S8eouSXnAbuUoghPeyyy4jyLkNuPHo+0ht73hKnifOkKD",
-	"S3R2Q4X38IAwmkonvSpaTmglisIWjMLEklkShEyA8UpIaOviJi6IPHkl4MbgeR3pZ3LNLYmAk3jaOfAS",
-	"Pdwphmasd2/cdqh+eiiHElxjmGN8G9siLSOMo2nQCm5c7ppyvI66I2HiBdYB94gcllxBqcoLUQW+WugV",
-	"YUkxDse4Q5mn7gUwPAZDmYi6Y660695EYw9RF3WxApvxokilfv0avzL8yooaJQfYQl43KUKriuWYd6Wb",
-	"iGZIbX6iXElTb/bMFRrccrqoqlGCGuLKSmGH8aHLYof/pvJjju+MD/S4dqhhiOoorpd9aRg6mZJ6HU1n",
-	"Rqyy6ZjAO+X26Ginvhmht/3vlNJLteoC8onTT+zjcvEepfjbN+7iiLMzDJK+0tXSJE/AwD4ValGi2tg8",
-	"++1yJbzKBllg0aHU1Lrbb4AYr1o3x8tvJLw3SrrB6X4lD+VYkG8+GpPOrX8dZznby4JGXxxRhBC9LUIo",
-	"0tbZsaggCgpynwe9p0mGAznbphMfRggN4WZDgL4Psays4sK731tmMcSsj3ofvkOYEg/bbnB/ET6WfNRi",
-	"9/3lWNx3SMaG3/tVrS7AP5mvNFwKVQfHdoh8Cioh/dqpEdVE3ifXPzS84lSf1xw6arw999UFaJleJ//+",
-	"F4qTYyCt3v0DmHIHmz6olzWUdsk81TZhTWLqSYmqO7filESFqZx4XjbsVOw6UG9sQFYvp4gDw/ph89lp",
-	"ca0LM5VXcUajpI5duhrYeNqpNtUUHrFKGdHmh0+VCZsYYniOlb6itFnDsUJ8zyXkFosCtHELGuA6SbTc",
-	"ZFHh0X+lnxpRp5tITJ91al+qqWElgAN3/OA1WPSikbKoH01PrHTSRKchn8ZsyCuQvvZn953H5Gjz5RJy",
-	"Ky4PvL776xpk9LJrHuwyVMM7eownmuhlTN5yfatjC9C+x3F74YmSKN4anLG3Nxewu2dYhxqSad3n4aq9",
-	"Sd4OxAByh8yRiDKp6A8yJHuHvDANZSAWQrQVdYc2A9poRajoLekN5wok6S6O9n3pninTJWkmzeW6XuvV",
-	"NQbijj3QG1a0GNc/XmIBEdNUawx5P2ItnZ0OsyNe+bwh+Fay8Z2EDCJgwm/hYTTNUooLiGtWoafqiusi",
-	"tEiaXoJVJ9tzHw1e1YVqDH2gl83Moo2NHb6jSuTbwgjovFROjMjGwsi74ahNLMc9Q0E3lP4dA20dXEvQ",
-	"vrYfyr+lMpBZFWJp98GxDxUUWXQjJJjRHJcE3GjmmTdtah3M9csx0wz3AUXxApmGDXfQ6SgBzvic+5D9",
-	"gr6Hh0Mh1+tBC1NDr4eLDoSoaGEGSIypfsn8bXn4QdJNjE1CSqofbVLZcCTorjek0qqoc7qg44PRGOQm",
-	"55raw0qSdpp8uMqejhC96ryA3TEpQaFaQ9jBGGiSnAj0KItCb5Pv1PxmUnCv7gS8z2m5ms8qpcpsxNlx",
-	"Okzh06f4C5FfQMHcTRGiB0cq6LAv0MbeeLOv1ruQsqaqQEJx/4ixE0nx2sGx3c0h3Ztc3rP75t/irEVN",
-	"WbW8Ue3onUwHvmK+K31LbhaG2c/DDDhWd8upaJADCWK2I+mDNL9K1JM6mqqVD13N/Ro/LVERFCmZpC1f",
-	"cyBOpgmRaSt/tGEyQ+mgLNVVhlSUNfm/UjqHa9dlkiHjadvNYXsBUbwNN/4C3bE1L1iutIY87pF+4kBA",
-	"bZSGrFQYfpPyDC6tk4c2GNcsWalWTFVOzaU0esGHkixLE811VyV46LkuQZCRw2ckIQIY/zzXg0uNh/Du",
-	"qYJz/Qo75+uE3QY3LOzWtcvoeIK7dvWLCMwJhH7YZnWSqhLUXVe/XtVY9TirNiJPo/ufK1plNMYkRb0p",
-	"VPgEtPQADpvhAY95SuOcxNMzRDNIvihTHhvmj5930iCdu//iDdYfly3BM5cRfpZ4gLlv1anKT4ldbaby",
-	"hanCm8oRCkk6vPf7l6ka4GKql7nJOD2RGUQAjPudOzBM8j5fF4wlVtfMeALJp43MP+8UPxY9jheyAdLJ",
-	"zjnp/Gtgbuxag3/jR2UAe3WHKm7XQQZwzYeaudPywOADPCqewg3ZkYI9y9cg7AtXqspKuISOO94/PKzz",
-	"HIwRlxDXL6TOrACo0Lrb1zlSfuaYt/cEUb/2LPJUTsFuUjIlxNJOsQNiZ1JI3sqMjomZepQcRJeiqHkH",
-	"f+YWldzGirglLp8A6/tpnOLaTCK9uH0s4mBkCNJ88lzKdGBI/O61MSnhbEVjeiYibE+2qfiVHFfBhkTZ",
-	"yk7TayBGiP1mCzneQ93Ih9vjhOFgzPTetI8KTbrZ4Zuq8qNUto/IBhUhk1KbgVDRN04/EwRf3zch7ZLR",
-	"UZjEAMK0vAHjKKGN04uabfiOFWK5BE1uFWO5LLgu4uZCshy05cLpmDtzcwXDQatrmB/UMRynxkEDs0pp",
-	"G2ghJEDKnVfexuT/CXI7+tASMjtd21aNFasc7Er6YQffOj0HI9xGiMA/SUcthw6rkihisg2/gGvOY8Tv",
-	"sH8aTBTjrbBW4axTpvi4l9Z/QtThgf9ZCruX2kn064cckk+IiDHQoFy1jmnanCENpqJEz6lkUhwp2q9A",
-	"EPaaDFQ0H4xkVPS8M0Oeava4fMFEtZJyb7IbigMDZkzAzH0E7bWkhb65IT/AlJIseuRMdGV1tUTqxE2h",
-	"iwnjBhp2PO9HtHSvoGbbsfpnXmsUoq747nBitvYaSgcD08hBnQkxDg3UfquJwAwVlEjmPbuOeJKg+VRN",
-	"hWHGqbtfDEW5t364P2453tKeXkBcoX0/vbWCfCCVBK1xuUsdnWBLvsECx6STCXGad7ZVzWn5IzYoyaJv",
-	"loh0EmjDmL0ENqPKwfvDKOI8xe0DaE2hn+h2DfpQn1/80OpJ02oYhw4HwIuja6IqxsHR4cH5zC+Jf2iQ",
-	"Ei3l/RgldJZ/KGDHL7BVLKMt8rKatUBZ4+n1WXdfomgs86IJchoruN2PhcKkxE44KMtEDBWJj1TiNiIc",
-	"d0/qS15++jgozFZ9gviA4s245zQOpImRTKg0N3vG94pPmjsKmrm7qeVrjNv6K7g9Sl4LfiivsQ6YPwr/",
-	"vCQr/zLUu7wEya5wTAr6fvQVW/g0J5WGXJi+JnwVSlE1cSNYmdE/ndzaA4Eqh9b5i7K3IONlMCyxH9uy",
-	"NmjIXskWwvaIfmamMnJyk1Seor4BWSTwl+JRcb7RA9fFRScavJXqohtNabjjqPDofdc1o8KHmVSnLo8i",
-	"n92lUxsYrnPybd3BbeKibtc29UnDELn7ap9MeYmQLmnkuuNTCEII1gNjCCr77dFvTMMSC/4q9uABTvDg",
-	"wdw3/e1x97M7zg8eJJW8T/YIgnDkx/Dzpijml7Fn8fT0eyQDQ28/alEWhwijk0+jLZmNGSN+9Vl7PkvR",
-	"7l8pMHN4VH3h1FtEkxNiEmvtTB5NFWXKmJAkw3dLpMTAoIe81sLuMJlw0HjFr8nnGt81ob8+dLwx4fm7",
-	"z6oLaNJRt4HCtQm363eKl3gfkWVRultIlUfsmy3fVCX4g/Lne4v/gCd/elo8fPLoPxZ/evjlwxyefvns",
-	"4UP+7Cl/9OzJI3j8py+fPoRHy6+eLR4Xj58+Xjx9/PSrL5/lT54+Wjz96tl/3HN8yIFMgM5C6rrZ/8bK",
-	"9tnJ69Ps3AHb4oRX4nvYURFdR8ahPC/P8STChoty9jz89D/DCTvK1aYdPvw685mxZmtrK/P8+Pjq6uoo",
-	"7nK8wsjAzKo6Xx+HeQb1e09enzYuSDL6445SUongzAmkcILf3nxzds5OXp8etQQzez57ePTw6JEbX1Ug",
-	"eSVmz2dP8Cc8PWvc92NPbLPnHz7OZ8dr4CUG0rs/NmC1yMMnDbzY+f+bK75agT7yNYvdT5ePj4NYcfzB",
-	"R0h+3PftOC7/dfyhE0haHOiJ5YGOP4Sst/tbd9LK+gDaqMNEKPY1O15gMq2pTcFEjceXgsqGOf6A4vLo",
-	"78c++0/6I6otdB6OQ7R1umUHSx/s1sHa65Fzm6/r6vgD/gfpMwKL3toe2608RvP08YfOavznwWq6v7fd",
-	"4xaXG1VAAFgtl5TFe9/n4w/0bzQRbCvQwgl+GN/uf6V3SMeYW283/HknvXG3hFT0+M/SACmmIffPTubt",
-	"a7jmyJ4WofHZTuZBQg1vSvEgPn74kKZ/iv+5myrh3detiVrhZw28mIgVw4sRhkefDoZTic8vHP9ixJ8/",
-	"zmdffkosnDqdXfKSYUua/skn3ATQlyIHdg6bSmmuRbljP8smY0+UCThFgRdSXckAubvc682G6x0KzRt1",
-	"CYb5JMMRcTINTkwhZxI6PFoaxtuFrwwa87EG02xOb5nfo2BkUzJCsNcMZwq2qnbw7qn47uCZmL4LXdFz",
-	"T/D4JDgPvPag4Ydy83B/m0L8PfcETXUvtUGzfzGCfzGCO2QEttZy9IhG9xe+gILKB8vlPF/DPn4wvC2j",
-	"C35WqVQk8dkeZuHzjI3xirMur4jKfD1/Oy2/p3cwkO24ACN86RPUG5xQ3Ir1uuFI4cxjBEG01/uSt398",
-	"/w9xv7/gMpznzo5TED7XpQDdUAGXw9Rv/+IC/99wAcphyWlf58xCWZr47FuFZ5+cLf5hqyQn2EQ+0C8j",
-	"n/r5+EO3jGFHSTDr2hbqKuqLJnPy9wx1h6awd+fv4ysubLZU2j9qxTITw84WeHnsM9j1fm2Txgy+YCac",
-	"6Mc4vDD563FTxSf5sa+Opr56dWykUYhQCp9b01Rs6kEO2Rh53r53/AlzxHvm2Vounh8f40OxtTL2ePZx",
-	"/qFn1Yg/vm9IIiT2nVVaXGKeoPcf/18AAAD//3zWrY3S0gAA",
+	"H4sIAAAAAAAC/+y9e3PcNrIo/lVQc06VH7+h5Feya1VtnZ9iJ1ndOInLUpJ7buybxZA9M1hxAC4BSjPx",
+	"1Xe/1Q2ABEmQw5FkZ/fW/mVriEej0Wg0+vlxlqpNoSRIo2cnH2cFL/kGDJT0F09TVUmTiAz/ykCnpSiM",
+	"UHJ24r8xbUohV7P5TOCvBTfr2Xwm+QZmJ2H/+ayEf1SihGx2YsoK5jOdrmHDcWCzK7B1PdI2WanEDXFq",
+	"hzh7PbsZ+cCzrASt+1D+KPMdEzLNqwyYKbnUPMVPml0Ls2ZmLTRznZmQTElgasnMutWYLQXkmT7yi/xH",
+	"BeUuWKWbfHhJNw2ISaly6MP5Sm0WQoKHCmqg6g1hRrEMltRozQ3DGRBW39AopoGX6ZotVbkHVAtECC/I",
+	"ajM7+XWmQWZQ0m6lIK7ov8sS4HdIDC9XYGYf5rHFLQ2UiRGbyNLOHPZL0FVuNKO2tMaVuALJsNcR+77S",
+	"hi2AccneffOKPX/+/CUuZMONgcwR2eCqmtnDNdnus5NZxg34z31a4/lKlVxmSd3+3TevaP5zt8CprbjW",
+	"ED8sp/iFnb0eWoDvGCEhIQ2saB9a1I89Ioei+XkBS1XCxD2xje91U8L5/9BdSblJ14US0kT2hdFXZj9H",
+	"eVjQfYyH1QC02heIqRIH/fVJ8vLDx6fzp09u/uPX0+R/uT+/eH4zcfmv6nH3YCDaMK3KEmS6S1YlcDot",
+	"ay77+Hjn6EGvVZVnbM2vaPP5hli968uwr2WdVzyvkE5EWqrTfKU0446MMljyKjfMT8wqmYPWNJqjdiY0",
+	"K0p1JTLI5kxIdr0W6ZqlXNshqB27FnmONFhpyIZoLb66kcN0E6IE4boVPmhB/7zIaNa1BxOwJW6QpLnS",
+	"kBi153ryNw6XGQsvlOau0oddVuxiDYwmxw/2siXcSaTpPN8xQ/uaMa4ZZ/5qmjOxZDtVsWvanFxcUn+3",
+	"GsTahiHSaHNa9yge3iH09ZARQd5CqRy4JOT5c9dHmVyKVVWCZtdrMGt355WgCyU1MLX4O6QGt/1/nP/4",
+	"A1Ml+x605it4y9NLBjJVGWRH7GzJpDIBaThaIhxiz6F1OLhil/zftUKa2OhVwdPL+I2ei42IrOp7vhWb",
+	"asNktVlAiVvqrxCjWAmmKuUQQHbEPaS44dv+pBdlJVPa/2baliyH1CZ0kfMdIWzDt395MnfgaMbznBUg",
+	"MyFXzGzloByHc+8HLylVJbMJYo7BPQ0uVl1AKpYCMlaPMgKJm2YfPEIeBk8jfAXgCLkHHCGngSNhG6EZ",
+	"PN34hRV8BQHJHLGfHHOjr0ZdgqwJnS129Kko4UqoStedBmCkqcclcKkMJEUJSxGhsXOHDs04s20cB944",
+	"GShV0nAhIWNCWqCVAcusBmEKJhx/7/Rv8QXX8OWL2c2+rxN3f6m6uz6645N2mxol9khGrk786g5sXLJq",
+	"9Z/wPgzn1mKV2J97GylWF3jbLEVON9Hfcf88GipNTKCFCH83abGS3FQlnLyXj/EvlrBzw2XGywx/2dif",
+	"vq9yI87FCn/K7U9v1Eqk52I1gMwa1uiDi7pt7D84Xpwdm230XfFGqcuqCBeUth6uix07ez20yXbMQwnz",
+	"tH7thg+Pi61/jBzaw2zrjRwAchB3BceGl7ArAaHl6ZL+2S6Jnviy/B3/KYoce5tiGUMt0rG7kkl94NQK",
+	"p0WRi5QjEt+5z/gVmQDYhwRvWhzThXryMQCxKFUBpRF2UF4USa5SnifacEMj/WcJy9nJ7D+OG/3Lse2u",
+	"j4PJ32Cvc+qEIqsVgxJeFAeM8RZFHz3CLJBB0ydiE5btkdAkpN1EJCWBLDiHKy7N0WweO5PNAf7VzdTg",
+	"20o7Ft+dJ9ggwpltuABtJWDb8IFmAeoZoZURWkkgXeVqUf/w8LQoGgzS99OisPgg6REECWawFdroR7R8",
+	"3pykcJ6z10fs23BsEsUVqpcW4EQNvBuW7tZyt1itW3JraEZ8oBltJyprbuY1GrQGcx8UR8+KtcpR6tlL",
+	"K9j4r65tSGb4+6TO/xokFuJ2mLiwFXOYs28c+iV43DzsUE6fcJy654iddvvejmxwlDjB3IpWRvfTjjuC",
+	"xxqF1yUvLIDui71LhaRHmm1kYc2uuEyBRAJ9D9Q9QmQ5N4AXvpduIlq0/g1VCWm+fHEHCqtR4qREL2WE",
+	"0DjZmxMusAH9SmrnDK7YRmXE4+9680y8FKL723wOzyVBdWu+tJd3RCHBD10YvspVevlXrtf3QEELP1af",
+	"imgatgaeQcnWXK+PZjGJLCSUZrQpxIINSRnCFsFUR/US72t5e5aWccOPZl144wfEop760QUBZeSd9yP9",
+	"h+cMPyMf5MarOVDFI4idqcAgk6FmxD6m7EzYADfeKLaxyhCGGoqDoHzVTB7fp0l79LXVv7gdcougHVLb",
+	"ez8GX6ltDIav1LZ3BNQW7oOBLtTW/kcY2OgJ8L12kCnaf4c+XpZ810cyjT0FybhAFPM1nQYZSkc4S6PI",
+	"Pl2o8nbcp8NWJGvU84zjqMFFNe8giZpWReJIMaLisw06AzUW0XGm0R0+hrEWFs4N/wRY0IYHwN8BC+2B",
+	"7hsLalOIHO6B9NdRpo8KlefP2PlfT794+uy3Z198iSRZlGpV8g1b7Axo9tC9Y5k2uxwe9Vc2n1k1Q3z0",
+	"L194pW573Ng4WlVlChte9IeyymIrLtpmDNv1sdZGM626BnDK4bwA5OQW7czaQRC010JzrWGzuJfNGEJY",
+	"1sySMQdJBnuJ6dDlNdPswiWWu7K6j2c/lKUqI7pIOmJGpSpPrqDUQkUsT29dC+Za+KdA0f3dQsuuuWY4",
+	"N6nJK0kCRYSyUP89me/boS+2ssHNKOe3642szs07ZV/ayPfytGYFWvW2KCovqlXr1bgs1YZxllFHuqO/",
+	"BUOiwJk0UF7x/B62UrihhmQq/50JyTSkSmZ6zp5Y+YZkCGvQIunnuhTGgGRct60Y2EBXiw1+zSY8gGuY",
+	"7vJCWQyBHyLyQmzg3PBN8eNyeT/6CUUDRZ5wYgMaZ2K2RQDQBIy4Ue+CDzMMgMPI+U6m9JL9tA/ZjZBk",
+	"adM7mQaqE3pVQraCcgI+7uEBS1M90BFwEB1v6PO55IVeq/t/H7aHj8EdByDQTLQbDCko2q3uQ8LWfqzJ",
+	"3La72D3MtpngLvur+RVkbpdZPWSIE8MNvIbc8G9UedGwq29LVRWfasPrOacuhddLwK4sw75eKSfkKm+7",
+	"860Q9uga/5AFvfJ3nFsDQU/c5o1YrU3w9n5bKrW8fxhjs8QApQ9Wc5Fjn77+4geV4UVhKn0P75RmsEYM",
+	"QJoNL3++UJVhnEmVAW1+peMvmAEHMPI8IYcZEz6KzNoqIxaA1JXyCleLhjYVE6qajglP7QlN7KUfn7Dx",
+	"YrCt7HTWuSgvgWeoHAbJ1MJZnJ0tnBbJyZfF+DeAez9F7oIWXEWpUtAalfpWVbsXNN/OyldmBE8EOAFc",
+	"z8K0Ykte3hnYy6u9cF7CLiHPK80efvezfvQHwGuU4fkexFKbGHprXZiQA1BPm36M4LqTh2THS2D+XmFG",
+	"0ZMvBwNDKDwIJ4P714Wot4t3R8sVlGTg/6QU7ye5GwHVoH5ier8rtFUx4E/sdEAoveOGSS6VF5pjg+Vc",
+	"m2QfW8ZG4Vo0riDghDFOTAMPCNVvuDbWKUXIjPTD2tloagsNTjEM8OBbHUf+2T/T+2OnSmqQutL1m11X",
+	"RaFKA1lsDejJNDzXD7Ct51LLYOxaMWAUqzTsG3kIS8H4Dll2JRZB3NS2W+e11V8cWTjxnt9FUdkCokHE",
+	"GCDnvlWA3dCncgAQoRtEW8IRukM5tSPnfKaNKgrkFiapZN1vCE3ntvWp+alp2ycubpp7O1OgyZXTtXeQ",
+	"X3szIJfoX6yZg4Nt+CXKHqQrtN4zfZjxMCZayBSSMcqn5zu2Co/A3kNaFauSZ5BkkPNdf9Cf7GdmP48N",
+	"QDve6ISUgcS6RcY3vaFk74U2MrSi8SJM8wfF6AtL8QjiU6AhENd7z8gZ0Ngx5uTo6EE9FM0V3SI/Hi3b",
+	"bnVkRLoNr5QJzMIEsuPoUwAewEM99O1RQZ2T5u3ZneK/QbsJfJtbTLIDPbSEZvyDFjBgaHARJ8F56bD3",
+	"DgeOss1BNraHjwwd2QGrx1teGpGKgt4638Hu3p9+3QmitniWgeECNfHBB/sMLML+zDr0dce83VNwksqk",
+	"D35PaRJZTi40iTxt4C9hR2/ut9ZTPFB13MdbNjIqEzYABAH1/qcogodNYMtTk+9IVbyGHbuGro64/dQ1",
+	"qkjCAaLGv5EZnaXbeln7HZhiej+noYLl9bdiPrNvgnH4LjoPgxY63FugUCqfoP3sISMKwSQHMlYo3HXh",
+	"glF8OIKnpBaQjmnnOw+uuypCNNMK2H+riqVc0pOrMlDLNKokQQH70gxCB3M6V7EGQ5DDBuxLkr48ftxd",
+	"+OPHbs+FZku49hFcjx/30fH4Melx3iptWofrHjSieNzOItcHWUXx4nOvkC5P2e9+40aespNvO4P7SelM",
+	"ae0IF5d/ZwbQOZnbKWsPaWSa65HZTlx5sJ7oumnfz8Wmyrm5D9MuXPE8UVdQliKDvZzcTSyU/PqK5z/W",
+	"3Sg6DVKk0RSSlGKqJo4FF9jHhmHtexs27qlis4FMcAP5jhUlpJBZVbnQTNcwHjHrUJyuuVyRpF+qauU8",
+	"Wu04xKkxTI8CoyrZGyIqDZmtTEg7HePcLorBR46hHAQc32Jd1bZ9eVzzej7IWgx9IvK6qv6oCXg+G3yq",
+	"IlKvmqeqRU47/G0CF28JagF+mokn2j8IdSi09PEVbgueAtzcT6Nrb4aOQdmfOLBkNR+HrFhNi19UeQnl",
+	"HTTx09YQTjO+nhhA0bWFDQfXWaF24R6kMjsQK6EoQdMdGurRtP2qlmFIr7tk9U4b2PRNDbbrbwNs5t3g",
+	"g1bJXEhINkrCLprFQkj4nj7Gett7fKAzSVRDfbuPpBb8HbDa80w5dXfFL+12lxN1TWr6G1Xelz3eDnio",
+	"yXbMRLrXjuumvK0RF4Nb+7ZPF/DXZXR6XicYESXjWqtUkFB5hu4qQjbmUuc/30b/2zqM4R7OXnfcjpEv",
+	"jCUnJTbkBeMszQWpuJXUpqxS815yUqIFS424MHptwbBa9ZVvEtfjRtSsbqj3kpP7aq1ai7pdLSGiR/oG",
+	"wGtXdbVagTadx9gS4L10rYRklRSG5trgcUnseSmgJD/CI9tyw3dsiTRhFPsdSsUWlWk/TyieVRtU0lqL",
+	"I07D1PK95IblwLVh3wt0+sLhvMeJP7ISzLUqL2ssxKWYFUjQQidxV8tv7VfygnfLXzuPePy/62xtVDh+",
+	"E/S6M9DKqfG/H/7XCebS4MnvT5KX/9/xh48vbh497v347OYvf/k/7Z+e3/zl0X/9Z2ynPOwiG4T87LV7",
+	"up+9pvdZY6Tqwf7ZDBQYoh0lstCVqENb7CFlFnAE9KitvTNreC/R4c4oTGwhMm5uRw7dG6Z3Fu3p6FBN",
+	"ayM62jq/1gNfPXfgMizCZDqs8dbSYt87OR7XjBvpQ5WxFVtW0m6lf2XYsD3vJaqW8zp23aa1OmEU2Lzm",
+	"3sXZ/fnsiy9n8yYguf4+m8/c1w8RShbZNhZ2nsE29ph1B4QOxgPNCr7TYOLcg2CPOsRa55Nw2A2gFkSv",
+	"RfH5OYU2YhHncD7AxynFtvJMWi9RPD9kg905045afn64TQmQQWHWsXQ3LUGNWjW7CdDxi8FwRZBzJo7g",
+	"qKuUyvBd7Fxzc+BLJFBrR1RTXn31ObCE5qkiwHq4kEmanxj9kMjjuPXNfOYu//t/MrmBY3B156wNrv5v",
+	"o9iDb7++YMeOYeoHhC03dBCzHlEZ2A9tjynDuEvyZYW89/K9fA1LIQV+P3kvM2748YJrkerjSkP5Fc+5",
+	"TOFopdiJj/R8zQ1/L3uS1mAeviDGlhXVIhcpKtxj5GlzK/VHeP/+V1Q7v3//oec80n8+uKmi/MVOkKAg",
+	"rCqTuMwwSQnXvIwZ53SdGYRGpt6js1ohW1VWg+vGZ278OM/jRaG7GQL6yy+KHJcfkKF28e+4ZUwbVXpZ",
+	"RGgPDe3vD8pdDCW/9vqjSoNmf9vw4lchzQeWvK+ePHkOrBUy/zd35Qv7Fp+sRRrMYNBVHtHC7bMStqbk",
+	"ScFXMRvg+/e/GuAF7T7JyxvcAhR0qVuIkzq8hoZqFuDxMbwBFo6DQ2lpcee2l88CGF8CfaItpDYobjSe",
+	"CbfdryB4/9bb1UkA0NulyqwTPNvRVWkkcb8zdXKwFRdSe3cRtDThIXB51BaoOoX00iW4gk1hdvNWd7Vs",
+	"CZqedQhtU5/ZcFJKvkMWFEyJVmTcieJc7rpZUDQY432e38El7C5Uk7vnkLQn7SwceuigEqUG0iUSa3hs",
+	"3RjdzXdubwgpLwqfzIIidT1ZnNR04fsMH2Qr8t7DIY4RRStLxBAieBlBBHUYQsEtForj3Yn0Y8vDV8bC",
+	"3nyRNGie9zPXpHk8OQ+1cDUX6/r7BiiPorrWbMFRblcuBaDNNBFwsUrzFQxIyKERa2I+h5bhiwbZd+9F",
+	"bzo0m7cvtN59EwXZNk5wzVFKAfyCpEKPmY5fop/J2kmdBYYy+zqELXISk2oHTst0eNkyJsrVGGhxAoZS",
+	"NgKHB6ONkVCyWXPtsxNm8+AsT5IBPmHmlLF8WWeBS12QqbHOhuV5bvec9l6XLmuWT5Xl82OFT8sJua7m",
+	"M+fFH9sOJUkAyiCHlV24bewJpcni0mwQwvHjcpkLCSyJeecFatDgmnFzAMrHjxmzGng2eYQYGQdgk/2f",
+	"BmY/qPBsytUhQEqXhYb7sclzIPgb4kGg1l8dRR5VIAsXA9a71HMA7lw66/ur41hMwzAh5wzZ3BXPQRr/",
+	"4msG6aVtIrG1k6TJeaA8GhJnRwwg9mI5aE3U41arCWUmD3RcoBuBeKG2iY0Cj0q8i+0C6T3qwo+9ogfT",
+	"Jsh6oNlCbcmria4W6zK+B5ZhODwYDQCU+QjXTv2GbnMLzNi049JUjAo1e1jLNg25DIkTU6YekGCGyOVh",
+	"kPPqVgB0lB1NAnn3+N37SG2LJ/3LvLnV5k0uRx8dFTv+Q0couksD+OtrYeosVW+7EktUT9Fq1UnQFYiQ",
+	"MaJnQkaMNH1TkIYc6FGQtISo5BJ28bcN0I1z7rsFygtKA8bl7lHg8VXCSmgDjRLd+4P8EepJTtlHlVoO",
+	"r84U5RLX906p+pqijlY52VrmZ18BuUwvRYm+uWiBiC4BG32j6VH9DTaNy0qtzWY2V7fI4ryBpsUom0zk",
+	"VZxe3bzfvcZpf6hZoq4WxG+FtI45C8otH/U0HZnaOiOPLviNXfAbfm/rnXYasClOXCK5tOf4FzkX3SDq",
+	"EXYQIcAYcfR3bRClIwwyiBDuc8dAbgps/Edj2tfeYcr82Hs9e3yc8tAdZUeKrqUBdHwVgsxEKJYIE6Rm",
+	"74fuDpwBXhQi23Z0oXbUwRczP0jh4RNadrBAu+sG24OBQO8Zix4qQbdzlzYCvk2y30qHdTQJMxftDKMh",
+	"QwinEtqXiOkjqo4u3IcrzJ/zHex+xra0nNnNfHY31WkM127EPbh+W29vFM9kmreqtJYl5ECU8wINXjxP",
+	"nIJ5iDRLdeVIk5p7ffRnZnVxNebF16dv3jrwUYeXAy+TWlQYXBW1K/5lVmXTpA4cEF+CAt98Xma3omSw",
+	"+XW+wlApfb0Gl8s/kEZ7SYcbg0MznldSL+MeQntVzs42Ypc4YiOBojaRNOo76tyxivArLnKvN/PQDnjz",
+	"0OKmZa6OcoVwgDtbVwIjWXKv7KZ3uuOno6GuPTwpnGuk2sDGFtTQTMmuCZ18u1EdR6SKnl0LcFqRPnOS",
+	"1YY0CYnORRrXscqFRuKQ1naGjRk1HhBGccRKDJhiZSWCsbDZlPxMHSCDOaLI1NEUUQ3uFsoVS6uk+EcF",
+	"TGQgDX4q6VR2DiqeS19wp3+douzQn8sNTH2C4e8iY4Tpsrs3HgExLmCElroeuK/rJ7NfaK2Rwh8Ck8QB",
+	"Bv9wxt6VOGKsd/ThqNk6L67bFrdpWZmnFFbzj1eXt3tgjmihNKGTZal+h/g7j57HkcAsNxEJU9T7KBL+",
+	"22UxtXanqffWzD643UPSTfCRtZ0UBqiedj4wy1F2Oq+h5tJutQ2Yafm6xQkmaKGP7fgNwTiYe564Ob9e",
+	"8PQyLmQgTKeNAbilSzeK+c4e97qOKrGzs8CWXLcVNui+gLKXVy9I4HNLgcFOO1lUaCQD7NiSCebW/pdr",
+	"FRmmktdcGvCZ6O1Rcr01WOUX9rpWJaXM0HG1fwap2PA8LjlkaV/Fm4mVsJWdKg1B6SA3kK2aZ6nIlV+q",
+	"Y6Ucas6W7Mk8qF/mdiMTV0KLRQ7U4qltgRZAWlttzfFdcHkgzVpT82cTmq8rmZWQmbW2iNWK1UIdPW9q",
+	"49UCzDWAZE+o3dOX7CGZ7bS4gkeIRXc/z06eviSlq/3jSewCcJW5xrhJRuzkF8dO4nRMdks7BjJuN+pR",
+	"NLuALc05zLhGTpPtOuUsUUvH6/afpQ2XfAVxT5HNHphsX9pNUqR18CIzW1dOm1LtmDDx+cFw5E8D3ufI",
+	"/iwYaE7eCLNxxh2tNkhPTV0gO6kfzhaps3dTDZf/SDbSwpuIOo/Iz6s0tfdbbNVkyf6Bb6CN1jnjNk9K",
+	"LhrvBV9ogp35NEw2c6lP125xg3Ph0knMwS2knMlCGnpYVGaZ/Jmla17yFNnf0RC4yeLLF5G8qu2cyfIw",
+	"wD873kvQUF7FUV8OkL2XIVxf9MeXyUYgq3/URHsEp3LQmBud1gzZDseHniqU4SjJILlVLXLjAae+E+HJ",
+	"kQHvSIr1eg6ix4NX9tkpsyrj5MEr3KGf3r1xUsZGlbHcis1xdxJHCaYUcAXZ4CbhmHfcizKftAt3gf6P",
+	"tTx4kTMQy/xZjj0EsETEyceB+gm1Jt35qke0A0PHFD8gGSzcUHPWzlX/+fno/XhBxS1dXrHdN2zhF48H",
+	"+qOLiD+YXGgDG1u+XckAoQS1OqIkk9XfAxs7Z1+p7VTC6ZxCTzz/BCiKoqQSefZzE/nZXuGi5DJdR21m",
+	"C+z4W1Pgsl6cvQNjJJauuZSQR4ez8uZvXi6NSM5/V1Pn2Qg5sW23OotdbmdxDeBtMD1QfkJErzA5ThBi",
+	"tR1UVztt5yuVMZqnycnXHNd+VZ+g9sI/KtAmFqBEH6zjmKEyn0jF1ImBzOhFesS+tTXs18BaCZfoJegz",
+	"YrSjpqsiVzybU6YOtCYwO6vtY8u02dIDK3oItVfR0YkF6UanuSDbDkPhEdPHGffXtrXIkjrBfSwAFVs0",
+	"KfhFx05AT6QQO0fsdVCNOih4Rolayg2+6urRrHxENIH/MYana2ygWqx1mOSn18zwVKmDmr7u/2lNifbc",
+	"IdyubIatmjFnCt/m10Lb0uVwBe2YVw+GVzv4GNj28spKSkspRwfccnXGzUPR7oGjcWtTQhSyDuIPFPpt",
+	"yZlDS4icU68YUfbqkfSK+doIyrqO2Pe+HDOXSoqUEnLFrmhX43yKnW1C7rKuItcfcXdCI4crWgWldsVz",
+	"WBysizKftRDXV/QHX3FTLXXYPw0V015zw1ZgtONs6I/uivk4XaOQGlxOVSSikE+qsmW7JA4ZNYcntdnk",
+	"QDKi0JuBx+M3+O0Hp1rAI8guhaRHhEObE/ysNpBKMBt8eQjDVgq0W087/lj/in2OKBQ3g+2HI1+ymcaw",
+	"pj9ctrVz94c69VZvZ2XGtq+wrUsEVf/c8nK2k54WhZt0uNRTVB7AZEdDCI5YLxNvPgqQW48fjjZCbqPu",
+	"KnSfIqFhai+mDRR0D/cIoy571Cmph0KrpShqwaybWAwpuZARMN4ICU1B8cgFkUavBNoYOq8D/XRacpOu",
+	"W2xon5GbLNwxhqaNM2/cdajOBhNKaI1+juFtbCo2DTCOukEjuHG5q+uYI3UHwsQrdH327gP9+kskVTkh",
+	"KuOmCfv2FZlijAMZt6/51r4A+segLxPZ7pQT7tCbaCgQdVFlKzAY5BhLcfsVfWX0lWUVgsYwL11Vp0It",
+	"CoZAdRPR9KnNTZQqqavNyFy+wR2nC0qcRaghLLPmdxgpDZVW+G8sD+jwzjhHj4NdDb1XR3ZY9qW+62RM",
+	"6kWaTjD8aTom6E65OzqaqW9H6E3/e6X0XK3agHzm9BNjXC7coxh/+xovjjA7Qy+5rb1a6uQJ5NinfGFa",
+	"ejbWYb9troTf+tluyaBUF74cV0AMl7Cc0+U34N4bJN3g9n61FsohJ9900CedGxcdZzgbZUGDEUfWQ4i+",
+	"Wyji2tkhryDrFISfe71vVYDbrXUUod7drA/Qd96XlRVcOPN7wyz6mHVe7/04hCn+sM0GdxfhfMkHNXbf",
+	"XQ35fftkbPS9W7nrElzIfFHClVCV27Da88k/Ce2vrVpYted9dP19xStN9ceqQweVtxeuioJdpnuTf/ez",
+	"9ZNjIE25+ydQ5fY2vVPsLbL3nZpsrlIbenLZehKNhqEuHz9VfXsRGNIQXX6OowMV8q3q9ikvTBUEwEcG",
+	"vRUPaGm+PwyjciSAwrYIzr7TJkwsK94WMKbkfIylF3RidqvI254Sdb3tfD1Fsurh42Y+O8sOkj1iKSpn",
+	"dpToDkQLyA1n8GqydhG3KpQWTUmBWGW5id6aF2twoSWOsPtjeVepK0gN1ZFoXEBKgEPykeFkQUHnf2fy",
+	"GqxS65xaXQKvsaxd/eIRe8SlXmBdEBxqE+8fTc9RdVo7+tGVRwm0VyBdTeV2yMxkx/3lElIjrvYEMv6y",
+	"BhkEyc29iotgWQZxjaJ2BKc8OIcrcBuAcn5LeHJ+f+AMhTFdwu6BZi1qiFYCmHup5TYpUAgDxB3Qvb9Q",
+	"mudDOnnn2yB0TRmEBe+4ZrtDk0xusIhYEJZ7y7k8STIehuqOTBmvYjRpLux6UAA7+TQPxTr2i6AMP+Ve",
+	"U80ZXRf49ClUQoUH6m67iSavXQoWCjutzVA+GQto/5uPMbez5OISwjJnZPTDAHrfIqrF8gqyZOQ+6gUo",
+	"MhEHelnPLBo3435IWn+PrTN5misUI5Ihj/y2Z2/tFvNAW/8lWzEASgfXEkpXDhJb4tiQGOXdksfgGEOF",
+	"tvW0b4MEPZgu1AI3mMTnXZOliNImc0raw51vVrhAVsKGI3RlkEtoeM4xZL+y330Mlk+bu1dZV9Pr/joV",
+	"3sFc6B4SQ6pfMndb7o/tuo3eTkhp6/LrWGIhCWXbsFSUKqtS94wJDkat25yctmuElURVXml/lZ03QhAg",
+	"ewm7Y/ue9AU+/A6GQFvJyYIeJKTobPK9ajJ1DO7VvYD3RyoB57NCqTwZsBud9bMhdSn+UmAuQYY3hXfE",
+	"HCi6xB6SuaJ2DLhe73z2n6IACdmjI8ZOpXV99z4C7XTcncnlAzM2/5ZmzSqboMzpJ4/ey7gPMaUOK+/I",
+	"zfww4zxMg8zuPJUdZHwisx3IxISp/folyI6mvsr7VvtuWaiGqCwUMZmkqXi0x+Wo9jZqisU0Hkd96SDP",
+	"1XVCVJTUqdRibw5s12aSPnls0w2xvYDAdYlrd4Hu2JpnLFVlCWnYIx4tYoHaqBKSXJEnU8zIujQoD23I",
+	"RVyyXK2YKvCZazMSenNUtJJRMNd9VW2ykc8WgsTazgZyS4B2kc4OXNu4D+9I4aTDizJdrCN6G9owv1sH",
+	"V15yBHdwIZEAzAmEvl9nddpfWHdd3RJnQwUHjdqINI7ufy3Hn0F3nRj1xlBhe7hYQmpGBzzkKbWdl05P",
+	"H80g0TEstl/u+Dl7F9E5/tcqiTvjsiVw05s74GeRWNaxVceKhUV2tZ7K1TLz4akDFBL1HRg31dsCkoup",
+	"Bvs6efdEZhAAMGzCb8EwyZB/KBhLKsia8AiSz2qZf96qly06HM8nVrQnO+X2zY/6Ji7yqgQXLkkHoVvC",
+	"qeBm7WUAbN5/meMrDzTFMto6NFxbPZLXZ7mylV3hShVJDlfQ8mxwMZxVmoLGwMyw5KXtzDKAgrS73TdH",
+	"zGQf8vaOIOrWngRG3ynYjUqmFrF2p9gesTMqJG9lYo+JnnqUEKIrkVW8hT99h+J/Q3X/IpePh/XDNE5x",
+	"MJOIL26MRex1sqn00LmUcR+bMIS4VinRbFmterZE2JxsXfBrOfwE6xNlIztNL5sZIPbrLaR0D7WdSO6O",
+	"E0aDMS1W+9fQEMRdnvKDVDZGZL0iolGpTYMvAh1m8vGCr+sbkXat0lHoyABCN7yBXFKhcXkMmqHGPBPL",
+	"JZTWrKINlxnqGoPmQrIUSsMFvjF3+vYPDIS2xHCmfW8M5NQ0qGdWsdcGaQgtIPnOPd6G5P8JcjvuQ0xm",
+	"t9e2UUP1TXu7Eo+R4Vt855Cz4AARuOh+euVQM6YkiZhswy/hwHm0+B3Gp6GcO04LaxTNOmWKm1Fa/5FQ",
+	"Rwf+JynMKLVb0a/rvWltQpYYPQ3KVWOYtpvTp8EijU9WtJ1uu8Uc/F5bBZWdDwaSUzremRBP1SMmX9BB",
+	"2anUqez64kCPGVtg5s4Z+SBpoatuSPcwpSiLHjgTbVldLYk6aVPsxaTKkB3Pu85B7Suo3nYqpJpWJQlR",
+	"13y3P8ddYuJQer9qO7J/zngfhxpqt9WWwEjGtfD3UsgdIp5EaD5WnqKfvOv+F2MDBho73KdbjtO0xxcQ",
+	"FvUfp7dGkPekEqE1Lnexo+N1ybdY4JB0MsHl9d62qj4tn2KDoiz6djldJ4HWd3+MYDMoNj3uRhGmfG5i",
+	"yUvrRUtmV/8e6vKL75t30rSS0b7DHvBC75qmXW3ocOD8wUHZ39dICZbyYYgSWsvf57DjFtg8LIMtcrKa",
+	"MWAT8NtAvva+BN5Y+lXt5DRUo73rC0X5nZW0xYV7PlRWfKQzFRKOwLv+iuef3w+KEn+fEj4gezdsOQ0d",
+	"aUIkW1Tq20VEvuGT5s75J5gay45egfwFcI+i14Ibyr1Ye8yfhH+eWy3/0pcOxeDpaxqTdpo9/ZItXMaY",
+	"ooRU6O5L+NpX9ar9RqjIpZ0CwxHHHVX2rfNnZe5AxkuvWGI/NBWCSJG9kg2EzRH9g5nKwMmNUnmM+npk",
+	"EcHfOI+icc4HqvCc13WBUpX7ogiuCk/LmzVkDQMO5zxNoaCsMMn1AAlbFb2nxjCq2XYOkuqFE/L6NRD6",
+	"KN+C9DZC66idzOKUXa+VhuYZTdZoqYxHDqo818CvBMJA7Ge68FHvB03lNjNyy6uqTPaCice4X4XARoo7",
+	"a/X+tYyEP+11hIxBLZV1Tos97E7rWl5TQG/clxBgm9Xfai7s+PcNudV83D+6PwmqLUcfPGK/NGernj5U",
+	"DFGmRNim4Io3cHfGrFEa03vcJnsYAVUOVTv9pUmfSe06VSOYsKlkT1hqcxk8FFQLWpW7R3OWCX3JHlJK",
+	"KmdUybjhaIB4xFTJ3Fmx1c+9j9kOzKPDwx/qK507FlwHP9zpvhvT5wXVRpotapFNtPgb6lD2EIAPBokM",
+	"y7RiS17eYim2UNDQzNZOdt2avzmwB041xEkajClbxK0WAuJ8Yo+vq8/h0CLgLo67h24eues6yKkX0Nr/",
+	"8Zs6vBmi1ubWglvrbcvuNLWtW0yPPwvTARVG9jKlQRLwW/4gnPVOQYO1M7KbcxyHv6jyEsohcYf0DXVB",
+	"2savK0TeNY3gs65URao2pNJtmkT095ZxJZaKRqnWNSGGF2T6vSSUCZlYvmc54ZAXOCUnmRrJ5eawgUq3",
+	"OIgoT4d+7mMTY9teYrfOuxI1T4UTpjiZz9LLhtXeGkyH14QQl+QgV7FAmnO1NNYq1M/Q390ZuxMj1T1v",
+	"IU+TBaQnSwdEQFlSVHmJJHeAy2lc3N+bhSKkpMGdnnepewDZNVZiJzQsJbFHfXXZCvRttMyBhk2VcM8B",
+	"v0HqjgMDfvtFMqYuj9ZBZFFp6K9z8ua3cBuRGJu1TY1W7yN3rKzllCDzeLVa7E5R7hYh2OiIEajsb0//",
+	"xkpY4oE0ij1+TBM8fjx3Tf/2rP0ZGcHjx9Gz+tni2y2O3Bhu3hjF/DyU8cxm9RpIrtfZD8zDt48wWqkS",
+	"MT4AJGihKRngby4h6+fV7XkIbKBY/6haWO8S3WoRE1lra/JgqiAJ4oT8h65bJNshOWGnVSnMjurEeAuc",
+	"+C0aif9tHYroQllrlwKnizPqEupKQ03gYqW9tu9bxXPSj1lPBwnMYB1i9vWWb4oc3EH5y4PFn+D5n19k",
+	"T54//dPiz0++eJLCiy9ePnnCX77gT18+fwrP/vzFiyfwdPnly8Wz7NmLZ4sXz158+cXL9PmLp4sXX778",
+	"04PZfCYQZAvozGcln/3PBEsyJ6dvz5ILBLbBCS8ERnve3JCpa6lIREKkpnQSYcNFPjvxP/3//oQdpWrT",
+	"DO9/nbmkx7O1MYU+OT6+vr4+CrscryhSKTGqStfHfp6beQfjp2/PapdI+/SlHbX5Ar1zmSeFU/r27uvz",
+	"C3b69uyoIZjZyezJ0ZOjpzi+KkDyQsxOZs/pJzo9a9r3Y0dss5OPN/PZ8Rp4btbujw2YUqT+Uwk827n/",
+	"62u+QvGHvF7tT1fPjv0L5/ijE4Zvxr4dB1cI/tz8lYhsT0+tgX5wBU3GW7cqhriAvqDDRCjGmh0v1PaA",
+	"pqCDxsNLIeOHPv5I0srg78cusWv8I5lR7Hk49tGf8ZYtLH00W4S10yPlJl1XxfFH+g/RZwCWTaN0bLby",
+	"mNxljj+KrP+5t5r27033sMXVRmXgAebZFZcpuB76ZrChtwztbXD80f9veDC1XNqyUGOfjz/af4eHqTM1",
+	"Hn+s/xtp7bNY6JFPxx+R/9zsb3FcYjbRAHZ815RiA9KEyLEvmmNKIL/r/7yTzu0mh5jq7CepwQQvI4Yd",
+	"Gv13zbzOMt/4fCdTbzvwiZNwvbNnT57Y6V/Qf2YuvXgn+vXY8Z6J1R/bKZyI4XdeRDW8TplrjmYEw9PP",
+	"B8OZpMB45OTM3lQ389kXnxMLZ3gQJM8ZtbTTP/+MmwDllUiBXcCmUCUvRb5jP8k6LW1Q7iZGgZdSXUsP",
+	"OYo51WbDyx09HzbqCjRzlXQC4mQlaLzlbOhCqTbd1z1faXKzokLDs7lN2PWBREQTk5a8Jb0/k/ciaAZv",
+	"n4pv956J6bvQFsJHNCGT4Jykm+y/IPr76/e+6zhmp3oQ26DZvxnBvxnBPTICU5Vy8IgG9xflpoDChTE1",
+	"as4BftC/LQNRZ1aoWIzn+QizUHKUV5y3eUVQy/rk12lFLJzrl/XqyUALV9+TXlD4PGgeOGXNkfyZJ9/u",
+	"YK/HKpTdfPinuN9fcenPc2vHbXg0L3MBZU0FXPbzm/+bC/w/wwVsoQZu93XODKALfnD2jaKzb+37tXXC",
+	"lj+cxgdaVvhGmG79fPyx9Wf78afXlcnUddCXFN7WrnOsvd0o8q33woo1rvTxNRcGVYUuFRHVWex3NsDz",
+	"Y5fCvfNrkzW194VSwQY/hkFh0V+P6zK20Y/dR3vsq3u0DjTycSX+c6PACxVixD1rVdivH5B3UZE0x1gb",
+	"/c7J8TGl91grbY5nN/OPHd1P+PFDTS4+NeKsKMUVQnPz4eb/DgBYp9uHDOcAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
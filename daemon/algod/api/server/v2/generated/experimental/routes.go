@@ -75,181 +75,194 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/ZPbNrLgv4LSe1X+OHE0/kjeeqpS7ya2k52Lk7jsSfbes30JRLYk7JAAFwA1Unz+",
-	"36/QAEiQBClqZmLvVt1P9oj4aDQajf5C98dZKopScOBazc4+zkoqaQEaJP5F01RUXCcsM39loFLJSs0E",
-	"n535b0Rpyfh6Np8x82tJ9WY2n3FaQNPG9J/PJPyjYhKy2ZmWFcxnKt1AQc3Ael+a1vVIu2QtEjfEuR3i",
-	"4sXs08gHmmUSlOpD+TPP94TxNK8yIFpSrmhqPilyzfSG6A1TxHUmjBPBgYgV0ZtWY7JikGfqxC/yHxXI",
-	"fbBKN/nwkj41ICZS5NCH87koloyDhwpqoOoNIVqQDFbYaEM1MTMYWH1DLYgCKtMNWQl5AFQLRAgv8KqY",
-	"nb2bKeAZSNytFNgW/7uSAH9Aoqlcg559mMcWt9IgE82KyNIuHPYlqCrXimBbXOOabYET0+uE/FgpTZZA",
-	"KCdvvntOnjx58swspKBaQ+aIbHBVzezhmmz32dksoxr85z6t0XwtJOVZUrd/891znP+tW+DUVlQpiB+W",
-	"c/OFXLwYWoDvGCEhxjWscR9a1G96RA5F8/MSVkLCxD2xje90U8L5v+iupFSnm1IwriP7QvArsZ+jPCzo",
-	"PsbDagBa7UuDKWkGfXeaPPvw8dH80emnf3t3nvy3+/OrJ58mLv95Pe4BDEQbppWUwNN9spZA8bRsKO/j",
-	"442jB7URVZ6RDd3i5tMCWb3rS0xfyzq3NK8MnbBUivN8LRShjowyWNEq18RPTCqeGzZlRnPUTpgipRRb",
-	"lkE2N9z3esPSDUmpskNgO3LN8tzQYKUgG6K1+OpGDtOnECUGrhvhAxf0z4uMZl0HMAE75AZJmgsFiRYH",
-	"rid/41CekfBCae4qddxlRS43QHBy88Fetog7bmg6z/dE475mhCpCib+a5oStyF5U5Bo3J2dX2N+txmCt",
-	"IAZpuDmte9Qc3iH09ZARQd5SiBwoR+T5c9dHGV+xdSVBkesN6I278ySoUnAFRCz/Dqk22/6/3v78ExGS",
-	"/AhK0TW8pukVAZ6KDLITcrEiXOiANBwtIQ5Nz6F1OLhil/zflTA0Uah1SdOr+I2es4JFVvUj3bGiKgiv",
-	"iiVIs6X+CtGCSNCV5EMA2REPkGJBd/1JL2XFU9z/ZtqWLGeojakyp3tEWEF335zOHTiK0DwnJfCM8TXR",
-	"Oz4ox5m5D4OXSFHxbIKYo82eBherKiFlKwYZqUcZgcRNcwgexo+DpxG+AnD8IIPg1LMcAIfDLkIz5nSb",
-	"L6SkawhI5oT84pgbftXiCnhN6GS5x0+lhC0Tlao7DcCIU49L4FxoSEoJKxahsbcOHYbB2DaOAxdOBkoF",
-	"15RxyAxzRqCFBsusBmEKJhzXd/q3+JIq+Prp0B3ffJ24+yvR3fXRHZ+029gosUcycnWar+7AxiWrVv8J",
-	"+mE4t2LrxP7c20i2vjS3zYrleBP93eyfR0OlkAm0EOHvJsXWnOpKwtl7/tD8RRLyVlOeUZmZXwr7049V",
-	"rtlbtjY/5fanV2LN0rdsPYDMGtaowoXdCvuPGS/OjvUuqle8EuKqKsMFpS3FdbknFy+GNtmOeSxhntfa",
-	"bqh4XO68MnJsD72rN3IAyEHcldQ0vIK9BAMtTVf4z26F9ERX8g/zT1nmprcuVzHUGjp2VzKaD5xZ4bws",
-	"c5ZSg8Q37rP5apgAWEWCNi0WeKGefQxALKUoQWpmB6VlmeQipXmiNNU40r9LWM3OZv+2aOwvC9tdLYLJ",
-	"X5leb7GTEVmtGJTQsjxijNdG9FEjzMIwaPyEbMKyPRSaGLebaEiJGRacw5ZyfdKoLC1+UB/gd26mBt9W",
-	"2rH47qhggwgntuESlJWAbcN7igSoJ4hWgmhFgXSdi2X9w/3zsmwwiN/Py9LiA6VHYCiYwY4prR7g8mlz",
-	"ksJ5Ll6ckO/DsVEUFzzfm8vBihrmbli5W8vdYrVtya2hGfGeIridQp6YrfFoMGL+XVAcqhUbkRup5yCt",
-	"mMZ/dW1DMjO/T+r8r0FiIW6HiQsVLYc5q+PgL4Fyc79DOX3CceaeE3Le7XszsjGjxAnmRrQyup923BE8",
-	"1ii8lrS0ALov9i5lHJU028jCektuOpHRRWEOznBAawjVjc/awfMQhQRJoQPDt7lIr/5K1eYOzvzSj9U/",
-	"fjgN2QDNQJINVZuTWUzKCI9XM9qUI2YaooJPlsFUJ/US72p5B5aWUU2DpTl442KJRT32Q6YHMqK7/Iz/",
-	"oTkxn83ZNqzfDntCLpGBKXucnZMhM9q+VRDsTKYBWiEEKayCT4zWfRSUz5vJ4/s0aY9eWpuC2yG3CNwh",
-	"sbvzY/Ct2MVg+FbsekdA7EDdBX2YcVCM1FCoCfC9cJAJ3H+HPiol3feRjGNPQbJZoBFdFZ4GHt74ZpbG",
-	"OHu+FPJm3KfDVjhpTM6EmlED5jvvIAmbVmXiSDFitrINOgM1Xr5xptEdPoaxFhbeavonYEGZUe8CC+2B",
-	"7hoLoihZDndA+pso019SBU8ek7d/Pf/q0ePfHn/1tSHJUoq1pAVZ7jUoct/pZkTpfQ4P+itD7ajKdXz0",
-	"r596Q2V73Ng4SlQyhYKW/aGsAdSKQLYZMe36WGujGVddAzjlcF6C4eQW7cTa9g1oL5gyElaxvJPNGEJY",
-	"1sySEQdJBgeJ6djlNdPswyXKvazuQpUFKYWM2NfwiGmRijzZglRMRLwpr10L4lp48bbs/m6hJddUETM3",
-	"mn4rjgJFhLL0jk/n+3boyx1vcDPK+e16I6tz807ZlzbyvSVRkRJkonecZLCs1i1NaCVFQSjJsCPe0d+D",
-	"RlHgkhXwVtOi/Hm1uhtVUeBAEZWNFaDMTMS2MHK9glRwGwlxQDtzo05BTxcx3kSnhwFwGHm75ynaGe/i",
-	"2A4rrgXj6PRQe54GWqyBMYds3SLL22urQ+iwU91TEXAMOl7hZzR0vIBc0++EvGwsgd9LUZV3LuR155y6",
-	"HOoW40wpmenrdWjG13k7+mZtYD+JrfGLLOi5P75uDQg9UuQrtt7oQK14LYVY3T2MsVligOIHq5Tlpk9f",
-	"NftJZIaZ6ErdgQjWDNZwOEO3IV+jS1FpQgkXGeDmVyounA3Ea6CjGP3bOpT39MbqWUsw1JXSyqy2Kgl6",
-	"b3v3RdMxoak9oQmiRg34rmqno21lp7OxALkEmu3JEoATsXQOIue6wkVSdD1rL9440TDCL1pwlVKkoBRk",
-	"iTNMHQTNt7NXhx7BEwKOANezECXIispbA3u1PQjnFewTDJRQ5P4Pv6oHXwBeLTTNDyAW28TQW6v5zgvY",
-	"h3ra9GME1508JDsqgfh7hWiB0mwOGoZQeBROBvevC1FvF2+Pli1I9Mf9qRTvJ7kdAdWg/sn0fltoq3Ig",
-	"/M+pt0bCMxvGKRdesIoNllOlk0Ns2TRq6eBmBQEnjHFiHHhA8HpFlbY+ZMYzNH3Z6wTnsUKYmWIY4EE1",
-	"xIz8q9dA+mOn5h7kqlK1OqKqshRSQxZbA4fdyFw/wa6eS6yCsWudRwtSKTg08hCWgvEdsuxKLIKorl0t",
-	"Lsiivzh0SJh7fh9FZQuIBhFjgLz1rQLshiFQA4Aw1SDaEg5THcqp467mM6VFWRpuoZOK1/2G0PTWtj7X",
-	"vzRt+8RFdXNvZwIURl659g7ya4tZG/y2oYo4OEhBr4zsgWYQ6+zuw2wOY6IYTyEZo3xU8Uyr8AgcPKRV",
-	"uZY0gySDnO77g/5iPxP7eWwA3PFG3RUaEhvFFN/0hpJ90MjI0ALHUzHhkeAXkpojaFSBhkBc7wMjZ4Bj",
-	"x5iTo6N79VA4V3SL/Hi4bLvVkRHxNtwKbXbc0QOC7Dj6FIAH8FAPfXNUYOek0T27U/wXKDdBLUccP8ke",
-	"1NASmvGPWsCADdUFiAfnpcPeOxw4yjYH2dgBPjJ0ZAcMuq+p1CxlJeo6P8D+zlW/7gRRNyPJQFOWQ0aC",
-	"D1YNLMP+xMbfdMe8mSo4yfbWB79nfIssJ2cKRZ428FewR537tQ3sDEwdd6HLRkY19xPlBAH14WJGBA+b",
-	"wI6mOt8bQU1vYE+uQQJR1bJgWtuA7baqq0WZhANE/RojMzonng2K9Dswxav4FocKltffivnM6gTj8F12",
-	"FIMWOpwuUAqRT7CQ9ZARhWBSvAcphdl15mLHffSwp6QWkI5powe3vv7vqRaacQXkv0RFUspR5ao01DKN",
-	"kCgooABpZjAiWD2ni+xoMAQ5FGA1Sfzy8GF34Q8fuj1niqzg2j+4MA276Hj4EO04r4XSrcN1B/ZQc9wu",
-	"ItcHOnzMxee0kC5PORxZ4EaespOvO4PXXiJzppRyhGuWf2sG0DmZuylrD2lkWlQFjjvJlxMMHVs37vtb",
-	"VlQ51XfhtYItzROxBSlZBgc5uZuYCf5yS/Of6274mARSQ6MpJCk+gZg4FlyaPvbVxCHdsIkmY0UBGaMa",
-	"8j0pJaRgo/yNyKdqGE+Ijf9LN5SvUdKXolq7ADQ7DnLqSlmbiqx4b4ioNKR3PEHrdIxzu6Bj/9DDyEFA",
-	"jS7WNW1bzeOa1vO5tz1TrtQAeV1Tf9S7NZ8NqqoGqdtGVbXIab9WmcDFW4JagJ9m4ok+EESdEVr6+Aq3",
-	"xZwCs7l/jq29GToGZX/iICSu+TgUFWf05Hx/B9KKHYhIKCUovFtC+5KyX8UqfJnmLh+1VxqKvgnedv1t",
-	"4Pi9GVT0BM8Zh6QQHPbRx9iMw4/4MXqc8H4b6IySxlDfrvLQgr8DVnueKdR4W/zibndPaNfVpL4T8q58",
-	"mXbAyXL5BNfhQT+5m/KmDk6a5xGfoHu30mUAal6/k2eSUKVEylDYusjU3B4050Z0j1za6H9dR+Pewdnr",
-	"jttxfoVPItG4C3lJKElzhqZfwZWWVarfc4rGpWCpkaglr0UPmxuf+yZx+2bE/OiGes8pRqzVJqdopMUK",
-	"IvaV7wC81VFV6zUo3VFSVgDvuWvFOKk40zhXYY5LYs9LCRJDh05sy4LuycrQhBbkD5CCLCvdFtvxWZbS",
-	"LM+dJ85MQ8TqPaea5ECVJj8yfrnD4by33h9ZDvpayKsaC/HbfQ0cFFNJPLrqe/sVA1/d8jcuCBaf0dvP",
-	"1ndjxm/ebu3R9tQ8Df8/9//z7N158t80+eM0efY/Fh8+Pv304GHvx8efvvnm/7Z/evLpmwf/+e+xnfKw",
-	"xx4NOcgvXjiV9uIF6i2N86YH+2cz3BeMJ1EiC8MwOrRF7uMDWUdAD9pWLb2B91zvuCGkLc1ZZnjLTcih",
-	"e8P0zqI9HR2qaW1Ex4rl13qkNnALLkMiTKbDGm8sRfUDEuPP89Cb6F7c4XlZVdxupZe+7esTHxgmVvP6",
-	"CabNznJG8H3ehvqoRvfn46++ns2bd3X199l85r5+iFAyy3ax15MZ7GJKnjsgeDDuKVLSvQId5x4IezQG",
-	"zgZlhMMWUCxBqg0rPz+nUJot4xzOx/Q7Y9GOX3AbbG/OD/om987lIVafH24tATIo9SaWtaElqGGrZjcB",
-	"OvEipRRb4HPCTuCka6zJjL7oovFyoCvMHoDap5iiDdXnwBKap4oA6+FCJllEYvSDIo/j1p/mM3f5qztX",
-	"h9zAMbi6c9aOSP+3FuTe9y8vycIxTHXPPuS1QwdPLyOqtHtd1IokMtzM5qqxQt57/p6/gBXjzHw/e88z",
-	"quliSRVL1aJSIL+lOeUpnKwFOfMPll5QTd/znqQ1mE4qeCpGymqZs5RchQpJQ542RUh/hPfv39F8Ld6/",
-	"/9ALquirD26qKH+xEyRGEBaVTlyCg0TCNZUxp5WqH7jjyDaDydisVsgWlbVs+gQKbvw4z6NlqboPXfvL",
-	"L8vcLD8gQ+WecZotI0oL6WURI6BYaHB/fxLuYpD02ttVKgWK/F7Q8h3j+gNJ3lenp0+AtF5+/u6ufEOT",
-	"+xImW1cGH+J2jSq4cKtWwk5LmpR0HfONvX//TgMtcfdRXi7QxpHnBLu1Xpz6iHocqlmAx8fwBlg4jn49",
-	"h4t7a3v5ZFbxJeAn3EJsY8SNxmN/0/0K3qDeeLs671h7u1TpTWLOdnRVypC435k6x83aCFk+jEKxNWqr",
-	"Lh3QEki6gfTK5WmBotT7eau7j9RxgqZnHUzZDD72BRnmkEDPwhJIVWbUieKU77uP+RVo7eOB38AV7C9F",
-	"k4LimNf77cfkauigIqUG0qUh1vDYujG6m+/CwVCxL0v/Jhsf53myOKvpwvcZPshW5L2DQxwjitZj5yFE",
-	"UBlBhCX+ARTcYKFmvFuRfmx5RstY2psvks3H837imjTKk4vcCleDVnf7vQBMByauFVlSI7cLl8nKPpgO",
-	"uFil6BoGJOTQuTPxWXLLIYSDHLr3ojedWHUvtN59EwXZNk7MmqOUAuaLIRVUZjrxen4m6z90nglMUOkQ",
-	"tsxRTKoDGy3TobLlZLMZ94ZAixMwSN4IHB6MNkZCyWZDlU+yhbnI/FmeJAP8iQkAxtK+XAShZkHCsTqp",
-	"i+e53XPa0y5d8hef8cWneQlVywkpW4yEj9Htse0QHAWgDHJY24Xbxp5QmmQEzQYZOH5erXLGgSSxqLXA",
-	"DBpcM24OMPLxQ0KsBZ5MHiFGxgHY6BfHgclPIjybfH0MkNwlU6B+bPSoB39D/N2XjeM2Io8oDQtnA16t",
-	"1HMA6kId6/urE3CLwxDG58SwuS3NDZtzGl8zSC/7CIqtnVwjLjLjwZA4O+IAsRfLUWuyV9FNVhPKTB7o",
-	"uEA3AvFS7BL78DMq8S53S0Pv0dB2fIYaO5g2z8s9RZZih9E+eLXYUOoDsAzD4cEINPwdU0iv2G/oNrfA",
-	"jE07Lk3FqFAhyThzXk0uQ+LElKkHJJghcrkfpG65EQAdY0eTB9kpvweV1LZ40r/Mm1tt3qQk86+GYsd/",
-	"6AhFd2kAf30rTJ1s5XVXYonaKdpBK+08M4EIGSN6wyb6Tpq+K0hBDqgUJC0hKrmKeU6NbgN447z13QLj",
-	"BWazoXz/IIiEkrBmSkNjRPdxEl/CPEkxiZ4Qq+HV6VKuzPreCFFfU9aNiB1by/zsK8BQ4hWTSifogYgu",
-	"wTT6TqFS/Z1pGpeV2rFWNuUsy+K8Aae9gn2SsbyK06ub94cXZtqfapaoqiXyW8ZtwMoSUyRHIzBHprZB",
-	"uqMLfmUX/Ire2XqnnQbT1EwsDbm05/gXORcdzjvGDiIEGCOO/q4NonSEQQYvZ/vcMZCbAh//yZj1tXeY",
-	"Mj/2wagd/3536I6yI0XXEhgMRlfB0E1kxBKmgwzD/SetA2eAliXLdh1bqB11UGOmRxk8fF62DhZwd91g",
-	"BzAQ2D1jr2okqHYKvkbAt7miWxlwTiZh5rKdKC9kCOFUTPlKB31E1a/uDuHqEmj+A+x/NW1xObNP89nt",
-	"TKcxXLsRD+D6db29UTyja96a0lqekCNRTstSii3NE2dgHiJNKbaONLG5t0d/ZlYXN2Nevjx/9dqB/2k+",
-	"S3OgMqlFhcFVYbvyX2ZVNtvfwAHxmdSNzudlditKBptfpygLjdLXG3ApqQNptJc7s3E4BEfRGalX8Qih",
-	"gyZn5xuxSxzxkUBZu0ga8531kLS9InRLWe7tZh7agWgeXNy0BKxRrhAOcGvvSuAkS+6U3fROd/x0NNR1",
-	"gCeFc40kzS5sXnhFBO+60DHmeV86r3tBMfOltYr0mROvCrQkJCpnadzGypfKEAe3vjPTmGDjAWHUjFix",
-	"AVcsr1gwlmk2JbdNB8hgjigyVTS9ToO7pXA1fyrO/lEBYRlwbT5JPJWdg4ppUpy1vX+dGtmhP5cb2Fro",
-	"m+FvI2OEWV+7Nx4CMS5ghJ66HrgvapXZL7S2SJkfApfEEQ7/cMbelTjirHf04ajZBi9u2h63sERPn/8Z",
-	"wrC52g/XB/LKq0s/OzBHtN4PU8lKij8gruehehx5sOTz3DKMcvkDwocOYZWLFouprTtN2aJm9sHtHpJu",
-	"QitUO0hhgOpx5wO3HCbc9BZqyu1W24ckrVi3OMGEUaULO35DMA7mXiRuTq+XNJaN1AgZBqbzxgHcsqVr",
-	"QXxnj3tVv7aws5PAl1y3ZfYxegmyeUvYT2xzQ4HBTjtZVGgkA6TaUCaYW/9frkRkmIpfU26ruJh+9ii5",
-	"3gqs8cv0uhYSU0mouNk/g5QVNI9LDlnaN/FmbM1sgZJKQVABww1kiz9ZKnJVROo3RA41FytyOg/K8Ljd",
-	"yNiWKbbMAVs8si2WVCEnrw1RdRezPOB6o7D54wnNNxXPJGR6oyxilSC1UIfqTe28WoK+BuDkFNs9ekbu",
-	"o9tOsS08MFh09/Ps7NEzNLraP05jF4ArMDPGTTJkJ39z7CROx+i3tGMYxu1GPYm+urcV5oYZ18hpsl2n",
-	"nCVs6Xjd4bNUUE7XEI8UKQ7AZPvibqIhrYMXntnySEpLsSdMx+cHTQ1/Gog+N+zPgkFSURRMF865o0Rh",
-	"6Kkpb2En9cPZWksuM7GHy39EH2npXUQdJfLzGk3t/RZbNXqyf6IFtNE6J9TmD8lZE73g86WTC5+eCFM1",
-	"1xmaLW7MXGbpKOZgMMOKlJJxjYpFpVfJX0i6oZKmhv2dDIGbLL9+GklP3U6Tyo8D/LPjXYICuY2jXg6Q",
-	"vZchXF9ynwueFIajZA+a1x7BqRx05sbddkO+w/GhpwplZpRkkNyqFrnRgFPfivD4yIC3JMV6PUfR49Er",
-	"++yUWck4edDK7NAvb145KaMQMpZzsDnuTuKQoCWDLcbuxTfJjHnLvZD5pF24DfRf1vPgRc5ALPNnOaYI",
-	"fCsi2qlPmV5b0l2sesQ6MHRMzQdDBks31Jy001N/fj56N1FQcU+XN2z3HVvmi8cD/tFFxBcmF9zAxpdv",
-	"VzJAKEF6/ijJZPX3wMdOybdiN5VwOqfQE88/AYqiKKlYnv3avPzsVD+QlKebqM9saTr+1tRpqxdn78Bo",
-	"+sAN5Rzy6HBW3vzNy6URyfnvYuo8BeMT23YLMtjldhbXAN4G0wPlJzToZTo3E4RYbT+qq4O287XICM7T",
-	"5Kprjmu/kEeQbv0fFSgde6CEH2zgGNpGDTuw2b4J8Aw10hPyvS3FvAHSSkSEmqDPFNF+NV2VuaDZHDNY",
-	"XL48f0XsrLaPrTZks42vURFqr6JjEwvScE4LQfaFg+LPI6aPMx6vbVatdFInB489QDUtmvTlrOMnQBUp",
-	"xM4JeREUVbVvVc0QBBOYyMJodfVoVj5CmjD/0ZqmG1T7Wqx1mOSnp8n3VKmC0pR1iak6NyWeOwO3y5Rv",
-	"E+XPiTC6+TVTtgIvbKH95rV+AO7MDv4NbHt5suLcUsrJEbdcnYnyWLR74OwV6V0JUcg6iD9S6LdVJo6t",
-	"GvAWe0VTZXVLEPRqUtoXlHXpIF9ZPaVccJZioqrYFe1K9U7xs03I6dU15Poj7k5o5HBFCx/UoXgOi4Ol",
-	"EDwjdIjrG/qDr2ZTLXXYPzXWhN1QTdagleNskM19/Q5na2Rcgcs1ioWdAz4pZMt3iRwy6g5ParfJkWSE",
-	"T28GlMfvzLefnGkBY9KvGEclwqHNCX7WGoiVRLXRPJgmawHKraf9/li9M31O8CluBrsPJ77yKI5hXX9m",
-	"2dbP3R/q3Hu9nZfZtH1u2roESfXPrShnO+l5WbpJh6u7ROUBveODCI54LxPvPgqQW48fjjZCbqPhKnif",
-	"GkKDLTq7ocR7uEcYdaWTThUtI7RaisIWxIaJRbMkMB4B4xXj0NTFjVwQafRKwI3B8zrQT6WSaisCTuJp",
-	"l0Bz9HDHGJrSzr1x26G66aEMSnCNfo7hbWyKtAwwjrpBI7hRvq/L8RrqDoSJ51gH3CGyX3IFpSonRGX4",
-	"aqFThCXGOAzj9mWe2hdA/xj0ZSLbHXOlHXsTDT1EXVbZGnRCsyyW+vVb/ErwK8kqlBxgB2lVpwgtS5Ji",
-	"3pV2Ipo+tbmJUsFVVYzM5RvccrqgqlGEGsLKSn6H8aHLco//xvJjDu+MC/Q4OtTQR3Vkx2Vf6odOxqRe",
-	"Q9OJYutkOibwTrk9Opqpb0boTf87pfRcrNuAfOb0E2NcLtyjGH97aS6OMDtDL+mrvVrq5AkY2Cd8LUpU",
-	"G+tnv22uhFdZLwssOpTqWnfjBojhqnVzvPwGwnuDpBvU3q/WQzkU5JsOxqRT7V7HaUpGWdDgiyMbIWTf",
-	"FiEUcevsUFSQDQoyn3u9p0mGPTlbxxMfBgj14WZ9gH7wsaykpMy53xtm0cesi3rvv0OYEg/bbHB3ES6W",
-	"fNBi98N2KO7bJ2PD792qVlfgnsyXErZMVN6x7SOfvEpof23ViKoj76Pr7xtecaovaw4dNN5euuoCdplO",
-	"J//hVxsnR4Bruf8nMOX2Nr1XL6sv7VrzVNOE1ImpJyWqbt2KUxIVxnLiOdmwVbHrQL2xHlm9mCIO9OuH",
-	"zWcX2VEXZiyv4syOEjt28Wpgw2mnmlRTeMRKoViTHz5WJmxiiOElVvoK0mb1x/LxPVtINRYFaOIWJMAx",
-	"SbTMZEHh0f+ffmpAna4jMV3WqbFUU/1KAAfu+N5rsOBFo82ifjI9sdJ5HZ2GfBqzIa+Bu9qf7Xcek6PN",
-	"VytINdseeH33tw3w4GXX3NtlbA3v4DEeq6OXMXnL8VbHBqCxx3Gj8ARJFG8NztDbmyvY31OkRQ3RtO5z",
-	"f9XeJG8HYgC5Q2JIRKhY9Ic1JDuHPFM1ZSAWfLSV7Q5NBrTBilDBW9IbzuVJ0lwczfvSkSnjJWkmzWW6",
-	"HvXqGgNxhx7o9StaDOsfL7CAiKqrNfq8H6GWTi762RGvXd4QfCtZ+058BhFQ/jf/MNrOkrMrCGtWoafq",
-	"msrMt4iaXrxVJxm5j3qv6nw1hi7Qq3pm1sTG9t9RRfJtYQR0mgsjRiRDYeTtcNQ6luOeskE3Nv07Btoa",
-	"uFYgXW0/lH9zoSDRwsfSjsExhgobWXQjJKjBHJcWuMHMM2+a1DqY65diphnqAorCBRIJBTXQySABzvCc",
-	"Y8h+br/7h0M+1+tBC1NNr4eLDvioaKZ6SAypfkXcbXn4QdJNjE2Mc1s/WsWy4XCQbW9IKUVWpfaCDg9G",
-	"bZCbnGtqhJVE7TRpf5UdHSF41XkF+4VVgny1Br+DIdBWcrKgB1kUOpt8p+Y3FYN7fSfgfUnL1XxWCpEn",
-	"A86Oi34Kny7FX7H0CjJibgofPThQQYfcRxt77c2+3ux9ypqyBA7ZgxNCzrmN1/aO7XYO6c7k/J4em3+H",
-	"s2aVzarljGon73k88BXzXclbcjM/zDgPU2BY3S2nsoMcSBCzG0gfJOl1pJ7UyVStvO9q7tb4aYjKQhGT",
-	"SZryNQfiZOoQmabyRxMm05cO8lxcJ0hFSZ3/K6ZzmHZtJukznjbdDLaXEMTbUOUu0D3Z0IykQkpIwx7x",
-	"Jw4WqEJISHKB4Tcxz+BKG3mowLhmTnKxJqI0aq5No+d9KNGyNMFcd1WCxz7XtRAk1uEzkBABlHue68C1",
-	"jfvwjlTBOb7CzuUmYrfBDfO7dXQZHUdwR1e/CMCcQOiHbVbnsSpB7XV161UNVY/TomBpHN3/WtEqgzEm",
-	"MeqNocIloLUP4LAZHvCQp9TOSTw9fTQDp8s85rEh7vg5Jw3Sufkv3mDdcckKHHMZ4GeRB5hjq45Vfors",
-	"aj2VK0zl31QOUEjU4T3uX7bVAJdTvcx1xumJzCAAYNjv3IJhkvf5WDBWWF0zoREkX9Qy/7xV/Jh1OJ7P",
-	"BmhPdkqtzr8BYsauJLg3frYMYKfuUEn1xssApnlfMzdaHih8gGeLp1Bl7UjenuVqEHaFK1EmOWyh5Y53",
-	"Dw+rNAWl2BbC+oW2M8kASrTudnWOmJ855O0dQdStPQk8lVOwG5VMLWLtTpEDYmdUSN7xxB4TNfUoGYi2",
-	"LKtoC3/qFpXchoq4RS4fD+uHaZziaCYRX9wYizgYGYI0Hz2XPB4YEr57rU1KOFtWm54tETYnW5X0mg+r",
-	"YH2ibGSn6TUQA8S+3EGK91A78uH2OCE4GFGdN+2DQpOsd/imqvwglY0RWa8iZFRqU+Ar+obpZ7zg6/pG",
-	"pF1rdGQqMgBTDW/AOEpo4vSCZgXdk4ytViCtW0VpyjMqs7A54yQFqSkzOuZe3VzBMNDKCuYHdQzDqXFQ",
-	"z6xi2gZaCC0g+d4pb0Py/wS5HX1oEZndXttaDBWr7O1K/GEH3Rk9ByPcBojAPUlHLcceVsFRxCQFvYIj",
-	"51HsDxifBhPFOCusFjjrlCk+jdL6z4g6PPC/cKZHqd2Kft2QQ+sTssToaZCvG8e03Zw+DcaiRC9tyaQw",
-	"UrRbgcDvtTVQ2flgIKOi450J8lQ14vIFFdRKSp3Jri8O9JixBWbuImiPkha65ob0AFOKsuiBM9GW1cUK",
-	"qRM3xV5MGDdQs+N5N6KlfQXV247VP9NKohB1TfeHE7M111A8GNiO7NUZH+NQQ+222hKYsgUlonnPjhFP",
-	"IjQfq6nQzzh194uxUe6NH+7PW46ztMcXEFZoH6e3RpD3pBKhNcr3saPjbck3WOCQdDIhTvPOtqo+LX/G",
-	"BkVZ9M0SkU4CrR+zF8FmUDl4PIwizFPcPICWNvQT3a5eH+ryix8bPWlaDWPf4QB4YXRNUMXYOzocOF/4",
-	"JfGPNVKCpXwYooTW8g8F7LgFNoplsEVOVtMabNZ4+/qsvS9BNJZ6Xgc5DRXc7sZCYVJiIxzkeSSGyoqP",
-	"tsRtQDjmnpRbmn/+OCjMVn2O+IDszbDnNAykCZFsUalu9ozvFZ00dxA0c3dT89cYt/U3MHsUvRbcUE5j",
-	"7TF/FP5pbq38K1/vcgucXOOYNuj70ddk6dKclBJSprqa8LUvRVXHjWBlRvd0cqcPBKocWuevQt+CjFfe",
-	"sER+asraoCF7zRsImyP6hZnKwMmNUnmM+npkEcFfjEeF+UYPXBdXrWjwRqoLbjQh4Y6jwoP3XUdGhfcz",
-	"qU5dno18NpdOpaC/zsm3dQu3kYu6WdvUJw195I7VPpnyEiFe0sh0x6cQFiFYD4wgqOT3R78TCSss+CvI",
-	"w4c4wcOHc9f098ftz+Y4P3wYVfI+2yMIiyM3hps3RjG/Dj2Lt0+/BzIwdPajYnl2iDBa+TSaktmYMeI3",
-	"l7XnixTt/s0GZvaPqiuceotocouYyFpbkwdTBZkyJiTJcN0iKTEw6CGtJNN7TCbsNV72W/S5xvd16K8L",
-	"Ha9NeO7u0+IK6nTUTaBwpfzt+r2gOd5H1rLIzS0k8hPyckeLMgd3UL65t/wPePKXp9npk0f/sfzL6Ven",
-	"KTz96tnpKX32lD569uQRPP7LV09P4dHq62fLx9njp4+XTx8//fqrZ+mTp4+WT79+9h/3DB8yIFtAZz51",
-	"3ex/Y2X75Pz1RXJpgG1wQkv2A+xtEV1Dxr48L03xJEJBWT478z/9T3/CTlJRNMP7X2cuM9Zso3WpzhaL",
-	"6+vrk7DLYo2RgYkWVbpZ+Hl69XvPX1/ULkhr9McdtUklvDPHk8I5fnvz8u0lOX99cdIQzOxsdnpyevLI",
-	"jC9K4LRks7PZE/wJT88G933hiG129vHTfLbYAM0xkN78UYCWLPWfJNBs7/6vrul6DfLE1Sw2P20fL7xY",
-	"sfjoIiQ/jX1bhOW/Fh9bgaTZgZ5YHmjx0We9HW/dSivrAmiDDhOhGGu2WGIyralNQQWNh5eCyoZafERx",
-	"efD3hcv+E/+Iaos9DwsfbR1v2cLSR70zsHZ6pFSnm6pcfMT/IH0GYNm3tgu94ws0Ty8+tlbjPvdW0/69",
-	"6R622BYiAw+wWK1sFu+xz4uP9t9gItiVIJkR/Gx8uzPF18fqIpudzV4GjZ5vIL3CwlfWD4Pn5fHpaSQR",
-	"QdCL2ONLlzlk5uw9PX06oQMXOuzkUrT2O/7Cr7i45gSfrVpeXhUFlXuUkXQluSI//0DYikB3Cqb8DMg/",
-	"6FqhuRar7MzmsxZ6PnxySLPPtBaYenDf4NL/vOdp9Mf+NncrjMZ+XnxsV7hp0Y/aVDoT10Ff1KasKaA/",
-	"X13zsfX34poybeQj994BMxD3O2ug+cIlN+n82rwn7n3BR9LBj6HnOfrrok7wHv3Y5VSxr+6kDjTyziv/",
-	"uZFaQilgdvYuuP/fffj0wXyTW/Q0vPsYXGpniwXGEG+E0ovZp/nHzoUXfvxQ05jP+TYrJdviE/IPn/5f",
-	"AAAA///KktDr7cgAAA==",
+	"H4sIAAAAAAAC/+y9e3PctrIg/lVQc2+VH7+h5Fdyj1V16v4UO8nVxklclpLsXdubgyF7ZnDEAXgIUJqJ",
+	"V999qxsACZIghyPJzjlV+5etIR6NRqPR6OenWao2hZIgjZ6dfJoVvOQbMFDSXzxNVSVNIjL8KwOdlqIw",
+	"QsnZif/GtCmFXM3mM4G/FtysZ/OZ5BuYnYT957MS/lGJErLZiSkrmM90uoYNx4HNrsDW9UjbZKUSN8Sp",
+	"HeLs9exm5APPshK07kP5s8x3TMg0rzJgpuRS8xQ/aXYtzJqZtdDMdWZCMiWBqSUz61ZjthSQZ/rIL/If",
+	"FZS7YJVu8uEl3TQgJqXKoQ/nK7VZCAkeKqiBqjeEGcUyWFKjNTcMZ0BYfUOjmAZepmu2VOUeUC0QIbwg",
+	"q83s5P1Mg8ygpN1KQVzRf5clwB+QGF6uwMw+zmOLWxooEyM2kaWdOeyXoKvcaEZtaY0rcQWSYa8j9mOl",
+	"DVsA45K9++4Ve/78+UtcyIYbA5kjssFVNbOHa7LdZyezjBvwn/u0xvOVKrnMkrr9u+9e0fznboFTW3Gt",
+	"IX5YTvELO3s9tADfMUJCQhpY0T60qB97RA5F8/MClqqEiXtiG9/rpoTz/6m7knKTrgslpInsC6OvzH6O",
+	"8rCg+xgPqwFotS8QUyUO+v5J8vLjp6fzp09u/u39afK/3J9fPb+ZuPxX9bh7MBBtmFZlCTLdJasSOJ2W",
+	"NZd9fLxz9KDXqsoztuZXtPl8Q6ze9WXY17LOK55XSCciLdVpvlKacUdGGSx5lRvmJ2aVzEFrGs1ROxOa",
+	"FaW6EhlkcyYku16LdM1Sru0Q1I5dizxHGqw0ZEO0Fl/dyGG6CVGCcN0KH7Sgf15kNOvagwnYEjdI0lxp",
+	"SIzacz35G4fLjIUXSnNX6cMuK3axBkaT4wd72RLuJNJ0nu+YoX3NGNeMM381zZlYsp2q2DVtTi4uqb9b",
+	"DWJtwxBptDmtexQP7xD6esiIIG+hVA5cEvL8ueujTC7FqipBs+s1mLW780rQhZIamFr8HVKD2/4/zn/+",
+	"iamS/Qha8xW85eklA5mqDLIjdrZkUpmANBwtEQ6x59A6HFyxS/7vWiFNbPSq4Oll/EbPxUZEVvUj34pN",
+	"tWGy2iygxC31V4hRrARTlXIIIDviHlLc8G1/0ouykintfzNtS5ZDahO6yPmOELbh278+mTtwNON5zgqQ",
+	"mZArZrZyUI7DufeDl5SqktkEMcfgngYXqy4gFUsBGatHGYHETbMPHiEPg6cRvgJwhNwDjpDTwJGwjdAM",
+	"nm78wgq+goBkjtgvjrnRV6MuQdaEzhY7+lSUcCVUpetOAzDS1OMSuFQGkqKEpYjQ2LlDh2ac2TaOA2+c",
+	"DJQqabiQkDEhLdDKgGVWgzAFE46/d/q3+IJr+PrF7Gbf14m7v1TdXR/d8Um7TY0SeyQjVyd+dQc2Llm1",
+	"+k94H4Zza7FK7M+9jRSrC7xtliKnm+jvuH8eDZUmJtBChL+btFhJbqoSTj7Ix/gXS9i54TLjZYa/bOxP",
+	"P1a5EedihT/l9qc3aiXSc7EaQGYNa/TBRd029h8cL86OzTb6rnij1GVVhAtKWw/XxY6dvR7aZDvmoYR5",
+	"Wr92w4fHxdY/Rg7tYbb1Rg4AOYi7gmPDS9iVgNDydEn/bJdET3xZ/oH/FEWOvU2xjKEW6dhdyaQ+cGqF",
+	"06LIRcoRie/cZ/yKTADsQ4I3LY7pQj35FIBYlKqA0gg7KC+KJFcpzxNtuKGR/r2E5exk9m/Hjf7l2HbX",
+	"x8Hkb7DXOXVCkdWKQQkvigPGeIuijx5hFsig6ROxCcv2SGgS0m4ikpJAFpzDFZfmaDaPncnmAL93MzX4",
+	"ttKOxXfnCTaIcGYbLkBbCdg2fKBZgHpGaGWEVhJIV7la1D88PC2KBoP0/bQoLD5IegRBghlshTb6ES2f",
+	"NycpnOfs9RH7PhybRHGF6qUFOFED74alu7XcLVbrltwamhEfaEbbicqam3mNBq3B3AfF0bNirXKUevbS",
+	"Cjb+L9c2JDP8fVLnfw0SC3E7TFzYijnM2TcO/RI8bh52KKdPOE7dc8ROu31vRzY4SpxgbkUro/tpxx3B",
+	"Y43C65IXFkD3xd6lQtIjzTaysGZXXKZAIoG+B+oeIbKcG8AL30s3ES1a/4aqhDRfv7gDhdUocVKilzJC",
+	"aJzszQkX2IB+JbVzBldsozLi8Xe9eSZeCtH9bT6H55KgujVf2ss7opDghy4M3+Qqvfwvrtf3QEELP1af",
+	"imgatgaeQcnWXK+PZjGJLCSUZrQpxIINSRnCFsFUR/US72t5e5aWccOPZl144wfEop760QUBZeSd9zP9",
+	"h+cMPyMf5MarOVDFI4idqcAgk6FmxD6m7EzYADfeKLaxyhCGGoqDoHzVTB7fp0l79K3Vv7gdcougHVLb",
+	"ez8G36htDIZv1LZ3BNQW7oOBLtTW/kcY2OgJ8L12kCnaf4c+XpZ810cyjT0FybhAFPM1nQYZSkc4S6PI",
+	"Pl2o8nbcp8NWJGvU84zjqMFFNe8giZpWReJIMaLisw06AzUW0XGm0R0+hrEWFs4N/wxY0IYHwN8BC+2B",
+	"7hsLalOIHO6B9NdRpo8KlefP2Pl/nX719Nnvz776GkmyKNWq5Bu22BnQ7KF7xzJtdjk86q9sPrNqhvjo",
+	"X7/wSt32uLFxtKrKFDa86A9llcVWXLTNGLbrY62NZlp1DeCUw3kByMkt2pm1gyBor4XmWsNmcS+bMYSw",
+	"rJklYw6SDPYS06HLa6bZhUssd2V1H89+KEtVRnSRdMSMSlWeXEGphYpYnt66Fsy18E+Bovu7hZZdc81w",
+	"blKTV5IEighlof57Mt+3Q19sZYObUc5v1xtZnZt3yr60ke/lac0KtOptUVReVKvWq3FZqg3jLKOOdEd/",
+	"D4ZEgTNpoLzi+T1spXBDDclU/jsTkmlIlcz0nD2x8g3JENagRdLPdSmMAcm4blsxsIGuFhv8mk14ANcw",
+	"3eWFshgCP0TkhdjAueGb4ufl8n70E4oGijzhxAY0zsRsiwCgCRhxo94FH2YYAIeR851M6SX7eR+yGyHJ",
+	"0qZ3Mg1UJ/SqhGwF5QR83MMDlqZ6oCPgIDre0OdzyQu9Vvf/PmwPH4M7DkCgmWg3GFJQtFvdh4St/ViT",
+	"uW13sXuYbTPBXfZX8yvI3C6zesgQJ4YbeA254d+p8qJhV9+Xqio+14bXc05dCq+XgF1Zhn29Uk7IVd52",
+	"51sh7NE1/ikLeuXvOLcGgp64zRuxWpvg7f22VGp5/zDGZokBSh+s5iLHPn39xU8qw4vCVPoe3inNYI0Y",
+	"gDQbXv58oSrDOJMqA9r8SsdfMAMOYOR5Qg4zJnwUmbVVRiwAqSvlFa4WDW0qJlQ1HROe2hOa2Es/PmHj",
+	"xWBb2emsc1FeAs9QOQySqYWzODtbOC2Sky+L8W8A936K3AUtuIpSpaA1KvWtqnYvaL6dla/MCJ4IcAK4",
+	"noVpxZa8vDOwl1d74byEXUKeV5o9/OFX/ehPgNcow/M9iKU2MfTWujAhB6CeNv0YwXUnD8mOl8D8vcKM",
+	"oidfDgaGUHgQTgb3rwtRbxfvjpYrKMnA/1kp3k9yNwKqQf3M9H5XaKtiwJ/Y6YBQescNk1wqLzTHBsu5",
+	"Nsk+toyNwrVoXEHACWOcmAYeEKrfcG2sU4qQGemHtbPR1BYanGIY4MG3Oo78q3+m98dOldQgdaXrN7uu",
+	"ikKVBrLYGtCTaXiun2Bbz6WWwdi1YsAoVmnYN/IQloLxHbLsSiyCuKltt85rq784snDiPb+LorIFRIOI",
+	"MUDOfasAu6FP5QAgQjeItoQjdIdyakfO+UwbVRTILUxSybrfEJrObetT80vTtk9c3DT3dqZAkyuna+8g",
+	"v/ZmQC7Rv1gzBwfb8EuUPUhXaL1n+jDjYUy0kCkkY5RPz3dsFR6BvYe0KlYlzyDJIOe7/qC/2M/Mfh4b",
+	"gHa80QkpA4l1i4xvekPJ3gttZGhF40WY5k+K0ReW4hHEp0BDIK73npEzoLFjzMnR0YN6KJorukV+PFq2",
+	"3erIiHQbXikTmIUJZMfRpwA8gId66NujgjonzduzO8V/g3YT+Da3mGQHemgJzfgHLWDA0OAiToLz0mHv",
+	"HQ4cZZuDbGwPHxk6sgNWj7e8NCIVBb11foDdvT/9uhNEbfEsA8MFauKDD/YZWIT9mXXo6455u6fgJJVJ",
+	"H/ye0iSynFxoEnnawF/Cjt7cb62neKDquI+3bGRUJmwACALq/U9RBA+bwJanJt+RqngNO3YNXR1x+6lr",
+	"VJGEA0SNfyMzOku39bL2OzDF9H5OQwXL62/FfGbfBOPwXXQeBi10uLdAoVQ+QfvZQ0YUgkkOZKxQuOvC",
+	"BaP4cARPSS0gHdPOdx5cd1WEaKYVsP9WFUu5pCdXZaCWaVRJggL2pRmEDuZ0rmINhiCHDdiXJH15/Li7",
+	"8MeP3Z4LzZZw7SO4Hj/uo+PxY9LjvFXatA7XPWhE8bidRa4PsorixedeIV2est/9xo08ZSffdgb3k9KZ",
+	"0toRLi7/zgygczK3U9Ye0sg01yOznbjyYD3RddO+n4tNlXNzH6ZduOJ5oq6gLEUGezm5m1go+e0Vz3+u",
+	"u1F0GqRIoykkKcVUTRwLLrCPDcPa9zZs3FPFZgOZ4AbyHStKSCGzqnKhma5hPGLWoThdc7kiSb9U1cp5",
+	"tNpxiFNjmB4FRlWyN0RUGjJbmZB2Osa5XRSDjxxDOQg4vsW6qm378rjm9XyQtRj6ROR1Vf1RE/B8NvhU",
+	"RaReNU9Vi5x2+NsELt4S1AL8NBNPtH8Q6lBo6eMr3BY8Bbi5n0fX3gwdg7I/cWDJaj4OWbGaFr+p8hLK",
+	"O2jip60hnGZ8PTGAomsLGw6us0Ltwj1IZXYgVkJRgqY7NNSjaftVLcOQXnfJ6p02sOmbGmzX3wfYzLvB",
+	"B62SuZCQbJSEXTSLhZDwI32M9bb3+EBnkqiG+nYfSS34O2C155ly6u6KX9rtLifqmtT0d6q8L3u8HfBQ",
+	"k+2YiXSvHddNeVsjLga39m2fLuCvy+j0vE4wIkrGtVapIKHyDN1VhGzMpc5/vo3+t3UYwz2cve64HSNf",
+	"GEtOSmzIC8ZZmgtScSupTVml5oPkpEQLlhpxYfTagmG16ivfJK7HjahZ3VAfJCf31Vq1FnW7WkJEj/Qd",
+	"gNeu6mq1Am06j7ElwAfpWgnJKikMzbXB45LY81JASX6ER7blhu/YEmnCKPYHlIotKtN+nlA8qzaopLUW",
+	"R5yGqeUHyQ3LgWvDfhTo9IXDeY8Tf2QlmGtVXtZYiEsxK5CghU7irpbf26/kBe+Wv3Ye8fh/19naqHD8",
+	"Juh1Z6CVU+N/P/zPE8ylwZM/niQv/7/jj59e3Dx63Pvx2c1f//p/2j89v/nro//899hOedhFNgj52Wv3",
+	"dD97Te+zxkjVg/2LGSgwRDtKZKErUYe22EPKLOAI6FFbe2fW8EGiw51RmNhCZNzcjhy6N0zvLNrT0aGa",
+	"1kZ0tHV+rQe+eu7AZViEyXRY462lxb53cjyuGTfShypjK7aspN1K/8qwYXveS1Qt53Xsuk1rdcIosHnN",
+	"vYuz+/PZV1/P5k1Acv19Np+5rx8jlCyybSzsPINt7DHrDggdjAeaFXynwcS5B8EedYi1zifhsBtALYhe",
+	"i+LLcwptxCLO4XyAj1OKbeWZtF6ieH7IBrtzph21/PJwmxIgg8KsY+luWoIatWp2E6DjF4PhiiDnTBzB",
+	"UVcpleG72Lnm5sCXSKDWjqimvPrqc2AJzVNFgPVwIZM0PzH6IZHHceub+cxd/vf/ZHIDx+DqzlkbXP3f",
+	"RrEH3397wY4dw9QPCFtu6CBmPaIysB/aHlOGcZfkywp5H+QH+RqWQgr8fvJBZtzw4wXXItXHlYbyG55z",
+	"mcLRSrETH+n5mhv+QfYkrcE8fEGMLSuqRS5SVLjHyNPmVuqP8OHDe1Q7f/jwsec80n8+uKmi/MVOkKAg",
+	"rCqTuMwwSQnXvIwZ53SdGYRGpt6js1ohW1VWg+vGZ278OM/jRaG7GQL6yy+KHJcfkKF28e+4ZUwbVXpZ",
+	"RGgPDe3vT8pdDCW/9vqjSoNmf9vw4r2Q5iNLPlRPnjwH1gqZ/5u78oV9i0/WIg1mMOgqj2jh9lkJW1Py",
+	"pOCrmA3ww4f3BnhBu0/y8ga3AAVd6hbipA6voaGaBXh8DG+AhePgUFpa3Lnt5bMAxpdAn2gLqQ2KG41n",
+	"wm33Kwjev/V2dRIA9HapMusEz3Z0VRpJ3O9MnRxsxYXU3l0ELU14CFwetQWqTiG9dAmuYFOY3bzVXS1b",
+	"gqZnHULb1Gc2nJSS75AFBVOiFRl3ojiXu24WFA3GeJ/nd3AJuwvV5O45JO1JOwuHHjqoRKmBdInEGh5b",
+	"N0Z3853bG0LKi8Ins6BIXU8WJzVd+D7DB9mKvPdwiGNE0coSMYQIXkYQQR2GUHCLheJ4dyL92PLwlbGw",
+	"N18kDZrn/cw1aR5PzkMtXM3Fuv6+AcqjqK41W3CU25VLAWgzTQRcrNJ8BQMScmjEmpjPoWX4okH23XvR",
+	"mw7N5u0LrXffREG2jRNcc5RSAL8gqdBjpuOX6GeydlJngaHMvg5hi5zEpNqB0zIdXraMiXI1BlqcgKGU",
+	"jcDhwWhjJJRs1lz77ITZPDjLk2SAz5g5ZSxf1lngUhdkaqyzYXme2z2nvdely5rlU2X5/Fjh03JCrqv5",
+	"zHnxx7ZDSRKAMshhZRduG3tCabK4NBuEcPy8XOZCAkti3nmBGjS4ZtwcgPLxY8asBp5NHiFGxgHYZP+n",
+	"gdlPKjybcnUIkNJloeF+bPIcCP6GeBCo9VdHkUcVyMLFgPUu9RyAO5fO+v7qOBbTMEzIOUM2d8VzkMa/",
+	"+JpBemmbSGztJGlyHiiPhsTZEQOIvVgOWhP1uNVqQpnJAx0X6EYgXqhtYqPAoxLvYrtAeo+68GOv6MG0",
+	"CbIeaLZQW/JqoqvFuozvgWUYDg9GAwBlPsK1U7+h29wCMzbtuDQVo0LNHtayTUMuQ+LElKkHJJghcnkY",
+	"5Ly6FQAdZUeTQN49fvc+UtviSf8yb261eZPL0UdHxY7/0BGK7tIA/vpamDpL1duuxBLVU7RadRJ0BSJk",
+	"jOiZkBEjTd8UpCEHehQkLSEquYRd/G0DdOOc+26B8oLSgHG5exR4fJWwEtpAo0T3/iB/hnqSU/ZRpZbD",
+	"qzNFucT1vVOqvqaoo1VOtpb5xVdALtNLUaJvLlogokvARt9pelR/h03jslJrs5nN1S2yOG+gaTHKJhN5",
+	"FadXN+8Pr3Han2qWqKsF8VshrWPOgnLLRz1NR6a2zsijC35jF/yG39t6p50GbIoTl0gu7Tn+Rc5FN4h6",
+	"hB1ECDBGHP1dG0TpCIMMIoT73DGQmwIb/9GY9rV3mDI/9l7PHh+nPHRH2ZGia2kAHV+FIDMRiiXCBKnZ",
+	"+6G7A2eAF4XIth1dqB118MXMD1J4+ISWHSzQ7rrB9mAg0HvGoodK0O3cpY2Ab5Pst9JhHU3CzEU7w2jI",
+	"EMKphPYlYvqIqqML9+EK8+f8ALtfsS0tZ3Yzn91NdRrDtRtxD67f1tsbxTOZ5q0qrWUJORDlvECDF88T",
+	"p2AeIs1SXTnSpOZeH/2FWV1cjXnx7embtw581OHlwMukFhUGV0Xtin+ZVdk0qQMHxJegwDefl9mtKBls",
+	"fp2vMFRKX6/B5fIPpNFe0uHG4NCM55XUy7iH0F6Vs7ON2CWO2EigqE0kjfqOOnesIvyKi9zrzTy0A948",
+	"tLhpmaujXCEc4M7WlcBIltwru+md7vjpaKhrD08K5xqpNrCxBTU0U7JrQiffblTHEamiZ9cCnFakz5xk",
+	"tSFNQqJzkcZ1rHKhkTiktZ1hY0aNB4RRHLESA6ZYWYlgLGw2JT9TB8hgjigydTRFVIO7hXLF0iop/lEB",
+	"ExlIg59KOpWdg4rn0hfc6V+nKDv053IDU59g+LvIGGG67O6NR0CMCxihpa4H7uv6yewXWmuk8IfAJHGA",
+	"wT+csXcljhjrHX04arbOi+u2xW1aVuYphdX849Xl7R6YI1ooTehkWao/IP7Oo+dxJDDLTUTCFPU+ioT/",
+	"dllMrd1p6r01sw9u95B0E3xkbSeFAaqnnQ/McpSdzmuoubRbbQNmWr5ucYIJWuhjO35DMA7mniduzq8X",
+	"PL2MCxkI02ljAG7p0o1ivrPHva6jSuzsLLAl122FDbovoOzl1QsS+NxSYLDTThYVGskAO7Zkgrm1/+Va",
+	"RYap5DWXBnwmenuUXG8NVvmFva5VSSkzdFztn0EqNjyPSw5Z2lfxZmIlbGWnSkNQOsgNZKvmWSpy5Zfq",
+	"WCmHmrMlezIP6pe53cjEldBikQO1eGpboAWQ1lZbc3wXXB5Is9bU/NmE5utKZiVkZq0tYrVitVBHz5va",
+	"eLUAcw0g2RNq9/Qle0hmOy2u4BFi0d3Ps5OnL0npav94ErsAXGWuMW6SETv5zbGTOB2T3dKOgYzbjXoU",
+	"zS5gS3MOM66R02S7TjlL1NLxuv1nacMlX0HcU2SzBybbl3aTFGkdvMjM1pXTplQ7Jkx8fjAc+dOA9zmy",
+	"PwsGmpM3wmyccUerDdJTUxfITuqHs0Xq7N1Uw+U/ko208CaiziPyyypN7f0WWzVZsn/iG2ijdc64zZOS",
+	"i8Z7wReaYGc+DZPNXOrTtVvc4Fy4dBJzcAspZ7KQhh4WlVkmf2Hpmpc8RfZ3NARusvj6RSSvajtnsjwM",
+	"8C+O9xI0lFdx1JcDZO9lCNcX/fFlshHI6h810R7BqRw05kanNUO2w/GhpwplOEoySG5Vi9x4wKnvRHhy",
+	"ZMA7kmK9noPo8eCVfXHKrMo4efAKd+iXd2+clLFRZSy3YnPcncRRgikFXEE2uEk45h33oswn7cJdoP9z",
+	"LQ9e5AzEMn+WYw8BLBFx8mmgfkKtSXe+6hHtwNAxxQ9IBgs31Jy1c9V/eT56P15QcUuXV2z3DVv4xeOB",
+	"/ugi4k8mF9rAxpZvVzJAKEGtjijJZPX3wMbO2TdqO5VwOqfQE88/AYqiKKlEnv3aRH62V7gouUzXUZvZ",
+	"Ajv+3hS4rBdn78AYiaVrLiXk0eGsvPm7l0sjkvPf1dR5NkJObNutzmKX21lcA3gbTA+UnxDRK0yOE4RY",
+	"bQfV1U7b+UpljOZpcvI1x7Vf1SeovfCPCrSJBSjRB+s4ZqjMJ1IxdWIgM3qRHrHvbQ37NbBWwiV6CfqM",
+	"GO2o6arIFc/mlKkDrQnMzmr72DJttvTAih5C7VV0dGJButFpLsi2w1B4xPRxxv21bS2ypE5wHwtAxRZN",
+	"Cn7RsRPQEynEzhF7HVSjDgqeUaKWcoOvuno0Kx8RTeB/jOHpGhuoFmsdJvnpNTM8Veqgpq/7f1pToj13",
+	"CLcrm2GrZsyZwrf5tdC2dDlcQTvm1YPh1Q4+Bra9vLKS0lLK0QG3XJ1x81C0e+Bo3NqUEIWsg/gDhX5b",
+	"cubQEiLn1CtGlL16JL1ivjaCsq4j9qMvx8ylkiKlhFyxK9rVOJ9iZ5uQu6yryPVH3J3QyOGKVkGpXfEc",
+	"FgfrosxnLcT1Ff3BV9xUSx32T0PFtNfcsBUY7Tgb+qO7Yj5O1yikBpdTFYko5JOqbNkuiUNGzeFJbTY5",
+	"kIwo9Gbg8fgdfvvJqRbwCLJLIekR4dDmBD+rDaQSzAZfHsKwlQLt1tOOP9bvsc8RheJmsP145Es20xjW",
+	"9IfLtnbu/lCn3urtrMzY9hW2dYmg6p9bXs520tOicJMOl3qKygOY7GgIwRHrZeLNRwFy6/HD0UbIbdRd",
+	"he5TJDRM7cW0gYLu4R5h1GWPOiX1UGi1FEUtmHUTiyElFzICxhshoSkoHrkg0uiVQBtD53Wgn05LbtJ1",
+	"iw3tM3KThTvG0LRx5o27DtXZYEIJrdHPMbyNTcWmAcZRN2gENy53dR1zpO5AmHiFrs/efaBff4mkKidE",
+	"Zdw0Yd++IlOMcSDj9jXf2hdA/xj0ZSLbnXLCHXoTDQWiLqpsBQaDHGMpbr+hr4y+sqxC0BjmpavqVKhF",
+	"wRCobiKaPrW5iVIldbUZmcs3uON0QYmzCDWEZdb8DiOlodIK/43lAR3eGefocbCroffqyA7LvtR3nYxJ",
+	"vUjTCYY/TccE3Sl3R0cz9e0Ivel/r5Seq1UbkC+cfmKMy4V7FONv3+LFEWZn6CW3tVdLnTyBHPuUL0xL",
+	"z8Y67LfNlfBbP9stGZTqwpfjCojhEpZzuvwG3HuDpBvc3q/WQjnk5JsO+qRz46LjDGejLGgw4sh6CNF3",
+	"C0VcOzvkFWSdgvBzr/etCnC7tY4i1Lub9QH6wfuysoILZ35vmEUfs87rvR+HMMUfttng7iKcL/mgxu6H",
+	"qyG/b5+Mjb53K3ddgguZL0q4EqpyG1Z7Pvknof21VQur9ryPrr+veKWp/lx16KDy9sJVUbDLdG/yH361",
+	"fnIMpCl3/wSq3N6md4q9Rfa+U5PNVWpDTy5bT6LRMNTl46eqby8CQxqiy89xdKBCvlXdPuWFqYIA+Mig",
+	"t+IBLc33x2FUjgRQ2BbB2XfahIllxdsCxpScj7H0gk7MbhV521Oirredr6dIVj183MxnZ9lBskcsReXM",
+	"jhLdgWgBueEMXk3WLuJWhdKiKSkQqyw30VvzYg0utMQRdn8s7yp1BamhOhKNC0gJcEg+MpwsKOj8/zJ5",
+	"DVapdU6tLoHXWNaufvGIPeJSL7AuCA61ifePpueoOq0d/ejKowTaK5CupnI7ZGay4/5yCakRV3sCGX9b",
+	"gwyC5OZexUWwLIO4RlE7glMenMMVuA1AOb8lPDm/P3CGwpguYfdAsxY1RCsBzL3UcpsUKIQB4g7o3l8o",
+	"zfMhnbzzbRC6pgzCgndcs92hSSY3WEQsCMu95VyeJBkPQ3VHpoxXMZo0F3Y9KICdfJqHYh37RVCGn3Kv",
+	"qeaMrgt8+hQqocIDdbfdRJPXLgULhZ3WZiifjAW0/83HmNtZcnEJYZkzMvphAL1vEdVieQVZMnIf9QIU",
+	"mYgDvaxnFo2bcT8krb/H1pk8zRWKEcmQR37bs7d2i3mgrf+SrRgApYNrCaUrB4ktcWxIjPJuyWNwjKFC",
+	"23rat0GCHkwXaoEbTOLzrslSRGmTOSXt4c43K1wgK2HDEboyyCU0POcYsl/Z7z4Gy6fN3ausq+l1f50K",
+	"72AudA+JIdUvmbst98d23UZvJ6S0dfl1LLGQhLJtWCpKlVWpe8YEB6PWbU5O2zXCSqIqr7S/ys4bIQiQ",
+	"vYTdsX1P+gIffgdDoK3kZEEPElJ0NvleNZk6BvfqXsD7M5WA81mhVJ4M2I3O+tmQuhR/KTCXIMObwjti",
+	"DhRdYg/JXFE7Blyvdz77T1GAhOzREWOn0rq+ex+BdjruzuTygRmbf0uzZpVNUOb0k0cfZNyHmFKHlXfk",
+	"Zn6YcR6mQWZ3nsoOMj6R2Q5kYsLUfv0SZEdTX+V9q323LFRDVBaKmEzSVDza43JUexs1xWIaj6O+dJDn",
+	"6johKkrqVGqxNwe2azNJnzy26YbYXkDgusS1u0B3bM0zlqqyhDTsEY8WsUBtVAlJrsiTKWZkXRqUhzbk",
+	"Ii5ZrlZMFfjMtRkJvTkqWskomOu+qjbZyGcLQWJtZwO5JUC7SGcHrm3ch3ekcNLhRZku1hG9DW2Y362D",
+	"Ky85gju4kEgA5gRC36+zOu0vrLuubomzoYKDRm1EGkf3v5bjz6C7Tox6Y6iwPVwsITWjAx7ylNrOS6en",
+	"j2aQ6BgW2y93/Jy9i+gc/2uVxJ1x2RK46c0d8LNILOvYqmPFwiK7Wk/lapn58NQBCon6Doyb6m0BycVU",
+	"g32dvHsiMwgAGDbht2CYZMg/FIwlFWRNeATJZ7XMP2/VyxYdjucTK9qTnXL75kd9Exd5VYILl6SD0C3h",
+	"VHCz9jIANu+/zPGVB5piGW0dGq6tHsnrs1zZyq5wpYokhytoeTa4GM4qTUFjYGZY8tJ2ZhlAQdrd7psj",
+	"ZrIPeXtHEHVrTwKj7xTsRiVTi1i7U2yP2BkVkrcyscdETz1KCNGVyCrewp++Q/G/obp/kcvHw/pxGqc4",
+	"mEnEFzfGIvY62VR66FzKuI9NGEJcq5RotqxWPVsibE62Lvi1HH6C9YmykZ2ml80MEPvtFlK6h9pOJHfH",
+	"CaPBmBar/WtoCOIuT/lBKhsjsl4R0ajUpsEXgQ4z+XjB1/WNSLtW6Sh0ZAChG95ALqnQuDwGzVBjnonl",
+	"EkprVtGGywx1jUFzIVkKpeEC35g7ffsHBkJbYjjTvjcGcmoa1DOr2GuDNIQWkHznHm9D8v8EuR33ISaz",
+	"22vbqKH6pr1dicfI8C2+c8hZcIAIXHQ/vXKoGVOSREy24Zdw4Dxa/AHj01DOHaeFNYpmnTLFzSit/0yo",
+	"owP/ixRmlNqt6Nf13rQ2IUuMngblqjFM283p02CRxicr2k633WIOfq+tgsrOBwPJKR3vTIin6hGTL+ig",
+	"7FTqVHZ9caDHjC0wc+eMfJC00FU3pHuYUpRFD5yJtqyulkSdtCn2YlJlyI7nXeeg9hVUbzsVUk2rkoSo",
+	"a77bn+MuMXEovV+1Hdk/Z7yPQw2122pLYCTjWvh7KeQOEU8iNB8rT9FP3nX/i7EBA40d7vMtx2na4wsI",
+	"i/qP01sjyHtSidAal7vY0fG65FsscEg6meDyem9bVZ+Wz7FBURZ9u5yuk0Druz9GsBkUmx53owhTPjex",
+	"5KX1oiWzq38PdfnFj807aVrJaN9hD3ihd03TrjZ0OHD+5KDsH2ukBEv5OEQJreXvc9hxC2welsEWOVnN",
+	"GLAJ+G0gX3tfAm8s/ap2chqq0d71haL8zkra4sI9HyorPtKZCglH4F1/xfMv7wdFib9PCR+QvRu2nIaO",
+	"NCGSLSr17SIi3/BJc+f8M0yNZUevQP4GuEfRa8EN5V6sPeZPwj/PrZZ/6UuHYvD0NY1JO82efs0WLmNM",
+	"UUIqdPclfO2retV+I1Tk0k6B4Yjjjir71vmrMncg46VXLLGfmgpBpMheyQbC5oj+yUxl4ORGqTxGfT2y",
+	"iOBvnEfROOcDVXjO67pAqcp9UQRXhaflzRqyhgGHc56mUFBWmOR6gIStit5TYxjVbDsHSfXCCXn9Ggh9",
+	"lG9BehuhddROZnHKrtdKQ/OMJmu0VMYjB1Wea+BXAmEg9jNd+Kj3g6Zymxm55VVVJnvBxGPcr0JgI8Wd",
+	"tXr/WkbCn/Y6Qsaglso6p8Uedqd1La8poDfuSwiwzepvNRd2/PuG3Go+7h/dnwXVlqMPHrHfmrNVTx8q",
+	"hihTImxTcMUbuDtj1iiN6T1ukz2MgCqHqp3+1qTPpHadqhFM2FSyJyy1uQweCqoFrcrdoznLhL5kDykl",
+	"lTOqZNxwNEA8Yqpk7qzY6ufex2wH5tHh4Q/1lc4dC66DH+50343p84JqI80WtcgmWvwNdSh7CMAHg0SG",
+	"ZVqxJS9vsRRbKGhoZmsnu27N3xzYA6ca4iQNxpQt4lYLAXE+scfX1edwaBFwF8fdQzeP3HUd5NQLaO3/",
+	"+E0d3gxRa3Nrwa31tmV3mtrWLabHn4XpgAoje5nSIAn4LX8QznqnoMHaGdnNOY7D31R5CeWQuEP6hrog",
+	"bePXFSLvmkbwWVeqIlUbUuk2TSL6e8u4EktFo1TrmhDDCzL9XhLKhEws37OccMgLnJKTTI3kcnPYQKVb",
+	"HESUp0M/97GJsW0vsVvnXYmap8IJU5zMZ+llw2pvDabDa0KIS3KQq1ggzblaGmsV6mfo7+6M3YmR6p63",
+	"kKfJAtKTpQMioCwpqrxEkjvA5TQu7u/NQhFS0uBOz7vUPYDsGiuxExqWktijvrpsBfo2WuZAw6ZKuOeA",
+	"3yB1x4EBv/0iGVOXR+sgsqg09Nc5efNbuI1IjM3apkar95E7VtZySpB5vFotdqcod4sQbHTECFT2t6d/",
+	"YyUs8UAaxR4/pgkeP567pn971v6MjODx4+hZ/WLx7RZHbgw3b4xifh3KeGazeg0k1+vsB+bh20cYrVSJ",
+	"GB8AErTQlAzwd5eQ9cvq9jwENlCsf1QtrHeJbrWIiay1NXkwVZAEcUL+Q9ctku2QnLDTqhRmR3VivAVO",
+	"/B6NxP++DkV0oay1S4HTxRl1CXWloSZwsdJe2/e94jnpx6yngwRmsA4x+3bLN0UO7qD89cHiP+D5X15k",
+	"T54//Y/FX5589SSFF1+9fPKEv3zBn758/hSe/eWrF0/g6fLrl4tn2bMXzxYvnr34+quX6fMXTxcvvn75",
+	"Hw9m85lAkC2gM5+VfPY/EyzJnJy+PUsuENgGJ7wQGO15c0OmrqUiEQmRmtJJhA0X+ezE//T/+xN2lKpN",
+	"M7z/deaSHs/WxhT65Pj4+vr6KOxyvKJIpcSoKl0f+3lu5h2Mn749q10i7dOXdtTmC/TOZZ4UTunbu2/P",
+	"L9jp27OjhmBmJ7MnR0+OnuL4qgDJCzE7mT2nn+j0rGnfjx2xzU4+3cxnx2vguVm7PzZgSpH6TyXwbOf+",
+	"r6/5CsUf8nq1P109O/YvnONPThi+Gft2HFwh+HPzVyKyPT21BvrBFTQZb92qGOIC+oIOE6EYa3a8UNsD",
+	"moIOGg8vhYwf+vgTSSuDvx+7xK7xj2RGsefh2Ed/xlu2sPTJbBHWTo+Um3RdFcef6D9EnwFYNo3SsdnK",
+	"Y3KXOf4ksv7n3mravzfdwxZXG5WBB5hnV1ym4Hrom8GG3jK0t8HxJ/+/4cHUcmnLQo19Pv5k/x0eps7U",
+	"ePyp/m+ktc9ioUc+HX9C/nOzv8VxidlEA9jxXVOKDUiXwt85SdUM5izDvHlBo1drSC+purP1kEOwZs+e",
+	"PIlk2wt6McvIUPmQIRd68eTFhA5SmbCTq0PS7/iLvJTqWjLKzWRvtWqz4eWOpEVTlVKzn39gYsmgO4XQ",
+	"fgbipHylyZGGSsnO5rOw/ezjjUOaffAdU379XYNL//NOptEf+wRfdIpSx34+/tT6s80X9LoymboO+tJb",
+	"yD75j7VXKUS+9WCJNa708TUXBqVIF6VOJXj6nQ3w/Nhl9+z82iTU6n2hLGHBjwHbif96XFc4i37s8vPY",
+	"V8fPBhp5l0P/uZHtQllpdvI+kJLef7z5iN9Kq0Z5/ym4+k+Ojynyc620OZ7dzD91xILw48ea/nzWnFlR",
+	"iiuE5ubjzf8dANCE9Asn3QAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -536,6 +536,15 @@ type KvDelta struct {
 	Value *[]byte `json:"value,omitempty"`
 }
 
+// LedgerSnapshot A ledger snapshot saved by a node running in dev mode.
+type LedgerSnapshot struct {
+	// Name The name of the snapshot.
+	Name string `json:"name"`
+
+	// Round The latest round captured by the snapshot.
+	Round uint64 `json:"round"`
+}
+
 // LedgerStateDelta Ledger StateDelta object
 type LedgerStateDelta = map[string]interface{}
 
@@ -762,6 +771,71 @@ type StateProofMessage struct {
 	VotersCommitment []byte `json:"VotersCommitment"`
 }
 
+// StateProofRoundStatus Signature collection status for a single state proof round.
+type StateProofRoundStatus struct {
+	// AcceptableWeight Signed weight the network accepts for this state proof as of the latest round.
+	AcceptableWeight uint64 `json:"acceptable-weight"`
+
+	// Missing Voters whose signature was not collected, heaviest first.
+	Missing *[]StateProofVoterWeight `json:"missing,omitempty"`
+
+	// OurMissing Voters with participation keys on this node whose signature was not collected.
+	OurMissing *[]string `json:"our-missing,omitempty"`
+
+	// OurNotVoters Accounts with participation keys on this node that were not selected as voters.
+	OurNotVoters *[]string `json:"our-not-voters,omitempty"`
+
+	// OurSigned Voters with participation keys on this node whose signature was collected.
+	OurSigned *[]string `json:"our-signed,omitempty"`
+
+	// ProvenWeight Weight the collected signatures must exceed for a proof to be built.
+	ProvenWeight uint64 `json:"proven-weight"`
+
+	// ProverState Where the prover for this round is held: cached (in memory), disk (only in the database) or missing (not created yet).
+	ProverState string `json:"prover-state"`
+
+	// Round The last round attested by the state proof.
+	Round uint64 `json:"round"`
+
+	// Signatures Number of signatures collected.
+	Signatures uint64 `json:"signatures"`
+
+	// SignedWeight Weight of the signatures collected so far.
+	SignedWeight uint64 `json:"signed-weight"`
+
+	// TotalWeight Total weight of the voters.
+	TotalWeight uint64 `json:"total-weight"`
+
+	// Voters Number of online accounts selected as voters.
+	Voters uint64 `json:"voters"`
+}
+
+// StateProofVoterWeight An online account selected as a state proof voter, with its weight.
+type StateProofVoterWeight struct {
+	Address string `json:"address"`
+
+	// Weight The voter's weight.
+	Weight uint64 `json:"weight"`
+}
+
+// StateProofWorkerStatus Progress of this node's state proof worker on the upcoming state proofs.
+type StateProofWorkerStatus struct {
+	// CachedProvers Number of provers held in the worker's in-memory cache.
+	CachedProvers uint64 `json:"cached-provers"`
+
+	// LatestRound The latest round in the ledger.
+	LatestRound uint64 `json:"latest-round"`
+
+	// NextStateProofRound The next round for which a state proof is expected, as tracked by the ledger.
+	NextStateProofRound uint64 `json:"next-state-proof-round"`
+
+	// ProversCacheLength Soft limit on the number of provers held in memory.
+	ProversCacheLength uint64 `json:"provers-cache-length"`
+
+	// Rounds Signature collection status for each state proof round the worker is working on.
+	Rounds []StateProofRoundStatus `json:"rounds"`
+}
+
 // TealKeyValue Represents a key-value pair in an application store.
 type TealKeyValue struct {
 	Key string `json:"key"`
@@ -897,6 +971,12 @@ type AccountAssetResponse struct {
 // data/basics/userBalance.go : AccountData
 type AccountResponse = Account
 
+// AdvanceRoundsResponse defines model for AdvanceRoundsResponse.
+type AdvanceRoundsResponse struct {
+	// Round The latest round.
+	Round uint64 `json:"round"`
+}
+
 // ApplicationResponse Application index and its parameters
 type ApplicationResponse = Application
 
@@ -965,6 +1045,12 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// GetBlockIntervalResponse defines model for GetBlockIntervalResponse.
+type GetBlockIntervalResponse struct {
+	// Interval Block interval in seconds, 0 when blocks are only written as transactions are submitted.
+	Interval uint64 `json:"interval"`
+}
+
 // GetBlockTimeStampOffsetResponse defines model for GetBlockTimeStampOffsetResponse.
 type GetBlockTimeStampOffsetResponse struct {
 	// Offset Timestamp offset in seconds.
@@ -977,6 +1063,14 @@ type GetSyncRoundResponse struct {
 	Round uint64 `json:"round"`
 }
 
+// LedgerSnapshotResponse A ledger snapshot saved by a node running in dev mode.
+type LedgerSnapshotResponse = LedgerSnapshot
+
+// LedgerSnapshotsResponse defines model for LedgerSnapshotsResponse.
+type LedgerSnapshotsResponse struct {
+	Snapshots []LedgerSnapshot `json:"snapshots"`
+}
+
 // LedgerStateDeltaForTransactionGroupResponse Ledger StateDelta object
 type LedgerStateDeltaForTransactionGroupResponse = LedgerStateDelta
 
@@ -1115,6 +1209,9 @@ type SimulateResponse struct {
 // StateProofResponse Represents a state proof and its corresponding message
 type StateProofResponse = StateProof
 
+// StateProofWorkerStatusResponse Progress of this node's state proof worker on the upcoming state proofs.
+type StateProofWorkerStatusResponse = StateProofWorkerStatus
+
 // SupplyResponse Supply represents the current supply of MicroAlgos in the system
 type SupplyResponse struct {
 	// CurrentRound Round
@@ -1194,6 +1291,9 @@ type AccountInformationParams struct {
 
 	// Exclude When set to `all` will exclude asset holdings, application local state, created asset parameters, any created application parameters. Defaults to `none`.
 	Exclude *AccountInformationParamsExclude `form:"exclude,omitempty" json:"exclude,omitempty"`
+
+	// Round When set, returns the account state as of the given round rather than the latest round. Rounds older than the ledger's in-memory lookback require an archival node with EnableAccountHistory set. Asset holdings, application local state and created assets and applications are excluded from the response, as with exclude=all.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // AccountInformationParamsFormat defines parameters for AccountInformation.
@@ -27,6 +27,15 @@ type ServerInterface interface {
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string) error
+	// Returns the saved ledger snapshots. Ledger snapshots are only available in dev mode.
+	// (GET /v2/devmode/snapshots)
+	ListLedgerSnapshots(ctx echo.Context) error
+	// Saves a snapshot of the ledger. Ledger snapshots are only available in dev mode.
+	// (POST /v2/devmode/snapshots/{name})
+	SaveLedgerSnapshot(ctx echo.Context, name string) error
+	// Reverts the ledger to a saved snapshot. Ledger snapshots are only available in dev mode.
+	// (POST /v2/devmode/snapshots/{name}/revert)
+	RevertLedgerSnapshot(ctx echo.Context, name string) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
@@ -73,6 +82,53 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// ListLedgerSnapshots converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLedgerSnapshots(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListLedgerSnapshots(ctx)
+	return err
+}
+
+// SaveLedgerSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) SaveLedgerSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SaveLedgerSnapshot(ctx, name)
+	return err
+}
+
+// RevertLedgerSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) RevertLedgerSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RevertLedgerSnapshot(ctx, name)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	var err error
@@ -123,6 +179,9 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.GET(baseURL+"/v2/devmode/snapshots", wrapper.ListLedgerSnapshots, m...)
+	router.POST(baseURL+"/v2/devmode/snapshots/:name", wrapper.SaveLedgerSnapshot, m...)
+	router.POST(baseURL+"/v2/devmode/snapshots/:name/revert", wrapper.RevertLedgerSnapshot, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
 
 }
@@ -130,186 +189,205 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPcNrLgv4KafVWOfUNJ/kh2raqtd4qdZHVxHJelZO8925fFkD0zWHEAhgBHM/Hp",
-	"f3+FboAESXCGIyn2pmp/sjXER6PRaPQXuj9OUrUqlARp9OT046TgJV+BgRL/4mmqKmkSkdm/MtBpKQoj",
-	"lJyc+m9Mm1LIxWQ6EfbXgpvlZDqRfAVNG9t/Oinh10qUkE1OTVnBdKLTJay4HdhsC9u6HmmTLFTihjij",
-	"Ic5fTm52fOBZVoLWfSh/lPmWCZnmVQbMlFxqntpPml0Ls2RmKTRznZmQTElgas7MstWYzQXkmT7yi/y1",
-	"gnIbrNJNPrykmwbEpFQ59OF8oVYzIcFDBTVQ9YYwo1gGc2y05IbZGSysvqFRTAMv0yWbq3IPqARECC/I",
-	"ajU5fTfRIDMocbdSEGv877wE+A0Sw8sFmMmHaWxxcwNlYsQqsrRzh/0SdJUbzbAtrnEh1iCZ7XXEfqi0",
-	"YTNgXLK3375gT58+fW4XsuLGQOaIbHBVzezhmqj75HSScQP+c5/WeL5QJZdZUrd/++0LnP/CLXBsK641",
-	"xA/Lmf3Czl8OLcB3jJCQkAYWuA8t6rc9Ioei+XkGc1XCyD2hxve6KeH8n3VXUm7SZaGENJF9YfiV0eco",
-	"Dwu67+JhNQCt9oXFVGkHfXeSPP/w8fH08cnNn96dJf/t/vzy6c3I5b+ox92DgWjDtCpLkOk2WZTA8bQs",
-	"uezj462jB71UVZ6xJV/j5vMVsnrXl9m+xDrXPK8snYi0VGf5QmnGHRllMOdVbpifmFUyt2zKjuaonQnN",
-	"ilKtRQbZ1HLf66VIlyzlmobAduxa5LmlwUpDNkRr8dXtOEw3IUosXLfCBy7oXxcZzbr2YAI2yA2SNFca",
-	"EqP2XE/+xuEyY+GF0txV+rDLil0ugeHk9gNdtog7aWk6z7fM4L5mjGvGmb+apkzM2VZV7Bo3JxdX2N+t",
-	"xmJtxSzScHNa96g9vEPo6yEjgryZUjlwicjz566PMjkXi6oEza6XYJbuzitBF0pqYGr2T0iN3fb/c/Hj",
-	"a6ZK9gNozRfwhqdXDGSqMsiO2PmcSWUC0nC0hDi0PYfW4eCKXfL/1MrSxEovCp5exW/0XKxEZFU/8I1Y",
-	"VSsmq9UMSrul/goxipVgqlIOAUQj7iHFFd/0J70sK5ni/jfTtmQ5S21CFznfIsJWfPPXk6kDRzOe56wA",
-	"mQm5YGYjB+U4O/d+8JJSVTIbIeYYu6fBxaoLSMVcQMbqUXZA4qbZB4+Qh8HTCF8BOH6QQXDqWfaAI2ET",
-	"oRl7uu0XVvAFBCRzxH5yzA2/GnUFsiZ0Ntvip6KEtVCVrjsNwIhT75bApTKQFCXMRYTGLhw6LIOhNo4D",
-	"r5wMlCppuJCQWeaMQCsDxKwGYQom3K3v9G/xGdfw1bOhO775OnL356q76zt3fNRuY6OEjmTk6rRf3YGN",
-	"S1at/iP0w3BuLRYJ/dzbSLG4tLfNXOR4E/3T7p9HQ6WRCbQQ4e8mLRaSm6qE0/fykf2LJezCcJnxMrO/",
-	"rOinH6rciAuxsD/l9NMrtRDphVgMILOGNapwYbcV/WPHi7Njs4nqFa+UuqqKcEFpS3Gdbdn5y6FNpjEP",
-	"JcyzWtsNFY/LjVdGDu1hNvVGDgA5iLuC24ZXsC3BQsvTOf6zmSM98Xn5m/2nKHLb2xTzGGotHbsrGc0H",
-	"zqxwVhS5SLlF4lv32X61TABIkeBNi2O8UE8/BiAWpSqgNIIG5UWR5CrleaINNzjSf5Qwn5xO/nTc2F+O",
-	"qbs+DiZ/ZXtdYCcrspIYlPCiOGCMN1b00TuYhWXQ+AnZBLE9FJqEpE20pCQsC85hzaU5alSWFj+oD/A7",
-	"N1ODb5J2CN8dFWwQ4YwazkCTBEwNH2gWoJ4hWhmiFQXSRa5m9Q9fnBVFg0H8flYUhA+UHkGgYAYboY1+",
-	"iMvnzUkK5zl/ecS+C8dGUVzJfGsvBxI17N0wd7eWu8Vq25JbQzPiA81wO1V5ZLfGo8GK+fdBcahWLFVu",
-	"pZ69tGIb/821DcnM/j6q8x+DxELcDhMXKloOc6Tj4C+BcvNFh3L6hOPMPUfsrNv3dmRjR4kTzK1oZed+",
-	"0rg78Fij8LrkBQHovtBdKiQqadSIYL0jNx3J6KIwB2c4oDWE6tZnbe95iEKCpNCB4etcpVd/43p5D2d+",
-	"5sfqHz+chi2BZ1CyJdfLo0lMygiPVzPamCNmG6KCz2bBVEf1Eu9reXuWlnHDg6U5eONiCaEe+yHTgzKi",
-	"u/yI/+E5s5/t2basn4Y9YpfIwDQdZ+dkyKy2TwoCzWQboBVCsRUp+Mxq3QdB+aKZPL5Po/boG7IpuB1y",
-	"i8AdUpt7PwZfq00Mhq/VpncE1Ab0fdCHHQfFSAMrPQK+lw4yhfvv0MfLkm/7SMaxxyDZLtCKrhpPgwxv",
-	"fDtLY5w9m6nydtynw1Yka0zOjNtRA+Y77SAJm1ZF4kgxYraiBp2BGi/fbqbRHT6GsRYWLgz/HbCg7aj3",
-	"gYX2QPeNBbUqRA73QPrLKNOfcQ1Pn7CLv519+fjJL0++/MqSZFGqRclXbLY1oNkXTjdj2mxzeNhfGWpH",
-	"VW7io3/1zBsq2+PGxtGqKlNY8aI/FBlASQSiZsy262OtjWZcdQ3gmMN5CZaTE9oZ2fYtaC+FthLWanYv",
-	"mzGEsKyZJWMOkgz2EtOhy2um2YZLLLdldR+qLJSlKiP2NTxiRqUqT9ZQaqEi3pQ3rgVzLbx4W3R/J2jZ",
-	"NdfMzo2m30qiQBGhLLOR4/k+DX25kQ1udnJ+Wm9kdW7eMfvSRr63JGpWQJmYjWQZzKpFSxOal2rFOMuw",
-	"I97R34FBUeBSrODC8FXx43x+P6qiwoEiKptYgbYzMWph5XoNqZIUCbFHO3OjjkFPFzHeRGeGAXAYudjK",
-	"FO2M93FshxXXlZDo9NBbmQZarIUxh2zRIsu7a6tD6KCpHugIOBYdr/AzGjpeQm74t6q8bCyB35WqKu5d",
-	"yOvOOXY53C3GmVIy29fr0EIu8nb0zcLCfhRb42dZ0At/fN0aEHqkyFdisTSBWvGmVGp+/zDGZokBih9I",
-	"Kcttn75q9lpllpmYSt+DCNYM1nA4S7chX+MzVRnGmVQZ4OZXOi6cDcRroKMY/dsmlPfMkvSsGVjqSnll",
-	"V1sVDL23vfui6ZjwlE5ogqjRA76r2ulIrWg6igXIS+DZls0AJFMz5yByritcJEfXs/HijRMNI/yiBVdR",
-	"qhS0hixxhqm9oPl2dHWYHXhCwBHgehamFZvz8s7AXq33wnkF2wQDJTT74vuf9cPPAK9Rhud7EIttYuit",
-	"1XznBexDPW76XQTXnTwkO14C8/cKMwql2RwMDKHwIJwM7l8Xot4u3h0tayjRH/e7Uryf5G4EVIP6O9P7",
-	"XaGtioHwP6feWgnPbpjkUnnBKjZYzrVJ9rFl26ilg9sVBJwwxolx4AHB6xXXhnzIQmZo+qLrBOchIcxO",
-	"MQzwoBpiR/7ZayD9sVN7D0pd6Vod0VVRqNJAFluDhM2OuV7Dpp5LzYOxa53HKFZp2DfyEJaC8R2yaCWE",
-	"IG5qV4sLsugvDh0S9p7fRlHZAqJBxC5ALnyrALthCNQAIEI3iCbCEbpDOXXc1XSijSoKyy1MUsm63xCa",
-	"Lqj1mfmpadsnLm6aeztToDHyyrV3kF8TZin4bck1c3CwFb+ysgeaQcjZ3YfZHsZEC5lCsovyUcWzrcIj",
-	"sPeQVsWi5BkkGeR82x/0J/rM6POuAXDHG3VXGUgoiim+6Q0l+6CRHUMrHE/HhEeGX1hqj6BVBRoCcb33",
-	"jJwBjh1jTo6OHtRD4VzRLfLj4bJpqyMj4m24VsbuuKMHBNlx9DEAD+ChHvr2qMDOSaN7dqf4L9BuglqO",
-	"OHySLeihJTTjH7SAARuqCxAPzkuHvXc4cJRtDrKxPXxk6MgOGHTf8NKIVBSo63wP23tX/boTRN2MLAPD",
-	"RQ4ZCz6QGliE/RnF33THvJ0qOMr21ge/Z3yLLCcXGkWeNvBXsEWd+w0FdgamjvvQZSOj2vuJS4aA+nAx",
-	"K4KHTWDDU5NvraBmlrBl11AC09VsJYyhgO22qmtUkYQDRP0aO2Z0TjwKivQ7MMareIFDBcvrb8V0QjrB",
-	"bvguO4pBCx1OFyiUykdYyHrIiEIwKt6DFcruunCx4z562FNSC0jHtNGDW1//D3QLzbgC9l+qYimXqHJV",
-	"BmqZRpUoKKAAaWewIlg9p4vsaDAEOayANEn88uhRd+GPHrk9F5rN4do/uLANu+h49AjtOG+UNq3DdQ/2",
-	"UHvcziPXBzp87MXntJAuT9kfWeBGHrOTbzqD114ie6a0doRrl39nBtA5mZsxaw9pZFxUBY47ypcTDB1b",
-	"N+77hVhVOTf34bWCNc8TtYayFBns5eRuYqHkN2ue/1h3w8ckkFoaTSFJ8QnEyLHg0vahVxP7dMMmmkys",
-	"VpAJbiDfsqKEFCjK34p8uobxiFH8X7rkcoGSfqmqhQtAo3GQU1eabCplJXtDRKUhs5EJWqdjnNsFHfuH",
-	"HlYOAm51sa5pmzSPa17P5972jLlSA+R1Tf1R79Z0MqiqWqSuG1WVkNN+rTKCi7cEtQA/zcQjfSCIOiu0",
-	"9PEVbos9BXZzfx9bezN0DMr+xEFIXPNxKCrO6sn59h6kFRqIlVCUoPFuCe1Lmr6qefgyzV0+eqsNrPom",
-	"eOr6y8Dxezuo6CmZCwnJSknYRh9jCwk/4MfoccL7baAzShpDfbvKQwv+DljtecZQ413xi7vdPaFdV5P+",
-	"VpX35cukAUfL5SNch3v95G7K2zo4eZ5HfILu3UqXAehp/U5elIxrrVKBwtZ5pqd00Jwb0T1yaaP/TR2N",
-	"ew9nrztux/kVPolE4y7kBeMszQWafpXUpqxS815yNC4FS41ELXktetjc+MI3ids3I+ZHN9R7yTFirTY5",
-	"RSMt5hCxr3wL4K2OulosQJuOkjIHeC9dKyFZJYXBuVb2uCR0XgooMXToiFqu+JbNLU0YxX6DUrFZZdpi",
-	"Oz7L0kbkufPE2WmYmr+X3LAcuDbsByEvNzic99b7IyvBXKvyqsZC/HZfgAQtdBKPrvqOvmLgq1v+0gXB",
-	"4jN6+ky+Gzt+83Zri7an5mn4//viP0/fnSX/zZPfTpLn/+v4w8dnNw8f9X58cvPXv/7/9k9Pb/768D//",
-	"I7ZTHvbYoyEH+flLp9Kev0S9pXHe9GD/ZIb7lZBJlMjCMIwObbEv8IGsI6CHbauWWcJ7aTbSEtKa5yKz",
-	"vOU25NC9YXpnkU5Hh2paG9GxYvm1HqgN3IHLsAiT6bDGW0tR/YDE+PM89Ca6F3d4XuaVpK300je9PvGB",
-	"YWo+rZ9gUnaWU4bv85bcRzW6P598+dVk2ryrq79PphP39UOEkkW2ib2ezGATU/LcAcGD8UCzgm81mDj3",
-	"QNijMXAUlBEOu4LVDEq9FMWn5xTaiFmcw/mYfmcs2shzScH29vygb3LrXB5q/unhNiVABoVZxrI2tAQ1",
-	"bNXsJkAnXqQo1RrklIkjOOoaazKrL7povBz4HLMHoPapxmhD9TkgQvNUEWA9XMgoi0iMflDkcdz6Zjpx",
-	"l7++d3XIDRyDqztn7Yj0fxvFHnz3zSU7dgxTP6CHvDR08PQyokq710WtSCLLzShXDQl57+V7+RLmQgr7",
-	"/fS9zLjhxzOuRaqPKw3l1zznMoWjhWKn/sHSS274e9mTtAbTSQVPxVhRzXKRsqtQIWnIk1KE9Ed4//4d",
-	"zxfq/fsPvaCKvvrgporyF5ogsYKwqkziEhwkJVzzMua00vUDdxyZMpjsmpWEbFWRZdMnUHDjx3keLwrd",
-	"fejaX35R5Hb5ARlq94zTbhnTRpVeFrECCkGD+/tauYuh5NferlJp0OwfK168E9J8YMn76uTkKbDWy89/",
-	"uCvf0uS2gNHWlcGHuF2jCi6c1ErYmJInBV/EfGPv378zwAvcfZSXV2jjyHOG3VovTn1EPQ7VLMDjY3gD",
-	"CI6DX8/h4i6ol09mFV8CfsItxDZW3Gg89rfdr+AN6q23q/OOtbdLlVkm9mxHV6UtifudqXPcLKyQ5cMo",
-	"tFigturSAc2ApUtIr1yeFlgVZjttdfeROk7Q9KxDaMrgQy/IMIcEehZmwKoi404U53LbfcyvwRgfD/wW",
-	"rmB7qZoUFIe83m8/JtdDBxUpNZAuLbGGx9aN0d18Fw6Gin1R+DfZ+DjPk8VpTRe+z/BBJpH3Hg5xjCha",
-	"j52HEMHLCCKI+AdQcIuF2vHuRPqx5VktY0Y3XySbj+f9zDVplCcXuRWuBq3u9H0FmA5MXWs241ZuVy6T",
-	"FT2YDrhYpfkCBiTk0Lkz8llyyyGEg+y796I3nZp3L7TefRMFmRonds1RSgH7xZIKKjOdeD0/E/kPnWcC",
-	"E1Q6hM1yFJPqwEZiOrxsOdko494QaHEChlI2AocHo42RULJZcu2TbGEuMn+WR8kAv2MCgF1pX86DULMg",
-	"4Vid1MXz3O457WmXLvmLz/ji07yEquWIlC1Wwsfo9th2KIkCUAY5LGjh1NgTSpOMoNkgC8eP83kuJLAk",
-	"FrUWmEGDa8bNAVY+fsQYWeDZ6BFiZByAjX5xHJi9VuHZlItDgJQumQL3Y6NHPfgb4u++KI7bijyqsCxc",
-	"DHi1Us8BuAt1rO+vTsAtDsOEnDLL5tY8t2zOaXzNIL3sIyi2dnKNuMiMh0Pi7A4HCF0sB62JrqLbrCaU",
-	"mTzQcYFuB8QztUno4WdU4p1tZpbeo6Ht+Aw1djApz8sDzWZqg9E+eLVQKPUeWIbh8GAEGv5GaKRX7Dd0",
-	"mxMwu6bdLU3FqFAjyThzXk0uQ+LEmKkHJJghcvkiSN1yKwA6xo4mD7JTfvcqqW3xpH+ZN7fatElJ5l8N",
-	"xY7/0BGK7tIA/vpWmDrZypuuxBK1U7SDVtp5ZgIRMkb0lk30nTR9V5CGHFApSFpCVHIV85xa3Qbwxrnw",
-	"3QLjBWaz4XL7MIiEKmEhtIHGiO7jJD6HeZJjEj2l5sOrM0U5t+t7q1R9TZEbETu2lvnJV4ChxHNRapOg",
-	"ByK6BNvoW41K9be2aVxWasdaUcpZkcV5A057BdskE3kVp1c37/cv7bSva5aoqxnyWyEpYGWGKZKjEZg7",
-	"pqYg3Z0LfkULfsXvbb3jToNtaicuLbm05/iDnIsO593FDiIEGCOO/q4NonQHgwxezva5YyA3BT7+o13W",
-	"195hyvzYe6N2/PvdoTuKRoquJTAY7FyFQDeRFUuECTIM95+0DpwBXhQi23RsoTTqoMbMDzJ4+LxsHSzg",
-	"7rrB9mAgsHvGXtWUoNsp+BoBn3JFtzLgHI3CzGU7UV7IEMKphPaVDvqIql/d7cPVJfD8e9j+bNviciY3",
-	"08ndTKcxXLsR9+D6Tb29UTyja55MaS1PyIEo50VRqjXPE2dgHiLNUq0daWJzb4/+xKwubsa8/Obs1RsH",
-	"/s10kubAy6QWFQZXhe2KP8yqKNvfwAHxmdStzudldhIlg82vU5SFRunrJbiU1IE02sud2TgcgqPojNTz",
-	"eITQXpOz843QEnf4SKCoXSSN+Y48JG2vCF9zkXu7mYd2IJoHFzcuAWuUK4QD3Nm7EjjJkntlN73THT8d",
-	"DXXt4UnhXDuSZq8oL7xmSnZd6BjzvC2c133FMfMlWUX6zElWK7QkJDoXadzGKmfaEock35ltzLDxgDBq",
-	"R6zEgCtWViIYyzYbk9umA2QwRxSZOppep8HdTLmaP5UUv1bARAbS2E8lnsrOQcU0Kc7a3r9OrezQn8sN",
-	"TBb6Zvi7yBhh1tfujYdA7BYwQk9dD9yXtcrsF1pbpOwPgUviAId/OGPvStzhrHf04aiZgheXbY9bWKKn",
-	"z/8sYVCu9v31gbzy6tLPDswRrfcjdDIv1W8Q1/NQPY48WPJ5bgVGufwG4UOHsMpFi8XU1p2mbFEz++B2",
-	"D0k3oRWqHaQwQPW484FbDhNuegs1l7TV9JCkFesWJ5gwqvSYxm8IxsHci8TN+fWMx7KRWiHDwnTWOIBb",
-	"tnSjmO/sca/r1xY0Owt8yXVbQY/RCyibt4T9xDa3FBho2tGiQiMZINWGMsGU/H+5VpFhKnnNJVVxsf3o",
-	"KLneGsj4ZXtdqxJTSei42T+DVKx4HpccsrRv4s3EQlCBkkpDUAHDDUTFn4iKXBWR+g2RQ835nJ1MgzI8",
-	"bjcysRZazHLAFo+pxYxr5OS1IaruYpcH0iw1Nn8yovmyklkJmVlqQqxWrBbqUL2pnVczMNcAkp1gu8fP",
-	"2RfottNiDQ8tFt39PDl9/ByNrvTHSewCcAVmdnGTDNnJ3x07idMx+i1pDMu43ahH0Vf3VGFumHHtOE3U",
-	"dcxZwpaO1+0/Sysu+QLikSKrPTBRX9xNNKR18CIzKo+kTam2TJj4/GC45U8D0eeW/REYLFWrlTAr59zR",
-	"amXpqSlvQZP64ajWkstM7OHyH9FHWngXUUeJ/LRGU7rfYqtGT/ZrvoI2WqeMU/6QXDTRCz5fOjv36Ykw",
-	"VXOdoZlwY+eyS0cxB4MZ5qwohTSoWFRmnvyFpUte8tSyv6MhcJPZV88i6anbaVLlYYB/cryXoKFcx1Ff",
-	"DpC9lyFcX/aFVDJZWY6SPWxeewSnctCZG3fbDfkOdw89ViizoySD5Fa1yI0HnPpOhCd3DHhHUqzXcxA9",
-	"HryyT06ZVRknD17ZHfrp7SsnZaxUGcs52Bx3J3GUYEoBa4zdi2+SHfOOe1Hmo3bhLtB/Xs+DFzkDscyf",
-	"5Zgi8LWKaKc+ZXptSXex6hHrwNAxtR8sGczcUFPWTk/96fno/URBxT1d3rDdd2zZLx4P+EcXEZ+ZXHAD",
-	"G18+rWSAUIL0/FGSyervgY+ds6/VZizhdE6hJ55/ARRFUVKJPPu5efnZqX5Qcpkuoz6zme34S1OnrV4c",
-	"3YHR9IFLLiXk0eFI3vzFy6URyfmfauw8KyFHtu0WZKDldhbXAN4G0wPlJ7ToFSa3E4RYbT+qq4O284XK",
-	"GM7T5Kprjmu/kEeQbv3XCrSJPVDCDxQ4hrZRyw4o2zcDmaFGesS+o1LMS2CtRESoCfpMEe1X01WRK55N",
-	"MYPF5TdnrxjNSn2o2hBlG1+gItReRccmFqThHBeC7AsHxZ9HjB9nd7y2XbU2SZ0cPPYA1bZo0peLjp8A",
-	"VaQQO0fsZVBUld6q2iEYJjApV1arq0cj+Qhpwv7HGJ4uUe1rsdZhkh+fJt9TpQ5KU9YlpurclHjuLNwu",
-	"Uz4lyp8yZXXza6GpAi+sof3mtX4A7swO/g1se3llJSVRytEBt1ydifJQtHvg6Ir0roQoZB3EHyj0U5WJ",
-	"Q6sGXGCvaKqsbgmCXk1KekFZlw7yldVTLpUUKSaqil3RrlTvGD/biJxeXUOuP+LuhEYOV7TwQR2K57A4",
-	"WArBM0KHuL6hP/hqN5Wog/40WBN2yQ1bgNGOs0E29fU7nK1RSA0u1ygWdg74pCpbvkvkkFF3eFK7TQ4k",
-	"I3x6M6A8fmu/vXamBYxJvxISlQiHNif4kTUQK4kaq3kIwxYKtFtP+/2xfmf7HOFT3Aw2H4585VEcg1x/",
-	"dtnk5+4Pdea93s7LbNu+sG1dgqT651aUM016VhRu0uHqLlF5wGzkIIIj3svEu48C5Nbjh6PtILed4Sp4",
-	"n1pCgzU6u6HAe7hHGHWlk04VLSu0EkVhC0ZhYtEsCUJGwHglJDR1cSMXRBq9EnBj8LwO9NNpyQ2JgKN4",
-	"2iXwHD3cMYamjXNv3HWobnooixJco59jeBubIi0DjKNu0AhuXG7rcryWugNh4gXWAXeI7JdcQanKCVEZ",
-	"vlroFGGJMQ7LuH2Zp/YF0D8GfZmIumOutENvoqGHqLMqW4BJeJbFUr9+jV8ZfmVZhZIDbCCt6hShRcFS",
-	"zLvSTkTTpzY3UaqkrlY75vIN7jhdUNUoQg1hZSW/w/jQZbbFf2P5MYd3xgV6HBxq6KM6ssOyL/VDJ2NS",
-	"r6XpRItFMh4TeKfcHR3N1Lcj9Kb/vVJ6rhZtQD5x+oldXC7coxh/+8ZeHGF2hl7SV7pa6uQJGNinfC1K",
-	"VBvrZ79troRXWS8LLDqU6lp3uw0Qw1Xrpnj5DYT3Bkk3ON2v5KEcCvJNB2PSuXGv4wxnO1nQ4IsjihCi",
-	"t0UIRdw6OxQVREFB9nOv9zjJsCdnm3jiwwChPtysD9D3PpaVFVw493vDLPqYdVHv/XcIY+Jhmw3uLsLF",
-	"kg9a7L5fD8V9+2Rs+L1b1eoK3JP5ooS1UJV3bPvIJ68S0q+tGlF15H10/X3DK071ec2hg8bbS1ddgJbp",
-	"dPLvf6Y4OQbSlNt/AVNub9N79bL60i6Zp5omrE5MPSpRdetWHJOoMJYTz8mGrYpde+qN9cjq5RhxoF8/",
-	"bDo5zw66MGN5FSc0SuzYxauBDaedalJN4RErlBZNfvhYmbCRIYaXWOkrSJvVH8vH96whNVgUoIlbKAEO",
-	"SaJlJwsKj/47/dSAOl1HYrqsU7tSTfUrAey543uvwYIXjZRF/Wh8YqWzOjoN+TRmQ16AdLU/2+88Rkeb",
-	"z+eQGrHe8/ru70uQwcuuqbfLUA3v4DGeqKOXMXnL4VbHBqBdj+N2whMkUbwzOENvb65g+0CzFjVE07pP",
-	"/VV7m7wdiAHkDoklEaVj0R9kSHYOeaFrykAs+Ggr6g5NBrTBilDBW9JbzuVJ0l4czfvSHVPGS9KMmst2",
-	"PejVNQbiDj3Q61e0GNY/XmIBEV1Xa/R5P0ItnZ33syNeu7wh+Fay9p34DCKg/W/+YTTNkosrCGtWoafq",
-	"mpeZbxE1vXirTrLjPuq9qvPVGLpAz+uZRRMb239HFcm3hRHQaa6sGJEMhZG3w1HrWI4HmoJuKP07Btpa",
-	"uOZQutp+KP/mSkNilI+l3QXHLlRQZNGtkKAHc1wScIOZZ942qXUw1y/HTDPcBRSFC2QlrLiFrgwS4AzP",
-	"uQvZL+i7fzjkc73utTDV9Lq/6ICPiha6h8SQ6ufM3Zb7HyTdxtgkpKT60TqWDUdC2faGFKXKqpQu6PBg",
-	"1Aa50bmmdrCSqJ0m7a+yoyMErzqvYHtMSpCv1uB3MASaJCcCPcii0NnkezW/6Rjci3sB73NarqaTQqk8",
-	"GXB2nPdT+HQp/kqkV5Axe1P46MGBCjrsC7Sx197s6+XWp6wpCpCQPTxi7ExSvLZ3bLdzSHcmlw/Mrvk3",
-	"OGtWUVYtZ1Q7ei/jga+Y76q8Izfzw+zmYRosq7vjVDTIngQxm4H0QSW/jtSTOhqrlfddzd0aPw1RERQx",
-	"maQpX7MnTqYOkWkqfzRhMn3pIM/VdYJUlNT5v2I6h23XZpI+42nTzWJ7BkG8DdfuAt2yJc9YqsoS0rBH",
-	"/IkDAbVSJSS5wvCbmGdwbqw8tMK4ZslytWCqsGoupdHzPpRoWZpgrvsqwUPPdQmChBw+AwkRQLvnuQ5c",
-	"atyHd0cVnMMr7FwuI3Yb3DC/WweX0XEEd3D1iwDMEYS+32Z1FqsS1F5Xt17VUPU4o1YijaP7jxWtMhhj",
-	"EqPeGCpcAlp6AIfN8ICHPKV2TuLp6aMZJJ/lMY8Nc8fPOWmQzu1/8Qbrjsvm4JjLAD+LPMDctepY5afI",
-	"rtZTucJU/k3lAIVEHd67/ctUDXA21stcZ5weyQwCAIb9zi0YRnmfDwVjjtU1Ex5B8nkt809bxY9Fh+P5",
-	"bIB0slNOOv8SmB27KsG98aMygJ26QwU3Sy8D2OZ9zdxqeaDxAR4VT+Ga7EjenuVqEHaFK1UkOayh5Y53",
-	"Dw+rNAWtxRrC+oXUmWUABVp3uzpHzM8c8vaOIOrWngSeyjHYjUqmhFjaKbZH7IwKyRuZ0DHRY4+ShWgt",
-	"soq38KfvUMltqIhb5PLxsH4YxykOZhLxxe1iEXsjQ5Dmo+dSxgNDwnevtUkJZ8tq0zMRYXOydcGv5bAK",
-	"1ifKRnYaXwMxQOw3G0jxHmpHPtwdJwwHY7rzpn1QaCrrHb6tKj9IZbuIrFcRMiq1afAVfcP0M17wdX0j",
-	"0i4ZHYWODCB0wxswjhKaOL2g2YpvWSbmcyjJraINlxkvs7C5kCyF0nBhdcytvr2CYaEtK5ju1TEsp8ZB",
-	"PbOKaRtoISRA8q1T3obk/xFyO/rQIjI7XdtGDRWr7O1K/GEH31g9ByPcBojAPUlHLYcOq5IoYrIVv4ID",
-	"59HiN9g9DSaKcVZYo3DWMVPc7KT1HxF1eOB/ksLspHYS/bohh+QTImL0NCgXjWOaNqdPg7Eo0UsqmRRG",
-	"inYrEPi9JgMVzQcDGRUd70yQp+odLl/QQa2k1Jns+uJAjxkTMFMXQXuQtNA1N6R7mFKURQ+cibasruZI",
-	"nbgpdDFh3EDNjqfdiJb2FVRvO1b/TKsShahrvt2fmK25huLBwDSyV2d8jEMNtdtqIjBNBSWiec8OEU8i",
-	"NB+rqdDPOHX/i6Eo98YP9/stx1na4wsIK7TvprdGkPekEqE1Lrexo+NtybdY4JB0MiJO8962qj4tv8cG",
-	"RVn07RKRjgKtH7MXwWZQOXh3GEWYp7h5AF1S6Ce6Xb0+1OUXPzR60rgaxr7DHvDC6JqgirF3dDhwPvNL",
-	"4h9qpARL+TBECa3l7wvYcQtsFMtgi5ysZgxQ1nh6fdbelyAaS7+og5yGCm53Y6EwKbEVDvI8EkNF4iOV",
-	"uA0Ix96T5Zrnnz4OCrNVnyE+IHs77DkNA2lCJBMq9e2e8b3io+YOgmbub2r5BuO2/g52j6LXghvKaaw9",
-	"5o/CP8/Jyj/39S7XINk1jklB34+/YjOX5qQoIRW6qwlf+1JUddwIVmZ0Tyc3Zk+gyr51/qzMHch47g1L",
-	"7HVT1gYN2QvZQNgc0c/MVAZObpTKY9TXI4sI/mI8Ksw3uue6uGpFgzdSXXCjqRLuOSo8eN91YFR4P5Pq",
-	"2OVR5LO9dCoN/XWOvq1buI1c1M3axj5p6CN3V+2TMS8R4iWNbHd8CkEIwXpgDEFl/3j8D1bCHAv+Kvbo",
-	"EU7w6NHUNf3Hk/Zne5wfPYoqeZ/sEQThyI3h5o1RzM9Dz+Lp6fdABobOflQiz/YRRiufRlMyGzNG/OKy",
-	"9nyWot2/UGBm/6i6wql3iCYnxETW2po8mCrIlDEiSYbrFkmJgUEPaVUKs8Vkwl7jFb9En2t8V4f+utDx",
-	"2oTn7j6jrqBOR90EClfa367fKZ7jfUSWRWlvIZUfsW82fFXk4A7KXx/M/gxP//IsO3n6+M+zv5x8eZLC",
-	"sy+fn5zw58/44+dPH8OTv3z57AQez796PnuSPXn2ZPbsybOvvnyePn32ePbsq+d/fmD5kAWZAJ341HWT",
-	"/4uV7ZOzN+fJpQW2wQkvxPewpSK6lox9eV6e4kmEFRf55NT/9L/9CTtK1aoZ3v86cZmxJktjCn16fHx9",
-	"fX0UdjleYGRgYlSVLo/9PL36vWdvzmsXJBn9cUcpqYR35nhSOMNvb7+5uGRnb86PGoKZnE5Ojk6OHtvx",
-	"VQGSF2JyOnmKP+HpWeK+Hztim5x+vJlOjpfAcwykt3+swJQi9Z9K4NnW/V9f88UCyiNXs9j+tH5y7MWK",
-	"448uQvJm17fjsPzX8cdWIGm2pyeWBzr+6LPe7m7dSivrAmiDDiOh2NXseIbJtMY2BR00Hl4KKhv6+COK",
-	"y4O/H7vsP/GPqLbQeTj20dbxli0sfTQbC2unR8pNuqyK44/4H6TPG2IYOcRiqylpDmdN8ykThvGZKjHd",
-	"rEmXlkf4PJdCBy0nSLVE8OeZJXTb6wVB4DNaU4mP03f9GAAciPmRkCtYkm8ObWumhi+jkyCoOlHfOq32",
-	"zd3z7iR5/uHj4+njk5s/2bvF/fnl05uRwRcv6nHZRX1xjGz4AZNEoo8Iz/KTk5OD6ov31KRmkbRJ9avX",
-	"/r3uaGHYQ+y2qjMQq5GxJ5ldZ/hYPfab6eTZgSveaUtqvQSO1FX/mmfMx8fh3I8/3dznEp+oWB7P6A67",
-	"mU6+/JSrP5eW5HnOsGWQnbi/9T/JK6mupW9pBY5qteLl1h9j3WIKzG02Xmt8odGLUIo1RzlPKtkquTr5",
-	"gIGysRjFAX6jDb8Fv7mwvf7Nbz4Vv8FNug9+0x7onvnNkwPP/B9/xf/msH80DntB7O5OHNYJfJQ+5dhs",
-	"5DFGHBx/bAmo7nNPQG3/3nQPW6xXKgMvg6r5nAqz7Pp8/JH+DSaCTQGlWIGkhNXuV3pafozpkrf9n7cy",
-	"jf7YX0e3Knrs5+OP7ap8LQTpZWUydU05QqNXFpae4bnLU4/m2lr1M4r5AZp3vOxHl3ok36KNWmTAOOZE",
-	"VJVpdHPbuY4KrL0ndgSml85MvRASJ0AzOM5CBRl48EJOQ6ok1RfvXI8Ostcqg/71iBfgrxWU2+YGdDBO",
-	"pi3+6Ag8Uv7gztdNn53dHEb+aK4nX1OfOOqi4q2/j6+5MPYSdQ9qEaP9zgZ4fuyy53V+bRLW9L5gFp7g",
-	"xzC0MfrrcV1BKPqxqwrHvjpVcKCRj47ynxuzWGhmQpKoDUzvPtidxfz0jloaq8np8TE+UlsqbY4nN9OP",
-	"HYtK+PFDvZk+qXC9qTcfbv4nAAD//87z2lBO0wAA",
+	"H4sIAAAAAAAC/+x9+3PcNtLgv4Ka76vy44aS/Eh2raqt7xQ7yeriOC7LSe4725fFkD0zWHEALgFKmvj0",
+	"v191AyBBEuRwJNneVOUnW0M8Go1Go9HPj7NUbQolQRo9O/44K3jJN2CgpL94mqpKmkRk+FcGOi1FYYSS",
+	"s2P/jWlTCrmazWcCfy24Wc/mM8k3MDsO+89nJfyrEiVks2NTVjCf6XQNG44Dm22BreuRrpKVStwQJ3aI",
+	"0xez65EPPMtK0LoP5U8y3zIh07zKgJmSS81T/KTZpTBrZtZCM9eZCcmUBKaWzKxbjdlSQJ7pA7/If1VQ",
+	"boNVusmHl3TdgJiUKoc+nM/VZiEkeKigBqreEGYUy2BJjdbcMJwBYfUNjWIaeJmu2VKVO0C1QITwgqw2",
+	"s+N3Mw0yg5J2KwVxQf9dlgC/Q2J4uQIz+zCPLW5poEyM2ESWduqwX4KucqMZtaU1rsQFSIa9DtiPlTZs",
+	"AYxL9ua75+zJkyfPcCEbbgxkjsgGV9XMHq7Jdp8dzzJuwH/u0xrPV6rkMkvq9m++e07zn7kFTm3FtYb4",
+	"YTnBL+z0xdACfMcICQlpYEX70KJ+7BE5FM3PC1iqEibuiW18p5sSzv9FdyXlJl0XSkgT2RdGX5n9HOVh",
+	"QfcxHlYD0GpfIKZKHPTdUfLsw8dH80dH1//x7iT5P+7Pr55cT1z+83rcHRiINkyrsgSZbpNVCZxOy5rL",
+	"Pj7eOHrQa1XlGVvzC9p8viFW7/oy7GtZ5wXPK6QTkZbqJF8pzbgjowyWvMoN8xOzSuagNY3mqJ0JzYpS",
+	"XYgMsjkTkl2uRbpmKdd2CGrHLkWeIw1WGrIhWouvbuQwXYcoQbhuhA9a0L8vMpp17cAEXBE3SNJcaUiM",
+	"2nE9+RuHy4yFF0pzV+n9Liv2dg2MJscP9rIl3Emk6TzfMkP7mjGuGWf+apozsWRbVbFL2pxcnFN/txrE",
+	"2oYh0mhzWvcoHt4h9PWQEUHeQqkcuCTk+XPXR5lcilVVgmaXazBrd+eVoAslNTC1+CekBrf9f5399Iqp",
+	"kv0IWvMVvObpOQOZqgyyA3a6ZFKZgDQcLREOsefQOhxcsUv+n1ohTWz0quDpefxGz8VGRFb1I78Sm2rD",
+	"ZLVZQIlb6q8Qo1gJpirlEEB2xB2kuOFX/UnflpVMaf+baVuyHFKb0EXOt4SwDb/629HcgaMZz3NWgMyE",
+	"XDFzJQflOJx7N3hJqSqZTRBzDO5pcLHqAlKxFJCxepQRSNw0u+ARcj94GuErAEfIHeAIOQ0cCVcRmsHT",
+	"jV9YwVcQkMwB+9kxN/pq1DnImtDZYkufihIuhKp03WkARpp6XAKXykBSlLAUERo7c+jQjDPbxnHgjZOB",
+	"UiUNFxIyJqQFWhmwzGoQpmDC8fdO/xZfcA1fP51d7/o6cfeXqrvrozs+abepUWKPZOTqxK/uwMYlq1b/",
+	"Ce/DcG4tVon9ubeRYvUWb5ulyOkm+ifun0dDpYkJtBDh7yYtVpKbqoTj9/Ih/sUSdma4zHiZ4S8b+9OP",
+	"VW7EmVjhT7n96aVaifRMrAaQWcMafXBRt439B8eLs2NzFX1XvFTqvCrCBaWth+tiy05fDG2yHXNfwjyp",
+	"X7vhw+PtlX+M7NvDXNUbOQDkIO4Kjg3PYVsCQsvTJf1ztSR64svyd/ynKHLsbYplDLVIx+5KJvWBUyuc",
+	"FEUuUo5IfOM+41dkAmAfErxpcUgX6vHHAMSiVAWURthBeVEkuUp5nmjDDY30nyUsZ8ez/zhs9C+Htrs+",
+	"DCZ/ib3OqBOKrFYMSnhR7DHGaxR99AizQAZNn4hNWLZHQpOQdhORlASy4BwuuDQHs3nsTDYH+J2bqcG3",
+	"lXYsvjtPsEGEM9twAdpKwLbhPc0C1DNCKyO0kkC6ytWi/uH+SVE0GKTvJ0Vh8UHSIwgSzOBKaKMf0PJ5",
+	"c5LCeU5fHLDvw7FJFFeoXlqAEzXwbli6W8vdYrVuya2hGfGeZrSdqKy5ntdo0BrMXVAcPSvWKkepZyet",
+	"YOO/u7YhmeHvkzr/MUgsxO0wcWEr5jBn3zj0S/C4ud+hnD7hOHXPATvp9r0Z2eAocYK5Ea2M7qcddwSP",
+	"NQovS15YAN0Xe5cKSY8028jCml1wmQKJBPoOqHuEyHJuAC98L91EtGj9G6oS0nz99BYUVqPESYleygih",
+	"cbI3J1xgA/qV1M4ZXLCNyojH3/bmmXgpRPe3+RyeS4LqxnxpJ++IQoIfujB8k6v0/O9cr++AghZ+rD4V",
+	"0TRsDTyDkq25Xh/MYhJZSCjNaFOIBRuSMoQtgqkO6iXe1fJ2LC3jhh/MuvDGD4hFPfWjCwLKyDvvJ/oP",
+	"zxl+Rj7IjVdzoIpHEDtTgUEmQ82IfUzZmbABbrxRbGOVIQw1FHtB+byZPL5Pk/boW6t/cTvkFkE7pK7u",
+	"/Bh8o65iMHyjrnpHQF3BXTDQhbqy/xEGNnoCfC8cZIr236GPlyXf9pFMY09BMi4QxXxNp0GG0hHO0iiy",
+	"TxaqvBn36bAVyRr1POM4anBRzTtIoqZVkThSjKj4bIPOQI1FdJxpdIePYayFhTPDPwEWtOEB8LfAQnug",
+	"u8aC2hQihzsg/XWU6aNC5cljdvb3k68ePf7t8VdfI0kWpVqVfMMWWwOa3XfvWKbNNocH/ZXNZ1bNEB/9",
+	"66deqdseNzaOVlWZwoYX/aGsstiKi7YZw3Z9rLXRTKuuAZxyON8CcnKLdmbtIAjaC6G51rBZ3MlmDCEs",
+	"a2bJmIMkg53EtO/ymmm24RLLbVndxbMfylKVEV0kHTGjUpUnF1BqoSKWp9euBXMt/FOg6P5uoWWXXDOc",
+	"m9TklSSBIkJZqP+ezPft0G+vZIObUc5v1xtZnZt3yr60ke/lac0KtOpdoai8qFatV+OyVBvGWUYd6Y7+",
+	"HgyJAqfSQHnB8zvYSuGGGpKp/HcmJNOQKpnpOTuy8g3JENagRdLPZSmMAcm4blsxsIGuFhv8mk14ANcw",
+	"3eaFshgCP0TkW7GBM8M3xU/L5d3oJxQNFHnCiQ1onInZFgFAEzDiRr0NPswwAA4jZ1uZ0kv20z5kN0KS",
+	"pU1vZRqoTuhVCdkKygn4uIMHLE11T0fAQXS8pM9nkhd6re7+fdgePgZ3HIBAM9FuMKSgaLe6Cwlb+7Em",
+	"c9vuYncw22aC2+yv5heQuV1m9ZAhTgw38AJyw79T5duGXX1fqqr4VBtezzl1KbxeAnZlGfb1SjkhV3nb",
+	"nW+FsEfX+EUW9NzfcW4NBD1xm5ditTbB2/t1qdTy7mGMzRIDlD5YzUWOffr6i1cqw4vCVPoO3inNYI0Y",
+	"gDQbXv58oSrDOJMqA9r8SsdfMAMOYOR5Qg4zJnwUmbVVRiwAqSvlFa4WDW0qJlQ1HROe2hOa2Es/PmHj",
+	"xWBb2emsc1FeAs9QOQySqYWzODtbOC2Sky+L8W8A936K3AUtuIpSpaA1KvWtqnYnaL6dla/MCJ4IcAK4",
+	"noVpxZa8vDWw5xc74TyHbUKeV5rd/+EX/eALwGuU4fkOxFKbGHprXZiQA1BPm36M4LqTh2THS2D+XmFG",
+	"0ZMvBwNDKNwLJ4P714Wot4u3R8sFlGTg/6QU7ye5HQHVoH5ier8ttFUx4E/sdEAoveOGSS6VF5pjg+Vc",
+	"m2QXW8ZG4Vo0riDghDFOTAMPCNUvuTbWKUXIjPTD2tloagsNTjEM8OBbHUf+xT/T+2OnSmqQutL1m11X",
+	"RaFKA1lsDejJNDzXK7iq51LLYOxaMWAUqzTsGnkIS8H4Dll2JRZB3NS2W+e11V8cWTjxnt9GUdkCokHE",
+	"GCBnvlWA3dCncgAQoRtEW8IRukM5tSPnfKaNKgrkFiapZN1vCE1ntvWJ+blp2ycubpp7O1OgyZXTtXeQ",
+	"X3ozIJfoX6yZg4Nt+DnKHqQrtN4zfZjxMCZayBSSMcqn5zu2Co/AzkNaFauSZ5BkkPNtf9Cf7WdmP48N",
+	"QDve6ISUgcS6RcY3vaFk74U2MrSi8SJM85Vi9IWleATxKdAQiOu9Y+QMaOwYc3J0dK8eiuaKbpEfj5Zt",
+	"tzoyIt2GF8oEZmEC2XH0KQAP4KEe+uaooM5J8/bsTvHfoN0Evs0NJtmCHlpCM/5eCxgwNLiIk+C8dNh7",
+	"hwNH2eYgG9vBR4aO7IDV4zUvjUhFQW+dH2B750+/7gRRWzzLwHCBmvjgg30GFmF/Zh36umPe7Ck4SWXS",
+	"B7+nNIksJxeaRJ428OewpTf3a+spHqg67uItGxmVCRsAgoB6/1MUwcMmcMVTk29JVbyGLbuEro64/dQ1",
+	"qkjCAaLGv5EZnaXbeln7HZhiej+joYLl9bdiPrNvgnH43nYeBi10uLdAoVQ+QfvZQ0YUgkkOZKxQuOvC",
+	"BaP4cARPSS0gHdPOtx5cd1WEaKYVsP9WFUu5pCdXZaCWaVRJggL2pRmEDuZ0rmINhiCHDdiXJH15+LC7",
+	"8IcP3Z4LzZZw6SO4Hj7so+PhQ9LjvFbatA7XHWhE8bidRq4PsorixedeIV2estv9xo08ZSdfdwb3k9KZ",
+	"0toRLi7/1gygczKvpqw9pJFprkfmauLKg/VE1037fiY2Vc7NXZh24YLnibqAshQZ7OTkbmKh5LcXPP+p",
+	"7kbRaZAijaaQpBRTNXEseIt9bBjWrrdh454qNhvIBDeQb1lRQgqZVZULzXQN4wGzDsXpmssVSfqlqlbO",
+	"o9WOQ5waw/QoMKqSvSGi0pC5kglpp2Oc20Ux+MgxlIOA41usq9q2L49LXs8HWYuhT0ReV9UfNQHPZ4NP",
+	"VUTqRfNUtchph79N4OItQS3ATzPxRPsHoQ6Flj6+wm3BU4Cb+2l07c3QMSj7EweWrObjkBWrafGrKs+h",
+	"vIUmftoawmnG1xMDKLq2sOHgOivULtyBVGYHYiUUJWi6Q0M9mrZf1TIM6XWXrN5qA5u+qcF2/W2AzbwZ",
+	"fNAqmQsJyUZJ2EazWAgJP9LHWG97jw90JolqqG/3kdSCvwNWe54pp+62+KXd7nKirklNf6fKu7LH2wH3",
+	"NdmOmUh32nHdlDc14mJwa9/26QL+uoxOz+sEI6JkXGuVChIqT9FdRcjGXOr859vof12HMdzB2euO2zHy",
+	"hbHkpMSGvGCcpbkgFbeS2pRVat5LTkq0YKkRF0avLRhWqz73TeJ63Iia1Q31XnJyX61Va1G3qyVE9Ejf",
+	"AXjtqq5WK9Cm8xhbAryXrpWQrJLC0FwbPC6JPS8FlORHeGBbbviWLZEmjGK/Q6nYojLt5wnFs2qDSlpr",
+	"ccRpmFq+l9ywHLg27EeBTl84nPc48UdWgrlU5XmNhbgUswIJWugk7mr5vf1KXvBu+WvnEY//d52tjQrH",
+	"b4JetwZaOTX+7/3/OsZcGjz5/Sh59j8OP3x8ev3gYe/Hx9d/+9v/a//05PpvD/7rP2M75WEX2SDkpy/c",
+	"0/30Bb3PGiNVD/bPZqDAEO0okYWuRB3aYvcps4AjoAdt7Z1Zw3uJDndGYWILkXFzM3Lo3jC9s2hPR4dq",
+	"WhvR0db5te756rkFl2ERJtNhjTeWFvveyfG4ZtxIH6qMrdiyknYr/SvDhu15L1G1nNex6zat1TGjwOY1",
+	"9y7O7s/HX309mzcByfX32Xzmvn6IULLIrmJh5xlcxR6z7oDQwbinWcG3GkycexDsUYdY63wSDrsB1ILo",
+	"tSg+P6fQRiziHM4H+Dil2JU8ldZLFM8P2WC3zrSjlp8fblMCZFCYdSzdTUtQo1bNbgJ0/GIwXBHknIkD",
+	"OOgqpTJ8FzvX3Bz4EgnU2hHVlFdffQ4soXmqCLAeLmSS5idGPyTyOG59PZ+5y//un0xu4Bhc3Tlrg6v/",
+	"2yh27/tv37JDxzD1PcKWGzqIWY+oDOyHtseUYdwl+bJC3nv5Xr6ApZACvx+/lxk3/HDBtUj1YaWh/Ibn",
+	"XKZwsFLs2Ed6vuCGv5c9SWswD18QY8uKapGLFBXuMfK0uZX6I7x//w7Vzu/ff+g5j/SfD26qKH+xEyQo",
+	"CKvKJC4zTFLCJS9jxjldZwahkan36KxWyFaV1eC68ZkbP87zeFHoboaA/vKLIsflB2SoXfw7bhnTRpVe",
+	"FhHaQ0P7+0q5i6Hkl15/VGnQ7B8bXrwT0nxgyfvq6OgJsFbI/D/clS/sW3yyFmkwg0FXeUQLt89KuDIl",
+	"Twq+itkA379/Z4AXtPskL29wC1DQpW4hTurwGhqqWYDHx/AGWDj2DqWlxZ3ZXj4LYHwJ9Im2kNqguNF4",
+	"Jtx0v4Lg/RtvVycBQG+XKrNO8GxHV6WRxP3O1MnBVlxI7d1F0NKEh8DlUVug6hTSc5fgCjaF2c5b3dWy",
+	"JWh61iG0TX1mw0kp+Q5ZUDAlWpFxJ4pzue1mQdFgjPd5fgPnsH2rmtw9+6Q9aWfh0EMHlSg1kC6RWMNj",
+	"68bobr5ze0NIeVH4ZBYUqevJ4rimC99n+CBbkfcODnGMKFpZIoYQwcsIIqjDEApusFAc71akH1sevjIW",
+	"9uaLpEHzvJ+5Js3jyXmohat5u66/b4DyKKpLzRYc5XblUgDaTBMBF6s0X8GAhBwasSbmc2gZvmiQXfde",
+	"9KZDs3n7QuvdN1GQbeME1xylFMAvSCr0mOn4JfqZrJ3UWWAos69D2CInMal24LRMh5ctY6JcjYEWJ2Ao",
+	"ZSNweDDaGAklmzXXPjthNg/O8iQZ4BNmThnLl3UauNQFmRrrbFie53bPae916bJm+VRZPj9W+LSckOtq",
+	"PnNe/LHtUJIEoAxyWNmF28aeUJosLs0GIRw/LZe5kMCSmHdeoAYNrhk3B6B8/JAxq4Fnk0eIkXEANtn/",
+	"aWD2SoVnU672AVK6LDTcj02eA8HfEA8Ctf7qKPKoAlm4GLDepZ4DcOfSWd9fHcdiGoYJOWfI5i54DtL4",
+	"F18zSC9tE4mtnSRNzgPlwZA4O2IAsRfLXmuiHjdaTSgzeaDjAt0IxAt1ldgo8KjEu7haIL1HXfixV/Rg",
+	"2gRZ9zRbqCvyaqKrxbqM74BlGA4PRgMAZT7CtVO/odvcAjM27bg0FaNCze7Xsk1DLkPixJSpBySYIXK5",
+	"H+S8uhEAHWVHk0DePX53PlLb4kn/Mm9utXmTy9FHR8WO/9ARiu7SAP76Wpg6S9XrrsQS1VO0WnUSdAUi",
+	"ZIzomZARI03fFKQhB3oUJC0hKjmHbfxtA3TjnPlugfKC0oBxuX0QeHyVsBLaQKNE9/4gX0I9ySn7qFLL",
+	"4dWZolzi+t4oVV9T1NEqJ1vL/OwrIJfppSjRNxctENElYKPvND2qv8OmcVmptdnM5uoWWZw30LQYZZOJ",
+	"vIrTq5v3hxc47auaJepqQfxWSOuYs6Dc8lFP05GprTPy6IJf2gW/5He23mmnAZvixCWSS3uOP8i56AZR",
+	"j7CDCAHGiKO/a4MoHWGQQYRwnzsGclNg4z8Y0772DlPmx97p2ePjlIfuKDtSdC0NoOOrEGQmQrFEmCA1",
+	"ez90d+AM8KIQ2VVHF2pHHXwx870UHj6hZQcLtLtusB0YCPSeseihEnQ7d2kj4Nsk+610WAeTMPO2nWE0",
+	"ZAjhVEL7EjF9RNXRhbtwhflzfoDtL9iWljO7ns9upzqN4dqNuAPXr+vtjeKZTPNWldayhOyJcl6gwYvn",
+	"iVMwD5FmqS4caVJzr4/+zKwursZ8++3Jy9cOfNTh5cDLpBYVBldF7Yo/zKpsmtSBA+JLUOCbz8vsVpQM",
+	"Nr/OVxgqpS/X4HL5B9JoL+lwY3BoxvNK6mXcQ2inytnZRuwSR2wkUNQmkkZ9R507VhF+wUXu9WYe2gFv",
+	"HlrctMzVUa4QDnBr60pgJEvulN30Tnf8dDTUtYMnhXONVBvY2IIaminZNaGTbzeq44hU0bNrAU4r0mdO",
+	"stqQJiHRuUjjOla50Egc0trOsDGjxgPCKI5YiQFTrKxEMBY2m5KfqQNkMEcUmTqaIqrB3UK5YmmVFP+q",
+	"gIkMpMFPJZ3KzkHFc+kL7vSvU5Qd+nO5galPMPxtZIwwXXb3xiMgxgWM0FLXA/dF/WT2C601UvhDYJLY",
+	"w+Afzti7EkeM9Y4+HDVb58V12+I2LSvzlMJq/vHq8nYPzBEtlCZ0sizV7xB/59HzOBKY5SYiYYp6H0TC",
+	"f7ssptbuNPXemtkHt3tIugk+sraTwgDV084HZjnKTuc11FzarbYBMy1ftzjBBC30oR2/IRgHc88TN+eX",
+	"C56ex4UMhOmkMQC3dOlGMd/Z417XUSV2dhbYkuu2wgbdF1D28uoFCXxuKDDYaSeLCo1kgB1bMsHc2v9y",
+	"rSLDVPKSSwM+E709Sq63Bqv8wl6XqqSUGTqu9s8gFRuexyWHLO2reDOxErayU6UhKB3kBrJV8ywVufJL",
+	"dayUQ83pkh3Ng/plbjcycSG0WORALR7ZFmgBpLXV1hzfBZcH0qw1NX88ofm6klkJmVlri1itWC3U0fOm",
+	"Nl4twFwCSHZE7R49Y/fJbKfFBTxALLr7eXb86BkpXe0fR7ELwFXmGuMmGbGTXx07idMx2S3tGMi43agH",
+	"0ewCtjTnMOMaOU2265SzRC0dr9t9ljZc8hXEPUU2O2CyfWk3SZHWwYvMbF05bUq1ZcLE5wfDkT8NeJ8j",
+	"+7NgoDl5I8zGGXe02iA9NXWB7KR+OFukzt5NNVz+I9lIC28i6jwiP6/S1N5vsVWTJfsV30AbrXPGbZ6U",
+	"XDTeC77QBDv1aZhs5lKfrt3iBufCpZOYg1tIOZOFNPSwqMwy+StL17zkKbK/gyFwk8XXTyN5Vds5k+V+",
+	"gH92vJegobyIo74cIHsvQ7i+6I8vk41AVv+gifYITuWgMTc6rRmyHY4PPVUow1GSQXKrWuTGA059K8KT",
+	"IwPekhTr9exFj3uv7LNTZlXGyYNXuEM/v3nppIyNKmO5FZvj7iSOEkwp4AKywU3CMW+5F2U+aRduA/2X",
+	"tTx4kTMQy/xZjj0EsETE8ceB+gm1Jt35qke0A0PHFD8gGSzcUHPWzlX/+fno3XhBxS1dXrHdN2zhF48H",
+	"+qOLiC9MLrSBjS3frmSAUIJaHVGSyervgY2ds2/U1VTC6ZxCTzz/BiiKoqQSefZLE/nZXuGi5DJdR21m",
+	"C+z4W1Pgsl6cvQNjJJauuZSQR4ez8uZvXi6NSM7/VFPn2Qg5sW23OotdbmdxDeBtMD1QfkJErzA5ThBi",
+	"tR1UVztt5yuVMZqnycnXHNd+VZ+g9sK/KtAmFqBEH6zjmKEyn0jF1ImBzOhFesC+tzXs18BaCZfoJegz",
+	"YrSjpqsiVzybU6YOtCYwO6vtY8u02dIDK3oItVfR0YkF6UanuSDbDkPhEdPHGffXtrXIkjrBfSwAFVs0",
+	"KfhFx05AT6QQOwfsRVCNOih4Rolayg2+6urRrHxENIH/MYana2ygWqx1mOSn18zwVKmDmr7u/2lNifbc",
+	"IdyubIatmjFnCt/ml0Lb0uVwAe2YVw+GVzv4GNj28spKSkspB3vccnXGzX3R7oGjcWtTQhSyDuL3FPpt",
+	"yZl9S4icUa8YUfbqkfSK+doIyrqO2I++HDOXSoqUEnLFrmhX43yKnW1C7rKuItcfcXdCI4crWgWldsVz",
+	"WBysizKftRDXV/QHX3FTLXXYPw0V015zw1ZgtONs6I/uivk4XaOQGlxOVSSikE+qsmW7JA4ZNYcntdlk",
+	"TzKi0JuBx+N3+O2VUy3gEWTnQtIjwqHNCX5WG0glmA2+PIRhKwXaracdf6zfYZ8DCsXN4OrDgS/ZTGNY",
+	"0x8u29q5+0OdeKu3szJj2+fY1iWCqn9ueTnbSU+Kwk06XOopKg9gsqMhBEesl4k3HwXIrccPRxsht1F3",
+	"FbpPkdAwtRfTBgq6h3uEUZc96pTUQ6HVUhS1YNZNLIaUXMgIGC+FhKageOSCSKNXAm0MndeBfjotuUnX",
+	"LTa0y8hNFu4YQ9PGmTduO1RngwkltEY/x/A2NhWbBhhH3aAR3Ljc1nXMkboDYeI5uj5794F+/SWSqpwQ",
+	"lXHThH37ikwxxoGM29d8a18A/WPQl4lsd8oJt+9NNBSIuqiyFRgMcoyluP2GvjL6yrIKQWOYl66qU6EW",
+	"BUOguolo+tTmJkqV1NVmZC7f4JbTBSXOItQQllnzO4yUhkor/DeWB3R4Z5yjx96uht6rI9sv+1LfdTIm",
+	"9SJNJxj+NB0TdKfcHh3N1Dcj9Kb/nVJ6rlZtQD5z+okxLhfuUYy/fYsXR5idoZfc1l4tdfIEcuxTvjAt",
+	"PRvrsN82V8Jv/Wy3ZFCqC1+OKyCGS1jO6fIbcO8Nkm5we79aC+WQk2866JPOjYuOM5yNsqDBiCPrIUTf",
+	"LRRx7eyQV5B1CsLPvd43KsDt1jqKUO9u1gfoB+/LygounPm9YRZ9zDqv934cwhR/2GaDu4twvuSDGrsf",
+	"Lob8vn0yNvrerdx1Di5kvijhQqjKbVjt+eSfhPbXVi2s2vM+uv6+4pWm+rLq0EHl7VtXRcEu073Jf/jF",
+	"+skxkKbc/huocnub3in2Ftn7Tk02V6kNPblsPYlGw1CXj5+qvn0bGNIQXX6Ogz0V8q3q9ikvTBUEwEcG",
+	"vREPaGm+PwyjciSAwrYIzr7TJkwsK94WMKbkfIylF3RidqvI244Sdb3tfDFFsurh43o+O832kj1iKSpn",
+	"dpToDkQLyA1n8GqydhG3KpQWTUmBWGW5id6ab9fgQkscYffH8q5SF5AaqiPRuICUAPvkI8PJgoLOf2by",
+	"GqxS65xaXQKvsaxd/eIRO8SlXmBdEBxqE+8fTM9RdVI7+tGVRwm0VyBdTeV2yMxkx/3lElIjLnYEMv66",
+	"BhkEyc29iotgWQZxjaJ2BKc8OPsrcBuAcn5DeHJ+d+AMhTGdw/aeZi1qiFYCmHup5SYpUAgDxB3Qvb9Q",
+	"mudDOnnn2yB0TRmEBe+4ZrtDk0xusIhYEJZ7w7k8STIehuqOTBmvYjRpLuy6VwA7+TQPxTr2i6AMP+Ve",
+	"UM0ZXRf49ClUQoUH6m67iSYvXQoWCjutzVA+GQto/5uPMbez5OIcwjJnZPTDAHrfIqrF8gqyZOQ+6gUo",
+	"MhEHelnPLBo3435IWn+PrTN5misUI5Ihj/y2Z2/tFnNPW/8lWzEASgfXEkpXDhJb4tiQGOXdksfgGEOF",
+	"tvW0b4IEPZgu1AI3mMTnTZOliNImc0raw51vVrhAVsKGI3RlkEtoeM4xZD+3330Mlk+bu1NZV9Pr7joV",
+	"3sFc6B4SQ6pfMndb7o7tuoneTkhp6/LrWGIhCWXbsFSUKqtS94wJDkat25yctmuElURVXml/lZ03QhAg",
+	"ew7bQ/ue9AU+/A6GQFvJyYIeJKTobPKdajJ1DO7VnYD3JZWA81mhVJ4M2I1O+9mQuhR/LjCXIMObwjti",
+	"DhRdYvfJXFE7Blyutz77T1GAhOzBAWMn0rq+ex+BdjruzuTynhmb/4pmzSqboMzpJw/ey7gPMaUOK2/J",
+	"zfww4zxMg8xuPZUdZHwiczWQiQlT+/VLkB1MfZX3rfbdslANUVkoYjJJU/Foh8tR7W3UFItpPI760kGe",
+	"q8uEqCipU6nF3hzYrs0kffLYphtiewGB6xLX7gLdsjXPWKrKEtKwRzxaxAK1USUkuSJPppiRdWlQHtqQ",
+	"i7hkuVoxVeAz12Yk9OaoaCWjYK67qtpkI58tBIm1nQ3klgDtIp0duLZxH96Rwkn7F2V6u47obWjD/G7t",
+	"XXnJEdzehUQCMCcQ+m6d1Ul/Yd11dUucDRUcNGoj0ji6/1iOP4PuOjHqjaHC9nCxhNSMDnjIU2o7L52e",
+	"PppBomNYbL/c8XP2LqJz/K9VEnfGZUvgpjd3wM8isaxjq44VC4vsaj2Vq2Xmw1MHKCTqOzBuqrcFJBdT",
+	"DfZ18u6JzCAAYNiE34JhkiF/XzCWVJA14REkn9Yy/7xVL1t0OJ5PrGhPdsrtmx/1TVzkVQkuXJIOQreE",
+	"U8HN2ssA2Lz/MsdXHmiKZbR1aLi2eiSvz3JlK7vClSqSHC6g5dngYjirNAWNgZlhyUvbmWUABWl3u2+O",
+	"mMk+5O0dQdStPQmMvlOwG5VMLWLtTrEdYmdUSL6SiT0meupRQoguRFbxFv70LYr/DdX9i1w+HtYP0zjF",
+	"3kwivrgxFrHTyabSQ+dSxn1swhDiWqVEs2W16tkSYXOydcEv5fATrE+Ujew0vWxmgNhvryCle6jtRHJ7",
+	"nDAajGmx2r2GhiBu85QfpLIxIusVEY1KbRp8Eegwk48XfF3fiLRrlY5CRwYQuuEN5JIKjctj0Aw15plY",
+	"LqG0ZhVtuMxQ1xg0F5KlUBou8I251Td/YCC0JYYz7XpjIKemQT2zir02SENoAcm37vE2JP9PkNtxH2Iy",
+	"u722jRqqb9rblXiMDL/Cdw45Cw4QgYvup1cONWNKkojJNvwc9pxHi99hfBrKueO0sEbRrFOmuB6l9Z8I",
+	"dXTgf5bCjFK7Ff263pvWJmSJ0dOgXDWGabs5fRos0vhkRdvptlvMwe+1VVDZ+WAgOaXjnQnxVD1i8gUd",
+	"lJ1KncquLw70mLEFZu6ckfeSFrrqhnQHU4qy6IEz0ZbV1ZKokzbFXkyqDNnxvOsc1L6C6m2nQqppVZIQ",
+	"dcm3u3PcJSYOpfertiP754z3caihdlttCYxkXAt/L4XcPuJJhOZj5Sn6ybvufjE2YKCxw3265ThNe3wB",
+	"YVH/cXprBHlPKhFa43IbOzpel3yDBQ5JJxNcXu9sq+rT8ik2KMqib5bTdRJofffHCDaDYtPjbhRhyucm",
+	"lry0XrRkdvXvoS6/+LF5J00rGe077AAv9K5p2tWGDgfOFw7K/rFGSrCUD0OU0Fr+Locdt8DmYRlskZPV",
+	"jAGbgN8G8rX3JfDG0s9rJ6ehGu1dXyjK76ykLS7c86Gy4iOdqZBwBN71Fzz//H5QlPj7hPAB2Zthy2no",
+	"SBMi2aJS3ywi8iWfNHfOP8HUWHb0AuSvgHsUvRbcUO7F2mP+JPzz3Gr5l750KAZPX9KYtNPs0dds4TLG",
+	"FCWkQndfwpe+qlftN0JFLu0UGI447qiya52/KHMLMl56xRJ71VQIIkX2SjYQNkf0CzOVgZMbpfIY9fXI",
+	"IoK/cR5F45wNVOE5q+sCpSr3RRFcFZ6WN2vIGgYcznmaQkFZYZLLARK2KnpPjWFUs+0cJNULJ+T1ayD0",
+	"Ub4B6W2E1lE7mcUpu1wrDc0zmqzRUhmPHFR5roFfCISB2M904aPeD5rKbWbklldVmewEE49xvwqBjRR3",
+	"1urdaxkJf9rpCBmDWirrnBZ72J3UtbymgN64LyHANqu/1VzY8e8acqv5uHt0fxJUW44+eMR+bc5WPX2o",
+	"GKJMiXCVgivewN0Zs0ZpTO9xk+xhBFQ5VO301yZ9JrXrVI1gwqaSPWapzWVwX1AtaFVuH8xZJvQ5u08p",
+	"qZxRJeOGowHiAVMlc2fFVj/3PmZbMA/2D3+or3TuWHAd/HCr+25MnxdUG2m2qEU20eJvqEPZQQA+GCQy",
+	"LNOKLXl5g6XYQkFDM1s72WVr/ubA7jnVECdpMKZsEbdaCIjziR2+rj6HQ4uAuzjuHrp55K7rIKdeQGv/",
+	"x2/q8GaIWptbC26tty2709S2bjE9/ixMe1QY2cmUBknAb/m9cNZbBQ3WzshuznEc/qrKcyiHxB3SN9QF",
+	"aRu/rhB5lzSCz7pSFanakEq3aRLR31vGlVgqGqVa14QYXpDp95xQJmRi+Z7lhENe4JScZGokl5vDBird",
+	"4CCiPB36uY9NjG17id0670rUPBVOmOJkPkvPG1Z7YzAdXhNCXJKDXMUCac7U0lirUD9Df3dn7E6MVPe8",
+	"gTxNFpCeLB0QAWVJUeU5ktweLqdxcX9nFoqQkgZ3et6l7gFk11iJndCwlMQO9dV5K9C30TIHGjZVwh0H",
+	"/AapO/YM+O0XyZi6PFoHkUWlob/OyZvfwm1EYmzWNjVavY/csbKWU4LM49VqsTtFuVuEYKMDRqCyfzz6",
+	"BythiQfSKPbwIU3w8OHcNf3H4/ZnZAQPH0bP6meLb7c4cmO4eWMU88tQxjOb1WsguV5nPzAP3y7CaKVK",
+	"xPgAkKCFpmSAv7mErJ9Xt+chsIFi/aNqYb1NdKtFTGStrcmDqYIkiBPyH7pukWyH5ISdVqUwW6oT4y1w",
+	"4rdoJP73dSiiC2WtXQqcLs6oc6grDTWBi5X22r7vFc9JP2Y9HSQwg3WI2bdXfFPk4A7K3+4t/gJP/vo0",
+	"O3ry6C+Lvx59dZTC06+eHR3xZ0/5o2dPHsHjv3719AgeLb9+tnicPX76ePH08dOvv3qWPnn6aPH062d/",
+	"uTebzwSCbAGd+azks/+dYEnm5OT1afIWgW1wwguB0Z7X12TqWioSkRCpKZ1E2HCRz479T//Tn7CDVG2a",
+	"4f2vM5f0eLY2ptDHh4eXl5cHYZfDFUUqJUZV6frQz3M972D85PVp7RJpn760ozZfoHcu86RwQt/efHv2",
+	"lp28Pj1oCGZ2PDs6ODp4hOOrAiQvxOx49oR+otOzpn0/dMQ2O/54PZ8droHnZu3+2IApReo/lcCzrfu/",
+	"vuQrFH/I69X+dPH40L9wDj86Yfh67NthcIXgz81fich29NQa6AdX0GS8datiiAvoCzpMhGKs2eFCXe3R",
+	"FHTQeHgpZPzQhx9JWhn8/dAldo1/JDOKPQ+HPvoz3rKFpY/mCmHt9Ei5SddVcfiR/kP0eW0ZRg4xdYrN",
+	"h8pZ03zOhGF8oUqqJGLSNfIIX8JA6KDlbD6rCf40Q0LHXs8tBL5Yka3eePyu75NMAzE/EnEFJPnm0LZm",
+	"avgyOS0FBQXrW6fVvrl73h0lzz58fDR/dHT9H3i3uD+/enI90Rn8eT0uO6svjokNP8xn1laqLQ9/fHTk",
+	"GZgzVwTEd+jOarC43sO9WaTdpDqhUewhSTsx7LHqtqozEKuRsSNPeWf4vnhCPPvpnisetW23kjzR8N30",
+	"0xnz8To096PPN/eppJB55PHM3mHX89lXn3P1pxJJnueMWgaFZ/pb/7M8l+pS+pYocFSbDS+3/hjrFlNg",
+	"brPpWuMrTV5NpbiwGi6pZKDSlqvZBwrc02Yyv9GG34DfnGGvP/nN5+I3tEl3wW/aA90xv3m855n/46/4",
+	"Tw77R+OwZ5bd3YrDOoHPZsY8NFfykDygDz+2BFT3uSegtn9vuoctLjYqAy+D8uyCyxRcD3092NA7++xs",
+	"cPjR/294MLVc2kqfY58PP9p/h4epk28ffqz/G2ntE5PR2Y66pb8U2uhAuVznMtMuC5uSO7KwtW8wHK+d",
+	"8E3PbsnB2xyqtaRJGsA2ODt1JM0EMe407+kIHQfseCVb5HVxejD7k7v9AbnbGyqKocc2lr3s/EKOi2SY",
+	"bwocdE7Oflyxc6IPP6KQR0/huGB6xi+gfbJt8X3T8xWy1SDo5xVJs7Y6UglFzlPriL9tUm96CKwF1aJk",
+	"Y5Ms9tkBAtE5fzvE2m7Cxg6mD9hPiNMcDPafu7qdc3YvuUfuvPd+uxeG9xzEBWOfaHFQJA4UvY329rcE",
+	"xd6vn17HPNtuK6fuw8H6hN5uUTOly5IXlgbaDWyGBxck4WXBP1nTH1HwolPOm1PZOjZfjC0dllhMxgxz",
+	"pzf0vcWfjArX4flNvnUsN86kMqFTXpLfPkX/YLVcEpFcYJ2t5CtsRVWKYPE3dDddjq16Ws9PaeIKM2da",
+	"Yfe6pN6FrSSCwK64kH2WZ1f2J9P7k+n9IZje06Onnw+CDjciD1bKg/fHlQwH2BixrOb8fmI2jE5DpdiA",
+	"NOEz1QJ0SNVZt/2ftzKN/th/W7fcewd+PvzY+rNtVdLrymTqUo5IqwWkgueuLDaupDFHGsX8AE2uW/aT",
+	"q3SQb8k5SWTAOJVgU5Vp7MXYuc6cUUcY4QhMr10ox0pImgBxymgWe2vwwP9JQ6pkpiMSroPslcqgz+aJ",
+	"Df+rgnLb8GEH42ze0tk54otUW781l+2r2K73Iy96OVjvvkPtvQcj33qEE2tc6cNLLgwqfV1CWsJ2v7MB",
+	"nh+6Ql6dX5vaGb0vVBAk+DFMDRL99ZC2bPBj13Qb++pMlwONfHYB/7lx4wjdIohcaoeIdx9w16lUtqOk",
+	"xsp/fHhISR7XSpvD2fX8Y8cDIPz4od5onyC/3vDrD9f/fwD/ePd4EvUAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -63,12 +63,24 @@ type ServerInterface interface {
 	// Get LedgerStateDelta objects for all transaction groups in a given round
 	// (GET /v2/deltas/{round}/txn/group)
 	GetTransactionGroupLedgerStateDeltasForRound(ctx echo.Context, round uint64, params GetTransactionGroupLedgerStateDeltasForRoundParams) error
+	// Advances the ledger by the given number of rounds. Rounds can only be advanced in dev mode.
+	// (POST /v2/devmode/blocks/advance/{rounds})
+	AdvanceRounds(ctx echo.Context, rounds uint64) error
+	// Returns the block interval. Block intervals can only be set in dev mode.
+	// (GET /v2/devmode/blocks/interval)
+	GetBlockInterval(ctx echo.Context) error
+	// Given an interval in seconds, writes a new block every time the interval elapses.
+	// (POST /v2/devmode/blocks/interval/{interval})
+	SetBlockInterval(ctx echo.Context, interval uint64) error
 	// Returns the timestamp offset. Timestamp offsets can only be set in dev mode.
 	// (GET /v2/devmode/blocks/offset)
 	GetBlockTimeStampOffset(ctx echo.Context) error
 	// Given a timestamp offset in seconds, adds the offset to every subsequent block header's timestamp.
 	// (POST /v2/devmode/blocks/offset/{offset})
 	SetBlockTimeStampOffset(ctx echo.Context, offset uint64) error
+	// Given a unix timestamp in seconds, uses it as the timestamp of the next block header.
+	// (POST /v2/devmode/blocks/timestamp/{timestamp})
+	SetNextBlockTimeStamp(ctx echo.Context, timestamp uint64) error
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
@@ -132,6 +144,13 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountInformation(ctx, address, params)
 	return err
@@ -483,6 +502,53 @@ func (w *ServerInterfaceWrapper) GetTransactionGroupLedgerStateDeltasForRound(ct
 	return err
 }
 
+// AdvanceRounds converts echo context to params.
+func (w *ServerInterfaceWrapper) AdvanceRounds(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rounds" -------------
+	var rounds uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rounds", runtime.ParamLocationPath, ctx.Param("rounds"), &rounds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rounds: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AdvanceRounds(ctx, rounds)
+	return err
+}
+
+// GetBlockInterval converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockInterval(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockInterval(ctx)
+	return err
+}
+
+// SetBlockInterval converts echo context to params.
+func (w *ServerInterfaceWrapper) SetBlockInterval(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "interval" -------------
+	var interval uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "interval", runtime.ParamLocationPath, ctx.Param("interval"), &interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetBlockInterval(ctx, interval)
+	return err
+}
+
 // GetBlockTimeStampOffset converts echo context to params.
 func (w *ServerInterfaceWrapper) GetBlockTimeStampOffset(ctx echo.Context) error {
 	var err error
@@ -512,6 +578,24 @@ func (w *ServerInterfaceWrapper) SetBlockTimeStampOffset(ctx echo.Context) error
 	return err
 }
 
+// SetNextBlockTimeStamp converts echo context to params.
+func (w *ServerInterfaceWrapper) SetNextBlockTimeStamp(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "timestamp" -------------
+	var timestamp uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "timestamp", runtime.ParamLocationPath, ctx.Param("timestamp"), &timestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timestamp: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetNextBlockTimeStamp(ctx, timestamp)
+	return err
+}
+
 // GetSupply converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 	var err error
@@ -685,8 +769,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/deltas/txn/group/:id", wrapper.GetLedgerStateDeltaForTransactionGroup, m...)
 	router.GET(baseURL+"/v2/deltas/:round", wrapper.GetLedgerStateDelta, m...)
 	router.GET(baseURL+"/v2/deltas/:round/txn/group", wrapper.GetTransactionGroupLedgerStateDeltasForRound, m...)
+	router.POST(baseURL+"/v2/devmode/blocks/advance/:rounds", wrapper.AdvanceRounds, m...)
+	router.GET(baseURL+"/v2/devmode/blocks/interval", wrapper.GetBlockInterval, m...)
+	router.POST(baseURL+"/v2/devmode/blocks/interval/:interval", wrapper.SetBlockInterval, m...)
 	router.GET(baseURL+"/v2/devmode/blocks/offset", wrapper.GetBlockTimeStampOffset, m...)
 	router.POST(baseURL+"/v2/devmode/blocks/offset/:offset", wrapper.SetBlockTimeStampOffset, m...)
+	router.POST(baseURL+"/v2/devmode/blocks/timestamp/:timestamp", wrapper.SetNextBlockTimeStamp, m...)
 	router.GET(baseURL+"/v2/ledger/supply", wrapper.GetSupply, m...)
 	router.GET(baseURL+"/v2/stateproofs/:round", wrapper.GetStateProof, m...)
 	router.GET(baseURL+"/v2/status", wrapper.GetStatus, m...)
@@ -702,259 +790,283 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9fXPbtrI4/FUw+v1m8nJFOa+9p57p3MdN0h7fpmkmcXvuuU2eFiJXEo4pgAcAZal5",
-	"8t2fwQIgQRKUKFt2ktZ/JRZJYLFYLPZ9P4xSsSwEB67V6PjDqKCSLkGDxL9omoqS64Rl5q8MVCpZoZng",
-	"o2P/jCgtGZ+PxiNmfi2oXozGI06XUL9jvh+PJPy7ZBKy0bGWJYxHKl3AkpqB9aYwb1cjrZO5SNwQJ3aI",
-	"0+ejj1se0CyToFQXyp94viGMp3mZAdGSckVT80iRC6YXRC+YIu5jwjgRHIiYEb1ovExmDPJMTfwi/12C",
-	"3ASrdJP3L+ljDWIiRQ5dOJ+J5ZRx8FBBBVS1IUQLksEMX1pQTcwMBlb/ohZEAZXpgsyE3AGqBSKEF3i5",
-	"HB3/OlLAM5C4WymwFf53JgH+gERTOQc9ej+OLW6mQSaaLSNLO3XYl6DKXCuC7+Ia52wFnJivJuTHUmky",
-	"BUI5efPdM/L48eOvzUKWVGvIHJH1rqqePVyT/Xx0PMqoBv+4S2s0nwtJeZZU77/57hnO/9YtcOhbVCmI",
-	"H5YT84ScPu9bgP8wQkKMa5jjPjSo33wRORT1z1OYCQkD98S+fNBNCef/pLuSUp0uCsG4juwLwafEPo7y",
-	"sODzbTysAqDxfmEwJc2gvz5Ivn7/4eH44YOP/+fXk+R/3Z9PH38cuPxn1bg7MBB9MS2lBJ5ukrkEiqdl",
-	"QXkXH28cPaiFKPOMLOgKN58ukdW7b4n51rLOFc1LQycsleIknwtFqCOjDGa0zDXxE5OS54ZNmdEctROm",
-	"SCHFimWQjQ33vViwdEFSquwQ+B65YHluaLBUkPXRWnx1Ww7TxxAlBq5L4QMX9Pkio17XDkzAGrlBkuZC",
-	"QaLFjuvJ3ziUZyS8UOq7Su13WZGzBRCc3Dywly3ijhuazvMN0bivGaGKUOKvpjFhM7IRJbnAzcnZOX7v",
-	"VmOwtiQGabg5jXvUHN4+9HWQEUHeVIgcKEfk+XPXRRmfsXkpQZGLBeiFu/MkqEJwBURM/wWpNtv+329/",
-	"ekWEJD+CUnQOr2l6ToCnIoNsQk5nhAsdkIajJcSh+bJvHQ6u2CX/LyUMTSzVvKDpefxGz9mSRVb1I12z",
-	"ZbkkvFxOQZot9VeIFkSCLiXvA8iOuIMUl3TdnfRMljzF/a+nbchyhtqYKnK6QYQt6fqbB2MHjiI0z0kB",
-	"PGN8TvSa98pxZu7d4CVSlDwbIOZos6fBxaoKSNmMQUaqUbZA4qbZBQ/j+8FTC18BOH6QXnCqWXaAw2Ed",
-	"oRlzus0TUtA5BCQzIT875oZPtTgHXhE6mW7wUSFhxUSpqo96YMSpt0vgXGhICgkzFqGxtw4dhsHYdxwH",
-	"XjoZKBVcU8YhM8wZgRYaLLPqhSmYcLu+073Fp1TBV0/67vj66cDdn4n2rm/d8UG7jS8l9khGrk7z1B3Y",
-	"uGTV+H6AfhjOrdg8sT93NpLNz8xtM2M53kT/Mvvn0VAqZAINRPi7SbE5p7qUcPyO3zd/kYS81ZRnVGbm",
-	"l6X96ccy1+wtm5ufcvvTSzFn6Vs270FmBWtU4cLPlvYfM16cHet1VK94KcR5WYQLShuK63RDTp/3bbId",
-	"c1/CPKm03VDxOFt7ZWTfL/S62sgeIHtxV1Dz4jlsJBhoaTrDf9YzpCc6k3+Yf4oiN1/rYhZDraFjdyWj",
-	"+cCZFU6KImcpNUh84x6bp4YJgFUkaP3GEV6oxx8CEAspCpCa2UFpUSS5SGmeKE01jvR/JcxGx6P/c1Tb",
-	"X47s5+oomPyl+eotfmREVisGJbQo9hjjtRF91BZmYRg0PkI2YdkeCk2M2000pMQMC85hRbme1CpLgx9U",
-	"B/hXN1ONbyvtWHy3VLBehBP74hSUlYDti3cUCVBPEK0E0YoC6TwX0+qHuydFUWMQn58UhcUHSo/AUDCD",
-	"NVNa3cPl0/okhfOcPp+Q78OxURQXPN+Yy8GKGuZumLlby91ilW3JraEe8Y4iuJ1CTszWeDQYMf8QFIdq",
-	"xULkRurZSSvm5b+7d0MyM78P+vjLILEQt/3EhYqWw5zVcfCXQLm526KcLuE4c8+EnLS/vRzZmFHiBHMp",
-	"Wtm6n3bcLXisUHghaWEBdE/sXco4Kmn2JQvrFbnpQEYXhTk4wwGtIVSXPms7z0MUEiSFFgzf5iI9/ztV",
-	"iwOc+akfq3v8cBqyAJqBJAuqFpNRTMoIj1c92pAjZl5EBZ9Mg6km1RIPtbwdS8uopsHSHLxxscSiHr9D",
-	"pgcyorv8hP+hOTGPzdk2rN8OOyFnyMCUPc7OyZAZbd8qCHYm8wJaIQRZWgWfGK17Lyif1ZPH92nQHr2w",
-	"NgW3Q24RuENiffBj8K1Yx2D4Vqw7R0CsQR2CPsw4KEZqWKoB8D13kAncf4c+KiXddJGMYw9BslmgEV0V",
-	"ngYe3vhmlto4ezIV8nLcp8VWOKlNzoSaUQPmO24hCV8ti8SRYsRsZV9oDVR7+bYzjfbwMYw1sPBW02vA",
-	"gjKjHgILzYEOjQWxLFgOByD9RZTpT6mCx4/I27+fPH346LdHT78yJFlIMZd0SaYbDYrcdboZUXqTw73u",
-	"ylA7KnMdH/2rJ95Q2Rw3No4SpUxhSYvuUNYAakUg+xox73Wx1kQzrroCcMjhPAPDyS3aibXtG9CeM2Uk",
-	"rOX0IJvRh7CsniUjDpIMdhLTvsurp9mES5QbWR5ClQUphYzY1/CIaZGKPFmBVExEvCmv3RvEveHF26L9",
-	"u4WWXFBFzNxo+i05ChQRytJrPpzv26HP1rzGzVbOb9cbWZ2bd8i+NJHvLYmKFCATveYkg2k5b2hCMymW",
-	"hJIMP8Q7+nvQKAqcsSW81XRZ/DSbHUZVFDhQRGVjS1BmJmLfMHK9glRwGwmxQztzow5BTxsx3kSn+wFw",
-	"GHm74SnaGQ9xbPsV1yXj6PRQG54GWqyBMYds3iDLq2urfeiwU91REXAMOl7iYzR0PIdc0++EPKstgd9L",
-	"URYHF/Lacw5dDnWLcaaUzHzrdWjG53kz+mZuYJ/E1vhJFvTMH1+3BoQeKfIlmy90oFa8lkLMDg9jbJYY",
-	"oPjAKmW5+aarmr0SmWEmulQHEMHqwWoOZ+g25Gt0KkpNKOEiA9z8UsWFs554DXQUo39bh/KeXlg9awqG",
-	"ulJamtWWBUHvbee+qD9MaGpPaIKoUT2+q8rpaN+y09lYgFwCzTZkCsCJmDoHkXNd4SIpup61F2+caBjh",
-	"Fw24CilSUAqyxBmmdoLm37NXh96CJwQcAa5mIUqQGZVXBvZ8tRPOc9gkGCihyN0fflH3PgG8Wmia70As",
-	"vhNDb6XmOy9gF+ph028juPbkIdlRCcTfK0QLlGZz0NCHwr1w0rt/bYg6u3h1tKxAoj/uWineT3I1AqpA",
-	"vWZ6vyq0ZdET/ufUWyPhmQ3jlAsvWMUGy6nSyS62bF5q6OBmBQEnjHFiHLhH8HpJlbY+ZMYzNH3Z6wTn",
-	"sUKYmaIf4F41xIz8i9dAumOn5h7kqlSVOqLKohBSQxZbA4f1lrlewbqaS8yCsSudRwtSKtg1ch+WgvEd",
-	"suxKLIKorlwtLsiiuzh0SJh7fhNFZQOIGhHbAHnr3wqwG4ZA9QDCVI1oSzhMtSinirsaj5QWRWG4hU5K",
-	"Xn3Xh6a39u0T/XP9bpe4qK7v7UyAwsgr976D/MJi1ga/LagiDg6ypOdG9kAziHV2d2E2hzFRjKeQbKN8",
-	"VPHMW+ER2HlIy2IuaQZJBjnddAf92T4m9vG2AXDHa3VXaEhsFFN802tK9kEjW4YWOJ6KCY8En5DUHEGj",
-	"CtQE4r7eMXIGOHaMOTk6ulMNhXNFt8iPh8u2Wx0ZEW/DldBmxx09IMiOow8BuAcP1dCXRwV+nNS6Z3uK",
-	"f4JyE1RyxP6TbED1LaEef68F9NhQXYB4cF5a7L3FgaNss5eN7eAjfUe2x6D7mkrNUlagrvMDbA6u+rUn",
-	"iLoZSQaashwyEjywamARfk9s/E17zMupgoNsb13wO8a3yHJyplDkaQJ/DhvUuV/bwM7A1HEIXTYyqrmf",
-	"KCcIqA8XMyJ4+AqsaarzjRHU9AI25AIkEFVOl0xrG7DdVHW1KJJwgKhfY8uMzolngyL9DgzxKr7FoYLl",
-	"dbdiPLI6wXb4zlqKQQMdThcohMgHWMg6yIhCMCjegxTC7DpzseM+ethTUgNIx7TRg1td/3dUA824AvJP",
-	"UZKUclS5Sg2VTCMkCgooQJoZjAhWzekiO2oMQQ5LsJokPrl/v73w+/fdnjNFZnDhEy7Mi2103L+PdpzX",
-	"QunG4TqAPdQct9PI9YEOH3PxOS2kzVN2Rxa4kYfs5OvW4JWXyJwppRzhmuVfmQG0TuZ6yNpDGhkWVYHj",
-	"DvLlBEPH1o37/pYty5zqQ3itYEXzRKxASpbBTk7uJmaCv1jR/KfqM0wmgdTQaApJiikQA8eCM/ONzZrY",
-	"pRvW0WRsuYSMUQ35hhQSUrBR/kbkUxWME2Lj/9IF5XOU9KUo5y4AzY6DnLpU1qYiS94ZIioN6TVP0Dod",
-	"49wu6Ngnehg5CKjRxdqmbat5XNBqPpfbM+RKDZDXNvVHvVvjUa+qapC6qlVVi5xmtsoALt4Q1AL81BMP",
-	"9IEg6ozQ0sVXuC3mFJjNvR5bez10DMruxEFIXP2wLyrO6Mn55gDSih2ISCgkKLxbQvuSsk/FLMxMc5eP",
-	"2igNy64J3n76W8/xe9Or6AmeMw7JUnDYRJOxGYcf8WH0OOH91vMxShp937aVhwb8LbCa8wyhxqviF3e7",
-	"fULbrib1nZCH8mXaAQfL5QNchzv95G7Kyzo4aZ5HfIIub6XNANS4ypNnklClRMpQ2DrN1NgeNOdGdEku",
-	"TfS/rqJxD3D22uO2nF9hSiQadyEvCCVpztD0K7jSskz1O07RuBQsNRK15LXofnPjM/9K3L4ZMT+6od5x",
-	"ihFrlckpGmkxg4h95TsAb3VU5XwOSreUlBnAO+7eYpyUnGmca2mOS2LPSwESQ4cm9s0l3ZCZoQktyB8g",
-	"BZmWuim2Y1qW0izPnSfOTEPE7B2nmuRAlSY/Mn62xuG8t94fWQ76QsjzCgvx230OHBRTSTy66nv7FANf",
-	"3fIXLggW0+jtY+u7MePXuVsbtD3VqeH/793/Ov71JPlfmvzxIPn6P47ef3jy8d79zo+PPn7zzf/X/Onx",
-	"x2/u/df/je2Uhz2WNOQgP33uVNrT56i31M6bDuw3ZrhfMp5EiSwMw2jRFrmLCbKOgO41rVp6Ae+4XnND",
-	"SCuas8zwlsuQQ/uG6ZxFezpaVNPYiJYVy691T23gClyGRJhMizVeWorqBiTG0/PQm+gy7vC8zEput9JL",
-	"3zb7xAeGidm4SsG01VmOCebnLaiPanR/Pnr61Whc59VVz0fjkXv6PkLJLFvHsiczWMeUPHdA8GDcUaSg",
-	"GwU6zj0Q9mgMnA3KCIddwnIKUi1YcfOcQmk2jXM4H9PvjEVrfsptsL05P+ib3DiXh5jdPNxaAmRQ6EWs",
-	"akNDUMO36t0EaMWLFFKsgI8Jm8CkbazJjL7oovFyoDOsHoDapxiiDVXnwBKap4oA6+FCBllEYvSDIo/j",
-	"1h/HI3f5q4OrQ27gGFztOStHpP9bC3Ln+xdn5MgxTHXHJvLaoYPUy4gq7bKLGpFEhpvZWjVWyHvH3/Hn",
-	"MGOcmefH73hGNT2aUsVSdVQqkN/SnPIUJnNBjn3C0nOq6TvekbR6y0kFqWKkKKc5S8l5qJDU5GlLhHRH",
-	"ePfuV5rPxbt37ztBFV31wU0V5S92gsQIwqLUiStwkEi4oDLmtFJVgjuObCuYbJvVCtmitJZNX0DBjR/n",
-	"ebQoVDvRtbv8osjN8gMyVC6N02wZUVpIL4sYAcVCg/v7SriLQdILb1cpFSjy+5IWvzKu35PkXfngwWMg",
-	"jczP392Vb2hyU8Bg60pvIm7bqIILt2olrLWkSUHnMd/Yu3e/aqAF7j7Ky0u0ceQ5wc8aGac+oh6Hqhfg",
-	"8dG/ARaOvbPncHFv7Ve+mFV8CfgItxDfMeJG7bG/7H4FOaiX3q5WHmtnl0q9SMzZjq5KGRL3O1PVuJkb",
-	"IcuHUSg2R23VlQOaAkkXkJ67Oi2wLPRm3PjcR+o4QdOzDqZsBR+bQYY1JNCzMAVSFhl1ojjlm3YyvwKt",
-	"fTzwGziHzZmoS1Dsk73fTCZXfQcVKTWQLg2xhsfWjdHefBcOhop9UficbEzO82RxXNGF/6b/IFuR9wCH",
-	"OEYUjWTnPkRQGUGEJf4eFFxioWa8K5F+bHlGy5jamy9SzcfzfuJeqZUnF7kVrgat7vb5ErAcmLhQZEqN",
-	"3C5cJSubMB1wsVLROfRIyKFzZ2BacsMhhIPsuveiN52YtS+0zn0TBdm+nJg1RykFzBNDKqjMtOL1/EzW",
-	"f+g8E1ig0iFsmqOYVAU2WqZDZcPJZivu9YEWJ2CQvBY4PBhNjISSzYIqX2QLa5H5szxIBrjGAgDbyr6c",
-	"BqFmQcGxqqiL57ntc9rRLl3xF1/xxZd5CVXLASVbjISP0e2x7RAcBaAMcpjbhduXPaHUxQjqDTJw/DSb",
-	"5YwDSWJRa4EZNLhm3Bxg5OP7hFgLPBk8QoyMA7DRL44Dk1ciPJt8vg+Q3BVToH5s9KgHf0M878vGcRuR",
-	"RxSGhbMer1bqOQB1oY7V/dUKuMVhCONjYtjciuaGzTmNrx6kU30ExdZWrREXmXGvT5zd4gCxF8tea7JX",
-	"0WVWE8pMHui4QLcF4qlYJzbxMyrxTtdTQ+/R0HZMQ40dTFvn5Y4iU7HGaB+8Wmwo9Q5Y+uHwYAQa/pop",
-	"pFf8ru82t8Bsm3a7NBWjQoUk48x5Fbn0iRNDpu6RYPrI5W5QuuVSALSMHXUdZKf87lRSm+JJ9zKvb7Vx",
-	"XZLMZw3Fjn/fEYruUg/+ulaYqtjK67bEErVTNINWmnVmAhEyRvSGTXSdNF1XkIIcUClIGkJUch7znBrd",
-	"BvDGees/C4wXWM2G8s29IBJKwpwpDbUR3cdJfArzJMUiekLM+lenCzkz63sjRHVNWTcifthY5o2vAEOJ",
-	"Z0wqnaAHIroE89J3CpXq78yrcVmpGWtlS86yLM4bcNpz2CQZy8s4vbp5f3hupn1VsURVTpHfMm4DVqZY",
-	"Ijkagbllahuku3XBL+2CX9KDrXfYaTCvmomlIZfmHF/IuWhx3m3sIEKAMeLo7lovSrcwyCBztssdA7kp",
-	"8PFPtllfO4cp82PvjNrx+bt9d5QdKbqWwGCwdRUM3URGLGE6qDDcTWntOQO0KFi2btlC7ai9GjPdy+Dh",
-	"67K1sIC76wbbgYHA7hnLqpGgmiX4agHf1opuVMCZDMLMWbNQXsgQwqmY8p0Ouoiqsu524eoMaP4DbH4x",
-	"7+JyRh/Ho6uZTmO4diPuwPXranujeEbXvDWlNTwhe6KcFoUUK5onzsDcR5pSrBxp4uveHn3DrC5uxjx7",
-	"cfLytQP/43iU5kBlUokKvavC94ovZlW22l/PAfGV1I3O52V2K0oGm1+VKAuN0hcLcCWpA2m0UzuzdjgE",
-	"R9EZqWfxCKGdJmfnG7FL3OIjgaJykdTmO+shaXpF6Iqy3NvNPLQ90Ty4uGEFWKNcIRzgyt6VwEmWHJTd",
-	"dE53/HTU1LWDJ4VzbSmavbR14RURvO1Cx5jnTeG87kuKlS+tVaTLnHi5REtConKWxm2sfKoMcXDrOzMv",
-	"E3y5Rxg1I5asxxXLSxaMZV4bUtumBWQwRxSZKlpep8bdVLiePyVn/y6BsAy4No8knsrWQcUyKc7a3r1O",
-	"jezQncsNbC309fBXkTHCqq/tGw+B2C5ghJ66DrjPK5XZL7SySJkfApfEHg7/cMbOlbjFWe/ow1GzDV5c",
-	"ND1uYYueLv8zhGFrte/uD+SVV1d+tmeOaL8fppKZFH9AXM9D9TiSsOTr3DKMcvkDwkSHsMtFg8VU1p26",
-	"bVE9e+9290k3oRWqGaTQQ/W484FbDgtuegs15XarbSJJI9YtTjBhVOmRHb8mGAdzJxI3pxdTGqtGaoQM",
-	"A9NJ7QBu2NK1IP5jj3tVZVvY2UngS67eZTYZvQBZ5xJ2C9tcUmCw0w4WFWrJAKk2lAnG1v+XKxEZpuQX",
-	"lNsuLuY7e5Tc1wqs8ct8dSEklpJQcbN/Bilb0jwuOWRp18SbsTmzDUpKBUEHDDeQbf5kqch1EalyiBxq",
-	"TmfkwThow+N2I2Mrptg0B3zjoX1jShVy8soQVX1ilgdcLxS+/mjA64uSZxIyvVAWsUqQSqhD9aZyXk1B",
-	"XwBw8gDfe/g1uYtuO8VWcM9g0d3Po+OHX6PR1f7xIHYBuAYz27hJhuzkH46dxOkY/ZZ2DMO43aiTaNa9",
-	"7TDXz7i2nCb76ZCzhG86Xrf7LC0pp3OIR4osd8Bkv8XdRENaCy88s+2RlJZiQ5iOzw+aGv7UE31u2J8F",
-	"g6RiuWR66Zw7SiwNPdXtLeykfjjba8lVJvZw+YfoIy28i6ilRN6s0dTeb7FVoyf7FV1CE61jQm39kJzV",
-	"0Qu+Xjo59eWJsFRzVaHZ4sbMZZaOYg4GM8xIIRnXqFiUepb8jaQLKmlq2N+kD9xk+tWTSHnqZplUvh/g",
-	"N453CQrkKo562UP2XoZw35K7XPBkaThKdq/O9ghOZa8zN+626/Mdbh96qFBmRkl6ya1skBsNOPWVCI9v",
-	"GfCKpFitZy963HtlN06ZpYyTBy3NDv385qWTMpZCxmoO1sfdSRwStGSwwti9+CaZMa+4FzIftAtXgf7T",
-	"eh68yBmIZf4sxxSBb0VEO/Ul0ytLuotVj1gH+o6peWDIYOqGGpNmeeqb56OHiYKKe7q8Ybvr2DJPPB7w",
-	"jzYiPjG54AbWvny7kh5CCcrzR0kmq54HPnZKvhXroYTTOoWeeD4DFEVRUrI8+6XO/Gx1P5CUp4uoz2xq",
-	"Pvyt7tNWLc7egdHygQvKOeTR4ay8+ZuXSyOS87/E0HmWjA98t92QwS63tbga8CaYHig/oUEv07mZIMRq",
-	"M6muCtrO5yIjOE9dq64+rt1GHkG59X+XoHQsQQkf2MAxtI0admCrfRPgGWqkE/K9bcW8ANIoRISaoK8U",
-	"0cyaLotc0GyMFSzOXpy8JHZW+43tNmSrjc9REWquomUTC8pwDgtB9o2D4ukRw8fZHq9tVq10UhUHjyWg",
-	"mjfq8uWs5SdAFSnEzoQ8D5qq2lxVMwTBAiZyabS6ajQrHyFNmP9oTdMFqn0N1tpP8sPL5HuqVEFryqrF",
-	"VFWbEs+dgdtVyreF8sdEGN38ginbgRdW0Mx5rRLAndnB58A2lydLzi2lTPa45apKlPui3QNnr0jvSohC",
-	"1kL8nkK/7TKxb9eAt/hVtFRWuwVBpyelzaCsWgf5zuop5YKzFAtVxa5o16p3iJ9tQE2vtiHXH3F3QiOH",
-	"K9r4oArFc1jsbYXgGaFDXNfQHzw1m2qpw/6psSfsgmoyB60cZ4Ns7Pt3OFsj4wpcrVFs7BzwSSEbvkvk",
-	"kFF3eFK5TfYkI0y96VEevzPPXjnTAsaknzOOSoRDmxP8rDUQO4lqo3kwTeYClFtPM/9Y/Wq+mWAqbgbr",
-	"9xPfeRTHsK4/s2zr5+4OdeK93s7LbN59Zt51BZKqnxtRznbSk6Jwk/Z3d4nKA3rNexEc8V4m3n0UILca",
-	"PxxtC7ltDVfB+9QQGqzQ2Q0F3sMdwqg6nbS6aBmh1VIUvkFsmFi0SgLjETBeMg51X9zIBZFGrwTcGDyv",
-	"Pd+pVFJtRcBBPO0MaI4e7hhDU9q5N646VLs8lEEJrtHP0b+NdZOWHsZRvVALbpRvqna8hroDYeIZ9gF3",
-	"iOy2XEGpyglRGWYttJqwxBiHYdy+zVPzAugeg65MZD/HWmn73kR9iajTMpuDTmiWxUq/fotPCT4lWYmS",
-	"A6whLasSoUVBUqy70ixE06U2N1EquCqXW+byL1xxuqCrUYQaws5Kfocx0WW6wX9j9TH7d8YFeuwdauij",
-	"OrL9qi91QydjUq+h6USxeTIcE3inXB0d9dSXI/T6+4NSei7mTUBuuPzENi4X7lGMv70wF0dYnaFT9NVe",
-	"LVXxBAzsE74XJaqNVdpvkyvhVdapAosOparX3XYDRH/XujFefj3hvUHRDWrvV+uh7AvyTXtj0ql22XGa",
-	"kq0sqDfjyEYI2dwihCJune2LCrJBQeZx5+thkmFHztbxwocBQn24WRegH3wsKykoc+73mll0Meui3rt5",
-	"CEPiYesNbi/CxZL3Wux+WPXFfftibPi83dXqHFzKfCFhxUTpHds+8smrhPbXRo+oKvI+uv6u4RWn+rTm",
-	"0F7j7ZnrLmCX6XTyH36xcXIEuJabz8CU29n0Tr+srrRrzVP1K6QqTD2oUHXjVhxSqDBWE8/Jho2OXTv6",
-	"jXXI6vkQcaDbP2w8Os32ujBjdRVHdpTYsYt3A+svO1WXmsIjVgjF6vrwsTZhA0MMz7DTV1A2qzuWj+9Z",
-	"QaqxKUAdtyAB9imiZSYLGo/elp/qUaerSExXdWpbqaluJ4Add3wnGyzIaLRV1CfDCyudVNFpyKexGvIc",
-	"uOv92czzGBxtPptBqtlqR/bdPxbAg8yusbfL2B7eQTIeq6KXsXjL/lbHGqBtyXFb4QmKKF4ZnL7cm3PY",
-	"3FGkQQ3Rsu5jf9Vepm4HYgC5Q2JIRKhY9Ic1JDuHPFMVZSAWfLSV/RzqCmi9HaGCXNJLzuVJ0lwcdX7p",
-	"linjLWkGzWU+3SvrGgNx+xL0uh0t+vWP59hARFXdGn3dj1BLJ6fd6ogXrm4I5kpWvhNfQQSU/80nRttZ",
-	"cnYOYc8q9FRdUJn5N6KmF2/VSbbcR52sOt+NoQ30rJqZ1bGx3TyqSL0tjIBOc2HEiKQvjLwZjlrFctxR",
-	"NujGln/HQFsD1wyk6+2H8m8uFCRa+FjabXBsQ4WNLLoUElRvjUsLXG/lmTd1aR2s9Uux0gx1AUXhAomE",
-	"JTXQyaAATv+c25D9zD73iUO+1utOC1NFr7ubDvioaKY6SAypfkbcbbk7IekyxibGue0frWLVcDjIpjek",
-	"kCIrU3tBhwejMsgNrjW1hZVE7TRpd5UtHSHI6jyHzZFVgny3Br+DIdBWcrKgB1UUWpt8UPObisE9Pwh4",
-	"n9JyNR4VQuRJj7PjtFvCp03x5yw9h4yYm8JHD/Z00CF30cZeebMvFhtfsqYogEN2b0LICbfx2t6x3awh",
-	"3Zqc39Hb5l/jrFlpq2o5o9rkHY8HvmK9K3lFbuaH2c7DFBhWd8Wp7CA7CsSse8oHSXoR6Sc1GaqVd13N",
-	"7R4/NVFZKGIySd2+ZkecTBUiU3f+qMNkutJBnouLBKkoqep/xXQO816TSfqKp/VnBttTCOJtqHIX6IYs",
-	"aEZSISWk4RfxFAcL1FJISHKB4Tcxz+BMG3loiXHNnORiTkRh1FxbRs/7UKJtaYK5DtWCx6brWggS6/Dp",
-	"KYgAyqXnOnDty114t3TB2b/DztkiYrfBDfO7tXcbHUdwe3e/CMAcQOi7bVYnsS5BzXW1+1X1dY/TYsnS",
-	"OLq/rGiV3hiTGPXGUOEK0NoEOHwND3jIUyrnJJ6eLpqB02ke89gQd/yckwbp3PwXb7D2uGQGjrn08LNI",
-	"Aua2Vcc6P0V2tZrKNabyOZU9FBJ1eG/3L9tugNOhXuaq4vRAZhAA0O93bsAwyPu8Lxgz7K6Z0AiSTyuZ",
-	"f9xofsxaHM9XA7QnO6VW518AMWOXElyOn20D2Oo7VFC98DKAeb2rmRstDxQm4NnmKVRZO5K3Z7kehG3h",
-	"ShRJDitouONd4mGZpqAUW0HYv9B+TDKAAq27bZ0j5mcOeXtLEHVrTwJP5RDsRiVTi1i7U2SH2BkVktc8",
-	"scdEDT1KBqIVy0rawJ+6Qie3viZukcvHw/p+GKfYm0nEF7eNReyMDEGaj55LHg8MCfNeK5MSzpZVpmdL",
-	"hPXJVgW94P0qWJcoa9lpeA/EALEv1pDiPdSMfLg6TggORlQrp71XaJLVDl9Wle+lsm1E1ukIGZXaFPiO",
-	"vmH5GS/4um8j0q41OjIVGYCpmjdgHCXUcXrBa0u6IRmbzUBat4rSlGdUZuHrjJMUpKbM6JgbdXkFw0Ar",
-	"Sxjv1DEMp8ZBPbOKaRtoIbSA5BunvPXJ/wPkdvShRWR2e21r0dessrMr8cQOujZ6Dka49RCBS0lHLcce",
-	"VsFRxCRLeg57zqPYH7B9GiwU46ywWuCsQ6b4uJXWf0LU4YH/mTO9ldqt6NcOObQ+IUuMngb5vHZM283p",
-	"0mAsSvTMtkwKI0XbHQj8XlsDlZ0PeioqOt6ZIE9VW1y+oIJeSakz2XXFgQ4ztsCMXQTtXtJC29yQ7mBK",
-	"URbdcyaasrqYIXXiptiLCeMGKnY8bke0NK+gatux+2daShSiLuhmd2G2+hqKBwPbkb0642McKqjdVlsC",
-	"U7ahRLTu2T7iSYTmYz0VuhWnDr8YG+Ve++GubznO0h5fQNihfTu91YK8J5UIrVG+iR0db0u+xAL7pJMB",
-	"cZoH26rqtFzHBkVZ9OUKkQ4CrRuzF8Fm0Dl4exhFWKe4ToCWNvQT3a5eH2rzix9rPWlYD2P/wQ7wwuia",
-	"oIuxd3Q4cD5xJvGPFVKCpbzvo4TG8ncF7LgF1oplsEVOVtMabNV4m33W3JcgGks9q4Kc+hput2OhsCix",
-	"EQ7yPBJDZcVH2+I2IBxzT8oVzW8+DgqrVZ8gPiB70+85DQNpQiRbVKrLpfG9pIPmDoJmDjc1f41xW/8A",
-	"s0fRa8EN5TTWDvNH4Z/m1so/8/0uV8DJBY5pg74ffkWmrsxJISFlqq0JX/hWVFXcCHZmdKmTa70jUGXX",
-	"On8R+gpkPPOGJfKqbmuDhuw5ryGsj+gnZio9JzdK5THq65BFBH8xHhXWG91xXZw3osFrqS640YSEA0eF",
-	"B/lde0aFdyupDl2ejXw2l06poLvOwbd1A7eRi7pe29CUhi5yt/U+GZKJEG9pZD7HVAiLEOwHRhBU8vvD",
-	"34mEGTb8FeT+fZzg/v2xe/X3R83H5jjfvx9V8m4sCcLiyI3h5o1RzC99afE29bunAkNrP0qWZ7sIo1FP",
-	"o26ZjRUjfnNVez5J0+7fbGBm96i6xqlXiCa3iImstTF5MFVQKWNAkQz3WaQkBgY9pKVkeoPFhL3Gy36L",
-	"pmt8X4X+utDxyoTn7j4tzqEqR10HCpfK367fC5rjfWQti9zcQiKfkBdruixycAflmzvT/4THf3uSPXj8",
-	"8D+nf3vw9EEKT55+/eAB/foJffj144fw6G9PnzyAh7Ovvp4+yh49eTR98ujJV0+/Th8/eTh98tXX/3nH",
-	"8CEDsgV05EvXjf4HO9snJ69PkzMDbI0TWrAfYGOb6Boy9u15aYonEZaU5aNj/9P/40/YJBXLenj/68hV",
-	"xhottC7U8dHRxcXFJPzkaI6RgYkWZbo48vN0+veevD6tXJDW6I87aotKeGeOJ4UTfPbmxdszcvL6dFIT",
-	"zOh49GDyYPLQjC8K4LRgo+PRY/wJT88C9/3IEdvo+MPH8ehoATTHQHrzxxK0ZKl/JIFmG/d/dUHnc5AT",
-	"17PY/LR6dOTFiqMPLkLyo5khavK09VSCIhrdVr4u2hotN7ZeSqM1nnKd2sZVw0TnW+IZlrmwQYeGzVWI",
-	"O83qzkCnNdPy9ZFtw4jjXyNZK95B7cv2NtopO2c2U+S/3/70ighJnHrzmqbnlXOenM5srUspVgyrJ2RB",
-	"yQ3z5cTT779LkJuavhznC5sh+P53zsu/VPOimcBdS1UxI0msbTLObMgiIOwqnrlmXGhFD5vNV2zYsNYH",
-	"ydfvPzz928fRAEAwuF4Blsr8neb57+SCYfdddCf5YtOumOg40usNpelxHR+LH9Q7OUYDTvU0bOdbvdOs",
-	"e/I7Fxx+79sGB1h0H2iemxcFh9gevMdijkgseOYePXhwsD7gVakf65SvRvEkcYmBugzJPqr6iV9IWtiz",
-	"6NuBY1SYM6zal7D7+ZMDLrSZqHvl5baH6yz6W5phi1VQ2i7l4Re7lFOO+S3mgiD2Avw4Hj39gvfmlBue",
-	"Q3OCbwaVkrsXzc/8nIsL7t80wk+5XFK5QdEm6APdKiNG5wq9Gcgi7dludH4dvf/Ye+sdhY0tjz40UiSy",
-	"K92JnZ6+p893XJN3VB/n7PYZafXNNM+rtojoGnLNQbFRo7o3Id+HXyP3xrKdtihmKTlkPsPB33pVHXJf",
-	"3byG7Y4KK5pGL+3AXHx7f3/q+/ukaexo9LKIAdM4BVth6jh+r3qBdiNjglSIPYrgBR24ml3bL9EH7Frb",
-	"M7d0TTvT+5gquJNR3+KuB3d9YlIAbyUxNTuPXj9r9hn11U3SuDKukXF/4ULfjzQ3dBIst1W5zjZ4uRUG",
-	"/zLCYJV5O7fSmetpdjXxELsbH33wTXsOIBK6pkUDhMFQrQ6+DQLz7rbYyb2J7cATvnM5nuFSbXeKedhK",
-	"6VbA+wwEvG6bshgYdfOpTyfUIQyLuo/ZzpZpvgNZKI34/nCD+619oVLcXxhZvWKbgXS3wHYJ9tkRxhyz",
-	"vja2+qcUwhzSbsWvv7T4VRXAuJIA1mg06EqqBG6sK1nv2tY5pitJrFkEJeBsmEFjGIo7wuM6ONiwGBtd",
-	"6+Jq1dhrhuhOtUqj3axxR2/siljfQ6igfrs5fb5LuvqC7DyDexlEboH43lw3L426Hd7cjNthGG968uDJ",
-	"zUEQ7sIrocl3eItfM4e8VpYWJ6t9Wdg2jnQ0tZ2ctnEl3mJLyCjqDk0Bj6qqP42D5+ZtG6VxF1ORmtUv",
-	"702I7xulqi6YLo93Lgyj8gkYVM7tR4bXGWSQO/7PYxz/zoR8hwkrWo0x2Ey7FonkDuP6+OGjx0/cK5Je",
-	"2Fiu9nvTr54cn3zzjXut7hJm9ZzO60rL4wXkuXAfuDuiO655cPw///zfyWRyZydbFetvN69sufzPhbeO",
-	"Y4nyFQH07dYXvkkxbd03vtqFuhtx338r1tFbQKxvb6FPdgsZ7P8pbp9pk4ycIlpZMhsF9Q54G9ljss99",
-	"NPYdsQzfqS6TCXklXG3TMqeSCJmBdG2D5yWVlGuAbOIpFWsMKFvLMc0Z5lhKgo1QZaJYBnWpkSrDuZCw",
-	"whh5nB51+gYEuxk9RtJ+tkz+R7oO6h1Oq2taC7dkNHsu6dq3YsZmo0LiT998Qx6Ma+0lz80ASYWYGHNd",
-	"0vXoBq1+FbENij9vdjLcGaCLYw+xINXST1UyIWyb9tfm3F+s5G7J3W3sgTjn3o6f2rET2hFcBdGtFgQr",
-	"2NlGzdg5eFMXXzFSnheh4izOzDDUOPAZ+wh2mqajSmgbvbeH+NYIcCVW0iaoPdkGZp2qow+ol4c8o3Nu",
-	"MWvur+UuDXxHUiy980iQGeh04RJ2W6iPsCffR7GfNy0ZZ0sD5YPxtUs1uIvdwkRhA4eM2jT5ITVCg1xK",
-	"dOCBjBDxT76lkXnMZraemK86eObq3qNrivke7VV7djsTcdWAtKjyes0u7gXls3ryrkCGaDmE//MWwfsh",
-	"uMMcX/g+3Ygxt4g/Q8S/VyUT8krUaeOuReSf0fV4nTf7dS/oleBgfexG8rW0eOtOrcQOwzgsUny9EKu/",
-	"VM26Li2CHPmO81vlkL/bfu9bZZEht7eZ7Iu8wv/usLTlljFrm+wshlCPNoQ5mxdtocJm+6hPqMV8En76",
-	"Gao2n4Jj3QyLwUPq+YwTC/hhmQ6W4LHEfFR1DurjQPFmbIO5kRZVGFq0f9oUcsHn6vNkRVvb4kXxEqGS",
-	"qk1dvBfdX+/sPsPqPkbltRGQrt6TYjwFosQSbDNYpsiSKeWCJZ88+NvNQajZ0rff4GHu6ifmLk8fPL65",
-	"6d+CXLEUyBksCyGpZPmG/MzpirIc68Vfgdthp72q/pq3BkebK6K3qVkXLA2LGF2eCTZC1z7oNcs+7maG",
-	"Qd3BPfkg4wEfDCvQ0qIAKi/PAHe7rto9JU6fh9HBjQZwVUWtCCgGRXsGyP/HaKDdCdPexcxdfiW3gPrq",
-	"X45NuNBdMRtXwTFGChCzY/KO3ydqQZ8+fPTbo6df+T8fPf2qx3Jm5nFFe7q2s3og89gOM8SA9kWbAw8r",
-	"tVf4Pb7p3d5vE8cjlq2jLaLqpq+dHgdOLLujSEE3vX3kih1Na8Nh6wa2N1/sUGk2XUT1K6/+VL1TTvm3",
-	"lRZsK/K5Xq+3zWp7kicCPmMIre5aW2F9ewPbLdJkiyyrTqE3rZzWSQb2ovPIk60755MKuvpTKakJ6qjA",
-	"vWDTRMunkymxjdk4cHcXUmiRitzGrpRFIaSuTreaDBL3oM9t15D2+gh3L2EupTpdlMXRB/wPVvj6WCce",
-	"YO1jdaTX/AgL/B992BoigCBG+qhbuTTaQaerJg9o474rBKB1YsbtQ2SbFWAsQUQ+ux7p7C8t1OzXFv+q",
-	"Ju3IiJ0DXOXVBQX6K9oNCn/7VDnbcCFCwrcumM9rQbVRZMZ4RmiwjS3dTciaEVyzYeS6F/0p7Cw373d6",
-	"+gWfs1dCk9NlYfujQXa16B3S5nD+9th63e4nGLirvxvi073zwxvfByZW1vWdF/weDrkgFRv8dFRibrS5",
-	"q6/H9n17k3/eN/kzX3K4QYa39/KXcy9LH055ewV//lfw4y92NdfoiBl4Jfub6NLXcK2J73khR1pho8mg",
-	"5Qrf5qdB1bu9SvWdkL69xe0t/oU6GexODk5aGmKh2ZXK5KY8ROjsZwX9MDtDnkcsDX0HdWx7/egFMCw6",
-	"I1KG9cNPMzW2h9gZJ9wpvhV8PmvBJ9jrW7nn1vTwhZkeeqQcp/U3e3L3CRr7CkCrpcjAR52I2cwVeeuT",
-	"fpq9Zwx5Kk2XBbFfRqUc9MaesSW8NW/+ZKc46BVbg90Si1rgGWQpSAXP1ACvqBv1svcQunH7AbhxD2i1",
-	"Ax4Wl/49uTTJvglqyHQogbSRr7BnkC9255CRwYosXZflq5Lt0Qf7L5rTCqFiTfY9AXc25q7bFlu9z47b",
-	"AJC8RiHUNSN2X4kZeWCL+JUcM3Xq5oCUZ0TLjRFUfc0SCTQnaSNCv4Kje3Le9p6cnapAZ3U9a4rrAqI+",
-	"oYcMZ21lR/1w4wfgGeWO5LsI0oJQwmFONVuBj1uf3GbUX/o2c/nsWxjgmNAss6ex3gRYgdwQVU6VkXV4",
-	"M9Dyjmqelz0YBqwLkMxc0TSvHfBWTTiy6fLbAirf2jeueGm1eJFN0pfNKCB/s7oUfjEjP7JUipN8LpSP",
-	"61IbpWHZab3nPv2tp+iqNyR0Y8AEzxmHZCl4rCHcT/j0R3wY+xpLDvR9fGYe9n3bum+b8LfAas4z5E6+",
-	"Kn4/k9N/pVyN1molFEIa7XZqm9Ra+t/zKPlDs+Fp9yRteBo4tdzDYKCwfVzj56MPjT9dsQz3plqUOhMX",
-	"wbeo2dugnyF58kGj6ktY0loNn9X12tKu04cU4CF2YqqnkdZfQTvy3u5ff9H8EOdyCYnEtehfgVQt9ew2",
-	"SeRPlSQyeN/34rG21eUujlaqw0okr0QGdtxmp9lYfWYuMnAdObuCSBXsGA+s97dS/V4r1Dml5XyhSVkQ",
-	"LWJB1fWHCU0tk02sehOfMKiIZpUgnG5BV0Bojn1OyRSAEzE1i67vR1wkVViTzkdmu5DOqCgUwFVIkYJS",
-	"kCW+HvUu0Ko+pxjHrbfgCQFHgKtZiBJkRuWVgT1f7YSz6hOuyN0ffjEK843Da0XB7Yi1lbAi6K2qbThp",
-	"rwv1sOm3EVx78pDsqATiRQNMJBHLIgeXShJB4V446d2/NkSdXbw6WjDXgl0zxftJrkZAFajXTO9XhbYs",
-	"EnN/d0F8Zp+esSVKYpxy4e2KscFyqnSyiy2bl8K1KLOCgBPGODEO3KNwvqRKv3FZhRlWoLHXCc5jZWwz",
-	"RT/Aq75+9GbkX6pu9J2xU3MfclWqqmW9yxSALLYGDustc72CdTUXpnX6satUBGvh2zVyH5aC8R2ygqLc",
-	"hOrAm2+GiywO7Y/UGSi6qGwAUSNiGyBv/VsBdkM3fg8gTNWItoSDRUZDypkKkQPlNqNLFIXhFjopefVd",
-	"H5re2rdP9M/1u13iorq+tzMBKkwTcZBfWMwqNNAuqCIODrKk5y6TZO6aLHVhNocxwQzwZBvlo8nWvBUe",
-	"gZ2HtCzmkmaQZJDTiCnlZ/uY2MfbBsAd9+SZrISGZAozISG+6TUly14TUTW0wPFUTHgk+ISk5gga5bkm",
-	"EPf1jpEzwLFjzMnR0Z1qKJwrukV+PFy23eoes5QZw+y4owcE2XH0IQD34KEa+vKowI+T2nzQnuKfoNwE",
-	"lRyx/yQbUH1LqMffawFtc154gTVuihZ7b3HgKNvsZWM7+EjfkY0ZEL9IY387dukaq780DaiBAji5jHJ7",
-	"dEGZTmZCWkE6oTMNcmdA/D8o8+5w5xrQwtUmIDiCuzfdOMjkw1YXjotYEIi7LgyJdP1vZqrvhBxUYrNZ",
-	"SIYyTUquWR6UGa9U5c/PYHhrBLg1AtwaAW6NALdGgFsjwK0R4NYIcGsEuDUC3BoBbo0Af10jwKcqmpt4",
-	"icOXEuOCJ+2oRHIblfinKjJZ3VXeKIFmjAvKtOua6fP93ZOr1djVQHPEAcuhP07ahm+evTh5SZQoZQok",
-	"NRAyToqcGt0A1rrq4dbsDur7FttGkLbxKFXw+BF5+/cTXwtv4Wq2Nd+9e+L6fyu9yeGe65IAPLOiqG+X",
-	"ANwg3XVLoP5O8L3eXOc7lmOMuSIv8O3nsIJcFCBtmS2iZRkx+ZwBzZ853Oyw+PzDTO6CVn83o/0+bhia",
-	"HNqWtPByvl8rVYTa3EXyPMhm/H1GcwW/9yU02vGWtIi1W6tuPmsLQm7yrcg2rRNidu0IN7B5NuqKeIxT",
-	"uYnUW+omE7RJQwvDrxxhdY1ZHw9et7FLtF0y20VhMXFdgoqe421UHi1YWG1YZyib8jpr0ckolq3ZrtI3",
-	"qgAcEgJ7hgkHdk/IG/vdp60KjxC5I1Yz888mcrD5ZsU08F2jRTjW86VG5XvER08vnv2xIeysTIEwrYgv",
-	"/bj7ehmP1okZaQ48cQwomYpskzTY16hxC2VMUaVgOd19E4X80zUYdpePebL9nvo018jzYHHbeHJINOvE",
-	"MeAe7rzRMJg3V9jCER17DjB+3Sy6j42GIBDHn2JWpRbv25fp1dNsbhnfLeMLTmNLImDclcptM5HJNTI+",
-	"uZEl7+d5L9aQlga48CTfRfM8+uRgrRuOzQym5XyOjZI7TjqzNMDxmOCfiBXa5Q7lgvtRkB28ap551XTv",
-	"9nBd7hJkYN/1NQ7v4XZQvkFvxrKgfON9vpAotixzi0PbY+6wjNZWs+1GAqA/1hn/+szar73NLzDeuqu2",
-	"+btFC7mgitj9hYyUPHO5Q52a12s+vGKIHfpszWs2vbU6iF1vZHVu3iFXhN/lZtK2IgXIRK+5PVDNTuq2",
-	"trY9uZPbBrF/jWvDpnxDD4Pt1omuGcKBbg8Z8DW8PoJuIHUyXKNHCFot+lNHwtYg9s2DRo90hm8GkdQm",
-	"Feckhbwg1HfvTwVXWpapfscpOmmChU26ASbeGt3P3575V+J+wogbzw31jlNs7l65bqJ8bgYRP8V3AJ6N",
-	"qnI+B2V4ZUgkM4B33L3FOCm50bTEjCxZKkViE1HNGTLyycS+uaQbMsP6H4L8AVKQqbnZg123BmOlWZ67",
-	"iBYzDRGzd5xqkgNVmvzIDJc1w/niA1UoF+gLIc8rLMQ7RcyBg2IqiRtfvrdPsRmDW7438qHB0j6ui6jf",
-	"bBcGDzvLeiE/fW7gpli7OGdK10EQHdhvzAG+ZDyJEtnZAoiLCWvTFrmLFdMcAd1reof0At5xc8NpQZCr",
-	"U305cmi7eTpn0Z6OFtU0NqLlDfJrHaTiHYTLkAiTuXWt/IlSMwM68O5L3Hhbjb6193u6URpXLvDMPO25",
-	"kO1T17yr5yWnJDQMYa1yMO6NswbIf97G7++vR1/0aDyYxtgdsMuumu2ZEG9+w8eE5oLPbRVCo0EK3CfG",
-	"i1JjYPV1GulgRfNErEBKloEauFIm+IsVzX+qPvs4HsEa0kRLmkJirQZDsXZmvrF0uusiDZrULZeQMaoh",
-	"35BCQgqZrbfFFKmV7YmtWEDSBeVzvHOlKOcL+5od5wIkVP28jH7bHiJe72TNE1t7rQvjCbGGyrA8LdB0",
-	"EemPgjeTUag9JdhyEkNU5ggrwMqafRr0eNQrIRukrurANoucJn8YcP03LvIAP/XEhyhFekutt9T6yag1",
-	"VvIPUTdr2QAsvsJtuWZj0XUXuLxB29MnqX57W0L+z15C3nMgRSiRtCH1x3uXUUWYJhdY4GcKxFw8Jdq8",
-	"XYtzpyFPiGFIgX3fVoJUrvNmuqCMu+owVboAwqFdd2Dt2xFei7nQMjO0Exp0QFpKpjeoJ9CC/XYO5v/v",
-	"jaCtQK68ClHKfHQ8WmhdHB8d5SKl+UIofTT6OA6fqdbD9xX8H7z0X0i2MhrNx/cf//8AAAD//ycflPTr",
-	"fAEA",
+	"H4sIAAAAAAAC/+y9a3PjtrIo+ldQOqdqHkeyPY9kr7gqda4zk2T5JJlMjZ3k7J2Zm0BkS8I2BXARoC2t",
+	"uf7vt7oBkCAJSpQtzyPxpxmLeDQajUajn+9HiVrmSoI0enT8fpTzgi/BQEF/8SRRpTQTkeJfKeikELkR",
+	"So6O/TemTSHkfDQeCfw152YxGo8kX8LoOOw/HhXwr1IUkI6OTVHCeKSTBSw5DmzWObauRlpN5mrihjix",
+	"Q5y+HF1v+MDTtACtu1D+LLM1EzLJyhSYKbjUPMFPml0Js2BmITRznZmQTElgasbMotGYzQRkqT7wi/xX",
+	"CcU6WKWbvH9J1zWIk0Jl0IXzhVpOhQQPFVRAVRvCjGIpzKjRghuGMyCsvqFRTAMvkgWbqWILqBaIEF6Q",
+	"5XJ0/PtIg0yhoN1KQFzSf2cFwL9hYngxBzN6N44tbmagmBixjCzt1GG/AF1mRjNqS2uci0uQDHsdsJ9K",
+	"bdgUGJfszXcv2LNnz77ChSy5MZA6IutdVT17uCbbfXQ8SrkB/7lLazybq4LLdFK1f/PdC5r/zC1waCuu",
+	"NcQPywl+Yacv+xbgO0ZISEgDc9qHBvVjj8ihqH+ewkwVMHBPbOO9bko4/0fdlYSbZJErIU1kXxh9ZfZz",
+	"lIcF3TfxsAqARvscMVXgoL8fTb569/7J+MnR9f/4/WTyX+7PL55dD1z+i2rcLRiINkzKogCZrCfzAjid",
+	"lgWXXXy8cfSgF6rMUrbgl7T5fEms3vVl2NeyzkuelUgnIinUSTZXmnFHRinMeJkZ5idmpcxAaxrNUTsT",
+	"muWFuhQppGMmJLtaiGTBEq7tENSOXYksQxosNaR9tBZf3YbDdB2iBOG6ET5oQZ8uMup1bcEErIgbTJJM",
+	"aZgYteV68jcOlykLL5T6rtK7XVbsfAGMJscP9rIl3Emk6SxbM0P7mjKuGWf+ahozMWNrVbIr2pxMXFB/",
+	"txrE2pIh0mhzGvcoHt4+9HWQEUHeVKkMuCTk+XPXRZmciXlZgGZXCzALd+cVoHMlNTA1/W9IDG77/zn7",
+	"+RVTBfsJtOZzeM2TCwYyUSmkB+x0xqQyAWk4WiIcYs++dTi4Ypf8f2uFNLHU85wnF/EbPRNLEVnVT3wl",
+	"luWSyXI5hQK31F8hRrECTFnIPoDsiFtIcclX3UnPi1ImtP/1tA1ZDqlN6Dzja0LYkq++Pho7cDTjWcZy",
+	"kKmQc2ZWsleOw7m3gzcpVCnTAWKOwT0NLladQyJmAlJWjbIBEjfNNniE3A2eWvgKwBFyCzhCDgNHwipC",
+	"M3i68QvL+RwCkjlgvzjmRl+NugBZETqbrulTXsClUKWuOvXASFNvlsClMjDJC5iJCI2dOXRoxplt4zjw",
+	"0slAiZKGCwkpE9ICrQxYZtULUzDh5vdO9xafcg1fPh9db/s6cPdnqr3rG3d80G5To4k9kpGrE7+6AxuX",
+	"rBr9B7wPw7m1mE/sz52NFPNzvG1mIqOb6L9x/zwaSk1MoIEIfzdpMZfclAUcv5WP8S82YWeGy5QXKf6y",
+	"tD/9VGZGnIk5/pTZn35Uc5GciXkPMitYow8u6ra0/+B4cXZsVtF3xY9KXZR5uKCk8XCdrtnpy75NtmPu",
+	"Spgn1Ws3fHicr/xjZNceZlVtZA+QvbjLOTa8gHUBCC1PZvTPakb0xGfFv/GfPM+wt8lnMdQiHbsrmdQH",
+	"Tq1wkueZSDgi8Y37jF+RCYB9SPC6xSFdqMfvAxDzQuVQGGEH5Xk+yVTCs4k23NBI/7OA2eh49D8Oa/3L",
+	"oe2uD4PJf8ReZ9QJRVYrBk14nu8wxmsUffQGZoEMmj4Rm7Bsj4QmIe0mIikJZMEZXHJpDkbj2JmsD/Dv",
+	"bqYa31basfhuPcF6Ec5swyloKwHbhg80C1DPCK2M0EoC6TxT0+qHhyd5XmOQvp/kucUHSY8gSDCDldBG",
+	"P6Ll8/okhfOcvjxg34djkyiuUL00BSdq4N0wc7eWu8Uq3ZJbQz3iA81oO1FZcz2u0KA1mH1QHD0rFipD",
+	"qWcrrWDjf7q2IZnh74M6fx4kFuK2n7iwFXOYs28c+iV43DxsUU6XcJy654CdtPvejGxwlDjB3IhWNu6n",
+	"HXcDHisUXhU8twC6L/YuFZIeabaRhTW95DIBEgn0Hqh7A5Fl3ABe+F66iWjRujdUKaT58vktKKxCiZMS",
+	"vZQRQuNkb064wAb0K6mdU7hkS5USj7/tzTPwUojub/05PJcE1Y350lbeEYUEP7Rh+CZTycU/uV7sgYKm",
+	"fqwuFdE0bAE8hYItuF4cjGISWUgo9WhDiAUbkjKETYOpDqol7mt5W5aWcsMPRm144wfEop760QUBReSd",
+	"9zP9h2cMPyMf5MarOVDFI4idqcAgk6JmxD6m7EzYADfeKLa0yhCGGoqdoHxRTx7fp0F79K3Vv7gdcoug",
+	"HVKrvR+Db9QqBsM3atU5AmoF+2CgU7Wy/xEGlnoAfC8dZIr236GPFwVfd5FMYw9BMi4QxXxNp0GG0hHO",
+	"UiuyT6aquBn3abEVyWr1POM4anBRjVtIoqZlPnGkGFHx2QatgWqL6Gam0R4+hrEGFs4MvwMsaMMD4G+B",
+	"heZA+8aCWuYigz2Q/iLK9FGh8uwpO/vnyRdPnv7x9IsvkSTzQs0LvmTTtQHNHrp3LNNmncGj7srGI6tm",
+	"iI/+5XOv1G2OGxtHq7JIYMnz7lBWWWzFRduMYbsu1ppoplVXAA45nOeAnNyinVk7CIL2UmiuNSyne9mM",
+	"PoSl9Swpc5CksJWYdl1ePc06XGKxLsp9PPuhKFQR0UXSETMqUdnkEgotVMTy9Nq1YK6Ffwrk7d8ttOyK",
+	"a4Zzk5q8lCRQRCgL9d+D+b4d+nwla9xs5Px2vZHVuXmH7EsT+V6e1ixHq94KReVpOW+8GmeFWjLOUupI",
+	"d/T3YEgUOJUGikue7WErhRuqT6by35mQTEOiZKrH7MjKNyRDWIMWST9XhTAGJOO6acXABrqcLvFrOuAB",
+	"XMF0mxfKtA/8EJHnYglnhi/zn2ez/egnFA0UecKJJWicidkWAUADMOJGvQ0+TD8ADiNna5nQS/ZuH7JL",
+	"IcnSptcyCVQn9KqEdA7FAHzs4QFLUz3QEXAQHT/S5zPJc71Q+38fNoePwR0HINBMNBv0KSiarfYhYWs/",
+	"1mBu217sFmZbT3Cb/dX8ElK3y6waMsSJ4QZeQmb4d6o4r9nV94Uq87va8GrOoUvh1RKwK0uxr1fKCTnP",
+	"mu58c4Q9usaPsqAX/o5zayDoidv8KOYLE7y9XxdKzfYPY2yWGKD0wWouMuzT1V+8UileFKbUe3in1IPV",
+	"YgDSbHj586kqDeNMqhRo80sdf8H0OICR5wk5zJjwUWQWVhkxBaSuhJe4WjS0qZhQVXec8MSe0Im99OMT",
+	"1l4MtpWdzjoXZQXwFJXDIJmaOouzs4XTIjn5shj/BnDvp8hd0IArL1QCWqNS36pqt4Lm21n5ymzAEwFO",
+	"AFezMK3YjBe3BvbiciucF7CekOeVZg9/+FU/+gjwGmV4tgWx1CaG3koXJmQP1MOm30Rw7clDsuMFMH+v",
+	"MKPoyZeBgT4U7oST3v1rQ9TZxduj5RIKMvDfKcX7SW5HQBWod0zvt4W2zHv8iZ0OCKV33DDJpfJCc2yw",
+	"jGsz2caWsVG4Fo0rCDhhjBPTwD1C9Y9cG+uUImRK+mHtbDSVhQan6Ae4962OI//qn+ndsRMlNUhd6urN",
+	"rss8V4WBNLYG9GTqn+sVrKq51CwYu1IMGMVKDdtG7sNSML5Dll2JRRA3le3WeW11F0cWTrzn11FUNoCo",
+	"EbEJkDPfKsBu6FPZA4jQNaIt4QjdopzKkXM80kblOXILMyll1a8PTWe29Yn5pW7bJS5u6ns7VaDJldO1",
+	"d5BfeTMgl+hfrJmDgy35BcoepCu03jNdmPEwTrSQCUw2UT4937FVeAS2HtIynxc8hUkKGV93B/3Ffmb2",
+	"86YBaMdrnZAyMLFukfFNrynZe6FtGFrReBGm+Uox+sISPIL4FKgJxPXeMnIKNHaMOTk6elANRXNFt8iP",
+	"R8u2Wx0ZkW7DS2UCszCB7Dj6EIB78FANfXNUUOdJ/fZsT/GfoN0Evs0NJlmD7ltCPf5OC+gxNLiIk+C8",
+	"tNh7iwNH2WYvG9vCR/qObI/V4zUvjEhETm+dH2C996dfe4KoLZ6lYLhATXzwwT4D87A/sw597TFv9hQc",
+	"pDLpgt9RmkSWkwlNIk8T+AtY05v7tfUUD1Qd+3jLRkZlwgaAIKDe/xRF8LAJrHhisjWpihewZlfQ1hE3",
+	"n7pG5ZNwgKjxb8OMztJtvaz9DgwxvZ/RUMHyulsxHtk3wWb4zlsPgwY63FsgVyoboP3sICMKwSAHMpYr",
+	"3HXhglF8OIKnpAaQjmlnaw+uuypCNNMK2H+qkiVc0pOrNFDJNKogQQH70gxCB3M6V7EaQ5DBEuxLkr48",
+	"ftxe+OPHbs+FZjO48hFcjx930fH4MelxXittGodrDxpRPG6nkeuDrKJ48blXSJunbHe/cSMP2cnXrcH9",
+	"pHSmtHaEi8u/NQNonczVkLWHNDLM9cisBq48WE903bTvZ2JZZtzsw7QLlzybqEsoCpHCVk7uJhZKfnvJ",
+	"s5+rbhSdBgnSaAKThGKqBo4F59jHhmFtexvW7qliuYRUcAPZmuUFJJBaVbnQTFcwHjDrUJwsuJyTpF+o",
+	"cu48Wu04xKkxTI8Co0rZGSIqDZmVnJB2Osa5XRSDjxxDOQg4vsXaqm378rji1XyQNhj6QOS1Vf1RE/B4",
+	"1PtURaRe1k9Vi5xm+NsALt4Q1AL81BMPtH8Q6lBo6eIr3BY8Bbi5d6Nrr4eOQdmdOLBk1R/7rFh1i99U",
+	"cQHFLTTxw9YQTrN5PTGAomsLG/aus0Ttwh6kMjsQKyAvQNMdGurRtP2qZmFIr7tk9VobWHZNDbbrHz1s",
+	"5k3vg1bJTEiYLJWEdTSLhZDwE32M9bb3eE9nkqj6+rYfSQ34W2A15xly6m6LX9rtNidqm9T0d6rYlz3e",
+	"DriryXaTiXSrHddNeVMjLga3dm2fLuCvzej0uEowIgrGtVaJIKHyFN1VhKzNpc5/von+11UYwx7OXnvc",
+	"lpEvjCUnJTZkOeMsyQSpuJXUpigT81ZyUqIFS424MHptQb9a9YVvEtfjRtSsbqi3kpP7aqVai7pdzSCi",
+	"R/oOwGtXdTmfgzatx9gM4K10rYRkpRSG5lricZnY85JDQX6EB7blkq/ZDGnCKPZvKBSblqb5PKF4Vm1Q",
+	"SWstjjgNU7O3khuWAdeG/STQ6QuH8x4n/shKMFequKiwEJdi5iBBCz2Ju1p+b7+SF7xb/sJ5xOP/XWdr",
+	"o8Lx66DXtYFGTo3/9+H/PsZcGnzy76PJV//r8N3759ePHnd+fHr99df/X/OnZ9dfP/rf/zO2Ux52kfZC",
+	"fvrSPd1PX9L7rDZSdWD/YAYKDNGOElnoStSiLfaQMgs4AnrU1N6ZBbyV6HBnFCa2ECk3NyOH9g3TOYv2",
+	"dLSoprERLW2dX+uOr55bcBkWYTIt1nhjabHrnRyPa8aN9KHK2IrNSmm30r8ybNie9xJVs3EVu27TWh0z",
+	"CmxecO/i7P58+sWXo3EdkFx9H41H7uu7CCWLdBULO09hFXvMugNCB+OBZjlfazBx7kGwRx1irfNJOOwS",
+	"UAuiFyL/8JxCGzGNczgf4OOUYit5Kq2XKJ4fssGunWlHzT483KYASCE3i1i6m4agRq3q3QRo+cVguCLI",
+	"MRMHcNBWSqX4LnauuRnwGRKotSOqIa++6hxYQvNUEWA9XMggzU+Mfkjkcdz6ejxyl//+n0xu4Bhc7Tkr",
+	"g6v/2yj24Ptvz9mhY5j6AWHLDR3ErEdUBvZD02PKMO6SfFkh7618K1/CTEiB34/fypQbfjjlWiT6sNRQ",
+	"fMMzLhM4mCt27CM9X3LD38qOpNWbhy+IsWV5Oc1Eggr3GHna3ErdEd6+/R3Vzm/fvus4j3SfD26qKH+x",
+	"E0xQEFalmbjMMJMCrngRM87pKjMIjUy9N85qhWxVWg2uG5+58eM8j+e5bmcI6C4/zzNcfkCG2sW/45Yx",
+	"bVThZRGhPTS0v6+UuxgKfuX1R6UGzf5c8vx3Ic07NnlbHh09A9YImf/TXfnCvsUHa5F6Mxi0lUe0cPus",
+	"hJUp+CTn85gN8O3b3w3wnHaf5OUlbgEKutQtxEkVXkND1Qvw+OjfAAvHzqG0tLgz28tnAYwvgT7RFlIb",
+	"FDdqz4Sb7lcQvH/j7WolAOjsUmkWEzzb0VVpJHG/M1VysDkXUnt3EbQ04SFwedSmqDqF5MIluIJlbtbj",
+	"Rnc1awiannUIbVOf2XBSSr5DFhRMiZan3IniXK7bWVA0GON9nt/ABazPVZ27Z5e0J80sHLrvoBKlBtIl",
+	"Emt4bN0Y7c13bm8IKc9zn8yCInU9WRxXdOH79B9kK/Lu4RDHiKKRJaIPEbyIIII69KHgBgvF8W5F+rHl",
+	"4Stjam++SBo0z/uZa1I/npyHWria80X1fQmUR1FdaTblKLcrlwLQZpoIuFip+Rx6JOTQiDUwn0PD8EWD",
+	"bLv3ojcdms2bF1rnvomCbBtPcM1RSgH8gqRCj5mWX6KfydpJnQWGMvs6hE0zEpMqB07LdHjRMCbK+SbQ",
+	"4gQMhawFDg9GEyOhZLPg2mcnTMfBWR4kA9xh5pRN+bJOA5e6IFNjlQ3L89z2Oe28Ll3WLJ8qy+fHCp+W",
+	"A3JdjUfOiz+2HUqSAJRCBnO7cNvYE0qdxaXeIITj59ksExLYJOadF6hBg2vGzQEoHz9mzGrg2eARYmQc",
+	"gE32fxqYvVLh2ZTzXYCULgsN92OT50DwN8SDQK2/Ooo8KkcWLnqsd4nnANy5dFb3V8uxmIZhQo4ZsrlL",
+	"noE0/sVXD9JJ20RiaytJk/NAedQnzm4wgNiLZac1UY8brSaUmTzQcYFuA8RTtZrYKPCoxDtdTZHeoy78",
+	"2Ct6MG2CrAeaTdWKvJroarEu41tg6YfDg1EDQJmPcO3Ur+82t8BsmnazNBWjQs0eVrJNTS594sSQqXsk",
+	"mD5yeRjkvLoRAC1lR51A3j1+tz5Sm+JJ9zKvb7VxncvRR0fFjn/fEYruUg/+ulqYKkvV67bEEtVTNFq1",
+	"EnQFImSM6JmQESNN1xSkIQN6FEwaQtTkAtbxtw3QjXPmuwXKC0oDxuX6UeDxVcBcaAO1Et37g3wM9SSn",
+	"7KNKzfpXZ/Jihut7o1R1TVFHq5xsLPODr4BcpmeiQN9ctEBEl4CNvtP0qP4Om8ZlpcZmM5urW6Rx3kDT",
+	"YpRNKrIyTq9u3h9e4rSvKpaoyynxWyGtY86UcstHPU03TG2dkTcu+Ee74B/53tY77DRgU5y4QHJpzvGZ",
+	"nIt2EPUGdhAhwBhxdHetF6UbGGQQIdzljoHcFNj4DzZpXzuHKfVjb/Xs8XHKfXeUHSm6lhrQzasQZCZC",
+	"sUSYIDV7N3S35wzwPBfpqqULtaP2vpj5TgoPn9CyhQXaXTfYFgwEes9Y9FABupm7tBbwbZL9Rjqsg0GY",
+	"OW9mGA0ZQjiV0L5ETBdRVXThNlxh/pwfYP0rtqXljK7Ho9upTmO4diNuwfXranujeCbTvFWlNSwhO6Kc",
+	"52jw4tnEKZj7SLNQl440qbnXR39gVhdXY55/e/Ljawc+6vAy4MWkEhV6V0Xt8s9mVTZNas8B8SUo8M3n",
+	"ZXYrSgabX+UrDJXSVwtwufwDabSTdLg2ONTjeSX1LO4htFXl7GwjdokbbCSQVyaSWn1HnVtWEX7JReb1",
+	"Zh7aHm8eWtywzNVRrhAOcGvrSmAkm+yV3XROd/x01NS1hSeFc22oNrC0BTU0U7JtQiffblTHEamiZ9cU",
+	"nFaky5xkuSRNwkRnIonrWOVUI3FIazvDxowa9wijOGIpekyxshTBWNhsSH6mFpDBHFFk6miKqBp3U+WK",
+	"pZVS/KsEJlKQBj8VdCpbBxXPpS+4071OUXbozuUGpj7B8LeRMcJ02e0bj4DYLGCElroOuC+rJ7NfaKWR",
+	"wh8Ck8QOBv9wxs6VuMFY7+jDUbN1Xlw0LW7DsjIPKazmH68ub3fPHNFCaUJPZoX6N8TfefQ8jgRmuYlI",
+	"mKLeB5Hw3zaLqbQ7db23evbe7e6TboKPrOmk0EP1tPOBWY6y03kNNZd2q23ATMPXLU4wQQt9aMevCcbB",
+	"3PHEzfjVlCcXcSEDYTqpDcANXbpRzHf2uNdVVImdnQW25KqtsEH3ORSdvHpBAp8bCgx22sGiQi0ZYMeG",
+	"TDC29r9Mq8gwpbzi0oDPRG+PkuutwSq/sNeVKihlho6r/VNIxJJncckhTboq3lTMha3sVGoISge5gWzV",
+	"PEtFrvxSFSvlUHM6Y0fjoH6Z241UXAotphlQiye2BVoAaW2VNcd3weWBNAtNzZ8OaL4oZVpAahbaIlYr",
+	"Vgl19LypjFdTMFcAkh1RuydfsYdkttPiEh4hFt39PDp+8hUpXe0fR7ELwFXm2sRNUmInvzl2Eqdjslva",
+	"MZBxu1EPotkFbGnOfsa14TTZrkPOErV0vG77WVpyyecQ9xRZboHJ9qXdJEVaCy8ytXXltCnUmgkTnx8M",
+	"R/7U432O7M+CgebkpTBLZ9zRaon0VNcFspP64WyROns3VXD5j2Qjzb2JqPWI/LBKU3u/xVZNluxXfAlN",
+	"tI4Zt3lSMlF7L/hCE+zUp2GymUt9unaLG5wLl05iDm4h5UwW0tDDojSzyT9YsuAFT5D9HfSBO5l++TyS",
+	"V7WZM1nuBvgHx3sBGorLOOqLHrL3MoTri/74crIUyOof1dEewansNeZGpzV9tsPNQw8VynCUSS+5lQ1y",
+	"4wGnvhXhyQ0D3pIUq/XsRI87r+yDU2ZZxMmDl7hDv7z50UkZS1XEcivWx91JHAWYQsAlpL2bhGPeci+K",
+	"bNAu3Ab6j2t58CJnIJb5sxx7CGCJiOP3PfUTKk2681WPaAf6jil+QDKYuqHGrJmr/sPz0f14QcUtXV6x",
+	"3TVs4RePB/qjjYiPTC60gbUt366kh1CCWh1Rkkmr74GNnbNv1Goo4bROoSeeTwBFUZSUIkt/rSM/myuc",
+	"Flwmi6jNbIod/6gLXFaLs3dgjMSSBZcSsuhwVt78w8ulEcn5v9XQeZZCDmzbrs5il9taXA14E0wPlJ8Q",
+	"0StMhhOEWG0G1VVO29lcpYzmqXPy1ce1W9UnqL3wrxK0iQUo0QfrOGaozCdSMXViIFN6kR6w720N+wWw",
+	"RsIlegn6jBjNqOkyzxRPx5SpA60JzM5q+9gybbb0wJweQs1VtHRiQbrRYS7ItkNfeMTwcTb7a9taZJMq",
+	"wX0sABVb1Cn4RctOQE+kEDsH7GVQjTooeEaJWoolvuqq0ax8RDSB/zGGJwtsoBqstZ/kh9fM8FSpg5q+",
+	"7v9JRYn23CHcrmyGrZoxZgrf5ldC29LlcAnNmFcPhlc7+BjY5vKKUkpLKQc73HJVxs1d0e6Bo3ErU0IU",
+	"shbidxT6bcmZXUuInFGvGFF26pF0ivnaCMqqjthPvhwzl0qKhBJyxa5oV+N8iJ1tQO6ytiLXH3F3QiOH",
+	"K1oFpXLFc1jsrYsyHjUQ11X0B19xUy112D8NFdNecMPmYLTjbOiP7or5OF2jkBpcTlUkopBPqqJhuyQO",
+	"GTWHTyqzyY5kRKE3PY/H7/DbK6dawCPILoSkR4RDmxP8rDaQSjAbfHkIw+YKtFtPM/5Y/459DigUN4XV",
+	"uwNfspnGsKY/XLa1c3eHOvFWb2dlxrYvsK1LBFX93PBytpOe5LmbtL/UU1QewGRHfQiOWC8n3nwUILca",
+	"PxxtA7ltdFeh+xQJDVN7MW0gp3u4QxhV2aNWST0UWi1FUQtm3cRiSMmEjIDxo5BQFxSPXBBJ9EqgjaHz",
+	"2tNPJwU3yaLBhrYZucnCHWNo2jjzxm2Ham0woYTW6Ofo38a6YlMP46ga1IIbl+uqjjlSdyBMvEDXZ+8+",
+	"0K2/RFKVE6JSbuqwb1+RKcY4kHH7mm/NC6B7DLoyke1OOeF2vYn6AlGnZToHg0GOsRS339BXRl9ZWiJo",
+	"DPPSlVUq1DxnCFQ7EU2X2txEiZK6XG6Yyze45XRBibMINYRl1vwOI6Wh0gr/jeUB7d8Z5+ixs6uh9+pI",
+	"d8u+1HWdjEm9SNMTDH8ajgm6U26PjnrqmxF63X+vlJ6peROQD5x+YhOXC/coxt++xYsjzM7QSW5rr5Yq",
+	"eQI59ilfmJaejVXYb5Mr4bdutlsyKFWFLzcrIPpLWI7p8utx7w2SbnB7v1oLZZ+Tb9Lrk86Ni44znG1k",
+	"Qb0RR9ZDiL5bKOLa2T6vIOsUhJ87vW9UgNutdSNCvbtZF6AfvC8ry7lw5veaWXQx67zeu3EIQ/xh6w1u",
+	"L8L5kvdq7H647PP79snY6Hu7ctcFuJD5vIBLoUq3YZXnk38S2l8btbAqz/vo+ruKV5rq46pDe5W3566K",
+	"gl2me5P/8Kv1k2MgTbH+BFS5nU1vFXuL7H2rJpur1IaeXLaeRK1hqMrHD1XfngeGNESXn+NgR4V8o7p9",
+	"wnNTBgHwkUFvxAMamu93/ajcEEBhWwRn32kTBpYVbwoYQ3I+xtILOjG7UeRtS4m6zna+HCJZdfBxPR6d",
+	"pjvJHrEUlSM7SnQHogXk+jN41Vm7iFvlSou6pECsstxAb83zBbjQEkfY3bG8q9QlJIbqSNQuIAXALvnI",
+	"cLKgoPN9Jq/eKrXOqdUl8NqUtatbPGKLuNQJrAuCQ23i/YPhOapOKkc/uvIogfYcpKup3AyZGey4P5tB",
+	"YsTllkDG3xYggyC5sVdxESyzIK5RVI7glAdndwVuDVDGbwhPxvcHTl8Y0wWsH2jWoIZoJYCxl1pukgKF",
+	"MEDcAd37c6V51qeTd74NQleUQVjwjmu2O9TJ5HqLiAVhuTecy5Mk42Go7oYp41WMBs2FXXcKYCef5r5Y",
+	"x24RlP6n3EuqOaOrAp8+hUqo8EDdbTvR5JVLwUJhp5UZyidjAe1/8zHmdpZMXEBY5oyMfhhA71tEtVhe",
+	"QTbZcB91AhSZiAM9q2YWtZtxNyStu8fWmTzJFIoRkz6P/KZnb+UW80Bb/yVbMQAKB9cMClcOElvi2DAx",
+	"yrslb4JjEyq0rad9EyTo3nShFrjeJD5v6ixFlDaZU9Ie7nyzwgWyApYcoSuCXEL9c25C9gv73cdg+bS5",
+	"W5V1Fb1ur1PhHcyF7iAxpPoZc7fl9tium+jthJS2Lr+OJRaSUDQNS3mh0jJxz5jgYFS6zcFpuzawkqjK",
+	"K+musvVGCAJkL2B9aN+TvsCH38EQaCs5WdCDhBStTd6rJlPH4J7vBbyPqQQcj3KlskmP3ei0mw2pTfEX",
+	"AnMJMrwpvCNmT9El9pDMFZVjwNVi7bP/5DlISB8dMHYireu79xFopuNuTS4fmE3zr2jWtLQJypx+8uCt",
+	"jPsQU+qw4pbczA+zmYdpkOmtp7KDbJ7IrHoyMWFqv24JsoOhr/Ku1b5dFqomKgtFTCapKx5tcTmqvI3q",
+	"YjG1x1FXOsgydTUhKppUqdRibw5s12SSPnls3Q2xPYXAdYlrd4Gu2YKnLFFFAUnYIx4tYoFaqgImmSJP",
+	"ppiRdWZQHlqSi7hkmZozleMz12Yk9OaoaCWjYK59VW2ykc8Wgom1nfXklgDtIp0duLZxF94NhZN2L8p0",
+	"vojobWjD/G7tXHnJEdzOhUQCMAcQ+nad1Ul3Ye11tUuc9RUcNGopkji6Py/Hn153nRj1xlBhe7hYQmpG",
+	"BzzkKZWdl05PF80g0TEstl/u+Dl7F9E5/tcqiVvjshlw05k74GeRWNZNq44VC4vsajWVq2Xmw1N7KCTq",
+	"O7DZVG8LSE6HGuyr5N0DmUEAQL8JvwHDIEP+rmDMqCDrhEeQfFrJ/ONGvWzR4ng+saI92Qm3b37UN3GR",
+	"lQW4cEk6CO0STjk3Cy8DYPPuyxxfeaApltHWoeHa6pG8PsuVrWwLVyqfZHAJDc8GF8NZJgloDMwMS17a",
+	"ziwFyEm7235zxEz2IW9vCaJu7ZPA6DsEu1HJ1CLW7hTbInZGheSVnNhjooceJYToUqQlb+BP36L4X1/d",
+	"v8jl42F9N4xT7Mwk4ovbxCK2OtmUuu9cyriPTRhCXKmUaLa0Uj1bIqxPts75lex/gnWJspadhpfNDBD7",
+	"7QoSuoeaTiS3xwmjwZgW8+1rqAniNk/5XirbRGSdIqJRqU2DLwIdZvLxgq/rG5F2rdJR6MgAQte8gVxS",
+	"oXZ5DJqhxjwVsxkU1qyiDZcp6hqD5kKyBArDBb4x1/rmDwyEtsBwpm1vDOTUNKhnVrHXBmkILSDZ2j3e",
+	"+uT/AXI77kNMZrfXtlF99U07uxKPkeErfOeQs2APEbjofnrlUDOmJImYbMkvYMd5tPg3bJ6Gcu44LaxR",
+	"NOuQKa430vrPhDo68L9IYTZSuxX92t6b1iZkidHToJzXhmm7OV0azJP4ZHnT6bZdzMHvtVVQ2fmgJzml",
+	"450T4ql6g8kXdFB2KnEqu6440GHGFpixc0beSVpoqxuSLUwpyqJ7zkRTVlczok7aFHsxqSJkx+O2c1Dz",
+	"Cqq2nQqpJmVBQtQVX2/PcTcxcSi9X7Ud2T9nvI9DBbXbaktgJONa+Dsp5HYRTyI0HytP0U3etf/F2ICB",
+	"2g53d8txmvb4AsKi/pvprRbkPalEaI3LdezoeF3yDRbYJ50McHnd21ZVp+UuNijKom+W03UQaF33xwg2",
+	"g2LTm90owpTPdSx5Yb1oyezq30NtfvFT/U4aVjLad9gCXuhdU7erDB0OnI8clP1ThZRgKe/6KKGx/G0O",
+	"O26B9cMy2CInqxkDNgG/DeRr7kvgjaVfVE5OfTXa275QlN9ZSVtcuONDZcVHOlMh4Qi86y959uH9oCjx",
+	"9wnhA9I3/ZbT0JEmRLJFpb5ZROSPfNDcGb+DqbHs6CXI3wD3KHotuKHci7XD/En455nV8s986VAMnr6i",
+	"MWmn2ZMv2dRljMkLSIRuv4SvfFWvym+EilzaKTAccbOjyrZ1/qrMLch45hVL7FVdIYgU2XNZQ1gf0Y/M",
+	"VHpObpTKY9TXIYsI/jbzKBrnrKcKz1lVFyhRmS+K4KrwNLxZQ9bQ43DOkwRyygozueohYaui99QYRjXb",
+	"zkFSvXBCXr0GQh/lG5DeUmgdtZNZnLKrhdJQP6PJGi2V8chBlecC+KVAGIj9DBc+qv2gqdxmRm55VRaT",
+	"rWDiMe5WIbCR4s5avX0tG8KftjpCxqCWyjqnxR52J1UtryGg1+5LCLDN6m81F3b8fUNuNR/7R/edoNpy",
+	"9N4j9lt9tqrpQ8UQZUqEVQKueAN3Z8wapTG9x02yhxFQRV+109/q9JnUrlU1ggmbSvaYJTaXwUNBtaBV",
+	"sX40ZqnQF+whpaRyRpWUG44GiEdMFcydFVv93PuYrcE82j38obrSuWPBVfDDre67Tfq8oNpIvUUNsokW",
+	"f0MdyhYC8MEgkWGZVmzGixssxRYK6pvZ2smuGvPXB3bHqfo4SY0xZYu4VUJAnE9s8XX1ORwaBNzGcfvQ",
+	"jSN3XQs51QIa+7/5pg5vhqi1ubHgxnqbsjtNbesW0+PPwrRDhZGtTKmXBPyWPwhnvVXQYOWM7ObcjMPf",
+	"VHEBRZ+4Q/qGqiBt7dcVIu+KRvBZV8o8UUtS6dZNIvp7y7gmloo2Uq1rQgwvyPR7QSgTcmL5nuWEfV7g",
+	"lJxkaCSXm8MGKt3gIKI8Hfq5b5oY23YSu7Xelah5yp0wxcl8llzUrPbGYDq8TghxkwzkPBZIc6ZmxlqF",
+	"uhn62ztjd2JDdc8byNNkAenI0gERUJYUVVwgye3gchoX97dmoQgpqXenx23q7kF2hZXYCQ1LSWxRX100",
+	"An1rLXOgYVMF7DngN0jdsWPAb7dIxtDl0TqILEoN3XUO3vwGbiMSY722odHqXeRuKms5JMg8Xq0Wu1OU",
+	"u0UINjpgBCr788mfrIAZHkij2OPHNMHjx2PX9M+nzc/ICB4/jp7VDxbfbnHkxnDzxijm176MZzarV09y",
+	"vdZ+YB6+bYTRSJWI8QEgQQtNyQD/cAlZP6xuz0NgA8W6R9XCepvoVouYyFobkwdTBUkQB+Q/dN0i2Q7J",
+	"CTspC2HWVCfGW+DEH9FI/O+rUEQXylq5FDhdnFEXUFUaqgMXS+21fd8rnpF+zHo6SGAG6xCzb1d8mWfg",
+	"DsrXD6b/Ac/+8Tw9evbkP6b/OPriKIHnX3x1dMS/es6ffPXsCTz9xxfPj+DJ7Muvpk/Tp8+fTp8/ff7l",
+	"F18lz54/mT7/8qv/eDAajwSCbAEd+azko/87wZLMk5PXp5NzBLbGCc8FRnteX5Opa6ZIREKkJnQSYclF",
+	"Njr2P/0//oQdJGpZD+9/Hbmkx6OFMbk+Pjy8uro6CLsczilSaWJUmSwO/TzX4xbGT16fVi6R9ulLO2rz",
+	"BXrnMk8KJ/Ttzbdn5+zk9elBTTCj49HRwdHBExxf5SB5LkbHo2f0E52eBe37oSO20fH76/HocAE8Mwv3",
+	"xxJMIRL/qQCert3/9RWfo/hDXq/2p8unh/6Fc/jeCcPXOEPUBcOmygzyI7q+QUFHF/1JlmSbCrNR9Vw7",
+	"cWVc1cJ3vm4ypQyGNghKj8ajCnGnaa3hOa2Zli99Y2sBHv8eiaL3DrO+IouNd3DJXuzBYkKz/3P28yum",
+	"CubMLa+xEoh3FkYHHipjUKhLQYnx0iCbIvY88PT7rxKKdU1fFtBRWOfOlzZ3XsdLPc+bublqLW/MaNvB",
+	"tZ8ZyaKeuH7S1IyLvHoCSGo2jKz1aPLVu/df/ON6NAAQCvbVYHD5f/Is+5NdiSxDlQ+6t/k6Qq5OxDhS",
+	"xpskwHEdr0cd6p0ck0G5+hp0r9s0U1r+KZWEP/u2wQEW3QeeZdhQSRi922Hp4xhhV5UZvWpiTmfFCuAF",
+	"d/THZVfPzEim1kxlaaMNPVUar7ZMqQuqU+M2liS6Illg1XGnJsQ3+bfk8+1OzD+FNtiX6nScDNsdOpON",
+	"DbL+bkFza1Z0yE2tN1B4vOjxRdC4Nl/zLOvboyrTZbVDHbXKu/HID00M8OnRkef6zsYTAHfoGNzQEpM+",
+	"pe71uDGKP583GKh7O9hPb6pUUwXPLf24LzZkyHnd2EYHeAk83+NCmwmxbr3c9nCdRX/DU1a4UClaypPP",
+	"dimnkpIf4G3NrDRyPR598RnvzanEC4BnjFoGFYm6t/4v8kKqK+lboiRaLpe8WJOcaYKq6s103XyuydWN",
+	"7ivLaAMzh5yP3l33iiCHIa85fB/8NRHprQSUNidjpy+3yCwP9EZG2ajn+fAkz+tivvT9JM9tgTPyGwRB",
+	"VwGshDb60QH7PuxNVynZImzxibKQkPrwdy+CVPW+fBWxGrYHOqwcEpWgAl+ie2HqYwtTJ03NU6NmZAyY",
+	"xinYCNPeL9Bu2EQQJ79Dsvn6cFS19DA0Kc9vUG97bwVABlty3sXe5VsZ9T3uenDXJyYF8FYSU1195MOw",
+	"Zu+gUt0kjSvjDhn3Zy70/cQzpJNgua0M8acv74XBv5UwWKVlsk9iXzv8duIhvUoP3/viuHsQCV1x4AHC",
+	"YPiKDvoGUVsPW+zkkX97B21uxjNcHqatYh6VLL4X8D4BAa9bDjwGRl3k+eMJdQTDoq4XvrU0ua/0HUoj",
+	"vg774Lrmn6kU9zdGVq/YhpBuF9huwD47wphj1nfGVv+SQphD2r349bcWv6rsiLcSwBoF/V2+zcCmeCvt",
+	"XVs7J0wliYWfGpyN0isgQ3FHeFxHjpI1g0IvXdClHvuXIX5yj0a7WePOu7ErYn0P4QP1m/Xpy23S1Wek",
+	"5xlcMzByC8T35q55adTs8ObDmB2G8abnR88/HAThLrxShn1Ht/gdc8g7ZWlxstqVhW3iSIdTtdrGlWSL",
+	"LRGjqCshBzyqSg08Dr5ja+sy85C8NJtVJh4dMF+f2cVQTMG71s4Vz+rofF7MbSfkdYgM9sD/eUzjPzhg",
+	"31E2A6PH5PmHY9iGQprjJ0+fPXdNMKUiOZW1202/fH588vXXrlldjdu+czrNtSmOF5BlynVwd0R3XPxw",
+	"/H//878ODg4ebGWravXN+pUt5/Cp8NZxLItaRQB9u/WZb1Lste7LbGxDXfUwvstbCaudx24Btbq/hT7a",
+	"LYTY/0vcPtMmGbmHaKXJbGRb3+NtBHrX+8h7JVEcfnWZHLBXygUXlhkvmCpSsGmKNZuXvODSACruHKVS",
+	"AjptE/0nmQBpmCqYhoKil0QKdR7KKv0VlpTChnZ6HLsJwXZGD/pTZvI/8VUQxjGtrmmj3JJJ7bnkKya0",
+	"iyg1Y4od5Cv29dfsqPYXQ8RM1WpSISbGXJd8NfqAWr+K2AYFA3yjVi8ddtT2LEd27CEapFr6qfLpheXJ",
+	"/96c+7OV3C25u43dE+fc2fBTG3ZCPQL9uEWDYAU7CrxkuszzbF1n5uRZLULFWRzOMFQ58AnbCLaqpqOP",
+	"0DZ67w/xvRLgVqykTVA7sg1KSaQP39O7POQZnXNLKVX+XubSwHZUqKU3Hik2A4OaCkRIG/UR9uS9uPt5",
+	"01JIzGw5Oj4a37lUQ7vYzVobVvdLuc2hNqSARJBohwx4UESI+GdfOhg/o52KG6hS0p+7omgu1YRznHcl",
+	"tezj2xbZc8EVPukT7uJOUL6oJ+8KZJlq0MTN7Z/3CN4NwR3m+K1lAu54uUX8FTz+/VNywl6pOqeYfUH9",
+	"JU2Pd3mz3/WCXikJ1saOkq+lxXtzaiV2IOOwSPHJJIOwrluJIIcYObxVDvknNtoiiwy5vXGyz/IK/6fD",
+	"0oZbBtd2sDVTXj3aEOaMDW0W+2Zt4Y/4ivko/PQTfNp8DI71YVgMHVLPZ+xPSu6X6VB+VkvMh1VZ2T4O",
+	"FK/UPZgbGVW5oUWLa08hU3KuP01WtLFmehQvESqpapjHC5X//c7uC0r9WqfSc1mKtJAJMK2WNvkeE9rn",
+	"3bMQ/uPDQWjE0tdmlGHs6kfmLl8cPftw059BcSkSYOewzFXBC5Gt2S+SX3KRUTGxW3A7XSWEDLXB0cr7",
+	"ZG1qJvdKwgy3N2eCDde192aFJretzDBISr8jHxQy4IPB3KgEB17cnAFuN121Cw6evgy9gxvVwav0kxFQ",
+	"EEU7Osj/r9FAvRM2QhZpL79SWkB9amjHJpzrrpqNK+cYJbHbMXsrHzO94F88efrH0y++9H8+/eLLHs0Z",
+	"zuMyKHV1Z/VA+NkOM0SB9lmrA/crtVf4Pf7Qu73bJo5HIl1F6wfDKigO0yyA58SyB5rlfN1bZLynXn8l",
+	"DYTDLgHFeL0Q+YfPhK+NmC6i7yv//KkKa57Kb6pXsE3XjsJ3/jEyoI9HpgBIITeLjQnncLeoVb2b4HI/",
+	"C+3qCdm8q2MmDuCgla4RUirljS9qzjLgs6pgslJDgicCPoOE5qkiwHq4kCFv0ij9UMIQIsoP/zitgwzs",
+	"ReeRV7TunI8q6JqP9Uid0BsVpBdsmmj5eDIlYMtxYO7OC2VUojLru1LmuSpMdbr1wSBxD/rMdg1pr49w",
+	"dxLmEm6SRZkfvqf/ULq16zrwgArj6EOzkodU/e3w/UYXAQLRZlqyNXUacmm0vGr3mUzd6/o936miUy95",
+	"mwtA68SM24eIZmenL/3935TP7kY6+1sLNRvf/60Nv71KOzJi5wD7wx1Wb6toN6gK1SysESHhexPMp7Wg",
+	"WikyEzJlPNjG1ttNFTUjuGPFyF0v+mPoWT683emLz/icodvQKWZ6XYI0kN7Oe4e1OZy/PTZet7sJBu7q",
+	"77r4dO/88Mb3jomVdn3rBb+DQS4IxQY/HS/wvxrv6rvRfd/f5J/2Tf7C539ukOH9vfz53MuFd6e8v4I/",
+	"/Sv42We7mjs0xAy8kv1NdONruH6J73ghd4QBV5i3ZQrfZKehp3d7lfo7Vfjah/e3+GdqZLA7OThoaYiG",
+	"Zlsok5tyH66znxT0w/QMWNq3o2noO6iuNJhZgKCkMyoRlEn8NNVje4idcsKd4nvB55MWfIK9vpd77lUP",
+	"n5nqoUfKca/+LBsiaOwqAF0uVQre64Snl1wm4AQiW1wlVzpWWrMQxmVLs5MHUcc0ljdBOlZMjmVU9qEo",
+	"JfFpIVkKlwynt0wYqdzeA/iZy3VjubYoqy6nS2GwHigL7hXNXFInb/kOO+ZKZSQBVWEWrpEtml6ZdVqp",
+	"KS0mbLmLIeJXvX6LOwTYoXPMuGFLpQ17cnR0dLRJBNOfikPzwJKKt60TNjxtX+yqb5cnYXxmoHBoxwZu",
+	"KwJSu4/t/Bx9j91p1CFHma6j3MdueVWoJuGySrDojmMa0sPBrfgl0nxxybNN70ULtG+JrMDXAu1jh+zK",
+	"Mlc7CVOSKteqKxl9RJKzy6kHZK9MIFxeLKihWpSQTEOiJArNRzaMzQGPjJfwj2sylNIv5M62Qc3Vt/uq",
+	"VDDdhmlM+8C/Zw+fI3t4EyQza27tAWtSapMh2DDtPfOCw/f+fxvEp7MOX3joKPDRLhzCeaWMKyWLKugt",
+	"1DhhV9A4Yuw1aVOsjFXPr2bsiGmjXKUnXhqF4VeJQ2heqLSkAcdMK+ua1nfEbRjrxkPe5GJnXS62Vd7q",
+	"PcI94pUIOeQ+BaxW1O8P9yzks4xu8omqondaddwkXDnKg0so1gzf581zDBnPXZqVm/MSNZu5ZNubpQpf",
+	"kBXB0IYvc2Z79gsK52IJZ9jyZzvFXuWFGuzWeW2B1zqtW258N+pt7nvTD8AHP67VDnhYXBqug71cfx1K",
+	"YG3k3+EVaCc4fG//HXL9dTamvgZnqvA3TAhg8/pyvejyomTqpdQUpyhcBW5KgmTwpKoqd2QBPGNJI1K6",
+	"gqP/cuqenK13VGd1PWuK31iqPqF/qfvqBZeO5LsIMoqY7JwbcQk+fvjg/lq75bW2iQGOGU9TexrrTbC3",
+	"my6nGgUL2Qx4e6Cb5+XmDKMa5vB99d9d2Qb9IGHlYfRS6HS9QYDGDCzhMLST4JSWXrQlJmmzIRt+AZQ9",
+	"MoEUZAJMXUIRZ7gxBvIKVi0msiv7aK1yHGygC0nFBqUUKwa5ShYH7NSwxJ60KbApzFQBocLMrrCxj7Fo",
+	"Ov/9Xmy+5y8b+AsRXk2vIXcpNWgmDCl+thxdH2x+I5aiJc/1QhkdBli0Ph2+R7q+3t7isEAOaOqGsMqh",
+	"EEuQhmf1r1YVeWjzLW6KyD2zLW4pbbe4IY3JimYYmX8SWJgQyT+JpFBYxF97y4deawPL0bglvruuf/To",
+	"/r0nSjeIUMlMSJgslYR15GzT15/oY6w35azs63yOH/v6th4KTfhbYDXnGfKYuC1+PxGx5VbJPlqrLSBX",
+	"hYHUK98t/e94YP2hWcuke5LWMgm8ot3HYCAle34+fN/402VbdS31ojSpugr6kmuIjRqj/5c6/m1AEkay",
+	"1+4YZ1+7aTWzBgh9t45ad+mgHOAhdpqqr5G68vXH/tLyf9PkI86fNyQSklATlEB1y/Z/n4HkL5WBZPC+",
+	"78R/K3a3kaOVer/SyiuVgh3XK+e8AaRb/ItebdoD0RJSqkjauHeCv7Hqdq04+oSXmMGlzJlRsYj9uuOE",
+	"J5bJTuxzNT5h1/EFp1vwS2A8K4CnqGcDydQUF13fnbRIrqnggZeFXbxwVEwK4MoLlYDWWJTRFTvbBppv",
+	"Z5MEmA14IsAJ4GoWphWb8eLWwF5cboXzAtYT0ttp9vCHX/WjjwCvFRM3I5baxNDb9jHqQj1s+k0E1548",
+	"JDteAPOiAWUpUeiaZqAHmN1w0rt/bYg6u3h7tFAiD3HHFO8nuR0BVaDeMb3fFtoyn+D93QXxhf2Kuirc",
+	"MMml8saS2GAZ12ayjS1jo3AtGqyKznPCGCemgXseoz9ybd64lFUp3kHeOYhXzmA4RT/AeIva10Rk5F/t",
+	"x9jYiZIapC41cyP4NBSQxtaA2o3+uVAv6OdSs2DsKs+FNVtsG7kPS8H4DllBxbfa0aBSw3QXR0YV7pQX",
+	"XVQ2gKgRsQmQM98qwG4YI9IDiNA1oi3hCN2inKlSGXBp0wWpPEduYSalrPr1oenMtj4xv9Rtu8TFTX1v",
+	"pwp0mIPEQX7lPQ65TNmCa+bgYEt+4dKUzF0F7y7MeBgnpMudbKJ8UiFjq/AIbD2kZT4veAqTFDIeUbP8",
+	"Yj8z+3nTALTjnjwnl8rAxCqX45teU3LRqz6qhlY0XoRpvlKMvrAEjyA+nmsCcb23jJwCjR1jTo6OHlRD",
+	"0VzRLfLj0bLtVveorHCMwAOVQHYcfQjAPXiohr45KqjzpFYftKf4T9BuAt/mBpOsQfctoR5/pwW0VX3h",
+	"Bda4KVrsvcWBo2yzl41t4SN9RzamXPwsLQztwLg7TC3cVK4GD8CDmzxuD6+4MOh8bgXpCfmFb8228BsX",
+	"PtbCm8eUS3zpPMtpAObGISYf1lF1XMSCwNx1gSTStQniVN+pYlD9lmaWYi4MK6URWVDDrnoqf3oKw3sl",
+	"wL0S4F4JcK8EuFcC3CsB7pUA90qAeyXAvRLgXglwrwT4+yoBPlZFpomXOHyeeqnkpO1qze5dIf9SFUyq",
+	"u8orJUiNgUoE5EtBMkn35XYFnAzwjHAgMuj34rY+o+ffnvzItCqLBFiCEArJ8owLyQysjC9Dz6Zcw5fP",
+	"fUYoe3fyJcPc/faCxQbPnrKzf574QgsLVxCg2fbhia2AzbRZZ/DIleAEmVpR1NfiBIlId6U4ub8TEpfO",
+	"ymooZiKjwBnNvqXWL+ESMpVDYXO4M1OUEZXPOfDshcPNFo3Pbzi588T/E0f7c9xQNDm0LXnlROvXyjXj",
+	"NjEWexmkyvpzxjMNf/Zly7LjLXk+ipRsqW4+qwsibvKNStetE4K7dkgb2DwbdbkFIXmxjiTz7kZItUnD",
+	"5v5whNVVZl3vvShIl2i7ZLaNwmLiegE6eo43UXlsnHrDOkPZfGqzFp2MYqnA2iUgRhWAQ9xjzymKyu4J",
+	"e2P7fdySgwSRO2I1M/9kPAebLSumQW2lMp71fK6hAB7x0dNLZ3/sosiB8lk4ihtwvWBGGRxpDnLiGNBk",
+	"qtL1pMG+Ro1bKBWaaw3L6fabKOSfdOKqy8csIstp3FMf5xp5GSxuE08OiWY1cQy4hzuvDQzmzRW2aETH",
+	"ngOM3zWL7mOjIQjM8aeYVqnF+3ZlevU063vGd8/4gtPYkgiEdEnQ2kzk4A4ZX7EuStnP875dQVIicOFJ",
+	"fkjqebLJobomNGymMC3ncxs+2TbS4dKAxhNKfiRWaJc7lAvuRkF28CpI8La5BNvDdblLkLbuoS+g8Yi2",
+	"g8s1WTOWOZdrb/NFtcOyzCwOU274wWi/jNaWSup6AoxHXqPXr9Z+7VqEylt31TZ/t2hhV1wzu7+QslL6",
+	"QMD2xGYlh6ejtUOfr2TNpjemnrXrjazOzTvkivC73MxEoVkOxcSspD1QjcPkCrfZk3twHwj797g2bB4L",
+	"6GGw3SJkNUPY0+1RBHyNro96siAYLvz1kLQW/aEjYd1Z23Kv3iOd4ZtOJLVKxRlJIcsZZ0kmyISqpDZF",
+	"mZi30qbxCRZ20HUw8drofv72wjeJ2wkjZjw31FuJnG7GKtNNlM/NIGKn+A7As1FdzuegkVeGRDIDeCtd",
+	"KyFZKSlz4IwtRVKoiQ1SzSl5ooED23LJ12xGyWUV+zcUik3LVgIxUhhrg0ZA69GC0zA1eyu5YRlwbdhP",
+	"ArksDuczqlSuXGCuVHFRYSFehnQOErTQk7jy5Xv7lSp9uuV7JR/+33WuU7l+2BKfHnaR9kJ++hLh5lQY",
+	"KxPa1E4QHdg/mAF8KeQkSmRoqXc+YW3aYg8pHb8joEdN65BZwFuJN5xRjLg6Nzcjh7aZp3MW7eloUU1j",
+	"I1rWIL/WQU+8vXAZFmEy96aVv1BoZkAH3nxJG29LHbb2fkczSuPKtZmt+y5k+9VVhu9p5B4JDUVYK0WN",
+	"a3HeAHmjjeLzr/Cx//eiR+PeXozdAa/HMde78LY2ivkNHzOeKTm3JS7wBalon4TMS0OO1XeppINLnk0w",
+	"WLkQKeiBKxVKfnvJs5+rbtfjEWoYJqbgCUys1mAo1s6xj6XTbRdp7WMtlktIBTeQrV2+J5tEUGhWP7YP",
+	"bMYCliy4nNOdW6hyvrDN7DiUddUXi8f3bXuI6KVsVnJiE/t3YTxhVlEZ1j4CnizC7Xc1N+lmuuLVfC6d",
+	"xJAnc4QVUNmWvhf0eNQrISNSL2vHNoucJn8YcP03LvIAP/XE+6hzc0+t99T60ag1lseUUDdr6QAsvsJt",
+	"uWNl0V1XT/mAuqePUlrpvj7hX70+oedAmnFW8IbUHy+Mzykb3hUl+JkCw4unJJ23ks6BmF7INkNloN+3",
+	"6W21y3yeLLiQLjtMFS7gUjsmamkzre/kxLWbutAyM9ITIjogKQth1vRO4Ln44wLw/+9Q0NZQXPonRFlk",
+	"o+PRwpj8+PAwUwnPFkqbw9H1OPymWx/fVfC/99J/XohLbmB0/e76/x8AfVHyjumjAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -36,6 +36,9 @@ type ServerInterface interface {
 	// Append state proof keys to a participation key
 	// (POST /v2/participation/{participation-id})
 	AppendKeys(ctx echo.Context, participationId string) error
+	// Get the status of the state proof worker.
+	// (GET /v2/stateproofs/status)
+	GetStateProofWorkerStatus(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -119,6 +122,17 @@ func (w *ServerInterfaceWrapper) AppendKeys(ctx echo.Context) error {
 	return err
 }
 
+// GetStateProofWorkerStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStateProofWorkerStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetStateProofWorkerStatus(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -152,194 +166,211 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
 	router.GET(baseURL+"/v2/participation/:participation-id", wrapper.GetParticipationKeyByID, m...)
 	router.POST(baseURL+"/v2/participation/:participation-id", wrapper.AppendKeys, m...)
+	router.GET(baseURL+"/v2/stateproofs/status", wrapper.GetStateProofWorkerStatus, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5PbNrIo/lVQOqfKiX/ijF/Jbly1dX4TO8nOjZO47En2nmP7ZiGyJWGHArgAOCPF",
-	"19/9FroBEiRBiZqZ2LtV+cseEY9Go9HoF7rfz3K1qZQEac3s6ftZxTXfgAWNf/E8V7W0mSjcXwWYXIvK",
-	"CiVnT8M3ZqwWcjWbz4T7teJ2PZvPJN9A28b1n880/LMWGorZU6trmM9MvoYNdwPbXeVaNyNts5XK/BBn",
-	"NMT589mHPR94UWgwZgjlT7LcMSHzsi6AWc2l4bn7ZNi1sGtm18Iw35kJyZQEppbMrjuN2VJAWZiTsMh/",
-	"1qB30Sr95ONL+tCCmGlVwhDOZ2qzEBICVNAA1WwIs4oVsMRGa26Zm8HBGhpaxQxwna/ZUukDoBIQMbwg",
-	"683s6ZuZAVmAxt3KQVzhf5ca4DfILNcrsLN389TilhZ0ZsUmsbRzj30Npi6tYdgW17gSVyCZ63XCfqiN",
-	"ZQtgXLJX3z5jjx8//sotZMOthcIT2eiq2tnjNVH32dNZwS2Ez0Na4+VKaS6LrGn/6ttnOP9rv8Cprbgx",
-	"kD4sZ+4LO38+toDQMUFCQlpY4T50qN/1SByK9ucFLJWGiXtCje90U+L5P+mu5Nzm60oJaRP7wvAro89J",
-	"HhZ138fDGgA67SuHKe0GffMg++rd+4fzhw8+/Mebs+x//J9fPP4wcfnPmnEPYCDZMK+1BpnvspUGjqdl",
-	"zeUQH688PZi1qsuCrfkVbj7fIKv3fZnrS6zzipe1oxORa3VWrpRh3JNRAUtel5aFiVktS8em3Gie2pkw",
-	"rNLqShRQzB33vV6LfM1ybmgIbMeuRVk6GqwNFGO0ll7dnsP0IUaJg+tG+MAF/esio13XAUzAFrlBlpfK",
-	"QGbVgesp3DhcFiy+UNq7yhx3WbGLNTCc3H2gyxZxJx1Nl+WOWdzXgnHDOAtX05yJJdupml3j5pTiEvv7",
-	"1TisbZhDGm5O5x51h3cMfQNkJJC3UKoELhF54dwNUSaXYlVrMOx6DXbt7zwNplLSAFOLf0Bu3bb/r9c/",
-	"/ciUZj+AMXwFL3l+yUDmqoDihJ0vmVQ2Ig1PS4hD13NsHR6u1CX/D6McTWzMquL5ZfpGL8VGJFb1A9+K",
-	"Tb1hst4sQLstDVeIVUyDrbUcA4hGPECKG74dTnqha5nj/rfTdmQ5R23CVCXfIcI2fPuXB3MPjmG8LFkF",
-	"shByxexWjspxbu7D4GVa1bKYIOZYt6fRxWoqyMVSQMGaUfZA4qc5BI+Qx8HTCl8ROGGQUXCaWQ6AI2Gb",
-	"oBl3ut0XVvEVRCRzwn72zA2/WnUJsiF0ttjhp0rDlVC1aTqNwIhT75fApbKQVRqWIkFjrz06HIOhNp4D",
-	"b7wMlCtpuZBQOOaMQCsLxKxGYYom3K/vDG/xBTfw5ZOxO779OnH3l6q/63t3fNJuY6OMjmTi6nRf/YFN",
-	"S1ad/hP0w3huI1YZ/TzYSLG6cLfNUpR4E/3D7V9AQ22QCXQQEe4mI1aS21rD07fyvvuLZey15bLgunC/",
-	"bOinH+rSitdi5X4q6acXaiXy12I1gswG1qTChd029I8bL82O7TapV7xQ6rKu4gXlHcV1sWPnz8c2mcY8",
-	"ljDPGm03VjwutkEZObaH3TYbOQLkKO4q7hpewk6Dg5bnS/xnu0R64kv9m/unqkrX21bLFGodHfsrGc0H",
-	"3qxwVlWlyLlD4iv/2X11TABIkeBti1O8UJ++j0CstKpAW0GD8qrKSpXzMjOWWxzpPzUsZ09n/3Ha2l9O",
-	"qbs5jSZ/4Xq9xk5OZCUxKONVdcQYL53oY/YwC8eg8ROyCWJ7KDQJSZvoSEk4FlzCFZf2pFVZOvygOcBv",
-	"/EwtvknaIXz3VLBRhDNquABDEjA1vGdYhHqGaGWIVhRIV6VaND98dlZVLQbx+1lVET5QegSBghlshbHm",
-	"c1w+b09SPM/58xP2XTw2iuJKljt3OZCo4e6Gpb+1/C3W2Jb8GtoR7xmG26n0iduagAYn5t8FxaFasVal",
-	"k3oO0opr/FffNiYz9/ukzv8eJBbjdpy4UNHymCMdB3+JlJvPepQzJBxv7jlhZ/2+NyMbN0qaYG5EK3v3",
-	"k8bdg8cGhdeaVwSg/0J3qZCopFEjgvWW3HQio0vCHJ3hiNYQqhuftYPnIQkJkkIPhq9LlV/+lZv1HZz5",
-	"RRhrePxwGrYGXoBma27WJ7OUlBEfr3a0KUfMNUQFny2iqU6aJd7V8g4sreCWR0vz8KbFEkI99kOmBzqh",
-	"u/yE/+Elc5/d2Xasn4Y9YRfIwAwdZ+9kKJy2TwoCzeQaoBVCsQ0p+Mxp3UdB+aydPL1Pk/boG7Ip+B3y",
-	"i8AdUts7PwZfq20Khq/VdnAE1BbMXdCHGwfFSAsbMwG+5x4yhfvv0ce15rshknHsKUh2C3Siq8HTIOMb",
-	"383SGmfPFkrfjPv02IpkrcmZcTdqxHznPSRh07rKPCkmzFbUoDdQ6+XbzzT6w6cw1sHCa8t/BywYN+pd",
-	"YKE70F1jQW0qUcIdkP46yfQX3MDjR+z1X8++ePjo10dffOlIstJqpfmGLXYWDPvM62bM2F0Jnw9XhtpR",
-	"Xdr06F8+CYbK7ripcYyqdQ4bXg2HIgMoiUDUjLl2Q6x10YyrbgCccjgvwHFyQjsj274D7bkwTsLaLO5k",
-	"M8YQVrSzFMxDUsBBYjp2ee00u3iJeqfru1BlQWulE/Y1PGJW5arMrkAboRLelJe+BfMtgnhb9X8naNk1",
-	"N8zNjabfWqJAkaAsu5XT+T4NfbGVLW72cn5ab2J1ft4p+9JFfrAkGlaBzuxWsgIW9aqjCS212jDOCuyI",
-	"d/R3YFEUuBAbeG35pvppubwbVVHhQAmVTWzAuJkYtXByvYFcSYqEOKCd+VGnoKePmGCis+MAeIy83skc",
-	"7Yx3cWzHFdeNkOj0MDuZR1qsg7GEYtUhy9trq2PooKnumQQ4Dh0v8DMaOp5Dafm3Sl+0lsDvtKqrOxfy",
-	"+nNOXQ73i/GmlML1DTq0kKuyG32zcrCfpNb4SRb0LBxfvwaEHinyhVitbaRWvNRKLe8extQsKUDxAyll",
-	"peszVM1+VIVjJrY2dyCCtYO1HM7RbczX+ELVlnEmVQG4+bVJC2cj8RroKEb/to3lPbsmPWsBjrpyXrvV",
-	"1hVD7+3gvmg7ZjynE5ohasyI76pxOlIrmo5iAUoNvNixBYBkauEdRN51hYvk6Hq2QbzxomGCX3TgqrTK",
-	"wRgoMm+YOghaaEdXh92DJwQcAW5mYUaxJde3Bvby6iCcl7DLMFDCsM++/8V8/gngtcry8gBisU0KvY2a",
-	"772AQ6inTb+P4PqTx2THNbBwrzCrUJotwcIYCo/Cyej+9SEa7OLt0XIFGv1xvyvFh0luR0ANqL8zvd8W",
-	"2roaCf/z6q2T8NyGSS5VEKxSg5Xc2OwQW3aNOjq4W0HECVOcGAceEbxecGPJhyxkgaYvuk5wHhLC3BTj",
-	"AI+qIW7kX4IGMhw7d/egNLVp1BFTV5XSForUGiRs98z1I2ybudQyGrvReaxitYFDI49hKRrfI4tWQgji",
-	"tnG1+CCL4eLQIeHu+V0SlR0gWkTsA+R1aBVhNw6BGgFEmBbRRDjC9Cinibuaz4xVVeW4hc1q2fQbQ9Nr",
-	"an1mf27bDomL2/beLhQYjLzy7T3k14RZCn5bc8M8HGzDL53sgWYQcnYPYXaHMTNC5pDto3xU8Vyr+Agc",
-	"PKR1tdK8gKyAku+Gg/5Mnxl93jcA7nir7ioLGUUxpTe9peQQNLJnaIXjmZTwyPALy90RdKpASyC+94GR",
-	"C8CxU8zJ09G9ZiicK7lFYTxcNm11YkS8Da+UdTvu6QFB9hx9CsAjeGiGvjkqsHPW6p79Kf4bjJ+gkSOO",
-	"n2QHZmwJ7fhHLWDEhuoDxKPz0mPvPQ6cZJujbOwAHxk7siMG3ZdcW5GLCnWd72F356pff4Kkm5EVYLko",
-	"oWDRB1IDq7g/o/ib/pg3UwUn2d6G4A+Mb4nllMKgyNMF/hJ2qHO/pMDOyNRxF7psYlR3P3HJENAQLuZE",
-	"8LgJbHluy50T1OwaduwaNDBTLzbCWgrY7qq6VlVZPEDSr7FnRu/Eo6DIsANTvIqvcahoecOtmM9IJ9gP",
-	"30VPMeigw+sClVLlBAvZABlJCCbFe7BKuV0XPnY8RA8HSuoA6Zk2enCb6/+e6aAZV8D+W9Us5xJVrtpC",
-	"I9MojYICCpBuBieCNXP6yI4WQ1DCBkiTxC/37/cXfv++33Nh2BKuw4ML17CPjvv30Y7zUhnbOVx3YA91",
-	"x+08cX2gw8ddfF4L6fOUw5EFfuQpO/myN3jjJXJnyhhPuG75t2YAvZO5nbL2mEamRVXguJN8OdHQqXXj",
-	"vr8Wm7rk9i68VnDFy0xdgdaigIOc3E8slPzmipc/Nd3wMQnkjkZzyHJ8AjFxLLhwfejVxCHdsI0mE5sN",
-	"FIJbKHes0pADRfk7kc80MJ4wiv/L11yuUNLXql75ADQaBzl1bcimoms5GCIpDdmtzNA6neLcPug4PPRw",
-	"chBwp4v1TdukeVzzZj7/tmfKlRohr2/qT3q35rNRVdUh9apVVQk53dcqE7h4R1CL8NNOPNEHgqhzQssQ",
-	"X/G2uFPgNvf3sbW3Q6egHE4chcS1H8ei4pyeXO7uQFqhgZiGSoPBuyW2Lxn6qpbxyzR/+ZidsbAZmuCp",
-	"668jx+/VqKKnZCkkZBslYZd8jC0k/IAfk8cJ77eRzihpjPXtKw8d+HtgdeeZQo23xS/udv+E9l1N5lul",
-	"78qXSQNOlssnuA4P+sn9lDd1cPKyTPgE/buVPgMw8+advNCMG6NygcLWeWHmdNC8G9E/cumi/2UTjXsH",
-	"Z68/bs/5FT+JROMulBXjLC8Fmn6VNFbXuX0rORqXoqUmopaCFj1ubnwWmqTtmwnzox/qreQYsdaYnJKR",
-	"FktI2Fe+BQhWR1OvVmBsT0lZAryVvpWQrJbC4lwbd1wyOi8VaAwdOqGWG75jS0cTVrHfQCu2qG1XbMdn",
-	"WcaKsvSeODcNU8u3kltWAjeW/SDkxRaHC976cGQl2GulLxsspG/3FUgwwmTp6Krv6CsGvvrlr30QLD6j",
-	"p8/ku3Hjt2+3dmh7ap+G/5/P/uvpm7Psf3j224Psq//v9N37Jx8+vz/48dGHv/zl/3Z/evzhL5//13+m",
-	"dirAnno05CE/f+5V2vPnqLe0zpsB7B/NcL8RMksSWRyG0aMt9hk+kPUE9HnXqmXX8FbarXSEdMVLUTje",
-	"chNy6N8wg7NIp6NHNZ2N6FmxwlqP1AZuwWVYgsn0WOONpahhQGL6eR56E/2LOzwvy1rSVgbpm16fhMAw",
-	"tZw3TzApO8tThu/z1jxENfo/H33x5Wzevqtrvs/mM//1XYKSRbFNvZ4sYJtS8vwBwYNxz7CK7wzYNPdA",
-	"2JMxcBSUEQ+7gc0CtFmL6uNzCmPFIs3hQky/NxZt5bmkYHt3ftA3ufMuD7X8+HBbDVBAZdeprA0dQQ1b",
-	"tbsJ0IsXqbS6Ajln4gRO+saawumLPhqvBL7E7AGofaop2lBzDojQAlVEWI8XMskikqIfFHk8t/4wn/nL",
-	"39y5OuQHTsHVn7NxRIa/rWL3vvvmgp16hmnu0UNeGjp6eplQpf3rok4kkeNmlKuGhLy38q18Dkshhfv+",
-	"9K0suOWnC25Ebk5rA/prXnKZw8lKsafhwdJzbvlbOZC0RtNJRU/FWFUvSpGzy1ghacmTUoQMR3j79g0v",
-	"V+rt23eDoIqh+uCnSvIXmiBzgrCqbeYTHGQarrlOOa1M88AdR6YMJvtmJSFb1WTZDAkU/PhpnseryvQf",
-	"ug6XX1WlW35EhsY/43RbxoxVOsgiTkAhaHB/f1T+YtD8OthVagOG/X3DqzdC2ncse1s/ePAYWOfl59/9",
-	"le9oclfBZOvK6EPcvlEFF05qJWyt5lnFVynf2Nu3byzwCncf5eUN2jjKkmG3zovTEFGPQ7ULCPgY3wCC",
-	"4+jXc7i419QrJLNKLwE/4RZiGydutB77m+5X9Ab1xtvVe8c62KXarjN3tpOrMo7Ew840OW5WTsgKYRRG",
-	"rFBb9emAFsDyNeSXPk8LbCq7m3e6h0gdL2gG1iEMZfChF2SYQwI9CwtgdVVwL4pzues/5jdgbYgHfgWX",
-	"sLtQbQqKY17vdx+Tm7GDipQaSZeOWONj68fob74PB0PFvqrCm2x8nBfI4mlDF6HP+EEmkfcODnGKKDqP",
-	"nccQwXUCEUT8Iyi4wULdeLci/dTynJaxoJsvkc0n8H7mm7TKk4/cileDVnf6vgFMB6auDVtwJ7crn8mK",
-	"HkxHXKw2fAUjEnLs3Jn4LLnjEMJBDt17yZtOLfsX2uC+SYJMjTO35iSlgPviSAWVmV68XpiJ/IfeM4EJ",
-	"Kj3CFiWKSU1gIzEdrjtONsq4NwZamoBBy1bgCGB0MRJLNmtuQpItzEUWzvIkGeB3TACwL+3LeRRqFiUc",
-	"a5K6BJ7bP6cD7dInfwkZX0Kal1i1nJCyxUn4GN2e2g4lUQAqoIQVLZwaB0JpkxG0G+Tg+Gm5LIUElqWi",
-	"1iIzaHTN+DnAycf3GSMLPJs8QoqMI7DRL44Dsx9VfDbl6hggpU+mwMPY6FGP/ob0uy+K43Yij6ocCxcj",
-	"Xq08cADuQx2b+6sXcIvDMCHnzLG5K146Nuc1vnaQQfYRFFt7uUZ8ZMbnY+LsHgcIXSxHrYmuopusJpaZ",
-	"AtBpgW4PxAu1zejhZ1LiXWwXjt6Toe34DDV1MCnPyz3DFmqL0T54tVAo9QFYxuEIYEQa/lYYpFfsN3ab",
-	"EzD7pt0vTaWo0CDJeHNeQy5j4sSUqUckmDFy+SxK3XIjAHrGjjYPsld+DyqpXfFkeJm3t9q8TUkWXg2l",
-	"jv/YEUru0gj+hlaYJtnKy77EkrRTdINWunlmIhEyRfSOTQydNENXkIESUCnIOkJUdpnynDrdBvDGeR26",
-	"RcYLzGbD5e7zKBJKw0oYC60RPcRJfArzJMckekotx1dnK71063ulVHNNkRsRO3aW+dFXgKHES6GNzdAD",
-	"kVyCa/StQaX6W9c0LSt1Y60o5awo0rwBp72EXVaIsk7Tq5/3++du2h8blmjqBfJbISlgZYEpkpMRmHum",
-	"piDdvQt+QQt+we9svdNOg2vqJtaOXLpz/Jucix7n3ccOEgSYIo7hro2idA+DjF7ODrljJDdFPv6TfdbX",
-	"wWEqwtgHo3bC+92xO4pGSq4lMhjsXYVAN5ETS4SNMgwPn7SOnAFeVaLY9myhNOqoxsyPMniEvGw9LODu",
-	"+sEOYCCye6Ze1Wgw3RR8rYBPuaI7GXBOJmHmopsoL2YI8VTChEoHQ0Q1r+4O4eoCePk97H5xbXE5sw/z",
-	"2e1Mpylc+xEP4Ppls71JPKNrnkxpHU/IkSjnVaXVFS8zb2AeI02trjxpYvNgj/7IrC5txrz45uzFSw/+",
-	"h/ksL4HrrBEVRleF7ap/m1VRtr+RAxIyqTudL8jsJEpGm9+kKIuN0tdr8CmpI2l0kDuzdThER9EbqZfp",
-	"CKGDJmfvG6El7vGRQNW4SFrzHXlIul4RfsVFGexmAdqRaB5c3LQErEmuEA9wa+9K5CTL7pTdDE53+nS0",
-	"1HWAJ8Vz7UmavaG88IYp2XehY8zzrvJe9w3HzJdkFRkyJ1lv0JKQmVLkaRurXBhHHJJ8Z64xw8Yjwqgb",
-	"sRYjrlhZi2gs12xKbpsekNEcSWSaZHqdFncL5Wv+1FL8swYmCpDWfdJ4KnsHFdOkeGv78Dp1ssNwLj8w",
-	"Wejb4W8jY8RZX/s3HgKxX8CIPXUDcJ83KnNYaGORcj9ELokjHP7xjIMrcY+z3tOHp2YKXlx3PW5xiZ4h",
-	"/3OEQbnaD9cHCsqrTz87Mkey3o8w2VKr3yCt56F6nHiwFPLcCoxy+Q3ihw5xlYsOi2msO23Zonb20e0e",
-	"k25iK1Q3SGGE6nHnI7ccJtwMFmouaavpIUkn1i1NMHFU6SmN3xKMh3kQiVvy6wVPZSN1QoaD6ax1AHds",
-	"6Vax0Dng3jSvLWh2FvmSm7aCHqNXoNu3hMPENjcUGGjayaJCKxkg1cYywZz8f6VRiWFqec0lVXFx/ego",
-	"+d4GyPjlel0rjakkTNrsX0AuNrxMSw5FPjTxFmIlqEBJbSCqgOEHouJPREW+ikjzhsij5nzJHsyjMjx+",
-	"NwpxJYxYlIAtHlKLBTfIyRtDVNPFLQ+kXRts/mhC83UtCw2FXRtCrFGsEepQvWmcVwuw1wCSPcB2D79i",
-	"n6Hbzogr+Nxh0d/Ps6cPv0KjK/3xIHUB+AIz+7hJgezkb56dpOkY/ZY0hmPcftST5Kt7qjA3zrj2nCbq",
-	"OuUsYUvP6w6fpQ2XfAXpSJHNAZioL+4mGtJ6eJEFlUcyVqsdEzY9P1ju+NNI9LljfwQGy9VmI+zGO3eM",
-	"2jh6astb0KRhOKq15DMTB7jCR/SRVsFF1FMiP67RlO631KrRk/0j30AXrXPGKX9IKdrohZAvnZ2H9ESY",
-	"qrnJ0Ey4cXO5paOYg8EMS1ZpIS0qFrVdZn9m+Zprnjv2dzIGbrb48kkiPXU3Tao8DvCPjncNBvRVGvV6",
-	"hOyDDOH7ss+kktnGcZTi8/a1R3QqR525abfdmO9w/9BThTI3SjZKbnWH3HjEqW9FeHLPgLckxWY9R9Hj",
-	"0Sv76JRZ6zR58Nrt0M+vXngpY6N0Kudge9y9xKHBagFXGLuX3iQ35i33QpeTduE20H9az0MQOSOxLJzl",
-	"lCLwtUpopyFlemNJ97HqCevA2DF1HxwZLPxQc9ZNT/3x+ejdREGlPV3BsD10bLkvAQ/4Rx8Rn5hccANb",
-	"Xz6tZIRQovT8SZIpmu+Rj52zr9V2KuH0TmEgnn8BFCVRUouy+KV9+dmrfqC5zNdJn9nCdfy1rdPWLI7u",
-	"wGT6wDWXEsrkcCRv/hrk0oTk/A81dZ6NkBPb9gsy0HJ7i2sB74IZgAoTOvQKW7oJYqx2H9U1QdvlShUM",
-	"52lz1bXHdVjII0q3/s8ajE09UMIPFDiGtlHHDijbNwNZoEZ6wr6jUsxrYJ1ERKgJhkwR3VfTdVUqXswx",
-	"g8XFN2cvGM1KfajaEGUbX6Ei1F1FzyYWpeGcFoIcCgeln0dMH2d/vLZbtbFZkxw89QDVtWjTl4uenwBV",
-	"pBg7J+x5VFSV3qq6IRgmMNEbp9U1o5F8hDTh/mMtz9eo9nVY6zjJT0+TH6jSRKUpmxJTTW5KPHcObp8p",
-	"nxLlz5lyuvm1MFSBF66g++a1eQDuzQ7hDWx3ebqWkijl5IhbrslEeSzaA3B0RQZXQhKyHuKPFPqpysSx",
-	"VQNeY69kqqx+CYJBTUp6QdmUDgqV1XMulRQ5JqpKXdG+VO8UP9uEnF59Q2444v6EJg5XsvBBE4rnsTha",
-	"CiEwQo+4oaE/+uo2laiD/rRYE3bNLVuBNZ6zQTEP9Tu8rVFIAz7XKBZ2jvik0h3fJXLIpDs8a9wmR5IR",
-	"Pr0ZUR6/dd9+9KYFjEm/FBKVCI82L/iRNRAriVqneQjLVgqMX0/3/bF54/qc4FPcArbvTkLlURyDXH9u",
-	"2eTnHg51Frze3svs2j5zbX2CpObnTpQzTXpWVX7S8eouSXnAbuUoghPeyyy4jyLkNuPHo+0ht73hKnif",
-	"OkKDK3R2Q4X38IAwmkonvSpaTmglisIWjMLEklkShEyA8UJIaOviJi6IPHkl4MbgeR3pZ3LNLYmAk3ja",
-	"BfASPdwphmasd2/cdqh+eiiHElxjmGN8G9siLSOMo2nQCm5c7ppyvI66I2HiGdYB94gcllxBqcoLUQW+",
-	"WugVYUkxDse4Q5mn7gUwPAZDmYi6Y660Y2+isYeoi7pYgc14UaRSv36NXxl+ZUWNkgNsIa+bFKFVxXLM",
-	"u9JNRDOkNj9RrqSpN3vmCg1uOV1U1ShBDXFlpbDD+NBlscN/U/kxx3fGB3ocHWoYojqK47IvDUMnU1Kv",
-	"o+nMiFU2HRN4p9weHe3UNyP0tv+dUnqpVl1APnL6iX1cLt6jFH/7xl0ccXaGQdJXulqa5AkY2KdCLUpU",
-	"G5tnv12uhFfZIAssOpSaWnf7DRDjVevmePmNhPdGSTc43a/koRwL8s1HY9K59a/jLGd7WdDoiyOKEKK3",
-	"RQhF2jo7FhVEQUHu86D3NMlwIGfbdOLDCKEh3GwI0PchlpVVXHj3e8sshpj1Ue/DdwhT4mHbDe4vwseS",
-	"j1rsvr8ai/sOydjwe7+q1SX4J/OVhiuh6uDYDpFPQSWkXzs1oprI++T6h4ZXnOrTmkNHjbcXvroALdPr",
-	"5N//QnFyDKTVu38BU+5g0wf1sobSLpmn2iasSUw9KVF151ackqgwlRPPy4adil0H6o0NyOr5FHFgWD9s",
-	"PjsvjrowU3kVZzRK6tilq4GNp51qU03hEauUEW1++FSZsIkhhhdY6StKmzUcK8T3XEFusShAG7egAY5J",
-	"ouUmiwqP/pF+akSdbiIxfdapfammhpUADtzxg9dg0YtGyqJ+Mj2x0lkTnYZ8GrMhr0D62p/ddx6To82X",
-	"S8ituDrw+u5va5DRy655sMtQDe/oMZ5oopcxecvxVscWoH2P4/bCEyVRvDU4Y29vLmF3z7AONSTTus/D",
-	"VXuTvB2IAeQOmSMRZVLRH2RI9g55YRrKQCyEaCvqDm0GtNGKUNFb0hvOFUjSXRzt+9I9U6ZL0kyay3U9",
-	"6tU1BuKOPdAbVrQY1z+eYwER01RrDHk/Yi2dnQ+zI177vCH4VrLxnYQMImDCb+FhNM1SikuIa1ahp+qa",
-	"6yK0SJpeglUn23MfDV7VhWoMfaCXzcyijY0dvqNK5NvCCOi8VE6MyMbCyLvhqE0sxz1DQTeU/h0DbR1c",
-	"S9C+th/Kv6UykFkVYmn3wbEPFRRZdCMkmNEclwTcaOaZV21qHcz1yzHTDPcBRfECmYYNd9DpKAHO+Jz7",
-	"kP2MvoeHQyHX60ELU0Ovh4sOhKhoYQZIjKl+yfxtefhB0k2MTUJKqh9tUtlwJOiuN6TSqqhzuqDjg9EY",
-	"5CbnmtrDSpJ2mny4yp6OEL3qvITdKSlBoVpD2MEYaJKcCPQoi0Jvk+/U/GZScK/uBLxPabmazyqlymzE",
-	"2XE+TOHTp/hLkV9CwdxNEaIHRyrosM/Qxt54s6/Xu5CypqpAQvH5CWNnkuK1g2O7m0O6N7m8Z/fNv8VZ",
-	"i5qyanmj2slbmQ58xXxX+pbcLAyzn4cZcKzullPRIAcSxGxH0gdpfp2oJ3UyVSsfupr7NX5aoiIoUjJJ",
-	"W77mQJxMEyLTVv5ow2SG0kFZqusMqShr8n+ldA7XrsskQ8bTtpvD9gKieBtu/AW6Y2tesFxpDXncI/3E",
-	"gYDaKA1ZqTD8JuUZXFonD20wrlmyUq2YqpyaS2n0gg8lWZYmmuuuSvDQc12CICOHz0hCBDD+ea4HlxoP",
-	"4d1TBef4CjsX64TdBjcs7NbRZXQ8wR1d/SICcwKhH7ZZnaWqBHXX1a9XNVY9zqqNyNPo/veKVhmNMUlR",
-	"bwoVPgEtPYDDZnjAY57SOCfx9AzRDJIvypTHhvnj5500SOfuv3iD9cdlS/DMZYSfJR5g7lt1qvJTYleb",
-	"qXxhqvCmcoRCkg7v/f5lqga4mOplbjJOT2QGEQDjfucODJO8z8eCscTqmhlPIPm8kfnnneLHosfxQjZA",
-	"Otk5J51/DcyNXWvwb/yoDGCv7lDF7TrIAK75UDN3Wh4YfIBHxVO4ITtSsGf5GoR94UpVWQlX0HHH+4eH",
-	"dZ6DMeIK4vqF1JkVABVad/s6R8rPHPP2niDq155Fnsop2E1KpoRY2il2QOxMCslbmdExMVOPkoPoShQ1",
-	"7+DP3KKS21gRt8TlE2B9N41THM0k0ovbxyIORoYgzSfPpUwHhsTvXhuTEs5WNKZnIsL2ZJuKX8txFWxI",
-	"lK3sNL0GYoTYb7aQ4z3UjXy4PU4YDsZM7037qNCkmx2+qSo/SmX7iGxQETIptRkIFX3j9DNB8PV9E9Iu",
-	"GR2FSQwgTMsbMI4S2ji9qNmG71ghlkvQ5FYxlsuC6yJuLiTLQVsunI65MzdXMBy0uob5QR3DcWocNDCr",
-	"lLaBFkICpNx55W1M/p8gt6MPLSGz07Vt1VixysGupB928K3TczDCbYQI/JN01HLosCqJIibb8Es4ch4j",
-	"foP902CiGG+FtQpnnTLFh720/hOiDg/8z1LYvdROol8/5JB8QkSMgQblqnVM0+YMaTAVJXpBJZPiSNF+",
-	"BYKw12SgovlgJKOi550Z8lSzx+ULJqqVlHuT3VAcGDBjAmbuI2iPkhb65ob8AFNKsuiRM9GV1dUSqRM3",
-	"hS4mjBto2PG8H9HSvYKabcfqn3mtUYi65rvDidnaaygdDEwjB3UmxDg0UPutJgIzVFAimffsGPEkQfOp",
-	"mgrDjFN3vxiKcm/9cL/fcrylPb2AuEL7fnprBflAKgla43KXOjrBlnyDBY5JJxPiNO9sq5rT8ntsUJJF",
-	"3ywR6STQhjF7CWxGlYP3h1HEeYrbB9CaQj/R7Rr0oT6/+KHVk6bVMA4dDoAXR9dEVYyDo8OD84lfEv/Q",
-	"ICVayrsxSugs/1DAjl9gq1hGW+RlNWuBssbT67PuvkTRWOZZE+Q0VnC7HwuFSYmdcFCWiRgqEh+pxG1E",
-	"OO6e1Fe8/PhxUJit+gzxAcWrcc9pHEgTI5lQaW72jO8FnzR3FDRzd1PLlxi39Tdwe5S8FvxQXmMdMH8U",
-	"/nlJVv5lqHd5BZJd45gU9P3wS7bwaU4qDbkwfU34OpSiauJGsDKjfzq5tQcCVQ6t8xdlb0HGy2BYYj+2",
-	"ZW3QkL2SLYTtEf3ETGXk5CapPEV9A7JI4C/Fo+J8oweui8tONHgr1UU3mtJwx1Hh0fuuI6PCh5lUpy6P",
-	"Ip/dpVMbGK5z8m3dwW3iom7XNvVJwxC5+2qfTHmJkC5p5LrjUwhCCNYDYwgq+/vDvzMNSyz4q9j9+zjB",
-	"/ftz3/Tvj7qf3XG+fz+p5H20RxCEIz+GnzdFMb+MPYunp98jGRh6+1GLsjhEGJ18Gm3JbMwY8avP2vNJ",
-	"inb/SoGZw6PqC6feIpqcEJNYa2fyaKooU8aEJBm+WyIlBgY95LUWdofJhIPGK35NPtf4rgn99aHjjQnP",
-	"331WXUKTjroNFK5NuF2/U7zE+4gsi9LdQqo8Yd9s+aYqwR+Uv9xb/Ake//lJ8eDxwz8t/vzgiwc5PPni",
-	"qwcP+FdP+MOvHj+ER3/+4skDeLj88qvFo+LRk0eLJ4+efPnFV/njJw8XT7786k/3HB9yIBOgs5C6bva/",
-	"sbJ9dvbyPLtwwLY44ZX4HnZURNeRcSjPy3M8ibDhopw9DT/9/+GEneRq0w4ffp35zFiztbWVeXp6en19",
-	"fRJ3OV1hZGBmVZ2vT8M8g/q9Zy/PGxckGf1xRympRHDmBFI4w2+vvnl9wc5enp+0BDN7Ontw8uDkoRtf",
-	"VSB5JWZPZ4/xJzw9a9z3U09ss6fvP8xnp2vgJQbSuz82YLXIwycNvNj5/5trvlqBPvE1i91PV49Og1hx",
-	"+t5HSH7Y9+00Lv91+r4TSFoc6InlgU7fh6y3+1t30sr6ANqow0Qo9jU7XWAyralNwUSNx5eCyoY5fY/i",
-	"8ujvpz77T/ojqi10Hk5DtHW6ZQdL7+3WwdrrkXObr+vq9D3+B+kzAove2p7arTxF8/Tp+85q/OfBarq/",
-	"t93jFlcbVUAAWC2XlMV73+fT9/RvNBFsK9DCCX4Y3+5/pXdIp5hbbzf8eSfz5I/DdQxKaCZN/a8o8Q9n",
-	"pTA2XchnhueVjvp5gRzY9t+DUD0ucg/hMX704MFRpcWnRZf2X6EM77Qh89q3sg/z2ZMjAd1r/em83U0A",
-	"8zUvWIhow7kffry5zyU+KnFcmdGtgxA8+XgQdIuffQ879qOy7FtUjz7MZ198zJ04l05Y4yXDllFu4+ER",
-	"+VleSnUtQ0snrtSbDde7ycfH8pVBV4QWV9wLi1E9zNk7DLWlKMfuUTsrigHRk9gGxn6t8P4bw9jGrCqf",
-	"qaNFWiu1CumWMFR7B6i6WEPiQRc9OwjBJlIVMIvlSatr+HBLntDzanFtzxNWHDRHYoXKZchGHoGafJ3U",
-	"9xDRyEON4xAJt0n5Tb3YCBPUhT94yh88RdP0jz/e9K9BX4kc2AVsKqW5FuWO/SybPGs35nFnRZF80tk9",
-	"+gd53Hy2zXJVwApk5hlYtlDFLtSr6ExwCaSgDgSZ0/fdonMk0s0KKMEmn6u53xlnK8yXOFzEYsfOnw8k",
-	"HOrW57xf77BpVMzt6Zv3pOE59aVVwPogDjhjXEesz5vepbnmPrJ3C1kpywgLhV/UH4zoD0Z0K+Fm8uGZ",
-	"It8ktQ/KYsoHd/Y8JCRNpbvmdgjKFB3lkx7fO9n4of6T0nfoaSwULPpAcYJ9NP/BIv5gEbdjEd9B4jDi",
-	"qfVMI0F0x+lDUxkGBmwX/dLO6OQIzeuS6yg89JCZ4wxH9MaNj8E1PrZSl8QV6XRcttXvExt4t3reHyzv",
-	"D5b378Pyzg4zmq5gcmvN6BJ2G141+pBZ17ZQ15GfA2GhGKShHdh9rE3/79NrLmy2VNonWsHSZ8POFnh5",
-	"6rMq935tExkOvmB2xujH+MlL8tfTprJk8mPfRZL66l0EI41C1Hz43LpLY/cjsvbG8fjmnWPLWLfIc/3W",
-	"m/b09BSTF6yVsaezD/P3PU9b/PFdQwLvm7vCk8KHdx/+XwAAAP//YEWO0mbdAAA=",
+	"H4sIAAAAAAAC/+y9e3PctrIg/lVQc2+VH7+h5FdyT1R16v6UOMnVxnFclpLs3dibgyF7ZnDEAXgIUJqJ",
+	"V999qxsACZIghyPJ9jm1+cvWEI9Go9Fo9PPDLFWbQkmQRs9OPswKXvINGCjpL56mqpImERn+lYFOS1EY",
+	"oeTsxH9j2pRCrmbzmcBfC27Ws/lM8g3MTsL+81kJ/6hECdnsxJQVzGc6XcOG48BmV2DreqRtslKJG+LU",
+	"DnH2cnYz8oFnWQla96H8SeY7JmSaVxkwU3KpeYqfNLsWZs3MWmjmOjMhmZLA1JKZdasxWwrIM33kF/mP",
+	"CspdsEo3+fCSbhoQk1Ll0IfzG7VZCAkeKqiBqjeEGcUyWFKjNTcMZ0BYfUOjmAZepmu2VOUeUC0QIbwg",
+	"q83s5LeZBplBSbuVgrii/y5LgD8gMbxcgZm9n8cWtzRQJkZsIks7c9gvQVe50Yza0hpX4gokw15H7MdK",
+	"G7YAxiV7+9037Pnz51/hQjbcGMgckQ2uqpk9XJPtPjuZZdyA/9ynNZ6vVMllltTt3373Dc1/7hY4tRXX",
+	"GuKH5RS/sLOXQwvwHSMkJKSBFe1Di/qxR+RQND8vYKlKmLgntvG9bko4/2fdlZSbdF0oIU1kXxh9ZfZz",
+	"lIcF3cd4WA1Aq32BmCpx0N+eJF+9//B0/vTJzb/9dpr8L/fnF89vJi7/m3rcPRiINkyrsgSZ7pJVCZxO",
+	"y5rLPj7eOnrQa1XlGVvzK9p8viFW7/oy7GtZ5xXPK6QTkZbqNF8pzbgjowyWvMoN8xOzSuagNY3mqJ0J",
+	"zYpSXYkMsjkTkl2vRbpmKdd2CGrHrkWeIw1WGrIhWouvbuQw3YQoQbhuhQ9a0D8vMpp17cEEbIkbJGmu",
+	"NCRG7bme/I3DZcbCC6W5q/RhlxW7WAOjyfGDvWwJdxJpOs93zNC+Zoxrxpm/muZMLNlOVeyaNicXl9Tf",
+	"rQaxtmGINNqc1j2Kh3cIfT1kRJC3UCoHLgl5/tz1USaXYlWVoNn1Gsza3Xkl6EJJDUwt/g6pwW3/H+c/",
+	"vWaqZD+C1nwFb3h6yUCmKoPsiJ0tmVQmIA1HS4RD7Dm0DgdX7JL/u1ZIExu9Knh6Gb/Rc7ERkVX9yLdi",
+	"U22YrDYLKHFL/RViFCvBVKUcAsiOuIcUN3zbn/SirGRK+99M25LlkNqELnK+I4Rt+PavT+YOHM14nrMC",
+	"ZCbkipmtHJTjcO794CWlqmQ2QcwxuKfBxaoLSMVSQMbqUUYgcdPsg0fIw+BphK8AHCH3gCPkNHAkbCM0",
+	"g6cbv7CCryAgmSP2s2Nu9NWoS5A1obPFjj4VJVwJVem60wCMNPW4BC6VgaQoYSkiNHbu0KEZZ7aN48Ab",
+	"JwOlShouJGRMSAu0MmCZ1SBMwYTj753+Lb7gGr58MbvZ93Xi7i9Vd9dHd3zSblOjxB7JyNWJX92BjUtW",
+	"rf4T3ofh3FqsEvtzbyPF6gJvm6XI6Sb6O+6fR0OliQm0EOHvJi1WkpuqhJN38jH+xRJ2brjMeJnhLxv7",
+	"049VbsS5WOFPuf3plVqJ9FysBpBZwxp9cFG3jf0Hx4uzY7ONviteKXVZFeGC0tbDdbFjZy+HNtmOeShh",
+	"ntav3fDhcbH1j5FDe5htvZEDQA7iruDY8BJ2JSC0PF3SP9sl0RNfln/gP0WRY29TLGOoRTp2VzKpD5xa",
+	"4bQocpFyROJb9xm/IhMA+5DgTYtjulBPPgQgFqUqoDTCDsqLIslVyvNEG25opH8vYTk7mf3bcaN/Obbd",
+	"9XEw+SvsdU6dUGS1YlDCi+KAMd6g6KNHmAUyaPpEbMKyPRKahLSbiKQkkAXncMWlOZrNY2eyOcC/uZka",
+	"fFtpx+K78wQbRDizDRegrQRsGz7QLEA9I7QyQisJpKtcLeofHp4WRYNB+n5aFBYfJD2CIMEMtkIb/YiW",
+	"z5uTFM5z9vKIfR+OTaK4QvXSApyogXfD0t1a7hardUtuDc2IDzSj7URlzc28RoPWYO6D4uhZsVY5Sj17",
+	"aQUb/5drG5IZ/j6p878GiYW4HSYubMUc5uwbh34JHjcPO5TTJxyn7jlip92+tyMbHCVOMLeildH9tOOO",
+	"4LFG4XXJCwug+2LvUiHpkWYbWVizKy5TIJFA3wN1jxBZzg3ghe+lm4gWrX9DVUKaL1/cgcJqlDgp0UsZ",
+	"ITRO9uaEC2xAv5LaOYMrtlEZ8fi73jwTL4Xo/jafw3NJUN2aL+3lHVFI8EMXhq9zlV7+F9fre6CghR+r",
+	"T0U0DVsDz6Bka67XR7OYRBYSSjPaFGLBhqQMYYtgqqN6ife1vD1Ly7jhR7MuvPEDYlFP/eiCgDLyzvuJ",
+	"/sNzhp+RD3Lj1Ryo4hHEzlRgkMlQM2IfU3YmbIAbbxTbWGUIQw3FQVB+00we36dJe/St1b+4HXKLoB1S",
+	"23s/Bl+rbQyGr9W2dwTUFu6DgS7U1v5HGNjoCfC9dJAp2n+HPl6WfNdHMo09Bcm4QBTzNZ0GGUpHOEuj",
+	"yD5dqPJ23KfDViRr1POM46jBRTXvIImaVkXiSDGi4rMNOgM1FtFxptEdPoaxFhbODf8IWNCGB8DfAQvt",
+	"ge4bC2pTiBzugfTXUaaPCpXnz9j5f51+8fTZ78+++BJJsijVquQbttgZ0Oyhe8cybXY5POqvbD6zaob4",
+	"6F++8Erd9rixcbSqyhQ2vOgPZZXFVly0zRi262OtjWZadQ3glMN5AcjJLdqZtYMgaC+F5lrDZnEvmzGE",
+	"sKyZJWMOkgz2EtOhy2um2YVLLHdldR/PfihLVUZ0kXTEjEpVnlxBqYWKWJ7euBbMtfBPgaL7u4WWXXPN",
+	"cG5Sk1eSBIoIZaH+ezLft0NfbGWDm1HOb9cbWZ2bd8q+tJHv5WnNCrTqbVFUXlSr1qtxWaoN4yyjjnRH",
+	"fw+GRIEzaaC84vk9bKVwQw3JVP47E5JpSJXM9Jw9sfINyRDWoEXSz3UpjAHJuG5bMbCBrhYb/JpNeADX",
+	"MN3lhbIYAj9E5IXYwLnhm+Kn5fJ+9BOKBoo84cQGNM7EbIsAoAkYcaPeBR9mGACHkfOdTOkl+3Efshsh",
+	"ydKmdzINVCf0qoRsBeUEfNzDA5ameqAj4CA6XtHnc8kLvVb3/z5sDx+DOw5AoJloNxhSULRb3YeErf1Y",
+	"k7ltd7F7mG0zwV32V/MryNwus3rIECeGG3gJueHfqfKiYVffl6oqPtaG13NOXQqvl4BdWYZ9vVJOyFXe",
+	"dudbIezRNX6WBX3j7zi3BoKeuM0rsVqb4O39plRqef8wxmaJAUofrOYixz59/cVrleFFYSp9D++UZrBG",
+	"DECaDS9/vlCVYZxJlQFtfqXjL5gBBzDyPCGHGRM+iszaKiMWgNSV8gpXi4Y2FROqmo4JT+0JTeylH5+w",
+	"8WKwrex01rkoL4FnqBwGydTCWZydLZwWycmXxfg3gHs/Re6CFlxFqVLQGpX6VlW7FzTfzspXZgRPBDgB",
+	"XM/CtGJLXt4Z2MurvXBewi4hzyvNHv7wi370GeA1yvB8D2KpTQy9tS5MyAGop00/RnDdyUOy4yUwf68w",
+	"o+jJl4OBIRQehJPB/etC1NvFu6PlCkoy8H9UiveT3I2AalA/Mr3fFdqqGPAndjoglN5xwySXygvNscFy",
+	"rk2yjy1jo3AtGlcQcMIYJ6aBB4TqV1wb65QiZEb6Ye1sNLWFBqcYBnjwrY4j/+Kf6f2xUyU1SF3p+s2u",
+	"q6JQpYEstgb0ZBqe6zVs67nUMhi7VgwYxSoN+0YewlIwvkOWXYlFEDe17dZ5bfUXRxZOvOd3UVS2gGgQ",
+	"MQbIuW8VYDf0qRwAROgG0ZZwhO5QTu3IOZ9po4oCuYVJKln3G0LTuW19an5u2vaJi5vm3s4UaHLldO0d",
+	"5NfeDMgl+hdr5uBgG36JsgfpCq33TB9mPIyJFjKFZIzy6fmOrcIjsPeQVsWq5BkkGeR81x/0Z/uZ2c9j",
+	"A9CONzohZSCxbpHxTW8o2XuhjQytaLwI03ytGH1hKR5BfAo0BOJ67xk5Axo7xpwcHT2oh6K5olvkx6Nl",
+	"262OjEi34ZUygVmYQHYcfQrAA3ioh749Kqhz0rw9u1P8N2g3gW9zi0l2oIeW0Ix/0AIGDA0u4iQ4Lx32",
+	"3uHAUbY5yMb28JGhIztg9XjDSyNSUdBb5wfY3fvTrztB1BbPMjBcoCY++GCfgUXYn1mHvu6Yt3sKTlKZ",
+	"9MHvKU0iy8mFJpGnDfwl7OjN/cZ6igeqjvt4y0ZGZcIGgCCg3v8URfCwCWx5avIdqYrXsGPX0NURt5+6",
+	"RhVJOEDU+Dcyo7N0Wy9rvwNTTO/nNFSwvP5WzGf2TTAO30XnYdBCh3sLFErlE7SfPWREIZjkQMYKhbsu",
+	"XDCKD0fwlNQC0jHtfOfBdVdFiGZaAftvVbGUS3pyVQZqmUaVJChgX5pB6GBO5yrWYAhy2IB9SdKXx4+7",
+	"C3/82O250GwJ1z6C6/HjPjoePyY9zhulTetw3YNGFI/bWeT6IKsoXnzuFdLlKfvdb9zIU3byTWdwPymd",
+	"Ka0d4eLy78wAOidzO2XtIY1Mcz0y24krD9YTXTft+7nYVDk392HahSueJ+oKylJksJeTu4mFkt9e8fyn",
+	"uhtFp0GKNJpCklJM1cSx4AL72DCsfW/Dxj1VbDaQCW4g37GihBQyqyoXmukaxiNmHYrTNZcrkvRLVa2c",
+	"R6sdhzg1hulRYFQle0NEpSGzlQlpp2Oc20Ux+MgxlIOA41usq9q2L49rXs8HWYuhT0ReV9UfNQHPZ4NP",
+	"VUTqVfNUtchph79N4OItQS3ATzPxRPsHoQ6Flj6+wm3BU4Cb+3F07c3QMSj7EweWrObjkBWrafGrKi+h",
+	"vIMmftoawmnG1xMDKLq2sOHgOivULtyDVGYHYiUUJWi6Q0M9mrZf1TIM6XWXrN5pA5u+qcF2/X2Azbwd",
+	"fNAqmQsJyUZJ2EWzWAgJP9LHWG97jw90JolqqG/3kdSCvwNWe54pp+6u+KXd7nKirklNf6fK+7LH2wEP",
+	"NdmOmUj32nHdlLc14mJwa9/26QL+uoxOz+sEI6JkXGuVChIqz9BdRcjGXOr859vof1OHMdzD2euO2zHy",
+	"hbHkpMSGvGCcpbkgFbeS2pRVat5JTkq0YKkRF0avLRhWq37jm8T1uBE1qxvqneTkvlqr1qJuV0uI6JG+",
+	"A/DaVV2tVqBN5zG2BHgnXSshWSWFobk2eFwSe14KKMmP8Mi23PAdWyJNGMX+gFKxRWXazxOKZ9UGlbTW",
+	"4ojTMLV8J7lhOXBt2I8Cnb5wOO9x4o+sBHOtyssaC3EpZgUStNBJ3NXye/uVvODd8tfOIx7/7zpbGxWO",
+	"3wS97gy0cmr874f/eYK5NHjyx5Pkq//v+P2HFzePHvd+fHbz17/+n/ZPz2/++ug//z22Ux52kQ1CfvbS",
+	"Pd3PXtL7rDFS9WD/ZAYKDNGOElnoStShLfaQMgs4AnrU1t6ZNbyT6HBnFCa2EBk3tyOH7g3TO4v2dHSo",
+	"prURHW2dX+uBr547cBkWYTId1nhrabHvnRyPa8aN9KHK2IotK2m30r8ybNie9xJVy3kdu27TWp0wCmxe",
+	"c+/i7P589sWXs3kTkFx/n81n7uv7CCWLbBsLO89gG3vMugNCB+OBZgXfaTBx7kGwRx1irfNJOOwGUAui",
+	"16L49JxCG7GIczgf4OOUYlt5Jq2XKJ4fssHunGlHLT893KYEyKAw61i6m5agRq2a3QTo+MVguCLIORNH",
+	"cNRVSmX4LnauuTnwJRKotSOqKa+++hxYQvNUEWA9XMgkzU+Mfkjkcdz6Zj5zl//9P5ncwDG4unPWBlf/",
+	"t1HswfffXrBjxzD1A8KWGzqIWY+oDOyHtseUYdwl+bJC3jv5Tr6EpZACv5+8kxk3/HjBtUj1caWh/Jrn",
+	"XKZwtFLsxEd6vuSGv5M9SWswD18QY8uKapGLFBXuMfK0uZX6I7x79xuqnd+9e99zHuk/H9xUUf5iJ0hQ",
+	"EFaVSVxmmKSEa17GjHO6zgxCI1Pv0VmtkK0qq8F14zM3fpzn8aLQ3QwB/eUXRY7LD8hQu/h33DKmjSq9",
+	"LCK0h4b297VyF0PJr73+qNKg2d82vPhNSPOeJe+qJ0+eA2uFzP/NXfnCvsUna5EGMxh0lUe0cPushK0p",
+	"eVLwVcwG+O7dbwZ4QbtP8vIGtwAFXeoW4qQOr6GhmgV4fAxvgIXj4FBaWty57eWzAMaXQJ9oC6kNihuN",
+	"Z8Jt9ysI3r/1dnUSAPR2qTLrBM92dFUaSdzvTJ0cbMWF1N5dBC1NeAhcHrUFqk4hvXQJrmBTmN281V0t",
+	"W4KmZx1C29RnNpyUku+QBQVTohUZd6I4l7tuFhQNxnif57dwCbsL1eTuOSTtSTsLhx46qESpgXSJxBoe",
+	"WzdGd/Od2xtCyovCJ7OgSF1PFic1Xfg+wwfZirz3cIhjRNHKEjGECF5GEEEdhlBwi4XieHci/djy8JWx",
+	"sDdfJA2a5/3MNWkeT85DLVzNxbr+vgHKo6iuNVtwlNuVSwFoM00EXKzSfAUDEnJoxJqYz6Fl+KJB9t17",
+	"0ZsOzebtC61330RBto0TXHOUUgC/IKnQY6bjl+hnsnZSZ4GhzL4OYYucxKTagdMyHV62jIlyNQZanICh",
+	"lI3A4cFoYySUbNZc++yE2Tw4y5NkgI+YOWUsX9ZZ4FIXZGqss2F5nts9p73Xpcua5VNl+fxY4dNyQq6r",
+	"+cx58ce2Q0kSgDLIYWUXbht7QmmyuDQbhHD8tFzmQgJLYt55gRo0uGbcHIDy8WPGrAaeTR4hRsYB2GT/",
+	"p4HZaxWeTbk6BEjpstBwPzZ5DgR/QzwI1Pqro8ijCmThYsB6l3oOwJ1LZ31/dRyLaRgm5Jwhm7viOUjj",
+	"X3zNIL20TSS2dpI0OQ+UR0Pi7IgBxF4sB62JetxqNaHM5IGOC3QjEC/UNrFR4FGJd7FdIL1HXfixV/Rg",
+	"2gRZDzRbqC15NdHVYl3G98AyDIcHowGAMh/h2qnf0G1ugRmbdlyailGhZg9r2aYhlyFxYsrUAxLMELk8",
+	"DHJe3QqAjrKjSSDvHr97H6lt8aR/mTe32rzJ5eijo2LHf+gIRXdpAH99LUydpepNV2KJ6ilarToJugIR",
+	"Mkb0TMiIkaZvCtKQAz0KkpYQlVzCLv62Abpxzn23QHlBacC43D0KPL5KWAltoFGie3+Qz6Ge5JR9VKnl",
+	"8OpMUS5xfW+Vqq8p6miVk61lfvIVkMv0UpTom4sWiOgSsNF3mh7V32HTuKzU2mxmc3WLLM4baFqMsslE",
+	"XsXp1c37w0uc9nXNEnW1IH4rpHXMWVBu+ain6cjU1hl5dMGv7IJf8Xtb77TTgE1x4hLJpT3Hv8i56AZR",
+	"j7CDCAHGiKO/a4MoHWGQQYRwnzsGclNg4z8a0772DlPmx97r2ePjlIfuKDtSdC0NoOOrEGQmQrFEmCA1",
+	"ez90d+AM8KIQ2bajC7WjDr6Y+UEKD5/QsoMF2l032B4MBHrPWPRQCbqdu7QR8G2S/VY6rKNJmLloZxgN",
+	"GUI4ldC+REwfUXV04T5cYf6cH2D3C7al5cxu5rO7qU5juHYj7sH1m3p7o3gm07xVpbUsIQeinBdo8OJ5",
+	"4hTMQ6RZqitHmtTc66M/MauLqzEvvj199caBjzq8HHiZ1KLC4KqoXfEvsyqbJnXggPgSFPjm8zK7FSWD",
+	"za/zFYZK6es1uFz+gTTaSzrcGBya8bySehn3ENqrcna2EbvEERsJFLWJpFHfUeeOVYRfcZF7vZmHdsCb",
+	"hxY3LXN1lCuEA9zZuhIYyZJ7ZTe90x0/HQ117eFJ4Vwj1QY2tqCGZkp2Tejk243qOCJV9OxagNOK9JmT",
+	"rDakSUh0LtK4jlUuNBKHtLYzbMyo8YAwiiNWYsAUKysRjIXNpuRn6gAZzBFFpo6miGpwt1CuWFolxT8q",
+	"YCIDafBTSaeyc1DxXPqCO/3rFGWH/lxuYOoTDH8XGSNMl9298QiIcQEjtNT1wH1ZP5n9QmuNFP4QmCQO",
+	"MPiHM/auxBFjvaMPR83WeXHdtrhNy8o8pbCaf7y6vN0Dc0QLpQmdLEv1B8TfefQ8jgRmuYlImKLeR5Hw",
+	"3y6LqbU7Tb23ZvbB7R6SboKPrO2kMED1tPOBWY6y03kNNZd2q23ATMvXLU4wQQt9bMdvCMbB3PPEzfn1",
+	"gqeXcSEDYTptDMAtXbpRzHf2uNd1VImdnQW25LqtsEH3BZS9vHpBAp9bCgx22smiQiMZYMeWTDC39r9c",
+	"q8gwlbzm0oDPRG+PkuutwSq/sNe1Killho6r/TNIxYbncckhS/sq3kyshK3sVGkISge5gWzVPEtFrvxS",
+	"HSvlUHO2ZE/mQf0ytxuZuBJaLHKgFk9tC7QA0tpqa47vgssDadaamj+b0HxdyayEzKy1RaxWrBbq6HlT",
+	"G68WYK4BJHtC7Z5+xR6S2U6LK3iEWHT38+zk6VekdLV/PIldAK4y1xg3yYid/OrYSZyOyW5px0DG7UY9",
+	"imYXsKU5hxnXyGmyXaecJWrpeN3+s7Thkq8g7imy2QOT7Uu7SYq0Dl5kZuvKaVOqHRMmPj8YjvxpwPsc",
+	"2Z8FA83JG2E2zrij1QbpqakLZCf1w9kidfZuquHyH8lGWngTUecR+WmVpvZ+i62aLNmv+QbaaJ0zbvOk",
+	"5KLxXvCFJtiZT8NkM5f6dO0WNzgXLp3EHNxCypkspKGHRWWWyV9YuuYlT5H9HQ2Bmyy+fBHJq9rOmSwP",
+	"A/yT470EDeVVHPXlANl7GcL1RX98mWwEsvpHTbRHcCoHjbnRac2Q7XB86KlCGY6SDJJb1SI3HnDqOxGe",
+	"HBnwjqRYr+cgejx4ZZ+cMqsyTh68wh36+e0rJ2VsVBnLrdgcdydxlGBKAVeQDW4SjnnHvSjzSbtwF+g/",
+	"r+XBi5yBWObPcuwhgCUiTj4M1E+oNenOVz2iHRg6pvgByWDhhpqzdq76T89H78cLKm7p8ortvmELv3g8",
+	"0B9dRHxmcqENbGz5diUDhBLU6oiSTFZ/D2zsnH2ttlMJp3MKPfH8E6AoipJK5NkvTeRne4WLkst0HbWZ",
+	"LbDj702By3px9g6MkVi65lJCHh3Oypu/e7k0Ijn/XU2dZyPkxLbd6ix2uZ3FNYC3wfRA+QkRvcLkOEGI",
+	"1XZQXe20na9UxmieJidfc1z7VX2C2gv/qECbWIASfbCOY4bKfCIVUycGMqMX6RH73tawXwNrJVyil6DP",
+	"iNGOmq6KXPFsTpk60JrA7Ky2jy3TZksPrOgh1F5FRycWpBud5oJsOwyFR0wfZ9xf29YiS+oE97EAVGzR",
+	"pOAXHTsBPZFC7Byxl0E16qDgGSVqKTf4qqtHs/IR0QT+xxierrGBarHWYZKfXjPDU6UOavq6/6c1Jdpz",
+	"h3C7shm2asacKXybXwttS5fDFbRjXj0YXu3gY2DbyysrKS2lHB1wy9UZNw9FuweOxq1NCVHIOog/UOi3",
+	"JWcOLSFyTr1iRNmrR9Ir5msjKOs6Yj/6csxcKilSSsgVu6JdjfMpdrYJucu6ilx/xN0JjRyuaBWU2hXP",
+	"YXGwLsp81kJcX9EffMVNtdRh/zRUTHvNDVuB0Y6zoT+6K+bjdI1CanA5VZGIQj6pypbtkjhk1Bye1GaT",
+	"A8mIQm8GHo/f4bfXTrWAR5BdCkmPCIc2J/hZbSCVYDb48hCGrRRot552/LH+DfscUShuBtv3R75kM41h",
+	"TX+4bGvn7g916q3ezsqMbb/Bti4RVP1zy8vZTnpaFG7S4VJPUXkAkx0NIThivUy8+ShAbj1+ONoIuY26",
+	"q9B9ioSGqb2YNlDQPdwjjLrsUaekHgqtlqKoBbNuYjGk5EJGwHglJDQFxSMXRBq9Emhj6LwO9NNpyU26",
+	"brGhfUZusnDHGJo2zrxx16E6G0wooTX6OYa3sanYNMA46gaN4Mblrq5jjtQdCBPfoOuzdx/o118iqcoJ",
+	"URk3Tdi3r8gUYxzIuH3Nt/YF0D8GfZnIdqeccIfeREOBqIsqW4HBIMdYituv6SujryyrEDSGeemqOhVq",
+	"UTAEqpuIpk9tbqJUSV1tRubyDe44XVDiLEINYZk1v8NIaai0wn9jeUCHd8Y5ehzsaui9OrLDsi/1XSdj",
+	"Ui/SdILhT9MxQXfK3dHRTH07Qm/63yul52rVBuQTp58Y43LhHsX427d4cYTZGXrJbe3VUidPIMc+5QvT",
+	"0rOxDvttcyX81s92SwaluvDluAJiuITlnC6/AffeIOkGt/ertVAOOfmmgz7p3LjoOMPZKAsajDiyHkL0",
+	"3UIR184OeQVZpyD83Ot9qwLcbq2jCPXuZn2AfvC+rKzgwpnfG2bRx6zzeu/HIUzxh202uLsI50s+qLH7",
+	"4WrI79snY6Pv3cpdl+BC5osSroSq3IbVnk/+SWh/bdXCqj3vo+vvK15pqs+rDh1U3l64Kgp2me5N/sMv",
+	"1k+OgTTl7p9Aldvb9E6xt8jed2qyuUpt6Mll60k0Goa6fPxU9e1FYEhDdPk5jg5UyLeq26e8MFUQAB8Z",
+	"9FY8oKX5fj+MypEACtsiOPtOmzCxrHhbwJiS8zGWXtCJ2a0ib3tK1PW28+UUyaqHj5v57Cw7SPaIpaic",
+	"2VGiOxAtIDecwavJ2kXcqlBaNCUFYpXlJnprXqzBhZY4wu6P5V2lriA1VEeicQEpAQ7JR4aTBQWd/8zk",
+	"NVil1jm1ugReY1m7+sUj9ohLvcC6IDjUJt4/mp6j6rR29KMrjxJor0C6msrtkJnJjvvLJaRGXO0JZPx1",
+	"DTIIkpt7FRfBsgziGkXtCE55cA5X4DYA5fyW8OT8/sAZCmO6hN0DzVrUEK0EMPdSy21SoBAGiDuge3+h",
+	"NM+HdPLOt0HomjIIC95xzXaHJpncYBGxICz3lnN5kmQ8DNUdmTJexWjSXNj1oAB28mkeinXsF0EZfsq9",
+	"pJozui7w6VOohAoP1N12E01euxQsFHZam6F8MhbQ/jcfY25nycUlhGXOyOiHAfS+RVSL5RVkych91AtQ",
+	"ZCIO9LKeWTRuxv2QtP4eW2fyNFcoRiRDHvltz97aLeaBtv5LtmIAlA6uJZSuHCS2xLEhMcq7JY/BMYYK",
+	"betp3wYJejBdqAVuMInP2yZLEaVN5pS0hzvfrHCBrIQNR+jKIJfQ8JxjyP7GfvcxWD5t7l5lXU2v++tU",
+	"eAdzoXtIDKl+ydxtuT+26zZ6OyGlrcuvY4mFJJRtw1JRqqxK3TMmOBi1bnNy2q4RVhJVeaX9VXbeCEGA",
+	"7CXsju170hf48DsYAm0lJwt6kJCis8n3qsnUMbhX9wLe51QCzmeFUnkyYDc662dD6lL8pcBcggxvCu+I",
+	"OVB0iT0kc0XtGHC93vnsP0UBErJHR4ydSuv67n0E2um4O5PLB2Zs/i3NmlU2QZnTTx69k3EfYkodVt6R",
+	"m/lhxnmYBpndeSo7yPhEZjuQiQlT+/VLkB1NfZX3rfbdslANUVkoYjJJU/Foj8tR7W3UFItpPI760kGe",
+	"q+uEqCipU6nF3hzYrs0kffLYphtiewGB6xLX7gLdsTXPWKrKEtKwRzxaxAK1USUkuSJPppiRdWlQHtqQ",
+	"i7hkuVoxVeAz12Yk9OaoaCWjYK77qtpkI58tBIm1nQ3klgDtIp0duLZxH96RwkmHF2W6WEf0NrRhfrcO",
+	"rrzkCO7gQiIBmBMIfb/O6rS/sO66uiXOhgoOGrURaRzd/1qOP4PuOjHqjaHC9nCxhNSMDnjIU2o7L52e",
+	"PppBomNYbL/c8XP2LqJz/K9VEnfGZUvgpjd3wM8isaxjq44VC4vsaj2Vq2Xmw1MHKCTqOzBuqrcFJBdT",
+	"DfZ18u6JzCAAYNiE34JhkiH/UDCWVJA14REkn9Uy/7xVL1t0OJ5PrGhPdsrtmx/1TVzkVQkuXJIOQreE",
+	"U8HN2ssA2Lz/MsdXHmiKZbR1aLi2eiSvz3JlK7vClSqSHK6g5dngYjirNAWNgZlhyUvbmWUABWl3u2+O",
+	"mMk+5O0dQdStPQmMvlOwG5VMLWLtTrE9YmdUSN7KxB4TPfUoIURXIqt4C3/6DsX/hur+RS4fD+v7aZzi",
+	"YCYRX9wYi9jrZFPpoXMp4z42YQhxrVKi2bJa9WyJsDnZuuDXcvgJ1ifKRnaaXjYzQOy3W0jpHmo7kdwd",
+	"J4wGY1qs9q+hIYi7POUHqWyMyHpFRKNSmwZfBDrM5OMFX9c3Iu1apaPQkQGEbngDuaRC4/IYNEONeSaW",
+	"SyitWUUbLjPUNQbNhWQplIYLfGPu9O0fGAhtieFM+94YyKlpUM+sYq8N0hBaQPKde7wNyf8T5Hbch5jM",
+	"bq9to4bqm/Z2JR4jw7f4ziFnwQEicNH99MqhZkxJEjHZhl/CgfNo8QeMT0M5d5wW1iiadcoUN6O0/hOh",
+	"jg78z1KYUWq3ol/Xe9PahCwxehqUq8YwbTenT4NFGp+saDvddos5+L22Cio7Hwwkp3S8MyGeqkdMvqCD",
+	"slOpU9n1xYEeM7bAzJ0z8kHSQlfdkO5hSlEWPXAm2rK6WhJ10qbYi0mVITued52D2ldQve1USDWtShKi",
+	"rvluf467xMSh9H7VdmT/nPE+DjXUbqstgZGMa+HvpZA7RDyJ0HysPEU/edf9L8YGDDR2uI+3HKdpjy8g",
+	"LOo/Tm+NIO9JJUJrXO5iR8frkm+xwCHpZILL671tVX1aPsYGRVn07XK6TgKt7/4YwWZQbHrcjSJM+dzE",
+	"kpfWi5bMrv491OUXPzbvpGklo32HPeCF3jVNu9rQ4cD5zEHZP9ZICZbyfogSWsvf57DjFtg8LIMtcrKa",
+	"MWAT8NtAvva+BN5Y+pvayWmoRnvXF4ryOytpiwv3fKis+EhnKiQcgXf9Fc8/vR8UJf4+JXxA9nbYcho6",
+	"0oRItqjUt4uIfMUnzZ3zjzA1lh29Avkr4B5FrwU3lHux9pg/Cf88t1r+pS8disHT1zQm7TR7+iVbuIwx",
+	"RQmp0N2X8LWv6lX7jVCRSzsFhiOOO6rsW+cvytyBjJdescReNxWCSJG9kg2EzRH9zExl4ORGqTxGfT2y",
+	"iOBvnEfROOcDVXjO67pAqcp9UQRXhaflzRqyhgGHc56mUFBWmOR6gIStit5TYxjVbDsHSfXCCXn9Ggh9",
+	"lG9BehuhddROZnHKrtdKQ/OMJmu0VMYjB1Wea+BXAmEg9jNd+Kj3g6Zymxm55VVVJnvBxGPcr0JgI8Wd",
+	"tXr/WkbCn/Y6Qsaglso6p8Uedqd1La8poDfuSwiwzepvNRd2/PuG3Go+7h/dHwXVlqMPHrFfm7NVTx8q",
+	"hihTImxTcMUbuDtj1iiN6T1ukz2MgCqHqp3+2qTPpHadqhFM2FSyJyy1uQweCqoFrcrdoznLhL5kDykl",
+	"lTOqZNxwNEA8Yqpk7qzY6ufex2wH5tHh4Q/1lc4dC66DH+50343p84JqI80WtcgmWvwNdSh7CMAHg0SG",
+	"ZVqxJS9vsRRbKGhoZmsnu27N3xzYA6ca4iQNxpQt4lYLAXE+scfX1edwaBFwF8fdQzeP3HUd5NQLaO3/",
+	"+E0d3gxRa3Nrwa31tmV3mtrWLabHn4XpgAoje5nSIAn4LX8QznqnoMHaGdnNOY7DX1V5CeWQuEP6hrog",
+	"bePXFSLvmkbwWVeqIlUbUuk2TSL6e8u4EktFo1TrmhDDCzL9XhLKhEws37OccMgLnJKTTI3kcnPYQKVb",
+	"HESUp0M/97GJsW0vsVvnXYmap8IJU5zMZ+llw2pvDabDa0KIS3KQq1ggzblaGmsV6mfo7+6M3YmR6p63",
+	"kKfJAtKTpQMioCwpqrxEkjvA5TQu7u/NQhFS0uBOz7vUPYDsGiuxExqWktijvrpsBfo2WuZAw6ZKuOeA",
+	"3yB1x4EBv/0iGVOXR+sgsqg09Nc5efNbuI1IjM3apkar95E7VtZySpB5vFotdqcod4sQbHTECFT2t6d/",
+	"YyUs8UAaxR4/pgkeP567pn971v6MjODx4+hZ/WTx7RZHbgw3b4xifhnKeGazeg0k1+vsB+bh20cYrVSJ",
+	"GB8AErTQlAzwd5eQ9dPq9jwENlCsf1QtrHeJbrWIiay1NXkwVZAEcUL+Q9ctku2QnLDTqhRmR3VivAVO",
+	"/B6NxP++DkV0oay1S4HTxRl1CXWloSZwsdJe2/e94jnpx6yngwRmsA4x+3bLN0UO7qD89cHiP+D5X15k",
+	"T54//Y/FX5588SSFF1989eQJ/+oFf/rV86fw7C9fvHgCT5dffrV4lj178Wzx4tmLL7/4Kn3+4unixZdf",
+	"/ceD2XwmEGQL6MxnJZ/9zwRLMienb86SCwS2wQkvBEZ73tyQqWupSERCpKZ0EmHDRT478T/9//6EHaVq",
+	"0wzvf525pMeztTGFPjk+vr6+Pgq7HK8oUikxqkrXx36em3kH46dvzmqXSPv0pR21+QK9c5knhVP69vbb",
+	"8wt2+ubsqCGY2cnsydGTo6c4vipA8kLMTmbP6Sc6PWva92NHbLOTDzfz2fEaeG7W7o8NmFKk/lMJPNu5",
+	"/+trvkLxh7xe7U9Xz479C+f4gxOGb8a+HQdXCP7c/JWIbE9PrYF+cAVNxlu3Koa4gL6gw0QoxpodL9T2",
+	"gKagg8bDSyHjhz7+QNLK4O/HLrFr/COZUex5OPbRn/GWLSx9MFuEtdMj5SZdV8XxB/oP0WcAlk2jdGy2",
+	"8pjcZY4/iKz/ubea9u9N97DF1UZl4AHm2RWXKbge+mawobcM7W1w/MH/b3gwtVzaslBjn48/2H+Hh6kz",
+	"NR5/qP8bae2zWOiRT8cfkP/c7G9xXGI20QB2fNeUYgPShMixL5pjSiC/6/+8k2n0x/6OFt2qy1EnrLc2",
+	"uy1nudAmXq12Np/VTO8so7vIdCP1NRWdto57xNCePXniubiz2QQn8NgxrKBk5LS4v86skdu9z8bHVnYz",
+	"n704ENBRu3wrQVUEmK95xnysEc399NPNfSYp3B/vJ2bvX4LgxaeDoLV97AfYsdfKsO/o1Xgzn33xKXfi",
+	"DFmN5DmjlkEBn/4R+VleSnUtfUsU3KrNhpe7ycfH8JUmJ7FSXHEnNtfN5Gr2noIgbfxZ+6idZlmP6K0A",
+	"C9p8rbLdCMY2elW4dJQN0hr5XUhcQt8geTOPaGp6y2I2INyHAUiVwSyUrE1Zwc0deULH35CX5iyiSCJH",
+	"EdJ/LJnpgRrNG9H13bMj999e+0i4qTynqwVZFpT8k6f8yVNqnvLFk+efbvpzKK9ECuwCNoUqeSnyHftZ",
+	"1snEb83jTrMsmmynffT38jjUjaQqgxXIxDGwZKGynS/K2JrgEuxTvSfIHH9o/elE9VkGOZhoIhH8nXG2",
+	"oqIA/UUsduzsZU/Csd26nPfrHTUNKpaf/PbBvnXxIdc8Rbsg9jhjWCy7y5vex7nmGNnjQlbKMIuFzC3q",
+	"T0b0JyO6k3Az+fBMkW+irw9bqoP37uy5r7oRq+nETR+UKW+Uz3p872Xj+++f2HvHJi3CMK7mg43g6qL5",
+	"TxbxJ4u4G4v4HiKHkU6tYxoRojvsPTSVYVAobdYylpLXlVF18yrnZRC4t0/NcUojOuXGp+Aan/pRF8VV",
+	"lvlcNVthPcwjG3i/77w/Wd6fLO9fh+Wd7mc0bcHkzi+jS9hteFG/h/S6Mpm6Diw+BAuBoo917cs0oO8t",
+	"VGn0vHEvibksWT+Tee0S2DhhN16BfMWF1Kb207R2xhI0FradO2eejuNUn+uyNb/yc9hMD7ajdYejWBfn",
+	"snkUk/EGPLk+IkMamDH2Ho+2fFsXJyh5YU3G8YY255QL27R9jv45OMj/U0qVvqufK4TuUrDfSXTy3sJV",
+	"HbHQdy08miQwRThBzyJUs4bW38fXXBh0VnHJcKnSf7+zAZ4fuyJinV+buh29L1SMJPgxTEsS/fWYBK3B",
+	"j12zceyrM5sONPKZDfznxoUkdMkgIa92xvjtPQpoVKbbyX+Nh8HJ8TElmFwrbY5nN/MPHe+D8OP7eic/",
+	"1FKj29Gb9zf/dwCJr0LLjvUAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -177,197 +177,210 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9fXPcNpIw/lXwm7sq27qhJL8ku1ZV6n6ynWR1sR2XpWR3z/KTxZA9M1iRAAOAo5n4",
-	"8Xd/Cg2ABElwhiMp9ubOf9ka4qXRaDT6Dd0fJqkoSsGBazU5+TApqaQFaJD4F01TUXGdsMz8lYFKJSs1",
-	"E3xy4r8RpSXji8l0wsyvJdXLyXTCaQFNG9N/OpHwa8UkZJMTLSuYTlS6hIKagfWmNK3rkdbJQiRuiFM7",
-	"xNmLycctH2iWSVCqD+WPPN8QxtO8yoBoSbmiqfmkyDXTS6KXTBHXmTBOBAci5kQvW43JnEGeqUO/yF8r",
-	"kJtglW7y4SV9bEBMpMihD+dzUcwYBw8V1EDVG0K0IBnMsdGSamJmMLD6hloQBVSmSzIXcgeoFogQXuBV",
-	"MTl5N1HAM5C4WymwFf53LgF+g0RTuQA9eT+NLW6uQSaaFZGlnTnsS1BVrhXBtrjGBVsBJ6bXIXlVKU1m",
-	"QCgnb797Th4/fvzULKSgWkPmiGxwVc3s4Zps98nJJKMa/Oc+rdF8ISTlWVK3f/vdc5z/3C1wbCuqFMQP",
-	"y6n5Qs5eDC3Ad4yQEOMaFrgPLeo3PSKHovl5BnMhYeSe2MZ3uinh/J91V1Kq02UpGNeRfSH4ldjPUR4W",
-	"dN/Gw2oAWu1LgylpBn13nDx9/+Hh9OHxx397d5r8t/vzq8cfRy7/eT3uDgxEG6aVlMDTTbKQQPG0LCnv",
-	"4+Otowe1FFWekSVd4ebTAlm960tMX8s6VzSvDJ2wVIrTfCEUoY6MMpjTKtfET0wqnhs2ZUZz1E6YIqUU",
-	"K5ZBNjXc93rJ0iVJqbJDYDtyzfLc0GClIBuitfjqthymjyFKDFw3wgcu6F8XGc26dmAC1sgNkjQXChIt",
-	"dlxP/sahPCPhhdLcVWq/y4pcLIHg5OaDvWwRd9zQdJ5viMZ9zQhVhBJ/NU0Jm5ONqMg1bk7OrrC/W43B",
-	"WkEM0nBzWveoObxD6OshI4K8mRA5UI7I8+eujzI+Z4tKgiLXS9BLd+dJUKXgCoiY/RNSbbb9v85/fE2E",
-	"JK9AKbqANzS9IsBTkUF2SM7mhAsdkIajJcSh6Tm0DgdX7JL/pxKGJgq1KGl6Fb/Rc1awyKpe0TUrqoLw",
-	"qpiBNFvqrxAtiARdST4EkB1xBykWdN2f9EJWPMX9b6ZtyXKG2pgqc7pBhBV0/c3x1IGjCM1zUgLPGF8Q",
-	"veaDcpyZezd4iRQVz0aIOdrsaXCxqhJSNmeQkXqULZC4aXbBw/h+8DTCVwCOH2QQnHqWHeBwWEdoxpxu",
-	"84WUdAEByRySnxxzw69aXAGvCZ3MNviplLBiolJ1pwEYcertEjgXGpJSwpxFaOzcocMwGNvGceDCyUCp",
-	"4JoyDplhzgi00GCZ1SBMwYTb9Z3+LT6jCr5+MnTHN19H7v5cdHd9646P2m1slNgjGbk6zVd3YOOSVav/",
-	"CP0wnFuxRWJ/7m0kW1yY22bOcryJ/mn2z6OhUsgEWojwd5NiC051JeHkkh+Yv0hCzjXlGZWZ+aWwP72q",
-	"cs3O2cL8lNufXooFS8/ZYgCZNaxRhQu7FfYfM16cHet1VK94KcRVVYYLSluK62xDzl4MbbIdc1/CPK21",
-	"3VDxuFh7ZWTfHnpdb+QAkIO4K6lpeAUbCQZams7xn/Uc6YnO5W/mn7LMTW9dzmOoNXTsrmQ0HzizwmlZ",
-	"5iylBolv3Wfz1TABsIoEbVoc4YV68iEAsZSiBKmZHZSWZZKLlOaJ0lTjSP8uYT45mfzbUWN/ObLd1VEw",
-	"+UvT6xw7GZHVikEJLcs9xnhjRB+1hVkYBo2fkE1YtodCE+N2Ew0pMcOCc1hRrg8blaXFD+oD/M7N1ODb",
-	"SjsW3x0VbBDhxDacgbISsG14T5EA9QTRShCtKJAucjGrf7h/WpYNBvH7aVlafKD0CAwFM1gzpdUDXD5t",
-	"TlI4z9mLQ/J9ODaK4oLnG3M5WFHD3A1zd2u5W6y2Lbk1NCPeUwS3U8hDszUeDUbMvwuKQ7ViKXIj9eyk",
-	"FdP4L65tSGbm91Gd/xgkFuJ2mLhQ0XKYszoO/hIoN/c7lNMnHGfuOSSn3b43IxszSpxgbkQrW/fTjrsF",
-	"jzUKryUtLYDui71LGUclzTaysN6Sm45kdFGYgzMc0BpCdeOztvM8RCFBUujA8CwX6dVfqFrewZmf+bH6",
-	"xw+nIUugGUiypGp5OIlJGeHxakYbc8RMQ1TwySyY6rBe4l0tb8fSMqppsDQHb1wssajHfsj0QEZ0lx/x",
-	"PzQn5rM524b122EPyQUyMGWPs3MyZEbbtwqCnck0QCuEIIVV8InRuveC8nkzeXyfRu3Rt9am4HbILQJ3",
-	"SKzv/Bg8E+sYDM/EuncExBrUXdCHGQfFSA2FGgHfCweZwP136KNS0k0fyTj2GCSbBRrRVeFp4OGNb2Zp",
-	"jLOnMyFvxn06bIWTxuRMqBk1YL7TDpKwaVUmjhQjZivboDNQ4+XbzjS6w8cw1sLCuaa/AxaUGfUusNAe",
-	"6K6xIIqS5XAHpL+MMv0ZVfD4ETn/y+lXDx/98uirrw1JllIsJC3IbKNBkftONyNKb3J40F8ZakdVruOj",
-	"f/3EGyrb48bGUaKSKRS07A9lDaBWBLLNiGnXx1obzbjqGsAxh/MCDCe3aCfWtm9Ae8GUkbCK2Z1sxhDC",
-	"smaWjDhIMthJTPsur5lmEy5RbmR1F6osSClkxL6GR0yLVOTJCqRiIuJNeeNaENfCi7dl93cLLbmmipi5",
-	"0fRbcRQoIpSl13w837dDX6x5g5utnN+uN7I6N++YfWkj31sSFSlBJnrNSQazatHShOZSFISSDDviHf09",
-	"aBQFLlgB55oW5Y/z+d2oigIHiqhsrABlZiK2hZHrFaSC20iIHdqZG3UMerqI8SY6PQyAw8j5hqdoZ7yL",
-	"YzusuBaMo9NDbXgaaLEGxhyyRYssb6+tDqHDTnVPRcAx6HiJn9HQ8QJyTb8T8qKxBH4vRVXeuZDXnXPs",
-	"cqhbjDOlZKav16EZX+Tt6JuFgf0wtsbPsqDn/vi6NSD0SJEv2WKpA7XijRRifvcwxmaJAYofrFKWmz59",
-	"1ey1yAwz0ZW6AxGsGazhcIZuQ75GZ6LShBIuMsDNr1RcOBuI10BHMfq3dSjv6aXVs2ZgqCullVltVRL0",
-	"3vbui6ZjQlN7QhNEjRrwXdVOR9vKTmdjAXIJNNuQGQAnYuYcRM51hYuk6HrWXrxxomGEX7TgKqVIQSnI",
-	"EmeY2gmab2evDr0FTwg4AlzPQpQgcypvDezVaiecV7BJMFBCkfs//KwefAZ4tdA034FYbBNDb63mOy9g",
-	"H+px028juO7kIdlRCcTfK0QLlGZz0DCEwr1wMrh/XYh6u3h7tKxAoj/ud6V4P8ntCKgG9Xem99tCW5UD",
-	"4X9OvTUSntkwTrnwglVssJwqnexiy6ZRSwc3Kwg4YYwT48ADgtdLqrT1ITOeoenLXic4jxXCzBTDAA+q",
-	"IWbkn70G0h87NfcgV5Wq1RFVlaWQGrLYGjist8z1Gtb1XGIejF3rPFqQSsGukYewFIzvkGVXYhFEde1q",
-	"cUEW/cWhQ8Lc85soKltANIjYBsi5bxVgNwyBGgCEqQbRlnCY6lBOHXc1nSgtytJwC51UvO43hKZz2/pU",
-	"/9S07RMX1c29nQlQGHnl2jvIry1mbfDbkiri4CAFvTKyB5pBrLO7D7M5jIliPIVkG+WjimdahUdg5yGt",
-	"yoWkGSQZ5HTTH/Qn+5nYz9sGwB1v1F2hIbFRTPFNbyjZB41sGVrgeComPBL8QlJzBI0q0BCI671j5Axw",
-	"7BhzcnR0rx4K54pukR8Pl223OjIi3oYroc2OO3pAkB1HHwPwAB7qoW+OCuycNLpnd4q/g3IT1HLE/pNs",
-	"QA0toRl/rwUM2FBdgHhwXjrsvcOBo2xzkI3t4CNDR3bAoPuGSs1SVqKu8wNs7lz1604QdTOSDDRlOWQk",
-	"+GDVwDLsT2z8TXfMm6mCo2xvffB7xrfIcnKmUORpA38FG9S539jAzsDUcRe6bGRUcz9RThBQHy5mRPCw",
-	"CaxpqvONEdT0EjbkGiQQVc0KprUN2G6rulqUSThA1K+xZUbnxLNBkX4HxngVz3GoYHn9rZhOrE6wHb6L",
-	"jmLQQofTBUoh8hEWsh4yohCMivcgpTC7zlzsuI8e9pTUAtIxbfTg1tf/PdVCM66A/F1UJKUcVa5KQy3T",
-	"CImCAgqQZgYjgtVzusiOBkOQQwFWk8QvBwfdhR8cuD1niszh2j+4MA276Dg4QDvOG6F063DdgT3UHLez",
-	"yPWBDh9z8TktpMtTdkcWuJHH7OSbzuC1l8icKaUc4Zrl35oBdE7meszaQxoZF1WB447y5QRDx9aN+37O",
-	"iiqn+i68VrCieSJWICXLYCcndxMzwb9d0fzHuhs+JoHU0GgKSYpPIEaOBRemj301sUs3bKLJWFFAxqiG",
-	"fENKCSnYKH8j8qkaxkNi4//SJeULlPSlqBYuAM2Og5y6UtamIiveGyIqDek1T9A6HePcLujYP/QwchBQ",
-	"o4t1TdtW87im9Xzubc+YKzVAXtfUH/VuTSeDqqpB6qpRVS1y2q9VRnDxlqAW4KeZeKQPBFFnhJY+vsJt",
-	"MafAbO7vY2tvho5B2Z84CIlrPg5FxRk9Od/cgbRiByISSgkK75bQvqTsVzEPX6a5y0dtlIaib4K3XX8Z",
-	"OH5vBxU9wXPGISkEh030MTbj8Ao/Ro8T3m8DnVHSGOrbVR5a8HfAas8zhhpvi1/c7e4J7bqa1HdC3pUv",
-	"0w44Wi4f4Trc6Sd3U97UwUnzPOITdO9WugxATet38kwSqpRIGQpbZ5ma2oPm3IjukUsb/W/qaNw7OHvd",
-	"cTvOr/BJJBp3IS8JJWnO0PQruNKySvUlp2hcCpYaiVryWvSwufG5bxK3b0bMj26oS04xYq02OUUjLeYQ",
-	"sa98B+CtjqpaLEDpjpIyB7jkrhXjpOJM41yFOS6JPS8lSAwdOrQtC7ohc0MTWpDfQAoyq3RbbMdnWUqz",
-	"PHeeODMNEfNLTjXJgSpNXjF+scbhvLfeH1kO+lrIqxoL8dt9ARwUU0k8uup7+xUDX93yly4IFp/R28/W",
-	"d2PGb95ubdD21DwN/z/3//Pk3Wny3zT57Th5+h9H7z88+fjgoPfjo4/ffPN/2z89/vjNg//899hOedhj",
-	"j4Yc5GcvnEp79gL1lsZ504P9kxnuC8aTKJGFYRgd2iL38YGsI6AHbauWXsIl12tuCGlFc5YZ3nITcuje",
-	"ML2zaE9Hh2paG9GxYvm17qkN3ILLkAiT6bDGG0tR/YDE+PM89Ca6F3d4XuYVt1vppW/7+sQHhon5tH6C",
-	"abOznBB8n7ekPqrR/fnoq68n0+ZdXf19Mp24r+8jlMyydez1ZAbrmJLnDggejHuKlHSjQMe5B8IejYGz",
-	"QRnhsAUUM5BqycpPzymUZrM4h/Mx/c5YtOZn3Abbm/ODvsmNc3mI+aeHW0uADEq9jGVtaAlq2KrZTYBO",
-	"vEgpxQr4lLBDOOwaazKjL7povBzoHLMHoPYpxmhD9TmwhOapIsB6uJBRFpEY/aDI47j1x+nEXf7qztUh",
-	"N3AMru6ctSPS/60Fuff9txfkyDFMdc8+5LVDB08vI6q0e13UiiQy3MzmqrFC3iW/5C9gzjgz308ueUY1",
-	"PZpRxVJ1VCmQz2hOeQqHC0FO/IOlF1TTS96TtAbTSQVPxUhZzXKWkqtQIWnI06YI6Y9wefmO5gtxefm+",
-	"F1TRVx/cVFH+YidIjCAsKp24BAeJhGsqY04rVT9wx5FtBpNts1ohW1TWsukTKLjx4zyPlqXqPnTtL78s",
-	"c7P8gAyVe8ZptowoLaSXRYyAYqHB/X0t3MUg6bW3q1QKFPlHQct3jOv3JLmsjo8fA2m9/PyHu/INTW5K",
-	"GG1dGXyI2zWq4MKtWglrLWlS0kXMN3Z5+U4DLXH3UV4u0MaR5wS7tV6c+oh6HKpZgMfH8AZYOPZ+PYeL",
-	"O7e9fDKr+BLwE24htjHiRuOxv+l+BW9Qb7xdnXesvV2q9DIxZzu6KmVI3O9MneNmYYQsH0ah2AK1VZcO",
-	"aAYkXUJ65fK0QFHqzbTV3UfqOEHTsw6mbAYf+4IMc0igZ2EGpCoz6kRxyjfdx/wKtPbxwG/hCjYXoklB",
-	"sc/r/fZjcjV0UJFSA+nSEGt4bN0Y3c134WCo2Jelf5ONj/M8WZzUdOH7DB9kK/LewSGOEUXrsfMQIqiM",
-	"IMIS/wAKbrBQM96tSD+2PKNlzOzNF8nm43k/cU0a5clFboWrQau7/V4ApgMT14rMqJHbhctkZR9MB1ys",
-	"UnQBAxJy6NwZ+Sy55RDCQXbde9GbTsy7F1rvvomCbBsnZs1RSgHzxZAKKjOdeD0/k/UfOs8EJqh0CJvl",
-	"KCbVgY2W6VDZcrLZjHtDoMUJGCRvBA4PRhsjoWSzpMon2cJcZP4sj5IBfscEANvSvpwFoWZBwrE6qYvn",
-	"ud1z2tMuXfIXn/HFp3kJVcsRKVuMhI/R7bHtEBwFoAxyWNiF28aeUJpkBM0GGTh+nM9zxoEksai1wAwa",
-	"XDNuDjDy8QEh1gJPRo8QI+MAbPSL48DktQjPJl/sAyR3yRSoHxs96sHfEH/3ZeO4jcgjSsPC2YBXK/Uc",
-	"gLpQx/r+6gTc4jCE8SkxbG5Fc8PmnMbXDNLLPoJiayfXiIvMeDAkzm5xgNiLZa812avoJqsJZSYPdFyg",
-	"2wLxTKwT+/AzKvHO1jND79HQdnyGGjuYNs/LPUVmYo3RPni12FDqHbAMw+HBCDT8NVNIr9hv6Da3wGyb",
-	"drs0FaNChSTjzHk1uQyJE2OmHpBghsjlfpC65UYAdIwdTR5kp/zuVFLb4kn/Mm9utWmTksy/Good/6Ej",
-	"FN2lAfz1rTB1spU3XYklaqdoB62088wEImSM6A2b6Dtp+q4gBTmgUpC0hKjkKuY5NboN4I1z7rsFxgvM",
-	"ZkP55kEQCSVhwZSGxoju4yQ+h3mSYhI9IebDq9OlnJv1vRWivqasGxE7tpb5yVeAocRzJpVO0AMRXYJp",
-	"9J1Cpfo70zQuK7VjrWzKWZbFeQNOewWbJGN5FadXN+8PL8y0r2uWqKoZ8lvGbcDKDFMkRyMwt0xtg3S3",
-	"LvilXfBLemfrHXcaTFMzsTTk0p7jD3IuOpx3GzuIEGCMOPq7NojSLQwyeDnb546B3BT4+A+3WV97hynz",
-	"Y++M2vHvd4fuKDtSdC2BwWDrKhi6iYxYwnSQYbj/pHXgDNCyZNm6Ywu1ow5qzHQvg4fPy9bBAu6uG2wH",
-	"BgK7Z+xVjQTVTsHXCPg2V3QrA87hKMxctBPlhQwhnIopX+mgj6j61d0uXF0AzX+Azc+mLS5n8nE6uZ3p",
-	"NIZrN+IOXL+ptzeKZ3TNW1NayxOyJ8ppWUqxonniDMxDpCnFypEmNvf26E/M6uJmzItvT1++ceB/nE7S",
-	"HKhMalFhcFXYrvzDrMpm+xs4ID6TutH5vMxuRclg8+sUZaFR+noJLiV1II32cmc2DofgKDoj9TweIbTT",
-	"5Ox8I3aJW3wkUNYuksZ8Zz0kba8IXVGWe7uZh3YgmgcXNy4Ba5QrhAPc2rsSOMmSO2U3vdMdPx0Nde3g",
-	"SeFcW5JmFzYvvCKCd13oGPO8KZ3XvaCY+dJaRfrMiVcFWhISlbM0bmPlM2WIg1vfmWlMsPGAMGpGrNiA",
-	"K5ZXLBjLNBuT26YDZDBHFJkqml6nwd1MuJo/FWe/VkBYBlybTxJPZeegYpoUZ23vX6dGdujP5Qa2Fvpm",
-	"+NvIGGHW1+6Nh0BsFzBCT10P3Be1yuwXWlukzA+BS2IPh384Y+9K3OKsd/ThqNkGLy7bHrewRE+f/xnC",
-	"sLnad9cH8sqrSz87MEe03g9TyVyK3yCu56F6HHmw5PPcMoxy+Q3Chw5hlYsWi6mtO03Zomb2we0ekm5C",
-	"K1Q7SGGA6nHnA7ccJtz0FmrK7VbbhyStWLc4wYRRpUd2/IZgHMy9SNycXs9oLBupETIMTKeNA7hlS9eC",
-	"+M4e96p+bWFnJ4EvuW7L7GP0EmTzlrCf2OaGAoOddrSo0EgGSLWhTDC1/r9cicgwFb+m3FZxMf3sUXK9",
-	"FVjjl+l1LSSmklBxs38GKStoHpccsrRv4s3YgtkCJZWCoAKGG8gWf7JU5KqI1G+IHGrO5uR4GpThcbuR",
-	"sRVTbJYDtnhoW8yoQk5eG6LqLmZ5wPVSYfNHI5ovK55JyPRSWcQqQWqhDtWb2nk1A30NwMkxtnv4lNxH",
-	"t51iK3hgsOju58nJw6dodLV/HMcuAFdgZhs3yZCd/NWxkzgdo9/SjmEYtxv1MPrq3laYG2ZcW06T7Trm",
-	"LGFLx+t2n6WCcrqAeKRIsQMm2xd3Ew1pHbzwzJZHUlqKDWE6Pj9oavjTQPS5YX8WDJKKomC6cM4dJQpD",
-	"T015CzupH87WWnKZiT1c/iP6SEvvIuookZ/WaGrvt9iq0ZP9mhbQRuuUUJs/JGdN9ILPl07OfHoiTNVc",
-	"Z2i2uDFzmaWjmIPBDHNSSsY1KhaVnid/JumSSpoa9nc4BG4y+/pJJD11O00q3w/wT453CQrkKo56OUD2",
-	"XoZwfcl9LnhSGI6SPWheewSnctCZG3fbDfkOtw89VigzoySD5Fa1yI0GnPpWhMe3DHhLUqzXsxc97r2y",
-	"T06ZlYyTB63MDv309qWTMgohYzkHm+PuJA4JWjJYYexefJPMmLfcC5mP2oXbQP95PQ9e5AzEMn+WY4rA",
-	"MxHRTn3K9NqS7mLVI9aBoWNqPhgymLmhpqSdnvrT89G7iYKKe7q8Ybvv2DJfPB7wjy4iPjO54AY2vny7",
-	"kgFCCdLzR0kmq78HPnZKnon1WMLpnEJPPP8CKIqipGJ59nPz8rNT/UBSni6jPrOZ6fhLU6etXpy9A6Pp",
-	"A5eUc8ijw1l58xcvl0Yk53+KsfMUjI9s2y3IYJfbWVwDeBtMD5Sf0KCX6dxMEGK1/aiuDtrOFyIjOE+T",
-	"q645rv1CHkG69V8rUDr2QAk/2MAxtI0admCzfRPgGWqkh+R7W4p5CaSViAg1QZ8pov1quipzQbMpZrC4",
-	"+Pb0JbGz2j622pDNNr5ARai9io5NLEjDOS4E2RcOij+PGD/O9nhts2qlkzo5eOwBqmnRpC9nHT8Bqkgh",
-	"dg7Ji6Coqn2raoYgmMBEFkarq0ez8hHShPmP1jRdotrXYq3DJD8+Tb6nShWUpqxLTNW5KfHcGbhdpnyb",
-	"KH9KhNHNr5myFXhhBe03r/UDcGd28G9g28uTFeeWUg73uOXqTJT7ot0DZ69I70qIQtZB/J5Cv60ysW/V",
-	"gHPsFU2V1S1B0KtJaV9Q1qWDfGX1lHLBWYqJqmJXtCvVO8bPNiKnV9eQ64+4O6GRwxUtfFCH4jksDpZC",
-	"8IzQIa5v6A++mk211GH/1FgTdkk1WYBWjrNBNvX1O5ytkXEFLtcoFnYO+KSQLd8lcsioOzyp3SZ7khE+",
-	"vRlQHr8z31470wLGpF8xjkqEQ5sT/Kw1ECuJaqN5ME0WApRbT/v9sXpn+hziU9wM1u8PfeVRHMO6/syy",
-	"rZ+7P9Sp93o7L7Np+9y0dQmS6p9bUc520tOydJMOV3eJygN6zQcRHPFeJt59FCC3Hj8cbQu5bQ1XwfvU",
-	"EBqs0NkNJd7DPcKoK510qmgZodVSFLYgNkwsmiWB8QgYLxmHpi5u5IJIo1cCbgye14F+KpVUWxFwFE+7",
-	"AJqjhzvG0JR27o3bDtVND2VQgmv0cwxvY1OkZYBx1A0awY3yTV2O11B3IEw8xzrgDpH9kisoVTkhKsNX",
-	"C50iLDHGYRi3L/PUvgD6x6AvE9numCtt35to6CHqrMoWoBOaZbHUr8/wK8GvJKtQcoA1pFWdIrQsSYp5",
-	"V9qJaPrU5iZKBVdVsWUu3+CW0wVVjSLUEFZW8juMD11mG/w3lh9zeGdcoMfeoYY+qiPbL/tSP3QyJvUa",
-	"mk4UWyTjMYF3yu3R0Ux9M0Jv+t8ppedi0QbkE6ef2Mblwj2K8bdvzcURZmfoJX21V0udPAED+4SvRYlq",
-	"Y/3st82V8CrrZYFFh1Jd6267AWK4at0UL7+B8N4g6Qa196v1UA4F+aaDMelUu9dxmpKtLGjwxZGNELJv",
-	"ixCKuHV2KCrIBgWZz73e4yTDnpyt44kPA4T6cLM+QD/4WFZSUubc7w2z6GPWRb333yGMiYdtNri7CBdL",
-	"Pmix+2E1FPftk7Hh925VqytwT+ZLCSsmKu/Y9pFPXiW0v7ZqRNWR99H19w2vONXnNYcOGm8vXHUBu0yn",
-	"k//ws42TI8C13PwLmHJ7m96rl9WXdq15qmlC6sTUoxJVt27FMYkKYznxnGzYqti1o95Yj6xejBEH+vXD",
-	"ppOzbK8LM5ZXcWJHiR27eDWw4bRTTaopPGKlUKzJDx8rEzYyxPACK30FabP6Y/n4nhWkGosCNHELEmCf",
-	"JFpmsqDw6Jf0UwPqdB2J6bJObUs11a8EsOOO770GC1402izqh+MTK53W0WnIpzEb8gK4q/3ZfucxOtp8",
-	"PodUs9WO13d/XQIPXnZNvV3G1vAOHuOxOnoZk7fsb3VsANr2OG4rPEESxVuDM/T25go29xRpUUM0rfvU",
-	"X7U3yduBGEDukBgSESoW/WENyc4hz1RNGYgFH21lu0OTAW2wIlTwlvSGc3mSNBdH8750y5TxkjSj5jJd",
-	"93p1jYG4Qw/0+hUthvWPF1hARNXVGn3ej1BLJ2f97IjXLm8IvpWsfSc+gwgo/5t/GG1nydkVhDWr0FN1",
-	"TWXmW0RNL96qk2y5j3qv6nw1hi7Q83pm1sTG9t9RRfJtYQR0mgsjRiRDYeTtcNQ6luOeskE3Nv07Btoa",
-	"uOYgXW0/lH9zoSDRwsfSboNjGypsZNGNkKAGc1xa4AYzz7xtUutgrl+KmWaoCygKF0gkFNRAJ4MEOMNz",
-	"bkP2c/vdPxzyuV53Wphqet1ddMBHRTPVQ2JI9XPibsvdD5JuYmxinNv60SqWDYeDbHtDSimyKrUXdHgw",
-	"aoPc6FxTW1hJ1E6T9lfZ0RGCV51XsDmySpCv1uB3MATaSk4W9CCLQmeT79T8pmJwL+4EvM9puZpOSiHy",
-	"ZMDZcdZP4dOl+CuWXkFGzE3howcHKuiQ+2hjr73Z18uNT1lTlsAhe3BIyCm38dresd3OId2ZnN/T2+Zf",
-	"46xZZbNqOaPa4SWPB75ivit5S27mh9nOwxQYVnfLqewgOxLErAfSB0l6HakndThWK++7mrs1fhqislDE",
-	"ZJKmfM2OOJk6RKap/NGEyfSlgzwX1wlSUVLn/4rpHKZdm0n6jKdNN4PtGQTxNlS5C3RDljQjqZAS0rBH",
-	"/ImDBaoQEpJcYPhNzDM410YeKjCumZNcLIgojZpr0+h5H0q0LE0w112V4LHPdS0EiXX4DCREAOWe5zpw",
-	"beM+vFuq4OxfYediGbHb4Ib53dq7jI4juL2rXwRgjiD03Tar01iVoPa6uvWqhqrHaVGwNI7uP1a0ymCM",
-	"SYx6Y6hwCWjtAzhshgc85Cm1cxJPTx/NwOksj3lsiDt+zkmDdG7+izdYd1wyB8dcBvhZ5AHmtlXHKj9F",
-	"drWeyhWm8m8qBygk6vDe7l+21QBnY73MdcbpkcwgAGDY79yCYZT3eV8w5lhdM6ERJJ/VMv+0VfyYdTie",
-	"zwZoT3ZKrc6/BGLGriS4N362DGCn7lBJ9dLLAKZ5XzM3Wh4ofIBni6dQZe1I3p7lahB2hStRJjmsoOWO",
-	"dw8PqzQFpdgKwvqFtjPJAEq07nZ1jpifOeTtHUHUrT0JPJVjsBuVTC1i7U6RHWJnVEhe88QeEzX2KBmI",
-	"ViyraAt/6haV3IaKuEUuHw/r+3GcYm8mEV/cNhaxMzIEaT56Lnk8MCR891qblHC2rDY9WyJsTrYq6TUf",
-	"VsH6RNnITuNrIAaI/XYNKd5D7ciH2+OE4GBEdd60DwpNst7hm6ryg1S2jch6FSGjUpsCX9E3TD/jBV/X",
-	"NyLtWqMjU5EBmGp4A8ZRQhOnFzQr6IZkbD4Had0qSlOeUZmFzRknKUhNmdExN+rmCoaBVlYw3aljGE6N",
-	"g3pmFdM20EJoAck3Tnkbkv9HyO3oQ4vI7Pba1mKoWGVvV+IPO+ja6DkY4TZABO5JOmo59rAKjiImKegV",
-	"7DmPYr/B9mkwUYyzwmqBs46Z4uNWWv8RUYcH/ifO9FZqt6JfN+TQ+oQsMXoa5IvGMW03p0+DsSjRC1sy",
-	"KYwU7VYg8HttDVR2PhjIqOh4Z4I8VW1x+YIKaiWlzmTXFwd6zNgCM3URtHtJC11zQ7qDKUVZ9MCZaMvq",
-	"Yo7UiZtiLyaMG6jZ8bQb0dK+guptx+qfaSVRiLqmm92J2ZprKB4MbEf26oyPcaihdlttCUzZghLRvGf7",
-	"iCcRmo/VVOhnnLr7xdgo98YP9/stx1na4wsIK7Rvp7dGkPekEqE1yjexo+NtyTdY4JB0MiJO8862qj4t",
-	"v8cGRVn0zRKRjgKtH7MXwWZQOXh7GEWYp7h5AC1t6Ce6Xb0+1OUXrxo9aVwNY99hB3hhdE1Qxdg7Ohw4",
-	"n/kl8asaKcFS3g9RQmv5uwJ23AIbxTLYIieraQ02a7x9fdbelyAaSz2vg5yGCm53Y6EwKbERDvI8EkNl",
-	"xUdb4jYgHHNPyhXNP30cFGarPkV8QPZ22HMaBtKESLaoVDd7xveSjpo7CJq5u6n5G4zb+iuYPYpeC24o",
-	"p7H2mD8K/zS3Vv65r3e5Ak6ucUwb9P3wazJzaU5KCSlTXU342peiquNGsDKjezq51jsCVXat82ehb0HG",
-	"c29YIq+bsjZoyF7wBsLmiH5mpjJwcqNUHqO+HllE8BfjUWG+0R3XxVUrGryR6oIbTUi446jw4H3XnlHh",
-	"/UyqY5dnI5/NpVMp6K9z9G3dwm3kom7WNvZJQx+522qfjHmJEC9pZLrjUwiLEKwHRhBU8o+H/yAS5ljw",
-	"V5CDA5zg4GDqmv7jUfuzOc4HB1El75M9grA4cmO4eWMU8/PQs3j79HsgA0NnPyqWZ7sIo5VPoymZjRkj",
-	"fnFZez5L0e5fbGBm/6i6wqm3iCa3iImstTV5MFWQKWNEkgzXLZISA4Me0koyvcFkwl7jZb9En2t8X4f+",
-	"utDx2oTn7j4trqBOR90EClfK367fC5rjfWQti9zcQiI/JN+uaVHm4A7KN/dmf4LHf36SHT9++KfZn4+/",
-	"Ok7hyVdPj4/p0yf04dPHD+HRn796cgwP518/nT3KHj15NHvy6MnXXz1NHz95OHvy9dM/3TN8yIBsAZ34",
-	"1HWTv2Fl++T0zVlyYYBtcEJL9gNsbBFdQ8a+PC9N8SRCQVk+OfE//f/+hB2momiG979OXGasyVLrUp0c",
-	"HV1fXx+GXY4WGBmYaFGlyyM/T69+7+mbs9oFaY3+uKM2qYR35nhSOMVvb789vyCnb84OG4KZnEyOD48P",
-	"H5rxRQmclmxyMnmMP+HpWeK+Hzlim5x8+DidHC2B5hhIb/4oQEuW+k8SaLZx/1fXdLEAeehqFpufVo+O",
-	"vFhx9MFFSH7c9u0oLP919KEVSJrt6InlgY4++Ky321u30sq6AFqz9Kgt9nvQ7s2E0mFtwpYJYLbxMaBT",
-	"ooR0gWWlZMKcqqm5IjNIJVA8A0JiFhAtK55aS7KdAjj+99Xp39Ca/ur0b+Qbcjx1yWEUqh2x6W3YVE0O",
-	"Z5kFu+9AUM82p3VIclAT4+RdzHISq6WMx8nQSkDt9YgNN0PTeliBvubNht8eJ0/ff/jqzx9jMl9Pgq2R",
-	"FETphqjXwmeGRaQVdP3NEMrWzu1pxv21ArlpFlHQ9SQEuG9qjjxd8lEKPndzq6a2i2hgivzX+Y+viZDE",
-	"6bhvaHpVR2gYkDHhqRQrhik0siDviuk5BLG7/kKgfRFEF+pRqEXZfsVfo/k9ZpNEQPHQPzo+3qsQecf4",
-	"1Cc0TAMTWN/68WyKwJqmOt8QqgIniqpmTebXThyNKJOW93arva8/o6+jFjNm7xtSF0kzg/XKtsN30cmS",
-	"2UKHC3TAuo27nxb0kBGF4H3ssg+31tPIl939n7G7fdmBlMKcaYaBYc2V46+zFpBNNS0H7kC08CH5u6hQ",
-	"wrP1ciGWvh5nQLe+n9M9bgje0DXxKvjl4KC78IMDt+dMkTlcI5OlHBt20XFwcGh26smerGyrNbmVC2DU",
-	"2dlnuN5mvaLrOms4JVzwhGM51xWQQC18cvzwD7vCM44v64xoSqzo/XE6+eoPvGVn3Ag2NCfY0q7m8R92",
-	"NecgVywFcgFFKSSVLN+Qn3idKi5IQd9nfz/xKy6uuUeE0SqroqBy44RoWvOcigfJ+7byn94zhUbQRi5K",
-	"FwpdzCiiTlply/li8v6j1wFGKhbbmh3NMD/u2KaggsbD2gn6D9TRB7SAD/5+5BJ6xj+iJ8KquEf+AWW8",
-	"ZUvx+aDXBtZOj5TqdFmVRx/wP6hyBmDZ9DlHes2PMOLk6ENrNe5zbzXt35vuYYtVITLwAIv53Bbm2fb5",
-	"6IP9N5gI1iVIZu4UfLLqfrWpBY4wXfam//OGp9Ef++voVsWP/Xz0oV2VsYUgtax0Jq6DvugBsO6r/nx1",
-	"nfLW30fXlGkjv7g3ulg1o99ZA82PXEK+zq9NDpzeF0zsE/zYkXhKYd+CtJXNt/T6ohV5KG0w/TOBBoIh",
-	"XrhOZowjgwgZWGPXsx/72kuPbV0swcYQeddoRDzUgsykoFlKFRZjcKkre2rrx1uqRt3Y/7OI4wvBREtA",
-	"/7mnOeqHO70hOO4Y+S/Yl6CGEcrhytoDf2eZqQfRM5oR/3goIa9objYcMnLqJPMWNn5veefzCyifWaL4",
-	"ZCLAM3/4FKH4xK2lu8n4o5ogx+yY+94oeIYBLIAnjgUlM5FtfEkuSa/12r7Z6DK3o7q2WvTjHRgJ/7Ut",
-	"g7sMgl/scF/scF8sNV/scF9294sdbqQd7ouV6ouV6n+llWof01RMzHSmmWFpE2uP0Na8VrejTY6nmsW3",
-	"X4wyXctk/VJWTB8ScoEZdKi5JWAFkuZY7lMFKbEKDIHEd6eQnVzypAWJDTQ0E99v/msjPC+r4+PHQI4f",
-	"dPsozfI85M39vijv4iebf/cbcjm5nPRGklCIFWT20U6YY8T22jns/1eP+2MvORG+UFvSFdTPU4mq5nOW",
-	"MovyXPAFoQvRRCcbvk24wC8gDXA2xSNheurSqDJFrs3iXQWYdiqUtuTelwDOmi3c6dHvkEvcmW8Ib09P",
-	"/n+MceP/r5bSb/HK81aMdOvYPa76hat8Cq7y2fnKH91HGpgP/0eKmU+On/xhFxQam18LTb7DyPvbiWN1",
-	"Va1YpsubClr+Ebc39zXRu2E0LN6idRzsu/fmIsAyuu6CbYI7T46OMJfeUih9NDHXXzvwM/z4vobZ1z6c",
-	"lJKtsJTC+4//LwAA//9Tf3Gr9esAAA==",
+	"H4sIAAAAAAAC/+x9/XPcNpLov4I3d1W2dUNJ/kh2rarUPSVOsrrYjstSkt0X+WUxJGYGKw7ABcDRTPz0",
+	"v7/qBkCCJMjhSLK9ufNPtob4aDQajUZ/vp+kclVIwYTRk5P3k4IqumKGKfyLpqkshUl4Bn9lTKeKF4ZL",
+	"MTnx34g2iovFZDrh8GtBzXIynQi6YpOTsP90otg/S65YNjkxqmTTiU6XbEVhYLMtoHU10iZZyMQNcWqH",
+	"OHsxuRn4QLNMMa27UP4o8i3hIs3LjBGjqNA0hU+aXHOzJGbJNXGdCRdECkbknJhlozGZc5Zn+tAv8p8l",
+	"U9tglW7y/iXd1CAmSuasC+c3cjXjgnmoWAVUtSHESJKxOTZaUkNgBoDVNzSSaEZVuiRzqXaAaoEI4WWi",
+	"XE1Ofp1oJjKmcLdSxtf437li7HeWGKoWzEzeTWOLmxumEsNXkaWdOewrpsvcaIJtcY0LvmaCQK9D8qrU",
+	"hswYoYK8/e4b8vTp0+ewkBU1hmWOyHpXVc8ersl2n5xMMmqY/9ylNZovpKIiS6r2b7/7Buc/dwsc24pq",
+	"zeKH5RS+kLMXfQvwHSMkxIVhC9yHBvVDj8ihqH+esblUbOSe2Mb3uinh/J90V1Jq0mUhuTCRfSH4ldjP",
+	"UR4WdB/iYRUAjfYFYErBoL8eJ8/fvX88fXx882+/nib/x/35xdObkcv/php3BwaiDdNSKSbSbbJQjOJp",
+	"WVLRxcdbRw96Kcs8I0u6xs2nK2T1ri+BvpZ1rmleAp3wVMnTfCE1oY6MMjanZW6In5iUImda42iO2gnX",
+	"pFByzTOWTQkX5HrJ0yVJqbZDYDtyzfMcaLDULOujtfjqBg7TTYgSgOtW+MAF/esio17XDkywDXKDJM2l",
+	"ZomRO64nf+NQkZHwQqnvKr3fZUUulozg5PDBXraIOwE0nedbYnBfM0I1ocRfTVPC52QrS3KNm5PzK+zv",
+	"VgNYWxFAGm5O4x6Fw9uHvg4yIsibSZkzKhB5/tx1USbmfFEqpsn1kpmlu/MU04UUmhE5+wdLDWz7f53/",
+	"+JpIRV4xremCvaHpFWEilRnLDsnZnAhpAtJwtIQ4hJ5963BwxS75f2gJNLHSi4KmV/EbPecrHlnVK7rh",
+	"q3JFRLmaMQVb6q8QI4liplSiDyA74g5SXNFNd9ILVYoU97+etiHLAbVxXeR0iwhb0c1Xx1MHjiY0z0nB",
+	"RMbFgpiN6JXjYO7d4CVKliIbIeYY2NPgYtUFS/mcs4xUowxA4qbZBQ8X+8FTC18BOFzsAIeLceAItonQ",
+	"DJxu+EIKumAByRySnxxzw69GXjFRETqZbfFTodiay1JXnXpgxKmHJXAhDUsKxeY8QmPnDh2aUGLbOA68",
+	"cjJQKoWhXLCMcGGBloZZZtULUzDh8Hune4vPqGZfPpvc7Po6cvfnsr3rgzs+arexUWKPZOTqhK/uwMYl",
+	"q0b/Ee/DcG7NF4n9ubORfHEBt82c53gT/QP2z6Oh1MgEGojwd5PmC0FNqdjJpTiAv0hCzg0VGVUZ/LKy",
+	"P70qc8PP+QJ+yu1PL+WCp+d80YPMCtbogwu7rew/MF6cHZtN9F3xUsqrsggXlDYerrMtOXvRt8l2zH0J",
+	"87R67YYPj4uNf4zs28Nsqo3sAbIXdwWFhldsqxhAS9M5/rOZIz3Rufod/imKHHqbYh5DLdCxu5JRfeDU",
+	"CqdFkfOUAhLfus/wFZgAsw8JWrc4wgv15H0AYqFkwZThdlBaFEkuU5on2lCDI/27YvPJyeTfjmr9y5Ht",
+	"ro+CyV9Cr3PsBCKrFYMSWhR7jPEGRB89wCyAQeMnZBOW7aHQxIXdRCAlDiw4Z2sqzOFkGjuT9QH+1c1U",
+	"49tKOxbfrSdYL8KJbThj2krAtuEDTQLUE0QrQbSiQLrI5az64eFpUdQYxO+nRWHxgdIj4yiYsQ3XRj/C",
+	"5dP6JIXznL04JN+HY6MoLkG9NGNO1IC7Ye5uLXeLVbolt4Z6xAea4HaCsuZmWqFBa2bug+LwWbGUOUg9",
+	"O2kFGv/FtQ3JDH4f1fmPQWIhbvuJC1oRhzn7xsFfgsfNwxbldAnHqXsOyWm77+3IBkaJE8ytaGVwP+24",
+	"A3isUHitaGEBdF/sXcoFPtJsIwtrtqYiZSgS6Hug7gEiy6lhcOF76SaiReveUCUX5stnd6CwCiVOSvRS",
+	"RgiNk70p4gIa4K+ods7Ymqxkhjz+rjfPyEshur/15/BcIlS35ks7eUcUEvjQhuHrXKZXf6F6eQ8UNPNj",
+	"dakIpyFLRjOmyJLq5eEkJpGFhFKPNoZYoCEqQ8gsmOqwWuJ9LW/H0jJq6OGkDW/8gFjUYz+8IJiKvPN+",
+	"xP/QnMBn4IPUeDUHqHg4sjMZGGQy0IzYx5SdCRrAxhtJVlYZQkBDsReU39STx/dp1B59a/UvbofcInCH",
+	"5Obej8HXchOD4Wu56RwBuWH3wUBncmP/ww1b6RHwvXCQSdx/hz6qFN12kYxjj0EyLBDEfI2nQYTSEcxS",
+	"K7JPZ1Ldjvu02IogtXqeUBg1uKimLSRh07JIHClGVHy2QWug2iI6zDTaw8cw1sDCuaEfAAva0AD4O2Ch",
+	"OdB9Y0GuCp6zeyD9ZZTpg0Ll6RNy/pfTLx4/+e3JF18CSRZKLhRdkdnWME0euncs0Wabs0fdlU0nVs0Q",
+	"H/3LZ16p2xw3No6WpUrZihbdoayy2IqLthmBdl2sNdGMq64AHHM4Lxhwcot2Yu0gANoLrqnWbDW7l83o",
+	"Q1hWz5IRB0nGdhLTvsurp9mGS1RbVd7Hs58pJVVEF4lHzMhU5smaKc1lxPL0xrUgroV/ChTt3y205Jpq",
+	"AnOjmrwUKFBEKAv036P5vh36YiNq3AxyfrveyOrcvGP2pYl8L09rUoBVbwOi8qxcNF6NcyVXhJIMO+Id",
+	"/T0zKAqcCcPUmub3sJXcDdUnU/nvhAuiWSpFpqfk2Mo3KENYgxZKP9eKG8MEobppxYAGupyt4Gs24gFc",
+	"wXSXF8qsD/wQkRd8xc4NXRU/zuf3o5+QOFDkCcdXTMNMxLYIABqBETfqXfBh+gFwGDnfihRfsh/2Ibvi",
+	"Ai1teivSQHWCr0qWLZgagY97eMDiVA90BBxAx0v8fC5ooZfy/t+HzeFjcMcBCDQTzQZ9Copmq/uQsLUf",
+	"azS3bS92B7OtJ7jL/mq6ZpnbZVINGeLEUMNesNzQ76S6qNnV90qWxYfa8GrOsUuh1RKgK8mgr1fKcbHI",
+	"m+58C4A9usZPsqBv/B3n1oDQI7d5yRdLE7y93ygp5/cPY2yWGKD4wWoucujT1V+8lhlcFKbU9/BOqQer",
+	"xQCg2fDypzNZGkKJkBnDzS91/AXT4wCGnifoMGPCR5FZWmXEjAF1pbSE1YKhTcaEqrpjQlN7QhN76ccn",
+	"rL0YbCs7nXUuyhWjGSiHmSBy5izOzhaOi6Toy2L8G8C9nyJ3QQOuQsmUaQ1Kfauq3Qmab2flKzOAJwQc",
+	"Aa5mIVqSOVV3BvZqvRPOK7ZN0PNKk4c//KwffQJ4jTQ034FYbBNDb6UL46IH6nHTDxFce/KQ7KhixN8r",
+	"xEh88uXMsD4U7oWT3v1rQ9TZxbujZc0UGvg/KMX7Se5GQBWoH5je7wptWfT4EzsdEEjvsGGCCumF5thg",
+	"OdUm2cWWoVG4Fg0rCDhhjBPjwD1C9UuqjXVK4SJD/bB2NprKQgNT9APc+1aHkX/2z/Tu2KkUmgld6urN",
+	"rsuikMqwLLYG8GTqn+s121RzyXkwdqUYMJKUmu0auQ9LwfgOWXYlFkHUVLZb57XVXRxaOOGe30ZR2QCi",
+	"RsQQIOe+VYDd0KeyBxCua0RbwuG6RTmVI+d0oo0sCuAWJilF1a8PTee29an5qW7bJS5q6ns7k0yjK6dr",
+	"7yC/9mZAKsC/WBMHB1nRK5A9UFdovWe6MMNhTDQXKUuGKB+f79AqPAI7D2lZLBTNWJKxnG67g/5kPxP7",
+	"eWgA3PFaJyQNS6xbZHzTa0r2XmgDQ0scL8I0X0uCX0gKRxCeAjWBuN47Rs4Yjh1jTo6OHlRD4VzRLfLj",
+	"4bLtVkdGxNtwLU1gFkaQHUcfA3APHqqhb48K7JzUb8/2FH9j2k3g29xiki3TfUuox99rAT2GBhdxEpyX",
+	"FntvceAo2+xlYzv4SN+R7bF6vKHK8JQX+Nb5gW3v/enXniBqiycZM5SDJj74YJ+BRdifWIe+9pi3ewqO",
+	"Upl0we8oTSLLyblGkacJ/BXb4pv7jfUUD1Qd9/GWjYxKuA0AAUC9/ymI4GETtqGpybeoKl6yLblmbR1x",
+	"86lrZJGEA0SNfwMzOku39bL2OzDG9H6OQwXL627FdGLfBMPwXbQeBg10uLdAIWU+QvvZQUYUglEOZKSQ",
+	"sOvcBaP4cARPSQ0gHdPOtx5cd1WEaMYVkL/JkqRU4JOrNKySaaRCQQH64gxcB3M6V7EaQyxnK2Zfkvjl",
+	"4KC98IMDt+dckzm79hFcBwdddBwcoB7njdSmcbjuQSMKx+0scn2gVRQuPvcKafOU3e43buQxO/mmNbif",
+	"FM+U1o5wYfl3ZgCtk7kZs/aQRsa5HpnNyJUH64muG/f9nK/KnJr7MO2yNc0TuWZK8Yzt5ORuYi7Ft2ua",
+	"/1h1w+g0lgKNpixJMaZq5FjsAvrYMKxdb8PaPZWvVizj1LB8SwrFUpZZVTnXRFcwHhLrUJwuqVigpK9k",
+	"uXAerXYc5NQQpoeBUaXoDBGVhsxGJKidjnFuF8XgI8dADmIU3mJt1bZ9eVzTaj6WNRj6SOS1Vf1RE/B0",
+	"0vtUBaSu66eqRU4z/G0EF28IagF+6olH2j8QdSC0dPEVbgucAtjcD6Nrr4eOQdmdOLBk1R/7rFh1i1+k",
+	"umLqDpr4cWsIpxleTwyg6NrChr3rLEG7cA9SmR2IKFYopvEODfVo2n6V8zCk112yeqsNW3VNDbbrbz1s",
+	"5m3vg1aKnAuWrKRg22gWCy7YK/wY623v8Z7OKFH19W0/khrwt8BqzjPm1N0Vv7jbbU7UNqnp76S6L3u8",
+	"HXBfk+2QiXSnHddNeVsjLgS3dm2fLuCvzej0tEowwhWhWsuUo1B5Bu4qXNTmUuc/30T/myqM4R7OXnvc",
+	"lpEvjCVHJTbLC0JJmnNUcUuhjSpTcykoKtGCpUZcGL22oF+t+o1vEtfjRtSsbqhLQdF9tVKtRd2u5iyi",
+	"R/qOMa9d1eViwbRpPcbmjF0K14oLUgpucK4VHJfEnpeCKfQjPLQtV3RL5kATRpLfmZJkVprm8wTjWbUB",
+	"Ja21OMI0RM4vBTUkZ1Qb8oqD0xcM5z1O/JEVzFxLdVVhIS7FLJhgmusk7mr5vf2KXvBu+UvnEQ//d52t",
+	"jQrGr4Net4Y1cmr834f/eQK5NGjy+3Hy/D+O3r1/dvPooPPjk5uvvvp/zZ+e3nz16D//PbZTHnae9UJ+",
+	"9sI93c9e4PusNlJ1YP9oBgoI0Y4SWehK1KIt8hAzCzgCetTU3pkluxTgcGckJLbgGTW3I4f2DdM5i/Z0",
+	"tKimsREtbZ1f656vnjtwGRJhMi3WeGtpseudHI9rho30ocrQisxLYbfSvzJs2J73EpXzaRW7btNanRAM",
+	"bF5S7+Ls/nzyxZeTaR2QXH2fTCfu67sIJfNsEws7z9gm9ph1BwQPxgNNCrrVzMS5B8IedYi1zifhsCsG",
+	"WhC95MXH5xTa8Fmcw/kAH6cU24gzYb1E4fygDXbrTDty/vHhNoqxjBVmGUt30xDUsFW9m4y1/GIgXJGJ",
+	"KeGH7LCtlMrgXexcc3NG50Cg1o4ox7z6qnNgCc1TRYD1cCGjND8x+kGRx3Hrm+nEXf73/2RyA8fgas9Z",
+	"GVz930aSB99/e0GOHMPUDxBbbuggZj2iMrAfmh5ThlCX5MsKeZfiUrxgcy44fD+5FBk19GhGNU/1UamZ",
+	"+prmVKTscCHJiY/0fEENvRQdSas3D18QY0uKcpbzFBTuMfK0uZW6I1xe/gpq58vLdx3nke7zwU0V5S92",
+	"ggQEYVmaxGWGSRS7pipmnNNVZhAcGXsPzmqFbFlaDa4bn7jx4zyPFoVuZwjoLr8oclh+QIbaxb/DlhFt",
+	"pPKyCNceGtzf19JdDIpee/1RqZkmf1/R4lcuzDuSXJbHx08ZaYTM/91d+dy+xUdrkXozGLSVR7hw+6xk",
+	"G6NoUtBFzAZ4efmrYbTA3Ud5eQVbAIIudgtxUoXX4FD1Ajw++jfAwrF3KC0u7tz28lkA40vAT7iF2AbE",
+	"jdoz4bb7FQTv33q7WgkAOrtUmmUCZzu6Kg0k7nemSg62oFxo7y4CliY4BC6P2gxUpyy9cgmu2Kow22mj",
+	"u5w3BE3POri2qc9sOCkm30ELCqREKzLqRHEqtu0sKJoZ432e37Irtr2Qde6efdKeNLNw6L6DipQaSJdA",
+	"rOGxdWO0N9+5vQGktCh8MguM1PVkcVLRhe/Tf5CtyHsPhzhGFI0sEX2IoCqCCOzQh4JbLBTGuxPpx5YH",
+	"r4yZvfkiadA87yeuSf14ch5q4WoultX3FcM8ivJakxkFuV26FIA200TAxUpNF6xHQg6NWCPzOTQMXzjI",
+	"rnsvetOB2bx5oXXumyjItnECa45SCoMvQCr4mGn5JfqZrJ3UWWAws69D2CxHMaly4LRMh6qGMVEshkCL",
+	"EzBTohY4PBhNjISSzZJqn50wmwZneZQM8AEzpwzlyzoLXOqCTI1VNizPc9vntPO6dFmzfKosnx8rfFqO",
+	"yHU1nTgv/th2SIECUMZytrALt409odRZXOoNAjh+nM9zLhhJYt55gRo0uGbcHAzk4wNCrAaejB4hRsYB",
+	"2Gj/x4HJaxmeTbHYB0jhstBQPzZ6DgR/s3gQqPVXB5FHFsDCeY/1LvUcgDqXzur+ajkW4zCEiykBNrem",
+	"ORPGv/jqQTppm1BsbSVpch4oj/rE2QEDiL1Y9loT9rjVakKZyQMdF+gGIJ7JTWKjwKMS72wzA3qPuvBD",
+	"r+jBtAmyHmgykxv0asKrxbqM74ClHw4PRg0AZj6CtWO/vtvcAjM07bA0FaNCTR5Wsk1NLn3ixJipeySY",
+	"PnJ5GOS8uhUALWVHnUDePX53PlKb4kn3Mq9vtWmdy9FHR8WOf98Riu5SD/66WpgqS9WbtsQS1VM0WrUS",
+	"dAUiZIzoCRcRI03XFKRZzvBRkDSEqOSKbeNvG4Y3zrnvFigvMA0YFdtHgceXYguuDauV6N4f5FOoJylm",
+	"H5Vy3r86U6g5rO+tlNU1hR2tcrKxzI++AnSZnnMFvrlggYguARp9p/FR/R00jctKjc0mNlc3z+K8AaeF",
+	"KJuM52WcXt28P7yAaV9XLFGXM+S3XFjHnBnmlo96mg5MbZ2RBxf80i74Jb239Y47DdAUJlZALs05/iDn",
+	"oh1EPcAOIgQYI47urvWidIBBBhHCXe4YyE2Bjf9wSPvaOUyZH3unZ4+PU+67o+xI0bXUgA6vgqOZCMQS",
+	"boLU7N3Q3Z4zQIuCZ5uWLtSO2vtipnspPHxCyxYWcHfdYDswEOg9Y9FDiulm7tJawLdJ9hvpsA5HYeai",
+	"mWE0ZAjhVFz7EjFdRFXRhbtwBflzfmDbn6EtLmdyM53cTXUaw7UbcQeu31TbG8UzmuatKq1hCdkT5bQA",
+	"gxfNE6dg7iNNJdeONLG510d/ZFYXV2NefHv68o0DH3R4OaMqqUSF3lVhu+IPsyqbJrXngPgSFPDm8zK7",
+	"FSWDza/yFYZK6eslc7n8A2m0k3S4NjjU43kl9TzuIbRT5exsI3aJAzYSVlQmklp9h51bVhG6pjz3ejMP",
+	"bY83Dy5uXObqKFcIB7izdSUwkiX3ym46pzt+Omrq2sGTwrkGqg2sbEENTaRom9DRtxvUcUiq4Nk1Y04r",
+	"0mVOolyhJiHROU/jOlYx00AcwtrOoDHBxj3CKIxY8h5TrCh5MBY0G5OfqQVkMEcUmTqaIqrG3Uy6Ymml",
+	"4P8sGeEZEwY+KTyVrYMK59IX3OlepyA7dOdyA2OfYPi7yBhhuuz2jYdADAsYoaWuA+6L6snsF1pppOCH",
+	"wCSxh8E/nLFzJQ4Y6x19OGq2zovLpsVtXFbmMYXV/OPV5e3umSNaKI3rZK7k7yz+zsPncSQwy02EwhT2",
+	"PoyE/7ZZTKXdqeu91bP3bnefdBN8JE0nhR6qx50PzHKYnc5rqKmwW20DZhq+bnGCCVroIzt+TTAO5o4n",
+	"bk6vZzS9igsZANNpbQBu6NKNJL6zx72uokrs7CSwJVdtuQ26L5jq5NULEvjcUmCw044WFWrJADo2ZIKp",
+	"tf/lWkaGKcU1FYb5TPT2KLnemlnlF/S6lgpTZui42j9jKV/RPC45ZGlXxZvxBbeVnUrNgtJBbiBbNc9S",
+	"kSu/VMVKOdSczcnxNKhf5nYj42uu+Sxn2OKxbQEWQFxbZc3xXWB5TJilxuZPRjRfliJTLDNLbRGrJamE",
+	"OnzeVMarGTPXjAlyjO0ePycP0Wyn+Zo9Aiy6+3ly8vg5Kl3tH8exC8BV5hriJhmyk18cO4nTMdot7RjA",
+	"uN2oh9HsArY0Zz/jGjhNtuuYs4QtHa/bfZZWVNAFi3uKrHbAZPvibqIirYUXkdm6ctoouSXcxOdnhgJ/",
+	"6vE+B/ZnwQBz8oqblTPuaLkCeqrrAtlJ/XC2SJ29myq4/Ee0kRbeRNR6RH5cpam932KrRkv2a7piTbRO",
+	"CbV5UnJeey/4QhPkzKdhsplLfbp2ixuYC5aOYg5sIeZM5sLgw6I08+TPJF1SRVNgf4d94CazL59F8qo2",
+	"cyaL/QD/6HhXTDO1jqNe9ZC9lyFcX/DHF8mKA6t/VEd7BKey15gbndb02Q6Hhx4rlMEoSS+5lQ1yowGn",
+	"vhPhiYEB70iK1Xr2ose9V/bRKbNUcfKgJezQT29fOiljJVUst2J93J3EoZhRnK1Z1rtJMOYd90Llo3bh",
+	"LtB/WsuDFzkDscyf5dhDAEpEnLzvqZ9QadKdr3pEO9B3TOEDkMHMDTUlzVz1H5+P3o8XVNzS5RXbXcMW",
+	"fPF4wD/aiPjE5IIbWNvy7Up6CCWo1RElmaz6HtjYKflabsYSTusUeuL5F0BRFCUlz7Of68jP5gpniop0",
+	"GbWZzaDjb3WBy2px9g6MkVi6pEKwPDqclTd/83JpRHL+hxw7z4qLkW3b1VnscluLqwFvgumB8hMCernJ",
+	"YYIQq82gusppO1/IjOA8dU6++rh2q/oEtRf+WTJtYgFK+ME6jhks8wlUjJ0IExm+SA/J97aG/ZKRRsIl",
+	"fAn6jBjNqOmyyCXNppipA6wJxM5q+9gybbb0wAIfQs1VtHRiQbrRcS7ItkNfeMT4cYb9tW0tsqRKcB8L",
+	"QIUWdQp+3rIT4BMpxM4heRFUow4KnmGiFrWCV101mpWPkCbgP8bQdAkNZIO19pP8+JoZnip1UNPX/T+t",
+	"KNGeO4Dblc2wVTOmRMLb/JprW7qcrVkz5tWD4dUOPga2uTxVCmEp5XCPW67KuLkv2j1wOG5lSohC1kL8",
+	"nkK/LTmzbwmRc+wVI8pOPZJOMV8bQVnVEXvlyzFTIQVPMSFX7Ip2Nc7H2NlG5C5rK3L9EXcnNHK4olVQ",
+	"Klc8h8XeuijTSQNxXUV/8BU21VKH/dNgMe0lNWTBjHacDfzRXTEfp2vkQjOXUxWIKOSTUjVsl8gho+bw",
+	"pDKb7ElGGHrT83j8Dr69dqoFOILkigt8RDi0OcHPagOxBLOBlwc3ZCGZdutpxh/rX6HPIYbiZmzz7tCX",
+	"bMYxrOkPlm3t3N2hTr3V21mZoe030NYlgqp+bng520lPi8JN2l/qKSoPQLKjPgRHrJeJNx8FyK3GD0cb",
+	"ILdBdxW8T4HQILUX0YYVeA93CKMqe9QqqQdCq6UobEGsm1gMKTkXETBecsHqguKRCyKNXgm4MXhee/rp",
+	"VFGTLhtsaJeRGy3cMYamjTNv3HWo1gYjSnCNfo7+bawrNvUwjqpBLbhRsa3qmAN1B8LEN+D67N0HuvWX",
+	"UKpyQlRGTR327SsyxRgHMG5f8615AXSPQVcmst0xJ9y+N1FfIOqszBbMQJBjLMXt1/iV4FeSlQAagbx0",
+	"ZZUKtSgIANVORNOlNjdRKoUuVwNz+QZ3nC4ocRahhrDMmt9hoDRQWsG/sTyg/TvjHD32djX0Xh3ZftmX",
+	"uq6TMakXaDqB8KfxmMA75e7oqKe+HaHX/e+V0nO5aALykdNPDHG5cI9i/O1buDjC7Ayd5Lb2aqmSJ6Bj",
+	"n/SFafHZWIX9NrkSfOtmu0WDUlX4clgB0V/CcoqXX497b5B0g9r71Voo+5x8016fdGpcdJyhZJAF9UYc",
+	"WQ8h/G6hiGtn+7yCrFMQfO70vlUBbrfWQYR6d7MuQD94X1ZSUO7M7zWz6GLWeb134xDG+MPWG9xehPMl",
+	"79XY/bDu8/v2ydjwe7ty1xVzIfOFYmsuS7dhleeTfxLaXxu1sCrP++j6u4pXnOrTqkN7lbcXroqCXaZ7",
+	"k//ws/WTI0wYtf0XUOV2Nr1V7C2y962abK5SG3hy2XoStYahKh8/Vn17ERjSAF1+jsM9FfKN6vYpLUwZ",
+	"BMBHBr0VD2hovt/1o3IggMK2CM6+0yaMLCveFDDG5HyMpRd0YnajyNuOEnWd7XwxRrLq4ONmOjnL9pI9",
+	"YikqJ3aU6A5EC8j1Z/Cqs3Yhtyqk5nVJgVhluZHemhdL5kJLHGF3x/KuUmuWGqwjUbuAKMb2yUcGkwUF",
+	"nT9n8uqtUuucWl0Cr6GsXd3iETvEpU5gXRAcahPvH47PUXVaOfrhlYcJtBdMuJrKzZCZ0Y778zlLDV/v",
+	"CGT8ZclEECQ39SouhGUexDXyyhEc8+Dsr8CtAcrpLeHJ6f2B0xfGdMW2DzRpUEO0EsDUSy23SYGCGEDu",
+	"AO79hdQ079PJO98GrivKQCx4xzXbndXJ5HqLiAVhubecy5MkoWGo7sCU8SpGo+aCrnsFsKNPc1+sY7cI",
+	"Sv9T7gXWnNFVgU+fQiVUeIDutp1o8tqlYMGw08oM5ZOxMO1/8zHmdpacX7GwzBka/SCA3reIarG8giwZ",
+	"uI86AYqEx4GeVzPz2s24G5LW3WPrTJ7mEsSIpM8jv+nZW7nFPNDWf8lWDGDKwTVnypWDhJYwNkuM9G7J",
+	"Q3AMoULbetq3QYLuTRdqgetN4vO2zlKEaZMpJu2hzjcrXCBRbEUBOhXkEuqfcwjZ39jvPgbLp83dqayr",
+	"6HV3nQrvYM51B4kh1c+Juy13x3bdRm/HhbB1+XUssZBgqmlYKpTMytQ9Y4KDUek2R6ftGmAlUZVX2l1l",
+	"640QBMhese2RfU/6Ah9+B0OgreRkQQ8SUrQ2+V41mToG9+JewPuUSsDppJAyT3rsRmfdbEhtir/ikEuQ",
+	"wE3hHTF7ii6Rh2iuqBwDrpdbn/2nKJhg2aNDQk6FdX33PgLNdNytycUDMzT/BmfNSpugzOknDy9F3IcY",
+	"U4epO3IzP8wwD9NMZHeeyg4yPJHZ9GRigtR+3RJkh2Nf5V2rfbssVE1UFoqYTFJXPNrhclR5G9XFYmqP",
+	"o650kOfyOkEqSqpUarE3B7RrMkmfPLbuBtiescB1iWp3gW7JkmYklUqxNOwRjxaxQK2kYkku0ZMpZmSd",
+	"G5CHVugiLkguF0QW8My1GQm9OSpaySiY676qNtnIZwtBYm1nPbklmHaRzg5c27gL70DhpP2LMl0sI3ob",
+	"3DC/W3tXXnIEt3chkQDMEYS+W2d12l1Ye13tEmd9BQeNXPE0ju4/luNPr7tOjHpjqLA9XCwhNsMDHvKU",
+	"ys6Lp6eLZibAMSy2X+74OXsX0jn81yqJW+OSOaOmM3fAzyKxrEOrjhULi+xqNZWrZebDU3soJOo7MGyq",
+	"twUkZ2MN9lXy7pHMIACg34TfgGGUIX9fMOZYkDWhESSfVTL/tFEvm7c4nk+saE92Su2bH/RNlOelYi5c",
+	"Eg9Cu4RTQc3SywDQvPsyh1ce0xjLaOvQUG31SF6f5cpWtoUrWSQ5W7OGZ4OL4SzTlGkIzAxLXtrOJGOs",
+	"QO1u+80RM9mHvL0liLq1J4HRdwx2o5KpRazdKbJD7IwKyRuR2GOixx4lgGjNs5I28KfvUPyvr+5f5PLx",
+	"sL4bxyn2ZhLxxQ2xiJ1ONqXuO5ci7mMThhBXKiWcLatUz5YI65OtC3ot+p9gXaKsZafxZTMDxH67YSne",
+	"Q00nkrvjhOBgRPPF7jXUBHGXp3wvlQ0RWaeIaFRq08wXgQ4z+XjB1/WNSLtW6ch1ZACua96ALqmsdnkM",
+	"moHGPOPzOVPWrKINFRnoGoPmXJCUKUM5vDG3+vYPDIBWQTjTrjcGcGoc1DOr2GsDNYQWkHzrHm998v8I",
+	"uR32ISaz22vbyL76pp1dicfI0A28c9BZsIcIXHQ/vnKwGZECRUyyoldsz3k0/50NT4M5d5wW1kicdcwU",
+	"N4O0/iOiDg/8T4KbQWq3ol/be9PahCwxehoUi9owbTenS4NFGp+saDrdtos5+L22Cio7H+tJTul4Z4I8",
+	"VQ+YfJkOyk6lTmXXFQc6zNgCM3XOyHtJC211Q7qDKUVZdM+ZaMrqco7UiZtiLyapQnY8bTsHNa+gatux",
+	"kGpaKhSirul2d467xMSh9H7VdmT/nPE+DhXUbqstgaGMa+HvpJDbRzyJ0HysPEU3edf9L8YGDNR2uA+3",
+	"HKdpjy8gLOo/TG+1IO9JJUJrVGxjR8frkm+xwD7pZITL671tVXVaPsQGRVn07XK6jgKt6/4YwWZQbHrY",
+	"jSJM+VzHkivrRYtmV/8eavOLV/U7aVzJaN9hB3ihd03drjJ0OHA+cVD2qwopwVLe9VFCY/m7HHbcAuuH",
+	"ZbBFTlYzhtkE/DaQr7kvgTeW/qZycuqr0d72hcL8zlLY4sIdHyorPuKZCgmHw12/pvnH94PCxN+niA+W",
+	"ve23nIaONCGSLSr17SIiX9JRc+f0A0wNZUfXTPzCYI+i14Ibyr1YO8wfhX+aWy3/3JcOheDpaxwTd5o8",
+	"/pLMXMaYQrGU6/ZL+NpX9ar8RrDIpZ0CwhGHHVV2rfNnae5AxnOvWCKv6wpBqMheiBrC+oh+YqbSc3Kj",
+	"VB6jvg5ZRPA3zKNwnPOeKjznVV2gVOa+KIKrwtPwZg1ZQ4/DOU1TVmBWmOS6h4Stit5TYxjVbDsHSfXC",
+	"CWn1Ggh9lG9BeiuuddROZnFKrpdSs/oZjdZoIY1HDqg8l4yuOcCA7Ge88FHtB07lNjNyy8tSJTvBhGPc",
+	"rUJgI8WdtXr3WgbCn3Y6QsagFtI6p8UedqdVLa8xoNfuSwCwzepvNRd2/PuG3Go+7h/dHwTVlqP3HrFf",
+	"6rNVTR8qhjBTItukzBVvoO6MWaM0pPe4TfYwBEr1VTv9pU6fie1aVSMIt6lkT0hqcxk85FgLWqrtoynJ",
+	"uL4iDzEllTOqZNRQMEA8IlIRd1Zs9XPvY7Zl5tH+4Q/VlU4dC66CH+503w3p84JqI/UWNcgmWvwNdCg7",
+	"CMAHg0SGJVqSOVW3WIotFNQ3s7WTXTfmrw/snlP1cZIaY9IWcauEgDif2OHr6nM4NAi4jeP2oZtG7roW",
+	"cqoFNPZ/+KYOb4aotbmx4MZ6m7I7Tm3rFuPjz8K0R4WRnUyplwT8lj8IZ71T0GDljOzmHMbhL1JdMdUn",
+	"7qC+oSpIW/t1hci7xhF81pWySOUKVbp1k4j+3jKuxFLRINW6Jsjwgky/V4gyLhLL9ywn7PMCx+QkYyO5",
+	"3Bw2UOkWBxHk6dDPfWhiaNtJ7NZ6V4LmqXDCFEXzWXpVs9pbg+nwmiDikpyJRSyQ5lzOjbUKdTP0t3fG",
+	"7sRAdc9byNNoAenI0gERYJYUqa6A5PZwOY2L+zuzUISU1LvT0zZ19yC7wkrshIalJHaor64agb61ljnQ",
+	"sEnF7jngN0jdsWfAb7dIxtjl4TqQLErNuuscvfkN3EYkxnptY6PVu8gdKms5Jsg8Xq0WumOUu0UINDok",
+	"CCr5++O/E8XmcCCNJAcHOMHBwdQ1/fuT5mdgBAcH0bP60eLbLY7cGG7eGMX83JfxzGb16kmu19oPyMO3",
+	"izAaqRIhPoAJprnGZIC/uYSsH1e35yGwgWLdo2phvUt0q0VMZK2NyYOpgiSII/Ifum6RbIfohJ2Wipst",
+	"1onxFjj+WzQS//sqFNGFslYuBU4XZ+QVqyoN1YGLpfbavu8lzVE/Zj0dBCMG6hCTbzd0VeTMHZSvHsz+",
+	"xJ7++Vl2/PTxn2Z/Pv7iOGXPvnh+fEyfP6OPnz99zJ78+Ytnx+zx/MvnsyfZk2dPZs+ePPvyi+fp02eP",
+	"Z8++fP6nB5PphAPIFtCJz0o++WsCJZmT0zdnyQUAW+OEFhyiPW9u0NQ1lygiAVJTPIlsRXk+OfE//W9/",
+	"wg5TuaqH979OXNLjydKYQp8cHV1fXx+GXY4WGKmUGFmmyyM/z820hfHTN2eVS6R9+uKO2nyB3rnMk8Ip",
+	"fnv77fkFOX1zdlgTzORkcnx4fPgYxpcFE7Tgk5PJU/wJT88S9/3IEdvk5P3NdHK0ZDQ3S/fHihnFU/9J",
+	"MZpt3f/1NV2A+INer/an9ZMj/8I5eu+E4Zuhb0fBFQI/138lPNvRU2uGP7iCJsOtGxVDXEAfLD3qG/I9",
+	"My6GW5uw7Hw4CMYR2dGnREvlXuCF4hJO1dQmR4A3Pp4BqTDBo1GlSPFoONsKwxc8eXX6V/TueXX6V/IV",
+	"1K2weT81mkFi09swjooczjILdtehSX+9Pa1eJUG5w5NfY5Zc91qrK2r64wS0ElB7NWLNzdDVJyjDV/Nm",
+	"4LfHyfN377/4801MB90RziskBVGDIeqN9EU/EGkruvmqD2Ube1JwDf8smdrWi1jRzSQEuPv4jqRS8F7T",
+	"viyPDXpxGX+chzXX5L/Of3xNpCLO5vYGysF4j3EAGWtZKLnmmB0xC1JqQs8+iN31FwLt69s71/OVXhTN",
+	"BG0Vmt9NJx5QPPRPjo89p3N2jeD0HblDHczUepx2CQ3WTQNvgG58DbymaArRsVQHTl26nNVFPVp+/bJI",
+	"wgGG/Q+6M7otiSph9w3x6cqqVokyDN9FqwBCAx3uuYsl+XerfzrIiELwLnbZh1vraeTz7v732N2u7EAK",
+	"CWeaY6BKfeX466wBZF0o2YHbE714SP4mS5TwQHYvDYtVJsMZuA7mdMHWNYYC/3n8cnDQXvjBgdtzrsmc",
+	"XSOTpQIbttFxcHAIO/VsT1Y26N3SSPM26uzsM1xns17RTVUQihIhRSLYghrwkguehc+OH/9hV3gmMNMH",
+	"iKbEit4308kXf+AtOxOGKUFzgi3tap7+YVdzztSap4xcsFUhFVU835KfRJUFPKgu1mV/P4krIa+FRwS8",
+	"KsvViqqtE6JpxXNKEeRlH+Q/nbDpWtBGLkoXGl1eUUS1Mq23dYrF5N2NfwOMfFgMNTuayc0eTZkOGve/",
+	"TtCfSR+9RwVk7+9HrlZD/CN6Rtkn7pFP6BJv2Xj4vDcbgLXVI6UmXZbF0Xv8Dz45A7BsZtQjsxFH6AF/",
+	"9J5n3c+d1TR/r7uHLdYrmTEPMM3WVKTM9dA3vQ29s9fOBkfv/f/6B5Pzua30OvT56L39t3+YKvn60fvq",
+	"v5HWPjGdHvh09B6k/ZvdLY4UFAgIYAdTheJwu4bIsUaKI6wJte3+vBVp9MfujjacCnp+Pnrf+LNJ+HpZ",
+	"mkxeB31Rf2/NVEfam8Ei3zqwxBqX+uiacgNSnsushGUju50No/mRy0jf+rVOAtv5gpltgx9bcmEhbQR/",
+	"80n+ll5fNOLFlA2B/lpm24EbY5PMuEA2GrL5WvtpP3bfeDfTiJ0LIz+8Q2tEiDaSzJSkWUq1gT+cl1Pn",
+	"cX9zxwdkO2L7LGKXQzBRX9JN0gMM8XCnDxuOO0ZKDvYlKOKLrxVttaYfWLLsQPQ1zYhP+ZCQVzSHDWcZ",
+	"OXXvlwY2PrRU+OnFuE8sd300Qelrf/g0oZiYpPHCVfFUCIE74hipCJ7BwAAWTCSOBSUzmW19TWpFr83G",
+	"Rtq3mdtRVVw8+vEeVKn/2vrTXWrTz9rKz9rKz/qsz9rKz7v7WVs5Ulv5WZf3WZf3P1KXt48CLyZmOgVW",
+	"v7SJxTcpMZ23Ha0z81Ysvpnnh5tKJuvWcubmkEA9c8UwAFKD5oXmJKXaSlcuuGKF3uSYLYhlJ5ciaUBi",
+	"w8Ng4of1f61P5WV5fPyUkeNH7T7a8DwPeXO3L8q7+MkWoPmKXE4uJ52RFFtJKOOAqRbCzJC2185h/1c1",
+	"7o+dlLKYV2RJ16xKKkR0OZ/zlFuU51IsCF3IOqYUw1CExC9MAXA2MT/hZurqiIBzKSze7korgWVTcu9K",
+	"AGf1Fu70e2iRS9zlAQhvT3+H/xjj7PA/Wkq/Q26eOzHSwbFvpp+5yifgKp+cr/zRLcmB+vC/pZj57PjZ",
+	"H3ZBobL5tTTkOzgMdxTHqrLSsfoEtxW0fOotr+6rfZxDn2G8RStv4V/fwUWgmVr7C7Z2gT05OsIM6Eup",
+	"zdHkZhp+062P7yqYffWoSaH4GqC5eXfz/wcAe7BnVy8AAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
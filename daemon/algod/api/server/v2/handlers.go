@@ -25,7 +25,9 @@ import (
 	"github.com/algorand/go-algorand/ledger/eval"
 	"io"
 	"math"
+	"mime"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
@@ -46,6 +48,8 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/data/txntrace"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
@@ -66,6 +70,10 @@ const MaxTealSourceBytes = 200_000
 // become quite large, so we allow up to 1MB
 const MaxTealDryrunBytes = 1_000_000
 
+// MaxAdvanceRounds sets a limit on the number of rounds a single dev mode
+// request can advance the ledger by
+const MaxAdvanceRounds = 10_000
+
 // Handlers is an implementation to the V2 route handler interface defined by the generated code.
 type Handlers struct {
 	Node     NodeInterface
@@ -119,6 +127,14 @@ type NodeInterface interface {
 	UnsetSyncRound()
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	SetNextBlockTimeStamp(int64) error
+	GetBlockInterval() (time.Duration, error)
+	SetBlockInterval(time.Duration) error
+	AdvanceRounds(rounds uint64) (basics.Round, error)
+	StateProofWorkerStatus() (stateproof.WorkerStatus, error)
+	SaveLedgerSnapshot(name string) (node.LedgerSnapshot, error)
+	RevertLedgerSnapshot(name string) (node.LedgerSnapshot, error)
+	ListLedgerSnapshots() ([]node.LedgerSnapshot, error)
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -361,13 +377,18 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 	if params.Exclude != nil {
 		switch *params.Exclude {
 		case "all":
-			return v2.basicAccountInformation(ctx, addr, handle, contentType)
+			return v2.basicAccountInformation(ctx, addr, params.Round, handle, contentType)
 		case "none", "":
 		default:
 			return badRequest(ctx, err, errFailedToParseExclude, v2.Log)
 		}
 	}
 
+	// assets and apps are only available for the latest round
+	if params.Round != nil {
+		return v2.basicAccountInformation(ctx, addr, params.Round, handle, contentType)
+	}
+
 	myLedger := v2.Node.LedgerForAPI()
 
 	// count total # of resources, if max limit is set
@@ -422,9 +443,21 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 }
 
 // basicAccountInformation handles the case when no resources (assets or apps) are requested.
-func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, handle codec.Handle, contentType string) error {
+// The account is looked up as of round, or the latest round if round is nil.
+func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, round *uint64, handle codec.Handle, contentType string) error {
 	myLedger := v2.Node.LedgerForAPI()
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+	rnd := myLedger.Latest()
+	if round != nil {
+		if basics.Round(*round) > rnd {
+			return badRequest(ctx, errors.New(errRoundGreaterThanTheLatest), errRoundGreaterThanTheLatest, v2.Log)
+		}
+		rnd = basics.Round(*round)
+	}
+	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(rnd, addr)
+	var roundOffsetErr *ledger.RoundOffsetError
+	if errors.As(err, &roundOffsetErr) || errors.Is(err, ledger.ErrAccountHistoryRound) {
+		return badRequest(ctx, err, errRoundNotAvailable, v2.Log)
+	}
 	if err != nil {
 		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
@@ -917,7 +950,9 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
+	submitTimer := txntrace.Start(txntrace.StageSubmit, txgroup)
 	err = v2.Node.BroadcastSignedTxGroup(txgroup)
+	submitTimer.End(err)
 	if err != nil {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
@@ -1560,7 +1595,48 @@ type CompileResponseWithSourceMap struct {
 	Sourcemap *logic.SourceMap `json:"sourcemap,omitempty"`
 }
 
-// TealCompile compiles TEAL code to binary, return both binary and hash
+// readTealBundle reads a multi-file TEAL program uploaded as multipart/form-data.
+// The program itself is the file in the "source" field, and the sources it may
+// #include are the files in "include" fields, named by their file names.
+func readTealBundle(req *http.Request) (string, logic.MapLoader, error) {
+	err := req.ParseMultipartForm(MaxTealSourceBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(req.MultipartForm.File["source"]) != 1 {
+		return "", nil, errors.New("bundle must contain exactly one source file")
+	}
+	var main string
+	bundle := make(logic.MapLoader)
+	for _, field := range []string{"source", "include"} {
+		for _, fh := range req.MultipartForm.File[field] {
+			// FileHeader.Filename drops directories, which #include needs
+			_, dispositionParams, err := mime.ParseMediaType(fh.Header.Get("Content-Disposition"))
+			if err != nil {
+				return "", nil, err
+			}
+			name := path.Clean(dispositionParams["filename"])
+			f, err := fh.Open()
+			if err != nil {
+				return "", nil, err
+			}
+			text, err := io.ReadAll(f)
+			f.Close()
+			if err != nil {
+				return "", nil, err
+			}
+			bundle[name] = text
+			if field == "source" {
+				main = name
+			}
+		}
+	}
+	return main, bundle, nil
+}
+
+// TealCompile compiles TEAL code to binary, return both binary and hash. The
+// program is either the TEAL source as the request body, or a multipart form
+// bundling the program with the sources it #includes.
 // (POST /v2/teal/compile)
 func (v2 *Handlers) TealCompile(ctx echo.Context, params model.TealCompileParams) (err error) {
 	// Return early if teal compile is not allowed in node config.
@@ -1573,14 +1649,25 @@ func (v2 *Handlers) TealCompile(ctx echo.Context, params model.TealCompileParams
 		params.Sourcemap = &defaultValue
 	}
 
-	buf := new(bytes.Buffer)
 	ctx.Request().Body = http.MaxBytesReader(nil, ctx.Request().Body, MaxTealSourceBytes)
-	_, err = buf.ReadFrom(ctx.Request().Body)
-	if err != nil {
-		return badRequest(ctx, err, err.Error(), v2.Log)
+	var ops *logic.OpStream
+	if mediaType, _, _ := mime.ParseMediaType(ctx.Request().Header.Get(echo.HeaderContentType)); mediaType == echo.MIMEMultipartForm {
+		var main string
+		var bundle logic.MapLoader
+		main, bundle, err = readTealBundle(ctx.Request())
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		ops, err = logic.AssembleWithIncludes(main, string(bundle[main]), bundle)
+	} else {
+		buf := new(bytes.Buffer)
+		_, err = buf.ReadFrom(ctx.Request().Body)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		source := buf.String()
+		ops, err = logic.AssembleString(source)
 	}
-	source := buf.String()
-	ops, err := logic.AssembleString(source)
 	if err != nil {
 		sb := strings.Builder{}
 		ops.ReportMultipleErrors("", &sb)
@@ -1593,6 +1680,9 @@ func (v2 *Handlers) TealCompile(ctx echo.Context, params model.TealCompileParams
 	var sourcemap *logic.SourceMap
 	if *params.Sourcemap {
 		rawmap := logic.GetSourceMap([]string{}, ops.OffsetToLine)
+		if len(ops.Sources) > 0 {
+			rawmap = logic.GetMultiSourceMap(ops.Sources, ops.OffsetToSource, ops.OffsetToLine)
+		}
 		sourcemap = &rawmap
 	}
 
@@ -1645,6 +1735,62 @@ func (v2 *Handlers) wrapStateproofError(ctx echo.Context, err error) error {
 	return internalError(ctx, err, err.Error(), v2.Log)
 }
 
+// GetStateProofWorkerStatus reports the progress of the state proof worker on upcoming state proofs.
+// (GET /v2/stateproofs/status)
+func (v2 *Handlers) GetStateProofWorkerStatus(ctx echo.Context) error {
+	status, err := v2.Node.StateProofWorkerStatus()
+	if errors.Is(err, stateproof.ErrWorkerNotRunning) {
+		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.StateProofWorkerStatusResponse{
+		LatestRound:         uint64(status.LatestRound),
+		NextStateProofRound: uint64(status.NextStateProofRound),
+		CachedProvers:       uint64(status.CachedProvers),
+		ProversCacheLength:  uint64(status.ProversCacheLength),
+		Rounds:              make([]model.StateProofRoundStatus, 0, len(status.Rounds)),
+	}
+	for _, rs := range status.Rounds {
+		round := model.StateProofRoundStatus{
+			Round:            uint64(rs.Round),
+			ProverState:      rs.ProverState,
+			SignedWeight:     rs.SignedWeight,
+			ProvenWeight:     rs.ProvenWeight,
+			AcceptableWeight: rs.AcceptableWeight,
+			TotalWeight:      rs.TotalWeight,
+			Voters:           uint64(rs.Voters),
+			Signatures:       uint64(rs.Signatures),
+			OurSigned:        addressesToStringsPtr(rs.OurSigned),
+			OurMissing:       addressesToStringsPtr(rs.OurMissing),
+			OurNotVoters:     addressesToStringsPtr(rs.OurNotVoters),
+		}
+		if len(rs.Missing) > 0 {
+			missing := make([]model.StateProofVoterWeight, len(rs.Missing))
+			for i, voter := range rs.Missing {
+				missing[i] = model.StateProofVoterWeight{Address: voter.Address.String(), Weight: voter.Weight}
+			}
+			round.Missing = &missing
+		}
+		response.Rounds = append(response.Rounds, round)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func addressesToStringsPtr(addrs []basics.Address) *[]string {
+	if len(addrs) == 0 {
+		return nil
+	}
+	res := make([]string, len(addrs))
+	for i, addr := range addrs {
+		res[i] = addr.String()
+	}
+	return &res
+}
+
 // GetLightBlockHeaderProof Gets a proof of a light block header for a given round
 // (GET /v2/blocks/{round}/lightheader/proof)
 func (v2 *Handlers) GetLightBlockHeaderProof(ctx echo.Context, round uint64) error {
@@ -1794,3 +1940,99 @@ func (v2 *Handlers) SetBlockTimeStampOffset(ctx echo.Context, offset uint64) err
 	}
 	return ctx.NoContent(http.StatusOK)
 }
+
+// SetNextBlockTimeStamp sets the timestamp of the next block.
+// This is only available in dev mode.
+// (POST /v2/devmode/blocks/timestamp/{timestamp})
+func (v2 *Handlers) SetNextBlockTimeStamp(ctx echo.Context, timestamp uint64) error {
+	if timestamp > math.MaxInt64 {
+		err := fmt.Errorf("block timestamp cannot be larger than max int64 value")
+		return badRequest(ctx, err, fmt.Sprintf(errFailedSettingNextBlockTimeStamp, err), v2.Log)
+	}
+	err := v2.Node.SetNextBlockTimeStamp(int64(timestamp))
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedSettingNextBlockTimeStamp, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetBlockInterval gets the interval at which blocks are written on their own.
+// This is only available in dev mode.
+// (GET /v2/devmode/blocks/interval)
+func (v2 *Handlers) GetBlockInterval(ctx echo.Context) error {
+	interval, err := v2.Node.GetBlockInterval()
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedRetrievingBlockInterval, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.GetBlockIntervalResponse{Interval: uint64(interval / time.Second)})
+}
+
+// SetBlockInterval sets the interval at which blocks are written on their own.
+// This is only available in dev mode.
+// (POST /v2/devmode/blocks/interval/{interval})
+func (v2 *Handlers) SetBlockInterval(ctx echo.Context, interval uint64) error {
+	if interval > uint64(math.MaxInt64/time.Second) {
+		err := fmt.Errorf("block interval cannot be larger than %d seconds", math.MaxInt64/time.Second)
+		return badRequest(ctx, err, fmt.Sprintf(errFailedSettingBlockInterval, err), v2.Log)
+	}
+	err := v2.Node.SetBlockInterval(time.Duration(interval) * time.Second)
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedSettingBlockInterval, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// AdvanceRounds writes the given number of blocks to the ledger.
+// This is only available in dev mode.
+// (POST /v2/devmode/blocks/advance/{rounds})
+func (v2 *Handlers) AdvanceRounds(ctx echo.Context, rounds uint64) error {
+	if rounds == 0 || rounds > MaxAdvanceRounds {
+		err := fmt.Errorf("rounds must be between 1 and %d", MaxAdvanceRounds)
+		return badRequest(ctx, err, fmt.Sprintf(errFailedAdvancingRounds, err), v2.Log)
+	}
+	latest, err := v2.Node.AdvanceRounds(rounds)
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedAdvancingRounds, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.AdvanceRoundsResponse{Round: uint64(latest)})
+}
+
+// ListLedgerSnapshots lists the saved ledger snapshots.
+// This is only available in dev mode.
+// (GET /v2/devmode/snapshots)
+func (v2 *Handlers) ListLedgerSnapshots(ctx echo.Context) error {
+	snapshots, err := v2.Node.ListLedgerSnapshots()
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedListingLedgerSnapshots, err), v2.Log)
+	}
+	response := model.LedgerSnapshotsResponse{Snapshots: make([]model.LedgerSnapshot, len(snapshots))}
+	for i, snapshot := range snapshots {
+		response.Snapshots[i] = model.LedgerSnapshot{Name: snapshot.Name, Round: uint64(snapshot.Round)}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// SaveLedgerSnapshot saves a snapshot of the ledger at the latest round.
+// This is only available in dev mode.
+// (POST /v2/devmode/snapshots/{name})
+func (v2 *Handlers) SaveLedgerSnapshot(ctx echo.Context, name string) error {
+	snapshot, err := v2.Node.SaveLedgerSnapshot(name)
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedSavingLedgerSnapshot, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.LedgerSnapshotResponse{Name: snapshot.Name, Round: uint64(snapshot.Round)})
+}
+
+// RevertLedgerSnapshot reverts the ledger to a saved snapshot.
+// This is only available in dev mode.
+// (POST /v2/devmode/snapshots/{name}/revert)
+func (v2 *Handlers) RevertLedgerSnapshot(ctx echo.Context, name string) error {
+	snapshot, err := v2.Node.RevertLedgerSnapshot(name)
+	if errors.Is(err, node.ErrLedgerSnapshotNotFound) {
+		return notFound(ctx, err, fmt.Sprintf(errFailedRevertingLedgerSnapshot, err), v2.Log)
+	}
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedRevertingLedgerSnapshot, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.LedgerSnapshotResponse{Name: snapshot.Name, Round: uint64(snapshot.Round)})
+}
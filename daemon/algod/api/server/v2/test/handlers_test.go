@@ -19,11 +19,13 @@ package test
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -130,6 +132,31 @@ func TestAccountInformation(t *testing.T) {
 	accountInformationTest(t, "bad account", 400)
 }
 
+func TestAccountInformationRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	latest := uint64(handler.Node.LedgerForAPI().Latest())
+	err := handler.AccountInformation(c, poolAddr.String(), model.AccountInformationParams{Round: &latest})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	actualResponse := model.AccountResponse{}
+	err = protocol.DecodeJSON(rec.Body.Bytes(), &actualResponse)
+	require.NoError(t, err)
+	require.Equal(t, latest, actualResponse.Round)
+	require.Equal(t, poolAddrResponseGolden.Amount, actualResponse.Amount)
+	require.Nil(t, actualResponse.Assets)
+
+	rec = httptest.NewRecorder()
+	c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	future := latest + 1
+	err = handler.AccountInformation(c, poolAddr.String(), model.AccountInformationParams{Round: &future})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
 func getBlockTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
 	defer releasefunc()
@@ -1331,6 +1358,60 @@ int 1`, logic.AssemblerMaxVersion)
 	tealCompileTest(t, badProgramBytes, 400, true, params, nil)
 }
 
+func TestTealCompileBundle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	files := map[string]string{
+		"main.teal":    fmt.Sprintf("#pragma version %d\nb start\n#include \"lib/one.teal\"\nstart:\ncallsub one\n", logic.AssemblerMaxVersion),
+		"lib/one.teal": "#export one\none:\nint 1\nretsub\n",
+	}
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	for _, name := range []string{"main.teal", "lib/one.teal"} {
+		field := "include"
+		if name == "main.teal" {
+			field = "source"
+		}
+		w, err := mw.CreateFormFile(field, name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(files[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, mw.Close())
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	mockNode.config.EnableDeveloperAPI = true
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	req.Header.Set(echo.HeaderContentType, mw.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	sourcemap := true
+	err := handler.TealCompile(c, model.TealCompileParams{Sourcemap: &sourcemap})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code, rec.Body.String())
+
+	var response v2.CompileResponseWithSourceMap
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, []string{"main.teal", "lib/one.teal"}, response.Sourcemap.Sources)
+
+	bundle := make(logic.MapLoader)
+	for name, text := range files {
+		bundle[name] = []byte(text)
+	}
+	ops, err := logic.AssembleWithIncludes("main.teal", files["main.teal"], bundle)
+	require.NoError(t, err)
+	require.Equal(t, base64.StdEncoding.EncodeToString(ops.Program), response.Result)
+}
+
 func tealDisassembleTest(t *testing.T, program []byte, expectedCode int,
 	expectedString string, enableDeveloperAPI bool,
 ) (response model.DisassembleResponse) {
@@ -2070,6 +2151,96 @@ func TestTimestampOffsetInDevMode(t *testing.T) {
 	require.Equal(t, "{\"message\":\"failed to set timestamp offset on the node: block timestamp offset cannot be larger than max int64 value\"}\n", rec.Body.String())
 }
 
+func TestDevModeBlocksNotInDevMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	err := handler.AdvanceRounds(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	require.Equal(t, "{\"message\":\"failed to advance rounds: rounds can only be advanced in dev mode\"}\n", rec.Body.String())
+	c, rec = newReq(t)
+
+	err = handler.SetNextBlockTimeStamp(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	require.Equal(t, "{\"message\":\"failed to set next block timestamp on the node: cannot set next block timestamp when not in dev mode\"}\n", rec.Body.String())
+	c, rec = newReq(t)
+
+	err = handler.GetBlockInterval(c)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	require.Equal(t, "{\"message\":\"failed retrieving block interval from node: block interval is only available in dev mode\"}\n", rec.Body.String())
+	c, rec = newReq(t)
+
+	err = handler.SetBlockInterval(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	require.Equal(t, "{\"message\":\"failed to set block interval on the node: block interval is only available in dev mode\"}\n", rec.Body.String())
+}
+
+func TestDevModeBlocksInDevMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupMockNodeForMethodGet(t, cannedStatusReportGolden, true)
+	defer releasefunc()
+	latest := handler.Node.LedgerForAPI().Latest()
+
+	// AdvanceRounds 200
+	err := handler.AdvanceRounds(c, 5)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var advanced model.AdvanceRoundsResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &advanced))
+	require.Equal(t, uint64(latest)+5, advanced.Round)
+	c, rec = newReq(t)
+
+	// AdvanceRounds 400 - zero or too many rounds
+	for _, rounds := range []uint64{0, v2.MaxAdvanceRounds + 1} {
+		err = handler.AdvanceRounds(c, rounds)
+		require.NoError(t, err)
+		require.Equal(t, 400, rec.Code)
+		c, rec = newReq(t)
+	}
+
+	// SetNextBlockTimeStamp 200
+	err = handler.SetNextBlockTimeStamp(c, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	c, rec = newReq(t)
+
+	// SetNextBlockTimeStamp 400
+	err = handler.SetNextBlockTimeStamp(c, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	require.Equal(t, "{\"message\":\"failed to set next block timestamp on the node: block timestamp cannot be larger than max int64 value\"}\n", rec.Body.String())
+	c, rec = newReq(t)
+
+	// SetBlockInterval 200
+	err = handler.SetBlockInterval(c, 3)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	c, rec = newReq(t)
+
+	// GetBlockInterval 200
+	err = handler.GetBlockInterval(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var interval model.GetBlockIntervalResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &interval))
+	require.Equal(t, uint64(3), interval.Interval)
+	c, rec = newReq(t)
+
+	// SetBlockInterval 400
+	err = handler.SetBlockInterval(c, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
 func TestDeltasForTxnGroup(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -2203,3 +2374,109 @@ func TestDeltasForTxnGroup(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 501, rec.Code)
 }
+
+func TestGetStateProofWorkerStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	voter := basics.Address{0x01}
+	ours := basics.Address{0x02}
+	mockNode := handler.Node.(*mockNode)
+	mockNode.spStatus = stateproof.WorkerStatus{
+		LatestRound:         300,
+		NextStateProofRound: 256,
+		CachedProvers:       1,
+		ProversCacheLength:  5,
+		Rounds: []stateproof.RoundStatus{{
+			Round:            256,
+			ProverState:      stateproof.ProverCached,
+			SignedWeight:     10,
+			ProvenWeight:     30,
+			AcceptableWeight: 20,
+			TotalWeight:      100,
+			Voters:           4,
+			Signatures:       1,
+			OurMissing:       []basics.Address{ours},
+			Missing:          []stateproof.VoterWeight{{Address: voter, Weight: 60}},
+		}},
+	}
+
+	err := handler.GetStateProofWorkerStatus(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response model.StateProofWorkerStatusResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(256), response.NextStateProofRound)
+	require.Equal(t, uint64(5), response.ProversCacheLength)
+	require.Len(t, response.Rounds, 1)
+	round := response.Rounds[0]
+	require.Equal(t, stateproof.ProverCached, round.ProverState)
+	require.Equal(t, uint64(10), round.SignedWeight)
+	require.Nil(t, round.OurSigned)
+	require.Equal(t, []string{ours.String()}, *round.OurMissing)
+	require.Equal(t, []model.StateProofVoterWeight{{Address: voter.String(), Weight: 60}}, *round.Missing)
+
+	c, rec = newReq(t)
+	mockNode.err = stateproof.ErrWorkerNotRunning
+	err = handler.GetStateProofWorkerStatus(c)
+	require.NoError(t, err)
+	require.Equal(t, 503, rec.Code)
+}
+
+func TestLedgerSnapshotsNotInDevMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	err := handler.SaveLedgerSnapshot(c, "baseline")
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	c, rec = newReq(t)
+
+	err = handler.ListLedgerSnapshots(c)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
+func TestLedgerSnapshotsInDevMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupMockNodeForMethodGet(t, cannedStatusReportGolden, true)
+	defer releasefunc()
+
+	err := handler.RevertLedgerSnapshot(c, "baseline")
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+	c, rec = newReq(t)
+
+	err = handler.SaveLedgerSnapshot(c, "baseline")
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var snapshot model.LedgerSnapshotResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &snapshot))
+	require.Equal(t, "baseline", snapshot.Name)
+	require.Equal(t, uint64(handler.Node.LedgerForAPI().Latest()), snapshot.Round)
+	c, rec = newReq(t)
+
+	err = handler.ListLedgerSnapshots(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var snapshots model.LedgerSnapshotsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &snapshots))
+	require.Equal(t, []model.LedgerSnapshot{snapshot}, snapshots.Snapshots)
+	c, rec = newReq(t)
+
+	err = handler.RevertLedgerSnapshot(c, "baseline")
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var reverted model.LedgerSnapshotResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &reverted))
+	require.Equal(t, snapshot, reverted)
+}
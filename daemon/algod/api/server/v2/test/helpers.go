@@ -21,6 +21,7 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -42,6 +43,7 @@ import (
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/node/indexer"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/util/db"
 )
 
@@ -100,6 +102,10 @@ type mockNode struct {
 	status          node.StatusReport
 	devmode         bool
 	timestampOffset *int64
+	nextTimestamp   *int64
+	blockInterval   time.Duration
+	spStatus        stateproof.WorkerStatus
+	snapshots       map[string]basics.Round
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -258,6 +264,73 @@ func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	return m.timestampOffset, nil
 }
 
+func (m *mockNode) SetNextBlockTimeStamp(timestamp int64) error {
+	if !m.devmode {
+		return fmt.Errorf("cannot set next block timestamp when not in dev mode")
+	}
+	m.nextTimestamp = &timestamp
+	return nil
+}
+
+func (m *mockNode) GetBlockInterval() (time.Duration, error) {
+	if !m.devmode {
+		return 0, fmt.Errorf("block interval is only available in dev mode")
+	}
+	return m.blockInterval, nil
+}
+
+func (m *mockNode) SetBlockInterval(interval time.Duration) error {
+	if !m.devmode {
+		return fmt.Errorf("block interval is only available in dev mode")
+	}
+	m.blockInterval = interval
+	return nil
+}
+
+func (m *mockNode) AdvanceRounds(rounds uint64) (basics.Round, error) {
+	if !m.devmode {
+		return 0, fmt.Errorf("rounds can only be advanced in dev mode")
+	}
+	return m.ledger.Latest() + basics.Round(rounds), nil
+}
+
+func (m *mockNode) StateProofWorkerStatus() (stateproof.WorkerStatus, error) {
+	return m.spStatus, m.err
+}
+
+func (m *mockNode) SaveLedgerSnapshot(name string) (node.LedgerSnapshot, error) {
+	if !m.devmode {
+		return node.LedgerSnapshot{}, fmt.Errorf("ledger snapshots are only available in dev mode")
+	}
+	if m.snapshots == nil {
+		m.snapshots = make(map[string]basics.Round)
+	}
+	m.snapshots[name] = m.ledger.Latest()
+	return node.LedgerSnapshot{Name: name, Round: m.snapshots[name]}, nil
+}
+
+func (m *mockNode) RevertLedgerSnapshot(name string) (node.LedgerSnapshot, error) {
+	if !m.devmode {
+		return node.LedgerSnapshot{}, fmt.Errorf("ledger snapshots are only available in dev mode")
+	}
+	rnd, ok := m.snapshots[name]
+	if !ok {
+		return node.LedgerSnapshot{}, fmt.Errorf("%w: '%s'", node.ErrLedgerSnapshotNotFound, name)
+	}
+	return node.LedgerSnapshot{Name: name, Round: rnd}, nil
+}
+
+func (m *mockNode) ListLedgerSnapshots() ([]node.LedgerSnapshot, error) {
+	if !m.devmode {
+		return nil, fmt.Errorf("ledger snapshots are only available in dev mode")
+	}
+	snapshots := make([]node.LedgerSnapshot, 0, len(m.snapshots))
+	for name, rnd := range m.snapshots {
+		snapshots = append(snapshots, node.LedgerSnapshot{Name: name, Round: rnd})
+	}
+	return snapshots, nil
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/multisig"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
@@ -35,7 +36,8 @@ import (
 // reqContext is passed to each of the handlers below via wrapCtx, allowing
 // handlers to interact with kmd's session store
 type reqContext struct {
-	sm *session.Manager
+	sm    *session.Manager
+	msigs *multisig.Coordinator
 }
 
 // errorResponse sets the specified status code (should != 200), and fills in the
@@ -171,8 +173,18 @@ func postWalletHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 		walletName = walletID
 	}
 
-	// Create the wallet via its driver
-	err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	// Create the wallet via its driver, restoring it from a BIP39 mnemonic
+	// if one was passed
+	if req.BIP39Mnemonic != "" {
+		mnemonicDriver, ok := walletDriver.(driver.MnemonicDriver)
+		if !ok {
+			errorResponse(w, http.StatusBadRequest, errNoBIP39Support)
+			return
+		}
+		err = mnemonicDriver.CreateWalletFromMnemonic(walletName, walletID, []byte(req.WalletPassword), req.BIP39Mnemonic, req.BIP39Passphrase)
+	} else {
+		err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -364,6 +376,65 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	successResponse(w, resp)
 }
 
+// postMnemonicExportHandler handles `POST /v1/mnemonic/export`
+func postMnemonicExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/mnemonic/export ExportMnemonic
+	//---
+	//    Summary: Export the BIP39 mnemonic from an HD wallet
+	//    Description: >
+	//      Export the BIP39 mnemonic that the keys of an HD wallet are derived from. Together with
+	//      the BIP39 passphrase the wallet was created with, it can restore every key of the wallet
+	//      in any BIP32-Ed25519 compatible wallet.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Export Mnemonic Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ExportMnemonicRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ExportMnemonicResponse"
+	var req kmdapi.APIV1POSTMnemonicExportRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wlt, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	hdWallet, ok := wlt.(wallet.HDWallet)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errNoBIP39Support)
+		return
+	}
+
+	// Export the mnemonic
+	mnemonic, err := hdWallet.ExportMnemonic([]byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTMnemonicExportResponse{
+		BIP39Mnemonic: mnemonic,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postWalletReleaseHandler handles `POST /v1/wallet/release`
 func postWalletReleaseHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/wallet/release ReleaseWalletHandleToken
@@ -499,6 +570,13 @@ func postWalletRenameHandler(ctx reqContext, w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// Renaming must not lift the wallet's signing policy
+	err = ctx.sm.CheckRename(metadata)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
 	// Fetch the wallet driver
 	driver, err := driver.FetchWalletDriver(metadata.DriverName)
 	if err != nil {
@@ -691,6 +769,70 @@ func postKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	successResponse(w, resp)
 }
 
+// postKeyDeriveHandler handles `POST /v1/key/derive`
+func postKeyDeriveHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/key/derive DeriveKey
+	//---
+	//    Summary: Derive a key at a BIP32 path
+	//    Produces:
+	//    - application/json
+	//    Description: >
+	//      Derives the key at the passed BIP32-Ed25519 derivation path (e.g. m/44'/283'/0'/0/0)
+	//      from the mnemonic of an HD wallet and adds it to the wallet, returning the public key.
+	//    Parameters:
+	//      - name: Derive Key Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/DeriveKeyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/DeriveKeyResponse"
+	var req kmdapi.APIV1POSTKeyDeriveRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	path, err := crypto.ParseHDPath(req.Path)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wlt, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	hdWallet, ok := wlt.(wallet.HDWallet)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errNoBIP39Support)
+		return
+	}
+
+	// Derive the key
+	addr, err := hdWallet.DeriveKey(path)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTKeyDeriveResponse{
+		Address: encodeAddress(addr),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // deleteKeyHandler handles `DELETE /v1/key`
 func deleteKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation DELETE /v1/key DeleteKey
@@ -1279,8 +1421,299 @@ func reqCallbackMiddleware(reqCB func()) func(http.Handler) http.Handler {
 	}
 }
 
+// postMultisigSessionHandler handles `POST /v1/multisig/session`
+func postMultisigSessionHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/multisig/session ProposeMultisigSession
+	//---
+	//    Summary: Propose a multisig session
+	//    Description: >
+	//      Start a session collecting the signatures of the co-signers of a multisig transaction group.
+	//      The multisig preimage of each transaction's sender (or of the signer, for rekeyed accounts)
+	//      must have been imported into the wallet. Co-signers find the session with
+	//      `POST /v1/multisig/session/list` and sign it with `POST /v1/multisig/session/sign`. If
+	//      submit is set, kmd sends the group to algod once every transaction is fully signed.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Propose Multisig Session Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ProposeMultisigSessionRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ProposeMultisigSessionResponse"
+	var req kmdapi.APIV1POSTMultisigSessionRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Decode the transactions
+	txns := make([]transactions.Transaction, len(req.Transactions))
+	for i, txBytes := range req.Transactions {
+		err = protocol.Decode(txBytes, &txns[i])
+		if err != nil {
+			errorResponse(w, http.StatusBadRequest, errCouldNotDecodeTx)
+			return
+		}
+	}
+
+	// Start the session
+	msigSession, err := ctx.msigs.Propose(wallet, txns, req.AuthAddr, req.Submit)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTMultisigSessionResponse{
+		Session: apiMultisigSession(msigSession),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postMultisigSessionListHandler handles `POST /v1/multisig/session/list`
+func postMultisigSessionListHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/multisig/session/list ListMultisigSessions
+	//---
+	//    Summary: List multisig sessions waiting for this wallet
+	//    Description: >
+	//      Lists the pending multisig sessions with transactions co-signed by a key in this wallet
+	//      that has not signed them yet.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: List Multisig Sessions Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ListMultisigSessionsRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ListMultisigSessionsResponse"
+	var req kmdapi.APIV1POSTMultisigSessionListRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// List the keys of the wallet
+	keys, err := wallet.ListKeys()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTMultisigSessionListResponse{}
+	for _, msigSession := range ctx.msigs.Pending(keys) {
+		resp.Sessions = append(resp.Sessions, apiMultisigSession(msigSession))
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postMultisigSessionInfoHandler handles `POST /v1/multisig/session/info`
+func postMultisigSessionInfoHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/multisig/session/info MultisigSessionInfo
+	//---
+	//    Summary: Get a multisig session
+	//    Description: >
+	//      Returns the signatures collected so far by a multisig session, and the signed transaction
+	//      group once it is complete. The wallet must have proposed the session or hold one of its keys.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Multisig Session Info Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/MultisigSessionInfoRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/MultisigSessionInfoResponse"
+	var req kmdapi.APIV1POSTMultisigSessionInfoRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Fetch the session
+	msigSession, err := ctx.msigs.Get(req.SessionID, wallet)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTMultisigSessionInfoResponse{
+		Session: apiMultisigSession(msigSession),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postMultisigSessionSignHandler handles `POST /v1/multisig/session/sign`
+func postMultisigSessionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/multisig/session/sign SignMultisigSession
+	//---
+	//    Summary: Sign a multisig session
+	//    Description: >
+	//      Adds signatures with the passed public key to every transaction of the session that it
+	//      co-signs. When every transaction reaches its threshold the session is complete, and it is
+	//      submitted to algod if it was proposed with submit set.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Sign Multisig Session Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/SignMultisigSessionRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/SignMultisigSessionResponse"
+	var req kmdapi.APIV1POSTMultisigSessionSignRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Sign the session
+	msigSession, err := ctx.msigs.Sign(req.SessionID, wallet, req.PublicKey, []byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTMultisigSessionSignResponse{
+		Session: apiMultisigSession(msigSession),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// deleteMultisigSessionHandler handles `DELETE /v1/multisig/session`
+func deleteMultisigSessionHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation DELETE /v1/multisig/session CancelMultisigSession
+	//---
+	//    Summary: Cancel a multisig session
+	//    Description: >
+	//      Drops a multisig session. Only the wallet that proposed the session may cancel it.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Cancel Multisig Session Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/CancelMultisigSessionRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/CancelMultisigSessionResponse"
+	var req kmdapi.APIV1DELETEMultisigSessionRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Cancel the session
+	err = ctx.msigs.Cancel(req.SessionID, wallet)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1DELETEMultisigSessionResponse{}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// apiMultisigSession converts a multisig session to its API representation
+func apiMultisigSession(s multisig.Session) kmdapi.APIV1MultisigSession {
+	res := kmdapi.APIV1MultisigSession{
+		ID:        s.ID,
+		Status:    string(s.Status),
+		Error:     s.Error,
+		Submit:    s.Submit,
+		ExpiresAt: s.Expires.Unix(),
+	}
+	for i := range s.Transactions {
+		res.Transactions = append(res.Transactions, protocol.Encode(&s.Transactions[i]))
+		res.Multisigs = append(res.Multisigs, protocol.Encode(&s.Multisigs[i]))
+	}
+	for _, stxn := range s.SignedTransactions() {
+		res.SignedTransactions = append(res.SignedTransactions, protocol.Encode(&stxn)...)
+	}
+	return res
+}
+
 // RegisterHandlers sets up the API handlers on the passed router
-func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logger, apiToken string, reqCB func()) {
+func RegisterHandlers(router *mux.Router, sm *session.Manager, msigs *multisig.Coordinator, log logging.Logger, apiToken string, reqCB func()) {
 	// All /v1 requests require a valid auth token
 	router.Use(authMiddleware(log, apiToken))
 
@@ -1289,7 +1722,8 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 
 	// ctx holds the global context passed to each of the handlers
 	ctx := reqContext{
-		sm: sm,
+		sm:    sm,
+		msigs: msigs,
 	}
 
 	router.HandleFunc("/wallets", wrapCtx(ctx, getWalletsHandler)).Methods("GET")
@@ -1300,10 +1734,12 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
 	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
 	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
+	router.HandleFunc("/mnemonic/export", wrapCtx(ctx, postMnemonicExportHandler)).Methods("POST")
 
 	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
 	router.HandleFunc("/key/import", wrapCtx(ctx, postKeyImportHandler)).Methods("POST")
 	router.HandleFunc("/key/export", wrapCtx(ctx, postKeyExportHandler)).Methods("POST")
+	router.HandleFunc("/key/derive", wrapCtx(ctx, postKeyDeriveHandler)).Methods("POST")
 	router.HandleFunc("/key", wrapCtx(ctx, postKeyHandler)).Methods("POST")
 	router.HandleFunc("/key", wrapCtx(ctx, deleteKeyHandler)).Methods("DELETE")
 
@@ -1314,6 +1750,12 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/multisig/export", wrapCtx(ctx, postMultisigExportHandler)).Methods("POST")
 	router.HandleFunc("/multisig", wrapCtx(ctx, deleteMultisigHandler)).Methods("DELETE")
 
+	router.HandleFunc("/multisig/session", wrapCtx(ctx, postMultisigSessionHandler)).Methods("POST")
+	router.HandleFunc("/multisig/session", wrapCtx(ctx, deleteMultisigSessionHandler)).Methods("DELETE")
+	router.HandleFunc("/multisig/session/list", wrapCtx(ctx, postMultisigSessionListHandler)).Methods("POST")
+	router.HandleFunc("/multisig/session/info", wrapCtx(ctx, postMultisigSessionInfoHandler)).Methods("POST")
+	router.HandleFunc("/multisig/session/sign", wrapCtx(ctx, postMultisigSessionSignHandler)).Methods("POST")
+
 	router.HandleFunc("/transaction/sign", wrapCtx(ctx, postTransactionSignHandler)).Methods("POST")
 	router.HandleFunc("/program/sign", wrapCtx(ctx, postProgramSignHandler)).Methods("POST")
 }
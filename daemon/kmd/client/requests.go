@@ -102,6 +102,9 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTMasterKeyExportRequest:
 		reqPath = "v1/master-key/export"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTMnemonicExportRequest:
+		reqPath = "v1/mnemonic/export"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTKeyImportRequest:
 		reqPath = "v1/key/import"
 		reqMethod = "POST"
@@ -111,6 +114,9 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTKeyRequest:
 		reqPath = "v1/key"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTKeyDeriveRequest:
+		reqPath = "v1/key/derive"
+		reqMethod = "POST"
 	case kmdapi.APIV1DELETEKeyRequest:
 		reqPath = "v1/key"
 		reqMethod = "DELETE"
@@ -141,6 +147,21 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1DELETEMultisigRequest:
 		reqPath = "v1/multisig"
 		reqMethod = "DELETE"
+	case kmdapi.APIV1POSTMultisigSessionRequest:
+		reqPath = "v1/multisig/session"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTMultisigSessionListRequest:
+		reqPath = "v1/multisig/session/list"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTMultisigSessionInfoRequest:
+		reqPath = "v1/multisig/session/info"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTMultisigSessionSignRequest:
+		reqPath = "v1/multisig/session/sign"
+		reqMethod = "POST"
+	case kmdapi.APIV1DELETEMultisigSessionRequest:
+		reqPath = "v1/multisig/session"
+		reqMethod = "DELETE"
 	}
 	return
 }
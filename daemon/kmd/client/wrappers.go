@@ -47,6 +47,16 @@ func (kcl KMDClient) GenerateKey(walletHandle []byte) (resp kmdapi.APIV1POSTKeyR
 	return
 }
 
+// DeriveKey wraps kmdapi.APIV1POSTKeyDeriveRequest
+func (kcl KMDClient) DeriveKey(walletHandle []byte, path string) (resp kmdapi.APIV1POSTKeyDeriveResponse, err error) {
+	req := kmdapi.APIV1POSTKeyDeriveRequest{
+		WalletHandleToken: string(walletHandle),
+		Path:              path,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // CreateWallet wraps kmdapi.APIV1POSTWalletRequest
 func (kcl KMDClient) CreateWallet(walletName []byte, walletDriverName string, walletPassword []byte, walletMDK crypto.MasterDerivationKey) (resp kmdapi.APIV1POSTWalletResponse, err error) {
 	req := kmdapi.APIV1POSTWalletRequest{
@@ -59,6 +69,20 @@ func (kcl KMDClient) CreateWallet(walletName []byte, walletDriverName string, wa
 	return
 }
 
+// CreateWalletFromMnemonic wraps kmdapi.APIV1POSTWalletRequest, restoring the
+// wallet from a BIP39 mnemonic and BIP39 passphrase
+func (kcl KMDClient) CreateWalletFromMnemonic(walletName []byte, walletDriverName string, walletPassword []byte, mnemonic string, passphrase string) (resp kmdapi.APIV1POSTWalletResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRequest{
+		WalletName:       string(walletName),
+		WalletDriverName: walletDriverName,
+		WalletPassword:   string(walletPassword),
+		BIP39Mnemonic:    mnemonic,
+		BIP39Passphrase:  passphrase,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // InitWallet wraps kmdapi.APIV1POSTWalletInitRequest
 func (kcl KMDClient) InitWallet(walletID []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletInitResponse, err error) {
 	req := kmdapi.APIV1POSTWalletInitRequest{
@@ -197,6 +221,16 @@ func (kcl KMDClient) ExportMasterDerivationKey(walletHandle []byte, walletPasswo
 	return
 }
 
+// ExportMnemonic wraps kmdapi.APIV1POSTMnemonicExportRequest
+func (kcl KMDClient) ExportMnemonic(walletHandle []byte, walletPassword []byte) (resp kmdapi.APIV1POSTMnemonicExportResponse, err error) {
+	req := kmdapi.APIV1POSTMnemonicExportRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignTransaction wraps kmdapi.APIV1POSTTransactionSignRequest
 func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKey, tx transactions.Transaction) (resp kmdapi.APIV1POSTTransactionSignResponse, err error) {
 	txBytes := protocol.Encode(&tx)
@@ -221,3 +255,58 @@ func (kcl KMDClient) SignProgram(walletHandle, pw []byte, addr string, data []by
 	err = kcl.DoV1Request(req, &resp)
 	return
 }
+
+// ProposeMultisigSession wraps kmdapi.APIV1POSTMultisigSessionRequest
+func (kcl KMDClient) ProposeMultisigSession(walletHandle []byte, txns []transactions.Transaction, authAddr crypto.Digest, submit bool) (resp kmdapi.APIV1POSTMultisigSessionResponse, err error) {
+	req := kmdapi.APIV1POSTMultisigSessionRequest{
+		WalletHandleToken: string(walletHandle),
+		AuthAddr:          authAddr,
+		Submit:            submit,
+	}
+	for i := range txns {
+		req.Transactions = append(req.Transactions, protocol.Encode(&txns[i]))
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ListMultisigSessions wraps kmdapi.APIV1POSTMultisigSessionListRequest
+func (kcl KMDClient) ListMultisigSessions(walletHandle []byte) (resp kmdapi.APIV1POSTMultisigSessionListResponse, err error) {
+	req := kmdapi.APIV1POSTMultisigSessionListRequest{
+		WalletHandleToken: string(walletHandle),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// MultisigSessionInfo wraps kmdapi.APIV1POSTMultisigSessionInfoRequest
+func (kcl KMDClient) MultisigSessionInfo(walletHandle []byte, sessionID string) (resp kmdapi.APIV1POSTMultisigSessionInfoResponse, err error) {
+	req := kmdapi.APIV1POSTMultisigSessionInfoRequest{
+		WalletHandleToken: string(walletHandle),
+		SessionID:         sessionID,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// SignMultisigSession wraps kmdapi.APIV1POSTMultisigSessionSignRequest
+func (kcl KMDClient) SignMultisigSession(walletHandle, pw []byte, sessionID string, pk crypto.PublicKey) (resp kmdapi.APIV1POSTMultisigSessionSignResponse, err error) {
+	req := kmdapi.APIV1POSTMultisigSessionSignRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+		SessionID:         sessionID,
+		PublicKey:         pk,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// CancelMultisigSession wraps kmdapi.APIV1DELETEMultisigSessionRequest
+func (kcl KMDClient) CancelMultisigSession(walletHandle []byte, sessionID string) (resp kmdapi.APIV1DELETEMultisigSessionResponse, err error) {
+	req := kmdapi.APIV1DELETEMultisigSessionRequest{
+		WalletHandleToken: string(walletHandle),
+		SessionID:         sessionID,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
@@ -18,6 +18,7 @@ package config
 
 import (
 	"encoding/json"
+	"net/url"
 	"os"
 	"path/filepath"
 
@@ -35,17 +36,21 @@ const (
 
 // KMDConfig contains global configuration information for kmd
 type KMDConfig struct {
-	DataDir             string       `json:"-"`
-	DriverConfig        DriverConfig `json:"drivers"`
-	SessionLifetimeSecs uint64       `json:"session_lifetime_secs"`
-	Address             string       `json:"address"`
-	AllowedOrigins      []string     `json:"allowed_origins"`
+	DataDir             string                 `json:"-"`
+	DriverConfig        DriverConfig           `json:"drivers"`
+	SessionLifetimeSecs uint64                 `json:"session_lifetime_secs"`
+	Address             string                 `json:"address"`
+	AllowedOrigins      []string               `json:"allowed_origins"`
+	SigningPolicies     SigningPoliciesConfig  `json:"signing_policies"`
+	MultisigSessions    MultisigSessionsConfig `json:"multisig_sessions"`
 }
 
 // DriverConfig contains config info specific to each wallet driver
 type DriverConfig struct {
 	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
 	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+	RemoteWalletDriverConfig RemoteWalletDriverConfig `json:"remote"`
+	HDWalletDriverConfig     HDWalletDriverConfig     `json:"hd"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -60,6 +65,80 @@ type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
 }
 
+// HDWalletDriverConfig is configuration specific to the HDWalletDriver. HD
+// wallets protect their seed with the scrypt parameters of the sqlite driver.
+type HDWalletDriverConfig struct {
+	Disable    bool   `json:"disable"`
+	WalletsDir string `json:"wallets_dir"`
+}
+
+// RemoteWalletDriverConfig is configuration specific to the RemoteWalletDriver
+type RemoteWalletDriverConfig struct {
+	Signers []RemoteSignerConfig `json:"signers"`
+}
+
+// RemoteSignerConfig describes a remote signing service exposed to kmd as a wallet.
+// The signer is reached over HTTPS, optionally authenticating kmd with a client
+// certificate (mTLS) and pinning the signer's certificate authority.
+//
+// The signer trusts whatever kmd forwards to it, and unlike the Ledger driver
+// there is no device asking for confirmation. Without a PasswordHash, anyone
+// able to obtain a kmd wallet handle for this wallet can sign with it, so the
+// kmd API token is then the only protection. PasswordHash, as produced by
+// driver.HashRemoteSignerPassword, requires the wallet password instead.
+type RemoteSignerConfig struct {
+	Name           string `json:"name"`
+	URL            string `json:"url"`
+	CACertFile     string `json:"ca_cert_file"`
+	ClientCertFile string `json:"client_cert_file"`
+	ClientKeyFile  string `json:"client_key_file"`
+	TimeoutSecs    uint64 `json:"timeout_secs"`
+	PasswordHash   string `json:"password_hash"`
+}
+
+// SigningPoliciesConfig contains the signing policies kmd enforces before
+// signing with a wallet, and where it records its decisions
+type SigningPoliciesConfig struct {
+	// AuditLogFile is the append-only log of signing decisions. Relative paths
+	// are resolved against the kmd data directory.
+	AuditLogFile string `json:"audit_log_file"`
+	// ApprovalURL is called for transactions that require a second-factor approval
+	ApprovalURL         string          `json:"approval_url"`
+	ApprovalTimeoutSecs uint64          `json:"approval_timeout_secs"`
+	Policies            []SigningPolicy `json:"policies"`
+}
+
+// SigningPolicy restricts what may be signed with a wallet, identified by
+// either its ID or its name. Amounts are in microAlgos and count the fee of
+// every transaction plus the amount of payments; a zero limit means no limit.
+// While an amount limit is set, transactions closing an account or
+// transferring a non-zero amount of an asset are denied.
+type SigningPolicy struct {
+	WalletID            string   `json:"wallet_id"`
+	WalletName          string   `json:"wallet_name"`
+	AllowedTxTypes      []string `json:"allowed_tx_types"`
+	MaxAmount           uint64   `json:"max_amount"`
+	WindowMaxAmount     uint64   `json:"window_max_amount"`
+	WindowSecs          uint64   `json:"window_secs"`
+	AllowedReceivers    []string `json:"allowed_receivers"`
+	ForbidRekey         bool     `json:"forbid_rekey"`
+	ForbidClose         bool     `json:"forbid_close"`
+	AllowProgramSigning bool     `json:"allow_program_signing"`
+	RequireApproval     bool     `json:"require_approval"`
+}
+
+// MultisigSessionsConfig configures the sessions kmd hosts to collect the
+// signatures of a multisig transaction group from its co-signers
+type MultisigSessionsConfig struct {
+	// SessionLifetimeSecs is how long a session waits for signatures. Zero
+	// selects the default of one day.
+	SessionLifetimeSecs uint64 `json:"session_lifetime_secs"`
+	// AlgodAddress and AlgodToken locate the algod that fully signed groups
+	// are submitted to. Sessions may not ask to be submitted if it is unset.
+	AlgodAddress string `json:"algod_address"`
+	AlgodToken   string `json:"algod_token"`
+}
+
 // ScryptParams stores the parameters used for key derivation. This allows
 // upgrading security parameters over time
 type ScryptParams struct {
@@ -95,6 +174,50 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+
+	hdWalletsDir := k.DriverConfig.HDWalletDriverConfig.WalletsDir
+	if hdWalletsDir != "" {
+		if !filepath.IsAbs(hdWalletsDir) {
+			return ErrHDWalletNotAbsolute
+		}
+		if hdWalletsDir == sqlWalletsDir {
+			return ErrHDWalletSameDir
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, signer := range k.DriverConfig.RemoteWalletDriverConfig.Signers {
+		if signer.Name == "" || names[signer.Name] {
+			return ErrRemoteSignerName
+		}
+		names[signer.Name] = true
+
+		u, err := url.Parse(signer.URL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return ErrRemoteSignerURL
+		}
+		if (signer.ClientCertFile == "") != (signer.ClientKeyFile == "") {
+			return ErrRemoteSignerClientCert
+		}
+	}
+
+	for _, policy := range k.SigningPolicies.Policies {
+		if (policy.WalletID == "") == (policy.WalletName == "") {
+			return ErrSigningPolicyWallet
+		}
+		if policy.WindowMaxAmount != 0 && policy.WindowSecs == 0 {
+			return ErrSigningPolicyWindow
+		}
+		if policy.RequireApproval && k.SigningPolicies.ApprovalURL == "" {
+			return ErrSigningPolicyApproval
+		}
+	}
+	if algodAddress := k.MultisigSessions.AlgodAddress; algodAddress != "" {
+		u, err := url.Parse(algodAddress)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrMultisigAlgodAddress
+		}
+	}
 	return nil
 }
 
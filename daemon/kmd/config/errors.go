@@ -22,3 +22,30 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrHDWalletNotAbsolute is returned when the passed hd wallet directory is relative
+var ErrHDWalletNotAbsolute = fmt.Errorf("hd wallets path must be absolute path")
+
+// ErrHDWalletSameDir is returned when hd and sqlite wallets are configured to share a directory
+var ErrHDWalletSameDir = fmt.Errorf("hd wallets path must differ from the sqlite wallets path")
+
+// ErrRemoteSignerName is returned when a remote signer has an empty or duplicate name
+var ErrRemoteSignerName = fmt.Errorf("remote signers must have unique, non-empty names")
+
+// ErrRemoteSignerURL is returned when a remote signer URL is not a valid https URL
+var ErrRemoteSignerURL = fmt.Errorf("remote signer url must be an https url")
+
+// ErrRemoteSignerClientCert is returned when only one of a remote signer's client certificate and key is set
+var ErrRemoteSignerClientCert = fmt.Errorf("remote signer client certificate and key must be set together")
+
+// ErrSigningPolicyWallet is returned when a signing policy does not name exactly one of a wallet ID or name
+var ErrSigningPolicyWallet = fmt.Errorf("signing policies must set exactly one of wallet_id and wallet_name")
+
+// ErrSigningPolicyWindow is returned when a signing policy limits a window without setting its length
+var ErrSigningPolicyWindow = fmt.Errorf("signing policies with a window_max_amount must set window_secs")
+
+// ErrSigningPolicyApproval is returned when a signing policy requires approval but no approval url is set
+var ErrSigningPolicyApproval = fmt.Errorf("signing policies requiring approval need an approval_url")
+
+// ErrMultisigAlgodAddress is returned when the algod address for multisig sessions is not an http(s) url
+var ErrMultisigAlgodAddress = fmt.Errorf("multisig sessions algod_address must be an http or https url")
@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/multisig"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/server"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
@@ -57,6 +59,24 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		return
 	}
 
+	// Load the signing policies, if any, and open the audit log
+	policies, err := policy.MakeEngine(kmdCfg, startConfig.Log)
+	if err != nil {
+		return
+	}
+	defer func() {
+		// Close the audit log if we fail to start
+		if err != nil && policies != nil {
+			policies.Close()
+		}
+	}()
+
+	// Host the sessions collecting multisig signatures
+	multisigs, err := multisig.MakeCoordinator(kmdCfg, startConfig.Log)
+	if err != nil {
+		return
+	}
+
 	// Make or read the API token + check that it's reasonable
 	apiToken, _, err := tokens.ValidateOrGenerateAPIToken(startConfig.DataDir, tokens.KmdTokenFilename)
 	if err != nil {
@@ -69,7 +89,8 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		DataDir:        startConfig.DataDir,
 		Address:        kmdCfg.Address,
 		AllowedOrigins: kmdCfg.AllowedOrigins,
-		SessionManager: session.MakeManager(kmdCfg),
+		SessionManager: session.MakeManager(kmdCfg, policies),
+		Multisigs:      multisigs,
 		Log:            startConfig.Log,
 		Timeout:        startConfig.Timeout,
 	}
@@ -82,5 +103,20 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 
 	// Start the wallet API server
 	died, sock, err = ws.Start(startConfig.Kill)
+	if err != nil || policies == nil {
+		return
+	}
+
+	// Close the audit log once the server has shut down
+	serverDied := died
+	died = make(chan error)
+	go func() {
+		err := <-serverDied
+		closeErr := policies.Close()
+		if closeErr != nil {
+			startConfig.Log.Warnf("non-nil error closing kmd audit log: %s", closeErr)
+		}
+		died <- err
+	}()
 	return
 }
@@ -53,3 +53,25 @@ type APIV1WalletHandle struct {
 	Wallet         APIV1Wallet `json:"wallet"`
 	ExpiresSeconds int64       `json:"expires_seconds"`
 }
+
+// APIV1MultisigSession is the API's representation of a multisig session, a
+// transaction group waiting for the signatures of its co-signers
+type APIV1MultisigSession struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	// Error is set if algod rejected the signed group
+	Error     string `json:"error,omitempty"`
+	Submit    bool   `json:"submit"`
+	ExpiresAt int64  `json:"expires_at"`
+	// Transactions are the msgpack encoded transactions of the group
+	// swagger:strfmt byte
+	Transactions [][]byte `json:"transactions"`
+	// Multisigs are the msgpack encoded multisig signatures collected so far
+	// for each transaction
+	// swagger:strfmt byte
+	Multisigs [][]byte `json:"multisigs"`
+	// SignedTransactions are the concatenated msgpack encoded signed
+	// transactions of the group, once the session is complete
+	// swagger:strfmt byte
+	SignedTransactions []byte `json:"signed_transactions,omitempty"`
+}
@@ -49,6 +49,8 @@ type APIV1POSTWalletRequest struct {
 	WalletDriverName    string                   `json:"wallet_driver_name"`
 	WalletPassword      string                   `json:"wallet_password"`
 	MasterDerivationKey APIV1MasterDerivationKey `json:"master_derivation_key"`
+	BIP39Mnemonic       string                   `json:"bip39_mnemonic"`
+	BIP39Passphrase     string                   `json:"bip39_passphrase"`
 }
 
 // APIV1POSTWalletInitRequest is the request for `POST /v1/wallet/init`
@@ -109,6 +111,16 @@ type APIV1POSTMasterKeyExportRequest struct {
 	WalletPassword    string `json:"wallet_password"`
 }
 
+// APIV1POSTMnemonicExportRequest is the request for `POST /v1/mnemonic/export`
+//
+// swagger:model ExportMnemonicRequest
+type APIV1POSTMnemonicExportRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+}
+
 // APIV1POSTKeyImportRequest is the request for `POST /v1/key/import`
 //
 // swagger:model ImportKeyRequest
@@ -140,6 +152,16 @@ type APIV1POSTKeyRequest struct {
 	DisplayMnemonic   bool   `json:"display_mnemonic"`
 }
 
+// APIV1POSTKeyDeriveRequest is the request for `POST /v1/key/derive`
+//
+// swagger:model DeriveKeyRequest
+type APIV1POSTKeyDeriveRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	Path              string `json:"path"`
+}
+
 // APIV1DELETEKeyRequest is the request for `DELETE /v1/key`
 //
 // swagger:model DeleteKeyRequest
@@ -262,3 +284,57 @@ type APIV1POSTMultisigProgramSignRequest struct {
 	PartialMsig    crypto.MultisigSig `json:"partial_multisig"`
 	WalletPassword string             `json:"wallet_password"`
 }
+
+// APIV1POSTMultisigSessionRequest is the request for `POST /v1/multisig/session`
+//
+// swagger:model ProposeMultisigSessionRequest
+type APIV1POSTMultisigSessionRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	// swagger:strfmt byte
+	Transactions [][]byte      `json:"transactions"`
+	AuthAddr     crypto.Digest `json:"signer"`
+	Submit       bool          `json:"submit"`
+}
+
+// APIV1POSTMultisigSessionListRequest is the request for `POST /v1/multisig/session/list`
+//
+// swagger:model ListMultisigSessionsRequest
+type APIV1POSTMultisigSessionListRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+}
+
+// APIV1POSTMultisigSessionInfoRequest is the request for `POST /v1/multisig/session/info`
+//
+// swagger:model MultisigSessionInfoRequest
+type APIV1POSTMultisigSessionInfoRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	SessionID         string `json:"session_id"`
+}
+
+// APIV1POSTMultisigSessionSignRequest is the request for `POST /v1/multisig/session/sign`
+//
+// swagger:model SignMultisigSessionRequest
+type APIV1POSTMultisigSessionSignRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string           `json:"wallet_handle_token"`
+	WalletPassword    string           `json:"wallet_password"`
+	SessionID         string           `json:"session_id"`
+	PublicKey         crypto.PublicKey `json:"public_key"`
+}
+
+// APIV1DELETEMultisigSessionRequest is the request for `DELETE /v1/multisig/session`
+//
+// swagger:model CancelMultisigSessionRequest
+type APIV1DELETEMultisigSessionRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	SessionID         string `json:"session_id"`
+}
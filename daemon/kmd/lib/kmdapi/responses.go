@@ -173,6 +173,20 @@ type exportMasterKeyResponse struct {
 	Body *APIV1POSTMasterKeyExportResponse
 }
 
+// APIV1POSTMnemonicExportResponse is the response to `POST /v1/mnemonic/export`
+// friendly:ExportMnemonicResponse
+type APIV1POSTMnemonicExportResponse struct {
+	APIV1ResponseEnvelope
+	BIP39Mnemonic string `json:"bip39_mnemonic"`
+}
+
+// Response to `POST /v1/mnemonic/export`
+// swagger:response ExportMnemonicResponse
+type exportMnemonicResponse struct {
+	//	in:body
+	Body *APIV1POSTMnemonicExportResponse
+}
+
 // APIV1POSTKeyImportResponse is the response to `POST /v1/key/import`
 // friendly:ImportKeyResponse
 type APIV1POSTKeyImportResponse struct {
@@ -215,6 +229,20 @@ type generateKeyResponse struct {
 	Body *APIV1POSTKeyResponse
 }
 
+// APIV1POSTKeyDeriveResponse is the response to `POST /v1/key/derive`
+// friendly:DeriveKeyResponse
+type APIV1POSTKeyDeriveResponse struct {
+	APIV1ResponseEnvelope
+	Address string `json:"address"`
+}
+
+// Response to `POST /v1/key/derive`
+// swagger:response DeriveKeyResponse
+type deriveKeyResponse struct {
+	//	in:body
+	Body *APIV1POSTKeyDeriveResponse
+}
+
 // APIV1DELETEKeyResponse is the response to `DELETE /v1/key`
 // friendly:DeleteKeyResponse
 type APIV1DELETEKeyResponse struct {
@@ -362,3 +390,72 @@ type signProgramMultisigResponse struct {
 	//	in:body
 	Body *APIV1POSTMultisigProgramSignResponse
 }
+
+// APIV1POSTMultisigSessionResponse is the response to `POST /v1/multisig/session`
+// friendly:ProposeMultisigSessionResponse
+type APIV1POSTMultisigSessionResponse struct {
+	APIV1ResponseEnvelope
+	Session APIV1MultisigSession `json:"session"`
+}
+
+// Response to `POST /v1/multisig/session`
+// swagger:response ProposeMultisigSessionResponse
+type proposeMultisigSessionResponse struct {
+	//	in:body
+	Body *APIV1POSTMultisigSessionResponse
+}
+
+// APIV1POSTMultisigSessionListResponse is the response to `POST /v1/multisig/session/list`
+// friendly:ListMultisigSessionsResponse
+type APIV1POSTMultisigSessionListResponse struct {
+	APIV1ResponseEnvelope
+	Sessions []APIV1MultisigSession `json:"sessions"`
+}
+
+// Response to `POST /v1/multisig/session/list`
+// swagger:response ListMultisigSessionsResponse
+type listMultisigSessionsResponse struct {
+	//	in:body
+	Body *APIV1POSTMultisigSessionListResponse
+}
+
+// APIV1POSTMultisigSessionInfoResponse is the response to `POST /v1/multisig/session/info`
+// friendly:MultisigSessionInfoResponse
+type APIV1POSTMultisigSessionInfoResponse struct {
+	APIV1ResponseEnvelope
+	Session APIV1MultisigSession `json:"session"`
+}
+
+// Response to `POST /v1/multisig/session/info`
+// swagger:response MultisigSessionInfoResponse
+type multisigSessionInfoResponse struct {
+	//	in:body
+	Body *APIV1POSTMultisigSessionInfoResponse
+}
+
+// APIV1POSTMultisigSessionSignResponse is the response to `POST /v1/multisig/session/sign`
+// friendly:SignMultisigSessionResponse
+type APIV1POSTMultisigSessionSignResponse struct {
+	APIV1ResponseEnvelope
+	Session APIV1MultisigSession `json:"session"`
+}
+
+// Response to `POST /v1/multisig/session/sign`
+// swagger:response SignMultisigSessionResponse
+type signMultisigSessionResponse struct {
+	//	in:body
+	Body *APIV1POSTMultisigSessionSignResponse
+}
+
+// APIV1DELETEMultisigSessionResponse is the response to `DELETE /v1/multisig/session`
+// friendly:CancelMultisigSessionResponse
+type APIV1DELETEMultisigSessionResponse struct {
+	APIV1ResponseEnvelope
+}
+
+// Response to `DELETE /v1/multisig/session`
+// swagger:response CancelMultisigSessionResponse
+type cancelMultisigSessionResponse struct {
+	//	in:body
+	Body *APIV1DELETEMultisigSessionResponse
+}
@@ -0,0 +1,438 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package multisig
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	kmdconfig "github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+)
+
+const (
+	defaultSessionLifetime = 24 * time.Hour
+	sessionIDBytes         = 16
+)
+
+// Status is the state of a multisig session
+type Status string
+
+const (
+	// StatusPending sessions are waiting for signatures
+	StatusPending Status = "pending"
+	// StatusComplete sessions have reached the threshold of every transaction
+	StatusComplete Status = "complete"
+	// StatusSubmitted sessions were complete and have been sent to algod
+	StatusSubmitted Status = "submitted"
+	// StatusFailed sessions were complete but algod rejected them
+	StatusFailed Status = "failed"
+)
+
+// SubmitFunc sends a fully signed transaction group to the network
+type SubmitFunc func(group []transactions.SignedTxn) error
+
+// Session is a transaction group waiting for the signatures of the co-signers
+// of its multisig senders
+type Session struct {
+	ID               string
+	ProposerWalletID []byte
+	Transactions     []transactions.Transaction
+	// AuthAddrs holds the multisig address authorizing each transaction, if
+	// it differs from the sender
+	AuthAddrs []crypto.Digest
+	Multisigs []crypto.MultisigSig
+	Submit    bool
+	Status    Status
+	Error     string
+	Expires   time.Time
+}
+
+// Coordinator hosts the pending multisig sessions of kmd
+type Coordinator struct {
+	mu       deadlock.Mutex
+	sessions map[string]*Session
+	lifetime time.Duration
+	submit   SubmitFunc
+	log      logging.Logger
+	now      func() time.Time
+}
+
+// MakeCoordinator creates the multisig session coordinator described by the
+// kmd configuration
+func MakeCoordinator(cfg kmdconfig.KMDConfig, log logging.Logger) (*Coordinator, error) {
+	msc := cfg.MultisigSessions
+	c := &Coordinator{
+		sessions: make(map[string]*Session),
+		lifetime: time.Duration(msc.SessionLifetimeSecs) * time.Second,
+		log:      log,
+		now:      time.Now,
+	}
+	if c.lifetime == 0 {
+		c.lifetime = defaultSessionLifetime
+	}
+	if msc.AlgodAddress != "" {
+		u, err := url.Parse(msc.AlgodAddress)
+		if err != nil {
+			return nil, err
+		}
+		algod := client.MakeRestClient(*u, msc.AlgodToken)
+		c.submit = algod.SendRawTransactionGroup
+	}
+	return c, nil
+}
+
+// SetSubmitter replaces the function used to submit complete sessions
+func (c *Coordinator) SetSubmitter(submit SubmitFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.submit = submit
+}
+
+// Propose starts a session collecting signatures for txns. The multisig
+// preimage of each transaction's sender, or of authAddr if it is set, must
+// have been imported into the proposing wallet. If submit is set, the group
+// is sent to algod once it is fully signed.
+func (c *Coordinator) Propose(w wallet.Wallet, txns []transactions.Transaction, authAddr crypto.Digest, submit bool) (Session, error) {
+	if len(txns) == 0 {
+		return Session{}, errNoTransactions
+	}
+	if len(txns) > config.MaxTxGroupSize {
+		return Session{}, errTooManyTransactions
+	}
+	if len(txns) > 1 {
+		var group transactions.TxGroup
+		for _, tx := range txns {
+			tx.Group = crypto.Digest{}
+			group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(tx.ID()))
+		}
+		groupID := crypto.HashObj(group)
+		for _, tx := range txns {
+			if tx.Group != groupID {
+				return Session{}, errBadGroup
+			}
+		}
+	}
+
+	md, err := w.Metadata()
+	if err != nil {
+		return Session{}, err
+	}
+
+	s := &Session{
+		ProposerWalletID: md.ID,
+		Transactions:     txns,
+		AuthAddrs:        make([]crypto.Digest, len(txns)),
+		Multisigs:        make([]crypto.MultisigSig, len(txns)),
+		Submit:           submit,
+		Status:           StatusPending,
+	}
+	for i, tx := range txns {
+		addr := crypto.Digest(tx.Sender)
+		if !authAddr.IsZero() {
+			addr = authAddr
+			s.AuthAddrs[i] = authAddr
+		}
+		version, threshold, pks, err := w.LookupMultisigPreimage(addr)
+		if err != nil {
+			return Session{}, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		// start from an unsigned multisig so that co-signers do not need the
+		// preimage in their own wallets
+		s.Multisigs[i] = crypto.MultisigSig{Version: version, Threshold: threshold}
+		for _, pk := range pks {
+			s.Multisigs[i].Subsigs = append(s.Multisigs[i].Subsigs, crypto.MultisigSubsig{Key: pk})
+		}
+	}
+
+	var id [sessionIDBytes]byte
+	crypto.RandBytes(id[:])
+	s.ID = hex.EncodeToString(id[:])
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if submit && c.submit == nil {
+		return Session{}, errNoAlgod
+	}
+	c.expireLocked()
+	s.Expires = c.now().Add(c.lifetime)
+	c.sessions[s.ID] = s
+	return s.copy(), nil
+}
+
+// Pending lists the sessions waiting for a signature from any of keys
+func (c *Coordinator) Pending(keys []crypto.Digest) []Session {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.expireLocked()
+
+	var pending []Session
+	for _, s := range c.sessions {
+		if s.Status != StatusPending {
+			continue
+		}
+		for _, key := range keys {
+			if len(s.unsigned(crypto.PublicKey(key))) > 0 {
+				pending = append(pending, s.copy())
+				break
+			}
+		}
+	}
+	return pending
+}
+
+// Get returns the session with the given id, if w proposed it or holds one of
+// its keys
+func (c *Coordinator) Get(id string, w wallet.Wallet) (Session, error) {
+	c.mu.Lock()
+	s, err := c.lookupLocked(id)
+	var snapshot Session
+	if err == nil {
+		snapshot = s.copy()
+	}
+	c.mu.Unlock()
+	if err != nil {
+		return Session{}, err
+	}
+
+	err = checkParticipant(snapshot, w)
+	if err != nil {
+		return Session{}, err
+	}
+	return snapshot, nil
+}
+
+// Sign adds the signatures of pk, which must be held by w, to every
+// transaction of the session it co-signs. Once every transaction reaches its
+// threshold the session is complete, and it is submitted if it was proposed
+// with submit set.
+func (c *Coordinator) Sign(id string, w wallet.Wallet, pk crypto.PublicKey, pw []byte) (Session, error) {
+	c.mu.Lock()
+	s, err := c.lookupLocked(id)
+	if err != nil {
+		c.mu.Unlock()
+		return Session{}, err
+	}
+	if s.Status != StatusPending {
+		c.mu.Unlock()
+		return Session{}, errSessionNotPending
+	}
+	toSign := s.unsigned(pk)
+	partials := make([]crypto.MultisigSig, len(toSign))
+	for j, i := range toSign {
+		partials[j] = copyMultisig(s.Multisigs[i])
+	}
+	c.mu.Unlock()
+
+	if len(toSign) == 0 {
+		return Session{}, errNothingToSign
+	}
+
+	// signing may wait on the user or on a signing policy approval, so it is
+	// done without holding the lock
+	for j, i := range toSign {
+		partials[j], err = w.MultisigSignTransaction(s.Transactions[i], pk, partials[j], pw, s.AuthAddrs[i])
+		if err != nil {
+			return Session{}, fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+
+	c.mu.Lock()
+	// other co-signers may have signed in the meantime, so merge rather than replace
+	if s.Status != StatusPending || c.sessions[id] != s {
+		c.mu.Unlock()
+		return Session{}, errSessionNotPending
+	}
+	for j, i := range toSign {
+		merged, err := crypto.MultisigMerge(s.Multisigs[i], partials[j])
+		if err != nil {
+			c.mu.Unlock()
+			return Session{}, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		s.Multisigs[i] = merged
+	}
+	if !s.complete() {
+		defer c.mu.Unlock()
+		return s.copy(), nil
+	}
+
+	s.Status = StatusComplete
+	submit := c.submit
+	if !s.Submit || submit == nil {
+		defer c.mu.Unlock()
+		return s.copy(), nil
+	}
+	group := s.signedTxns()
+	c.mu.Unlock()
+
+	// only the signature completing the session gets here, so the group is
+	// submitted once
+	err = submit(group)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		c.log.Warnf("kmd multisig session %s: could not submit group: %v", id, err)
+		s.Status = StatusFailed
+		s.Error = err.Error()
+	} else {
+		s.Status = StatusSubmitted
+	}
+	return s.copy(), nil
+}
+
+// Cancel drops the session with the given id. Only the proposing wallet may
+// cancel a session.
+func (c *Coordinator) Cancel(id string, w wallet.Wallet) error {
+	md, err := w.Metadata()
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, err := c.lookupLocked(id)
+	if err != nil {
+		return err
+	}
+	if string(s.ProposerWalletID) != string(md.ID) {
+		return errNotProposer
+	}
+	delete(c.sessions, id)
+	return nil
+}
+
+// SignedTransactions returns the signed transaction group of a complete session
+func (s Session) SignedTransactions() []transactions.SignedTxn {
+	if s.Status == StatusPending {
+		return nil
+	}
+	return s.signedTxns()
+}
+
+func (c *Coordinator) lookupLocked(id string) (*Session, error) {
+	c.expireLocked()
+	s, ok := c.sessions[id]
+	if !ok {
+		return nil, errSessionNotFound
+	}
+	return s, nil
+}
+
+// expireLocked drops the sessions which outlived their lifetime
+func (c *Coordinator) expireLocked() {
+	now := c.now()
+	for id, s := range c.sessions {
+		if now.After(s.Expires) {
+			delete(c.sessions, id)
+		}
+	}
+}
+
+func checkParticipant(s Session, w wallet.Wallet) error {
+	md, err := w.Metadata()
+	if err != nil {
+		return err
+	}
+	if string(s.ProposerWalletID) == string(md.ID) {
+		return nil
+	}
+
+	keys, err := w.ListKeys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		for _, msig := range s.Multisigs {
+			for _, subsig := range msig.Subsigs {
+				if subsig.Key == crypto.PublicKey(key) {
+					return nil
+				}
+			}
+		}
+	}
+	return errNotParticipant
+}
+
+// unsigned returns the indexes of the transactions that pk co-signs but has
+// not signed yet
+func (s *Session) unsigned(pk crypto.PublicKey) (idx []int) {
+	for i, msig := range s.Multisigs {
+		for _, subsig := range msig.Subsigs {
+			if subsig.Key == pk && subsig.Sig.Blank() {
+				idx = append(idx, i)
+				break
+			}
+		}
+	}
+	return
+}
+
+// complete reports whether every transaction reached its threshold
+func (s *Session) complete() bool {
+	for _, msig := range s.Multisigs {
+		signed := 0
+		for _, subsig := range msig.Subsigs {
+			if !subsig.Sig.Blank() {
+				signed++
+			}
+		}
+		if signed < int(msig.Threshold) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Session) signedTxns() []transactions.SignedTxn {
+	group := make([]transactions.SignedTxn, len(s.Transactions))
+	for i, tx := range s.Transactions {
+		group[i] = transactions.SignedTxn{
+			Txn:      tx,
+			Msig:     copyMultisig(s.Multisigs[i]),
+			AuthAddr: basics.Address(s.AuthAddrs[i]),
+		}
+	}
+	return group
+}
+
+// copy returns a snapshot of the session that is safe to use without the lock
+func (s *Session) copy() Session {
+	cp := *s
+	cp.Transactions = append([]transactions.Transaction(nil), s.Transactions...)
+	cp.AuthAddrs = append([]crypto.Digest(nil), s.AuthAddrs...)
+	cp.Multisigs = make([]crypto.MultisigSig, len(s.Multisigs))
+	for i, msig := range s.Multisigs {
+		cp.Multisigs[i] = copyMultisig(msig)
+	}
+	return cp
+}
+
+func copyMultisig(msig crypto.MultisigSig) crypto.MultisigSig {
+	msig.Subsigs = append([]crypto.MultisigSubsig(nil), msig.Subsigs...)
+	return msig
+}
@@ -0,0 +1,259 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package multisig
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testWallet holds a single key and the preimages of the multisig accounts it co-signs
+type testWallet struct {
+	wallet.Wallet
+	id        string
+	secrets   *crypto.SignatureSecrets
+	preimages map[crypto.Digest][]crypto.PublicKey
+	threshold uint8
+}
+
+func makeTestWallet(id string) *testWallet {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	return &testWallet{id: id, secrets: crypto.GenerateSignatureSecrets(seed), preimages: make(map[crypto.Digest][]crypto.PublicKey)}
+}
+
+func (tw *testWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{ID: []byte(tw.id), Name: []byte(tw.id)}, nil
+}
+
+func (tw *testWallet) ListKeys() ([]crypto.Digest, error) {
+	return []crypto.Digest{crypto.Digest(tw.secrets.SignatureVerifier)}, nil
+}
+
+func (tw *testWallet) LookupMultisigPreimage(addr crypto.Digest) (uint8, uint8, []crypto.PublicKey, error) {
+	pks, ok := tw.preimages[addr]
+	if !ok {
+		return 0, 0, nil, errors.New("unknown multisig address")
+	}
+	return 1, tw.threshold, pks, nil
+}
+
+func (tw *testWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	if string(pw) != "pw" {
+		return crypto.MultisigSig{}, errors.New("bad password")
+	}
+	if pk != tw.secrets.SignatureVerifier {
+		return crypto.MultisigSig{}, errors.New("unknown key")
+	}
+	for i := range partial.Subsigs {
+		if partial.Subsigs[i].Key == pk {
+			partial.Subsigs[i].Sig = tw.secrets.Sign(tx)
+		}
+	}
+	return partial, nil
+}
+
+// makeTestMultisig sets up a 2-of-3 multisig account co-signed by three wallets
+func makeTestMultisig(t *testing.T) (basics.Address, []*testWallet) {
+	wallets := []*testWallet{makeTestWallet("a"), makeTestWallet("b"), makeTestWallet("c")}
+	var pks []crypto.PublicKey
+	for _, w := range wallets {
+		pks = append(pks, w.secrets.SignatureVerifier)
+	}
+	addr, err := crypto.MultisigAddrGen(1, 2, pks)
+	require.NoError(t, err)
+	wallets[0].preimages[addr] = pks
+	wallets[0].threshold = 2
+	return basics.Address(addr), wallets
+}
+
+func makeTestCoordinator(t *testing.T) *Coordinator {
+	c, err := MakeCoordinator(config.KMDConfig{}, logging.TestingLog(t))
+	require.NoError(t, err)
+	return c
+}
+
+func testGroup(sender basics.Address, n int) []transactions.Transaction {
+	txns := make([]transactions.Transaction, n)
+	var group transactions.TxGroup
+	for i := range txns {
+		txns[i] = transactions.Transaction{
+			Type:             protocol.PaymentTx,
+			Header:           transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: 1000}, FirstValid: 1, LastValid: 100},
+			PaymentTxnFields: transactions.PaymentTxnFields{Amount: basics.MicroAlgos{Raw: uint64(i)}},
+		}
+		group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(txns[i].ID()))
+	}
+	if n > 1 {
+		groupID := crypto.HashObj(group)
+		for i := range txns {
+			txns[i].Group = groupID
+		}
+	}
+	return txns
+}
+
+func TestMultisigSession(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	addr, wallets := makeTestMultisig(t)
+	c := makeTestCoordinator(t)
+	var submitted []transactions.SignedTxn
+	c.SetSubmitter(func(group []transactions.SignedTxn) error {
+		submitted = group
+		return nil
+	})
+
+	txns := testGroup(addr, 2)
+	s, err := c.Propose(wallets[0], txns, crypto.Digest{}, true)
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, s.Status)
+	require.Nil(t, s.SignedTransactions())
+
+	// co-signers see the session without having imported the preimage
+	for _, w := range wallets {
+		keys, err := w.ListKeys()
+		require.NoError(t, err)
+		pending := c.Pending(keys)
+		require.Len(t, pending, 1)
+		require.Equal(t, s.ID, pending[0].ID)
+	}
+	_, err = c.Get(s.ID, makeTestWallet("outsider"))
+	require.Error(t, err)
+
+	_, err = c.Sign(s.ID, wallets[1], wallets[1].secrets.SignatureVerifier, []byte("wrong"))
+	require.Error(t, err)
+	s, err = c.Sign(s.ID, wallets[1], wallets[1].secrets.SignatureVerifier, []byte("pw"))
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, s.Status)
+
+	// signing twice has nothing left to do
+	_, err = c.Sign(s.ID, wallets[1], wallets[1].secrets.SignatureVerifier, []byte("pw"))
+	require.ErrorIs(t, err, errNothingToSign)
+	keys, _ := wallets[1].ListKeys()
+	require.Empty(t, c.Pending(keys))
+
+	s, err = c.Sign(s.ID, wallets[2], wallets[2].secrets.SignatureVerifier, []byte("pw"))
+	require.NoError(t, err)
+	require.Equal(t, StatusSubmitted, s.Status)
+	require.Len(t, submitted, 2)
+	for i, stxn := range submitted {
+		require.Equal(t, txns[i], stxn.Txn)
+		require.NoError(t, crypto.MultisigVerify(stxn.Txn, crypto.Digest(addr), stxn.Msig))
+	}
+	require.Equal(t, submitted, s.SignedTransactions())
+
+	// complete sessions take no more signatures and are no longer pending
+	_, err = c.Sign(s.ID, wallets[0], wallets[0].secrets.SignatureVerifier, []byte("pw"))
+	require.ErrorIs(t, err, errSessionNotPending)
+	keys, _ = wallets[0].ListKeys()
+	require.Empty(t, c.Pending(keys))
+	info, err := c.Get(s.ID, wallets[2])
+	require.NoError(t, err)
+	require.Equal(t, StatusSubmitted, info.Status)
+}
+
+func TestMultisigSessionSubmitFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	addr, wallets := makeTestMultisig(t)
+	c := makeTestCoordinator(t)
+
+	// sessions may only ask to be submitted if kmd knows an algod
+	_, err := c.Propose(wallets[0], testGroup(addr, 1), crypto.Digest{}, true)
+	require.ErrorIs(t, err, errNoAlgod)
+
+	c.SetSubmitter(func(group []transactions.SignedTxn) error {
+		return errors.New("overspend")
+	})
+	s, err := c.Propose(wallets[0], testGroup(addr, 1), crypto.Digest{}, true)
+	require.NoError(t, err)
+	_, err = c.Sign(s.ID, wallets[0], wallets[0].secrets.SignatureVerifier, []byte("pw"))
+	require.NoError(t, err)
+	s, err = c.Sign(s.ID, wallets[1], wallets[1].secrets.SignatureVerifier, []byte("pw"))
+	require.NoError(t, err)
+	require.Equal(t, StatusFailed, s.Status)
+	require.Equal(t, "overspend", s.Error)
+	require.Len(t, s.SignedTransactions(), 1)
+}
+
+func TestMultisigSessionPropose(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	addr, wallets := makeTestMultisig(t)
+	c := makeTestCoordinator(t)
+
+	_, err := c.Propose(wallets[0], nil, crypto.Digest{}, false)
+	require.ErrorIs(t, err, errNoTransactions)
+
+	// the proposer must know the multisig preimage
+	_, err = c.Propose(wallets[1], testGroup(addr, 1), crypto.Digest{}, false)
+	require.Error(t, err)
+
+	// transactions must form a group
+	txns := testGroup(addr, 2)
+	txns[1].Amount.Raw++
+	_, err = c.Propose(wallets[0], txns, crypto.Digest{}, false)
+	require.ErrorIs(t, err, errBadGroup)
+
+	// rekeyed accounts are authorized by the signer's preimage
+	rekeyed := testGroup(basics.Address{0x01}, 1)
+	s, err := c.Propose(wallets[0], rekeyed, crypto.Digest(addr), false)
+	require.NoError(t, err)
+	_, err = c.Sign(s.ID, wallets[0], wallets[0].secrets.SignatureVerifier, []byte("pw"))
+	require.NoError(t, err)
+	s, err = c.Sign(s.ID, wallets[2], wallets[2].secrets.SignatureVerifier, []byte("pw"))
+	require.NoError(t, err)
+	require.Equal(t, StatusComplete, s.Status)
+	stxns := s.SignedTransactions()
+	require.Equal(t, addr, stxns[0].AuthAddr)
+	require.NoError(t, crypto.MultisigVerify(stxns[0].Txn, crypto.Digest(addr), stxns[0].Msig))
+}
+
+func TestMultisigSessionCancelAndExpire(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	addr, wallets := makeTestMultisig(t)
+	c := makeTestCoordinator(t)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	s, err := c.Propose(wallets[0], testGroup(addr, 1), crypto.Digest{}, false)
+	require.NoError(t, err)
+	require.ErrorIs(t, c.Cancel(s.ID, wallets[1]), errNotProposer)
+	require.NoError(t, c.Cancel(s.ID, wallets[0]))
+	_, err = c.Get(s.ID, wallets[0])
+	require.ErrorIs(t, err, errSessionNotFound)
+
+	s, err = c.Propose(wallets[0], testGroup(addr, 1), crypto.Digest{}, false)
+	require.NoError(t, err)
+	now = now.Add(defaultSessionLifetime + time.Second)
+	_, err = c.Sign(s.ID, wallets[1], wallets[1].secrets.SignatureVerifier, []byte("pw"))
+	require.ErrorIs(t, err, errSessionNotFound)
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package multisig
+
+import (
+	"fmt"
+)
+
+var errSessionNotFound = fmt.Errorf("multisig session not found")
+var errSessionNotPending = fmt.Errorf("multisig session is no longer waiting for signatures")
+var errNotParticipant = fmt.Errorf("wallet is not a participant in the multisig session")
+var errNotProposer = fmt.Errorf("only the wallet that proposed a multisig session may cancel it")
+var errNothingToSign = fmt.Errorf("key has nothing left to sign in the multisig session")
+var errNoTransactions = fmt.Errorf("multisig session needs at least one transaction")
+var errTooManyTransactions = fmt.Errorf("too many transactions for a transaction group")
+var errBadGroup = fmt.Errorf("transactions do not form a transaction group")
+var errNoAlgod = fmt.Errorf("cannot submit multisig sessions: no algod_address is configured")
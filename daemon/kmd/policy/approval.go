@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultApprovalTimeout = 60 * time.Second
+
+// maxApprovalResponseBytes bounds how much of an approval response is read
+const maxApprovalResponseBytes = 64 * 1024
+
+type approvalResponse struct {
+	Approved bool   `json:"approved"`
+	Reason   string `json:"reason"`
+}
+
+// makeHTTPApprover returns an ApprovalFunc which posts each request to url
+// and waits for an {"approved": true} response. The approval service is
+// expected to hold the request open until a second factor has approved or
+// rejected the transaction.
+func makeHTTPApprover(url string, timeout time.Duration) ApprovalFunc {
+	if timeout == 0 {
+		timeout = defaultApprovalTimeout
+	}
+	client := &http.Client{Timeout: timeout}
+	return func(req ApprovalRequest) error {
+		body, err := json.Marshal(&req)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("approval service returned %s", resp.Status)
+		}
+		var decision approvalResponse
+		err = json.NewDecoder(io.LimitReader(resp.Body, maxApprovalResponseBytes)).Decode(&decision)
+		if err != nil {
+			return fmt.Errorf("bad approval response: %w", err)
+		}
+		if !decision.Approved {
+			if decision.Reason != "" {
+				return fmt.Errorf("rejected: %s", decision.Reason)
+			}
+			return fmt.Errorf("rejected")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// AuditEntry is a single signing decision recorded in the audit log
+type AuditEntry struct {
+	Time             time.Time `json:"time"`
+	WalletID         string    `json:"wallet_id"`
+	WalletName       string    `json:"wallet_name"`
+	Operation        string    `json:"operation"`
+	TxID             string    `json:"txid,omitempty"`
+	TxType           string    `json:"tx_type,omitempty"`
+	Sender           string    `json:"sender"`
+	Receiver         string    `json:"receiver,omitempty"`
+	Amount           uint64    `json:"amount,omitempty"`
+	Fee              uint64    `json:"fee,omitempty"`
+	ApprovalRequired bool      `json:"approval_required,omitempty"`
+	Allowed          bool      `json:"allowed"`
+	Reason           string    `json:"reason,omitempty"`
+}
+
+// AuditLog is an append-only log of signing decisions, one JSON object per line
+type AuditLog struct {
+	mu   deadlock.Mutex
+	file *os.File
+}
+
+// OpenAuditLog opens the audit log at path for appending, creating it if needed
+func OpenAuditLog(path string) (*AuditLog, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLog{file: f}, nil
+}
+
+// Append writes entry to the log and syncs it to disk before returning
+func (a *AuditLog) Append(entry AuditEntry) error {
+	line, err := json.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, err = a.file.Write(line)
+	if err != nil {
+		return err
+	}
+	return a.file.Sync()
+}
+
+// Close closes the log
+func (a *AuditLog) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.file.Close()
+}
@@ -0,0 +1,395 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const defaultAuditLogFilename = "kmd_audit.log"
+
+// Operations recorded in the audit log
+const (
+	OpSignTransaction         = "sign_transaction"
+	OpMultisigSignTransaction = "multisig_sign_transaction"
+	OpSignProgram             = "sign_program"
+	OpMultisigSignProgram     = "multisig_sign_program"
+	OpExportKey               = "export_key"
+	OpExportMasterKey         = "export_master_derivation_key"
+	OpExportMnemonic          = "export_mnemonic"
+)
+
+// ErrDenied is wrapped by the errors returned when a signing policy denies a request
+var ErrDenied = fmt.Errorf("denied by signing policy")
+
+// ApprovalRequest describes a transaction waiting for a second-factor approval
+type ApprovalRequest struct {
+	WalletID    string `json:"wallet_id"`
+	WalletName  string `json:"wallet_name"`
+	TxID        string `json:"txid"`
+	Transaction []byte `json:"transaction"`
+}
+
+// ApprovalFunc decides whether a transaction requiring approval may be signed.
+// It returns nil if the transaction was approved.
+type ApprovalFunc func(req ApprovalRequest) error
+
+type spend struct {
+	at     time.Time
+	amount uint64
+}
+
+type walletPolicy struct {
+	cfg              config.SigningPolicy
+	allowedTxTypes   map[protocol.TxType]bool
+	allowedReceivers map[basics.Address]bool
+
+	// spends are the payments allowed within the last WindowSecs
+	spends []spend
+}
+
+// Engine evaluates signing policies before anything is signed, and records
+// every decision in the audit log.
+type Engine struct {
+	mu      deadlock.Mutex
+	byID    map[string]*walletPolicy
+	byName  map[string]*walletPolicy
+	audit   *AuditLog
+	approve ApprovalFunc
+	log     logging.Logger
+	now     func() time.Time
+}
+
+// MakeEngine creates the policy engine described by the kmd configuration.
+// It returns a nil Engine if no signing policies or audit log are configured.
+func MakeEngine(cfg config.KMDConfig, log logging.Logger) (*Engine, error) {
+	spc := cfg.SigningPolicies
+	if len(spc.Policies) == 0 && spc.AuditLogFile == "" {
+		return nil, nil
+	}
+
+	e := &Engine{
+		byID:   make(map[string]*walletPolicy),
+		byName: make(map[string]*walletPolicy),
+		log:    log,
+		now:    time.Now,
+	}
+
+	for _, pc := range spc.Policies {
+		wp, err := makeWalletPolicy(pc)
+		if err != nil {
+			return nil, err
+		}
+		if pc.WalletID != "" {
+			e.byID[pc.WalletID] = wp
+		} else {
+			e.byName[pc.WalletName] = wp
+		}
+	}
+
+	if spc.ApprovalURL != "" {
+		e.approve = makeHTTPApprover(spc.ApprovalURL, time.Duration(spc.ApprovalTimeoutSecs)*time.Second)
+	}
+
+	auditFile := spc.AuditLogFile
+	if auditFile == "" {
+		auditFile = defaultAuditLogFilename
+	}
+	if !filepath.IsAbs(auditFile) {
+		auditFile = filepath.Join(cfg.DataDir, auditFile)
+	}
+	var err error
+	e.audit, err = OpenAuditLog(auditFile)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func makeWalletPolicy(cfg config.SigningPolicy) (*walletPolicy, error) {
+	wp := &walletPolicy{cfg: cfg}
+	if len(cfg.AllowedTxTypes) > 0 {
+		wp.allowedTxTypes = make(map[protocol.TxType]bool)
+		for _, txType := range cfg.AllowedTxTypes {
+			wp.allowedTxTypes[protocol.TxType(txType)] = true
+		}
+	}
+	if len(cfg.AllowedReceivers) > 0 {
+		wp.allowedReceivers = make(map[basics.Address]bool)
+		for _, receiver := range cfg.AllowedReceivers {
+			addr, err := basics.UnmarshalChecksumAddress(receiver)
+			if err != nil {
+				return nil, fmt.Errorf("signing policy receiver %s: %w", receiver, err)
+			}
+			wp.allowedReceivers[addr] = true
+		}
+	}
+	return wp, nil
+}
+
+// SetApprover replaces the function used for second-factor approvals
+func (e *Engine) SetApprover(approve ApprovalFunc) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.approve = approve
+}
+
+// Close closes the audit log
+func (e *Engine) Close() error {
+	return e.audit.Close()
+}
+
+func (e *Engine) policyFor(md wallet.Metadata) *walletPolicy {
+	if wp, ok := e.byID[string(md.ID)]; ok {
+		return wp
+	}
+	return e.byName[string(md.Name)]
+}
+
+// CheckRename returns an error wrapping ErrDenied if the wallet described by
+// md has a policy. Policies may be keyed by wallet name, so renaming the
+// wallet would lift its policy.
+func (e *Engine) CheckRename(md wallet.Metadata) error {
+	e.mu.Lock()
+	wp := e.policyFor(md)
+	e.mu.Unlock()
+
+	if wp != nil {
+		return fmt.Errorf("%w: wallets with a signing policy cannot be renamed", ErrDenied)
+	}
+	return nil
+}
+
+// CheckTransaction evaluates the policy of the wallet described by md against
+// tx, records the decision and returns an error wrapping ErrDenied if tx may
+// not be signed.
+func (e *Engine) CheckTransaction(md wallet.Metadata, op string, tx transactions.Transaction) error {
+	entry := AuditEntry{
+		WalletID:   string(md.ID),
+		WalletName: string(md.Name),
+		Operation:  op,
+		TxID:       tx.ID().String(),
+		TxType:     string(tx.Type),
+		Sender:     tx.Sender.String(),
+		Fee:        tx.Fee.Raw,
+	}
+	if tx.Type == protocol.PaymentTx {
+		entry.Amount = tx.Amount.Raw
+		entry.Receiver = tx.Receiver.String()
+	}
+
+	e.mu.Lock()
+	wp := e.policyFor(md)
+	var err error
+	if wp != nil {
+		err = wp.check(tx, e.now())
+	}
+	approve := e.approve
+	e.mu.Unlock()
+
+	// the approval callback may take a while, so it is made without holding the lock
+	if err == nil && wp != nil && wp.cfg.RequireApproval {
+		entry.ApprovalRequired = true
+		err = requestApproval(approve, ApprovalRequest{
+			WalletID:    entry.WalletID,
+			WalletName:  entry.WalletName,
+			TxID:        entry.TxID,
+			Transaction: protocol.Encode(&tx),
+		})
+	}
+
+	if err == nil && wp != nil {
+		e.mu.Lock()
+		// the window may have filled up while waiting for approval
+		err = wp.check(tx, e.now())
+		if err == nil {
+			wp.record(tx, e.now())
+		}
+		e.mu.Unlock()
+	}
+
+	return e.decide(entry, err)
+}
+
+// CheckProgram evaluates the policy of the wallet described by md before a
+// program is signed. Signing a program delegates signing authority, so it is
+// only allowed for wallets with a policy if the policy explicitly allows it.
+func (e *Engine) CheckProgram(md wallet.Metadata, op string, src crypto.Digest) error {
+	entry := AuditEntry{
+		WalletID:   string(md.ID),
+		WalletName: string(md.Name),
+		Operation:  op,
+		Sender:     basics.Address(src).String(),
+	}
+
+	e.mu.Lock()
+	wp := e.policyFor(md)
+	e.mu.Unlock()
+
+	var err error
+	if wp != nil && !wp.cfg.AllowProgramSigning {
+		err = fmt.Errorf("%w: program signing is not allowed", ErrDenied)
+	}
+	return e.decide(entry, err)
+}
+
+// CheckExport records an attempt to export key material from the wallet
+// described by md. Exported keys can sign anything, so exporting them is
+// denied for wallets with a policy.
+func (e *Engine) CheckExport(md wallet.Metadata, op string) error {
+	entry := AuditEntry{
+		WalletID:   string(md.ID),
+		WalletName: string(md.Name),
+		Operation:  op,
+	}
+
+	e.mu.Lock()
+	wp := e.policyFor(md)
+	e.mu.Unlock()
+
+	var err error
+	if wp != nil {
+		err = fmt.Errorf("%w: exporting keys is not allowed", ErrDenied)
+	}
+	return e.decide(entry, err)
+}
+
+// decide writes the audit entry for a decision. A decision that cannot be
+// audited is turned into a denial.
+func (e *Engine) decide(entry AuditEntry, err error) error {
+	entry.Time = e.now().UTC()
+	entry.Allowed = err == nil
+	if err != nil {
+		entry.Reason = err.Error()
+	}
+
+	auditErr := e.audit.Append(entry)
+	if auditErr != nil {
+		e.log.Errorf("kmd signing policy: could not write audit log: %v", auditErr)
+		if err == nil {
+			err = fmt.Errorf("%w: could not write audit log", ErrDenied)
+		}
+	}
+	return err
+}
+
+func requestApproval(approve ApprovalFunc, req ApprovalRequest) error {
+	if approve == nil {
+		return fmt.Errorf("%w: approval required but no approver is configured", ErrDenied)
+	}
+	err := approve(req)
+	if err != nil {
+		return fmt.Errorf("%w: not approved: %v", ErrDenied, err)
+	}
+	return nil
+}
+
+// check returns an error wrapping ErrDenied if the policy does not allow tx
+func (wp *walletPolicy) check(tx transactions.Transaction, now time.Time) error {
+	cfg := wp.cfg
+	if wp.allowedTxTypes != nil && !wp.allowedTxTypes[tx.Type] {
+		return fmt.Errorf("%w: transaction type %s is not allowed", ErrDenied, tx.Type)
+	}
+	if cfg.ForbidRekey && !tx.RekeyTo.IsZero() {
+		return fmt.Errorf("%w: rekeying is not allowed", ErrDenied)
+	}
+	closing := !tx.CloseRemainderTo.IsZero() || !tx.AssetCloseTo.IsZero()
+	if cfg.ForbidClose && closing {
+		return fmt.Errorf("%w: closing accounts is not allowed", ErrDenied)
+	}
+	// a close moves the whole balance whatever the amount, so it cannot be
+	// checked against a limit
+	if closing && (cfg.MaxAmount != 0 || cfg.WindowMaxAmount != 0) {
+		return fmt.Errorf("%w: closing accounts is not allowed with an amount limit", ErrDenied)
+	}
+	// the limits are in microalgos, so asset amounts cannot be checked
+	// against them either
+	if tx.Type == protocol.AssetTransferTx && tx.AssetAmount != 0 && (cfg.MaxAmount != 0 || cfg.WindowMaxAmount != 0) {
+		return fmt.Errorf("%w: transferring assets is not allowed with an amount limit", ErrDenied)
+	}
+
+	if wp.allowedReceivers != nil {
+		var receivers []basics.Address
+		switch tx.Type {
+		case protocol.PaymentTx:
+			receivers = append(receivers, tx.Receiver, tx.CloseRemainderTo)
+		case protocol.AssetTransferTx:
+			receivers = append(receivers, tx.AssetReceiver, tx.AssetCloseTo)
+		}
+		for _, receiver := range receivers {
+			if !receiver.IsZero() && !wp.allowedReceivers[receiver] {
+				return fmt.Errorf("%w: receiver %s is not allowed", ErrDenied, receiver)
+			}
+		}
+	}
+
+	amount := spentAmount(tx)
+	if cfg.MaxAmount != 0 && amount > cfg.MaxAmount {
+		return fmt.Errorf("%w: amount %d exceeds the limit of %d", ErrDenied, amount, cfg.MaxAmount)
+	}
+	if cfg.WindowMaxAmount != 0 {
+		wp.expire(now)
+		total := amount
+		for _, s := range wp.spends {
+			total += s.amount
+		}
+		if total > cfg.WindowMaxAmount || total < amount {
+			return fmt.Errorf("%w: amount %d exceeds the limit of %d per %d seconds", ErrDenied, amount, cfg.WindowMaxAmount, cfg.WindowSecs)
+		}
+	}
+	return nil
+}
+
+// record adds an allowed transaction to the rolling window
+func (wp *walletPolicy) record(tx transactions.Transaction, now time.Time) {
+	if wp.cfg.WindowMaxAmount == 0 {
+		return
+	}
+	wp.spends = append(wp.spends, spend{at: now, amount: spentAmount(tx)})
+}
+
+// spentAmount is what tx takes from the sender's algo balance: the fee, plus
+// the amount of payments
+func spentAmount(tx transactions.Transaction) uint64 {
+	amount := tx.Fee.Raw
+	if tx.Type == protocol.PaymentTx {
+		amount = basics.AddSaturate(amount, tx.Amount.Raw)
+	}
+	return amount
+}
+
+// expire drops spends that fell out of the rolling window
+func (wp *walletPolicy) expire(now time.Time) {
+	cutoff := now.Add(-time.Duration(wp.cfg.WindowSecs) * time.Second)
+	i := 0
+	for i < len(wp.spends) && !wp.spends[i].at.After(cutoff) {
+		i++
+	}
+	wp.spends = wp.spends[i:]
+}
@@ -0,0 +1,394 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testWallet signs nothing, it only records that it was asked to
+type testWallet struct {
+	wallet.Wallet
+	md       wallet.Metadata
+	signed   int
+	exported int
+}
+
+func (tw *testWallet) CheckPassword(pw []byte) error {
+	if string(pw) != "pw" {
+		return errors.New("bad password")
+	}
+	return nil
+}
+
+func (tw *testWallet) Metadata() (wallet.Metadata, error) {
+	return tw.md, nil
+}
+
+func (tw *testWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	tw.signed++
+	return nil, nil
+}
+
+func (tw *testWallet) SignProgram(program []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	tw.signed++
+	return nil, nil
+}
+
+func (tw *testWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	tw.exported++
+	return crypto.PrivateKey{}, nil
+}
+
+// testHDWallet is a testWallet whose keys are derived from a mnemonic
+type testHDWallet struct {
+	*testWallet
+}
+
+func (thw testHDWallet) DeriveKey(path []uint32) (crypto.Digest, error) {
+	return crypto.Digest{}, nil
+}
+
+func (thw testHDWallet) ExportMnemonic(pw []byte) (string, error) {
+	thw.exported++
+	return "", nil
+}
+
+func makeTestEngine(t *testing.T, spc config.SigningPoliciesConfig) (*Engine, string) {
+	dir := t.TempDir()
+	cfg := config.KMDConfig{DataDir: dir, SigningPolicies: spc}
+	require.NoError(t, cfg.Validate())
+	e, err := MakeEngine(cfg, logging.TestingLog(t))
+	require.NoError(t, err)
+	require.NotNil(t, e)
+	t.Cleanup(func() { e.Close() })
+	return e, filepath.Join(dir, defaultAuditLogFilename)
+}
+
+func readAuditLog(t *testing.T, path string) []AuditEntry {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry AuditEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func payment(amount uint64, receiver basics.Address) transactions.Transaction {
+	return transactions.Transaction{
+		Type:             protocol.PaymentTx,
+		PaymentTxnFields: transactions.PaymentTxnFields{Receiver: receiver, Amount: basics.MicroAlgos{Raw: amount}},
+	}
+}
+
+func TestMakeEngineDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e, err := MakeEngine(config.KMDConfig{DataDir: t.TempDir()}, logging.TestingLog(t))
+	require.NoError(t, err)
+	require.Nil(t, e)
+}
+
+func TestSigningPolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	allowed := basics.Address{0x01}
+	other := basics.Address{0x02}
+	e, auditFile := makeTestEngine(t, config.SigningPoliciesConfig{
+		Policies: []config.SigningPolicy{{
+			WalletName:       "hot",
+			AllowedTxTypes:   []string{string(protocol.PaymentTx), string(protocol.AssetTransferTx)},
+			MaxAmount:        1000,
+			WindowMaxAmount:  1500,
+			WindowSecs:       60,
+			AllowedReceivers: []string{allowed.String()},
+			ForbidRekey:      true,
+			ForbidClose:      true,
+		}},
+	})
+	now := time.Unix(1000000, 0)
+	e.now = func() time.Time { return now }
+
+	hot := wallet.Metadata{ID: []byte("1"), Name: []byte("hot")}
+	check := func(tx transactions.Transaction) error {
+		return e.CheckTransaction(hot, OpSignTransaction, tx)
+	}
+
+	require.NoError(t, check(payment(1000, allowed)))
+	require.ErrorIs(t, check(payment(1001, allowed)), ErrDenied)
+	require.ErrorIs(t, check(payment(1, other)), ErrDenied)
+	require.ErrorIs(t, check(transactions.Transaction{Type: protocol.KeyRegistrationTx}), ErrDenied)
+
+	rekey := payment(1, allowed)
+	rekey.RekeyTo = other
+	require.ErrorIs(t, check(rekey), ErrDenied)
+
+	closeOut := payment(1, allowed)
+	closeOut.CloseRemainderTo = allowed
+	require.ErrorIs(t, check(closeOut), ErrDenied)
+
+	assetClose := transactions.Transaction{Type: protocol.AssetTransferTx}
+	assetClose.AssetReceiver = allowed
+	assetClose.AssetCloseTo = allowed
+	require.ErrorIs(t, check(assetClose), ErrDenied)
+	assetClose.AssetCloseTo = basics.Address{}
+	require.NoError(t, check(assetClose))
+
+	// the rolling window only counts allowed payments
+	require.ErrorIs(t, check(payment(600, allowed)), ErrDenied)
+	require.NoError(t, check(payment(500, allowed)))
+	now = now.Add(30 * time.Second)
+	require.ErrorIs(t, check(payment(1, allowed)), ErrDenied)
+	now = now.Add(31 * time.Second)
+	require.NoError(t, check(payment(1000, allowed)))
+
+	// wallets without a policy are unrestricted, but still audited
+	cold := wallet.Metadata{ID: []byte("2"), Name: []byte("cold")}
+	require.NoError(t, e.CheckTransaction(cold, OpSignTransaction, payment(1000000, other)))
+
+	entries := readAuditLog(t, auditFile)
+	require.Len(t, entries, 13)
+	var allowedCount int
+	for _, entry := range entries {
+		if entry.Allowed {
+			allowedCount++
+			require.Empty(t, entry.Reason)
+		} else {
+			require.Contains(t, entry.Reason, ErrDenied.Error())
+		}
+	}
+	require.Equal(t, 5, allowedCount)
+	require.Equal(t, "cold", entries[12].WalletName)
+	require.Equal(t, uint64(1000000), entries[12].Amount)
+}
+
+func TestSigningPolicyLimitBypasses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// closing is not forbidden, but the amount limits still apply
+	e, auditFile := makeTestEngine(t, config.SigningPoliciesConfig{
+		Policies: []config.SigningPolicy{{
+			WalletName:      "hot",
+			MaxAmount:       1000,
+			WindowMaxAmount: 1500,
+			WindowSecs:      60,
+		}},
+	})
+	now := time.Unix(1000000, 0)
+	e.now = func() time.Time { return now }
+
+	hot := wallet.Metadata{ID: []byte("1"), Name: []byte("hot")}
+	check := func(tx transactions.Transaction) error {
+		return e.CheckTransaction(hot, OpSignTransaction, tx)
+	}
+	receiver := basics.Address{1}
+
+	// a zero amount close would move the whole balance
+	closeOut := payment(0, receiver)
+	closeOut.CloseRemainderTo = receiver
+	require.ErrorIs(t, check(closeOut), ErrDenied)
+
+	assetClose := transactions.Transaction{Type: protocol.AssetTransferTx}
+	assetClose.AssetCloseTo = receiver
+	require.ErrorIs(t, check(assetClose), ErrDenied)
+
+	// asset amounts are not counted against the limits, so they are denied,
+	// while opting in moves nothing
+	assetTransfer := transactions.Transaction{Type: protocol.AssetTransferTx}
+	assetTransfer.AssetReceiver = receiver
+	assetTransfer.AssetAmount = 1
+	require.ErrorIs(t, check(assetTransfer), ErrDenied)
+	assetTransfer.AssetAmount = 0
+	require.NoError(t, check(assetTransfer))
+
+	// fees count towards both limits, whatever the transaction type
+	highFee := payment(0, receiver)
+	highFee.Fee = basics.MicroAlgos{Raw: 1001}
+	require.ErrorIs(t, check(highFee), ErrDenied)
+
+	withFee := payment(900, receiver)
+	withFee.Fee = basics.MicroAlgos{Raw: 100}
+	require.NoError(t, check(withFee))
+	keyreg := transactions.Transaction{Type: protocol.KeyRegistrationTx}
+	keyreg.Fee = basics.MicroAlgos{Raw: 501}
+	require.ErrorIs(t, check(keyreg), ErrDenied)
+	keyreg.Fee = basics.MicroAlgos{Raw: 500}
+	require.NoError(t, check(keyreg))
+	require.ErrorIs(t, check(payment(1, receiver)), ErrDenied)
+
+	entries := readAuditLog(t, auditFile)
+	require.Len(t, entries, 9)
+	require.Equal(t, uint64(100), entries[5].Fee)
+}
+
+func TestSigningPolicyRename(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e, _ := makeTestEngine(t, config.SigningPoliciesConfig{
+		Policies: []config.SigningPolicy{{WalletID: "1"}, {WalletName: "hot"}},
+	})
+	require.ErrorIs(t, e.CheckRename(wallet.Metadata{ID: []byte("1"), Name: []byte("cold")}), ErrDenied)
+	require.ErrorIs(t, e.CheckRename(wallet.Metadata{ID: []byte("2"), Name: []byte("hot")}), ErrDenied)
+	require.NoError(t, e.CheckRename(wallet.Metadata{ID: []byte("3"), Name: []byte("cold")}))
+}
+
+func TestSigningPolicyWrapWallet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e, auditFile := makeTestEngine(t, config.SigningPoliciesConfig{
+		Policies: []config.SigningPolicy{{WalletID: "1", MaxAmount: 10}},
+	})
+	tw := &testWallet{md: wallet.Metadata{ID: []byte("1"), Name: []byte("hot")}}
+	w := e.WrapWallet(tw)
+
+	// bad passwords are not policy decisions
+	_, err := w.SignTransaction(payment(1, basics.Address{}), crypto.PublicKey{}, []byte("bad"))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrDenied)
+
+	_, err = w.SignTransaction(payment(1, basics.Address{}), crypto.PublicKey{}, []byte("pw"))
+	require.NoError(t, err)
+	_, err = w.SignTransaction(payment(11, basics.Address{}), crypto.PublicKey{}, []byte("pw"))
+	require.ErrorIs(t, err, ErrDenied)
+	_, err = w.SignProgram([]byte{0x01}, crypto.Digest{}, []byte("pw"))
+	require.ErrorIs(t, err, ErrDenied)
+	require.Equal(t, 1, tw.signed)
+
+	entries := readAuditLog(t, auditFile)
+	require.Len(t, entries, 3)
+	require.Equal(t, OpSignProgram, entries[2].Operation)
+}
+
+func TestSigningPolicyExport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e, auditFile := makeTestEngine(t, config.SigningPoliciesConfig{
+		Policies: []config.SigningPolicy{{WalletName: "hot"}},
+	})
+	hot := &testWallet{md: wallet.Metadata{ID: []byte("1"), Name: []byte("hot")}}
+	w := e.WrapWallet(testHDWallet{hot})
+
+	_, err := w.ExportKey(crypto.Digest{}, []byte("bad"))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrDenied)
+	_, err = w.ExportKey(crypto.Digest{}, []byte("pw"))
+	require.ErrorIs(t, err, ErrDenied)
+	_, err = w.ExportMasterDerivationKey([]byte("pw"))
+	require.ErrorIs(t, err, ErrDenied)
+	hw, ok := w.(wallet.HDWallet)
+	require.True(t, ok)
+	_, err = hw.ExportMnemonic([]byte("pw"))
+	require.ErrorIs(t, err, ErrDenied)
+	require.Zero(t, hot.exported)
+
+	// wallets without a policy can still export their keys
+	cold := &testWallet{md: wallet.Metadata{ID: []byte("2"), Name: []byte("cold")}}
+	w = e.WrapWallet(testHDWallet{cold})
+	_, err = w.ExportKey(crypto.Digest{}, []byte("pw"))
+	require.NoError(t, err)
+	_, err = w.(wallet.HDWallet).ExportMnemonic([]byte("pw"))
+	require.NoError(t, err)
+	require.Equal(t, 2, cold.exported)
+
+	entries := readAuditLog(t, auditFile)
+	require.Len(t, entries, 5)
+	require.Equal(t, OpExportMasterKey, entries[1].Operation)
+	require.False(t, entries[2].Allowed)
+	require.True(t, entries[4].Allowed)
+}
+
+func TestSigningPolicyApproval(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var approve bool
+	var requests []ApprovalRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ApprovalRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+		json.NewEncoder(w).Encode(&approvalResponse{Approved: approve, Reason: "not today"})
+	}))
+	defer server.Close()
+
+	e, auditFile := makeTestEngine(t, config.SigningPoliciesConfig{
+		ApprovalURL: server.URL,
+		Policies:    []config.SigningPolicy{{WalletName: "hot", RequireApproval: true}},
+	})
+	hot := wallet.Metadata{ID: []byte("1"), Name: []byte("hot")}
+	tx := payment(5, basics.Address{0x01})
+
+	err := e.CheckTransaction(hot, OpSignTransaction, tx)
+	require.ErrorIs(t, err, ErrDenied)
+	require.Contains(t, err.Error(), "not today")
+
+	approve = true
+	require.NoError(t, e.CheckTransaction(hot, OpSignTransaction, tx))
+
+	require.Len(t, requests, 2)
+	require.Equal(t, "hot", requests[1].WalletName)
+	require.Equal(t, tx.ID().String(), requests[1].TxID)
+	var decoded transactions.Transaction
+	require.NoError(t, protocol.Decode(requests[1].Transaction, &decoded))
+	require.Equal(t, tx, decoded)
+
+	entries := readAuditLog(t, auditFile)
+	require.Len(t, entries, 2)
+	require.True(t, entries[1].ApprovalRequired)
+	require.True(t, entries[1].Allowed)
+}
+
+func TestSigningPolicyConfigValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	validate := func(spc config.SigningPoliciesConfig) error {
+		return config.KMDConfig{SigningPolicies: spc}.Validate()
+	}
+	policies := func(p config.SigningPolicy) config.SigningPoliciesConfig {
+		return config.SigningPoliciesConfig{Policies: []config.SigningPolicy{p}}
+	}
+
+	require.NoError(t, validate(policies(config.SigningPolicy{WalletName: "a"})))
+	require.ErrorIs(t, validate(policies(config.SigningPolicy{})), config.ErrSigningPolicyWallet)
+	require.ErrorIs(t, validate(policies(config.SigningPolicy{WalletName: "a", WalletID: "b"})), config.ErrSigningPolicyWallet)
+	require.ErrorIs(t, validate(policies(config.SigningPolicy{WalletName: "a", WindowMaxAmount: 1})), config.ErrSigningPolicyWindow)
+	require.ErrorIs(t, validate(policies(config.SigningPolicy{WalletName: "a", RequireApproval: true})), config.ErrSigningPolicyApproval)
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// policyWallet checks every signing request against the Engine before
+// passing it on to the wrapped wallet
+type policyWallet struct {
+	wallet.Wallet
+	engine *Engine
+}
+
+// policyHDWallet is a policyWallet which keeps the wallet.HDWallet methods
+// of the wrapped wallet reachable. Deriving keys does not sign anything, so it
+// is not subject to signing policies, but exporting the mnemonic is.
+type policyHDWallet struct {
+	*policyWallet
+	wallet.HDWallet
+}
+
+// WrapWallet returns a wallet.Wallet which enforces the engine's signing
+// policies on w
+func (e *Engine) WrapWallet(w wallet.Wallet) wallet.Wallet {
+	pw := &policyWallet{Wallet: w, engine: e}
+	if hw, ok := w.(wallet.HDWallet); ok {
+		return &policyHDWallet{policyWallet: pw, HDWallet: hw}
+	}
+	return pw
+}
+
+// SignTransaction implements wallet.Wallet
+func (pw *policyWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pass []byte) ([]byte, error) {
+	// check the password first, so that bad passwords are not audited as
+	// decisions and cannot consume the rolling window
+	err := pw.Wallet.CheckPassword(pass)
+	if err != nil {
+		return nil, err
+	}
+	md, err := pw.Wallet.Metadata()
+	if err != nil {
+		return nil, err
+	}
+	err = pw.engine.CheckTransaction(md, OpSignTransaction, tx)
+	if err != nil {
+		return nil, err
+	}
+	return pw.Wallet.SignTransaction(tx, pk, pass)
+}
+
+// MultisigSignTransaction implements wallet.Wallet
+func (pw *policyWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pass []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	err := pw.Wallet.CheckPassword(pass)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	md, err := pw.Wallet.Metadata()
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	err = pw.engine.CheckTransaction(md, OpMultisigSignTransaction, tx)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	return pw.Wallet.MultisigSignTransaction(tx, pk, partial, pass, signer)
+}
+
+// SignProgram implements wallet.Wallet
+func (pw *policyWallet) SignProgram(program []byte, src crypto.Digest, pass []byte) ([]byte, error) {
+	err := pw.Wallet.CheckPassword(pass)
+	if err != nil {
+		return nil, err
+	}
+	md, err := pw.Wallet.Metadata()
+	if err != nil {
+		return nil, err
+	}
+	err = pw.engine.CheckProgram(md, OpSignProgram, src)
+	if err != nil {
+		return nil, err
+	}
+	return pw.Wallet.SignProgram(program, src, pass)
+}
+
+// MultisigSignProgram implements wallet.Wallet
+func (pw *policyWallet) MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pass []byte) (crypto.MultisigSig, error) {
+	err := pw.Wallet.CheckPassword(pass)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	md, err := pw.Wallet.Metadata()
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	err = pw.engine.CheckProgram(md, OpMultisigSignProgram, src)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	return pw.Wallet.MultisigSignProgram(program, src, pk, partial, pass)
+}
+
+// ExportKey implements wallet.Wallet
+func (pw *policyWallet) ExportKey(pk crypto.Digest, pass []byte) (crypto.PrivateKey, error) {
+	err := pw.checkExport(OpExportKey, pass)
+	if err != nil {
+		return crypto.PrivateKey{}, err
+	}
+	return pw.Wallet.ExportKey(pk, pass)
+}
+
+// ExportMasterDerivationKey implements wallet.Wallet
+func (pw *policyWallet) ExportMasterDerivationKey(pass []byte) (crypto.MasterDerivationKey, error) {
+	err := pw.checkExport(OpExportMasterKey, pass)
+	if err != nil {
+		return crypto.MasterDerivationKey{}, err
+	}
+	return pw.Wallet.ExportMasterDerivationKey(pass)
+}
+
+// ExportMnemonic implements wallet.HDWallet
+func (phw *policyHDWallet) ExportMnemonic(pass []byte) (string, error) {
+	err := phw.checkExport(OpExportMnemonic, pass)
+	if err != nil {
+		return "", err
+	}
+	return phw.HDWallet.ExportMnemonic(pass)
+}
+
+func (pw *policyWallet) checkExport(op string, pass []byte) error {
+	err := pw.Wallet.CheckPassword(pass)
+	if err != nil {
+		return err
+	}
+	md, err := pw.Wallet.Metadata()
+	if err != nil {
+		return err
+	}
+	return pw.engine.CheckExport(md, op)
+}
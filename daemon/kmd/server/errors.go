@@ -22,6 +22,7 @@ import (
 
 var errDataDirRequired = fmt.Errorf("WalletServerConfig.DataDir is required")
 var errSessionManagerRequired = fmt.Errorf("WalletServerConfig.SessionManager must be initialized")
+var errMultisigsRequired = fmt.Errorf("WalletServerConfig.Multisigs is required")
 var errLogRequired = fmt.Errorf("WalletServerConfig.Log is required")
 
 // ErrAlreadyRunning is returned if we failed to start kmd because we couldn't
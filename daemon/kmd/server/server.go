@@ -30,6 +30,7 @@ import (
 	"github.com/gofrs/flock"
 
 	"github.com/algorand/go-algorand/daemon/kmd/api"
+	"github.com/algorand/go-algorand/daemon/kmd/multisig"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/util/tokens"
@@ -55,6 +56,7 @@ type WalletServerConfig struct {
 	Address        string
 	AllowedOrigins []string
 	SessionManager *session.Manager
+	Multisigs      *multisig.Coordinator
 	Log            logging.Logger
 	Timeout        *time.Duration
 }
@@ -91,6 +93,10 @@ func ValidateConfig(cfg WalletServerConfig) error {
 		return errSessionManagerRequired
 	}
 
+	if cfg.Multisigs == nil {
+		return errMultisigsRequired
+	}
+
 	if cfg.Log == nil {
 		return errLogRequired
 	}
@@ -211,7 +217,7 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 	// Initialize HTTP server
 	watchdogCB := ws.makeWatchdogCallback(kill)
 	srv := http.Server{
-		Handler: api.Handler(ws.SessionManager, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
+		Handler: api.Handler(ws.SessionManager, ws.Multisigs, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
 	}
 
 	// Read the kill channel and shut down the server gracefully
@@ -135,6 +135,11 @@ func (sm *Manager) InitWalletHandle(w wallet.Wallet, pw []byte) ([]byte, error)
 		return nil, err
 	}
 
+	// Enforce signing policies on everything signed through the handle
+	if sm.policies != nil {
+		w = sm.policies.WrapWallet(w)
+	}
+
 	// Generate wallet handle credentials
 	handleID, handleSecret, err := generateHandleIDAndSecret()
 	if err != nil {
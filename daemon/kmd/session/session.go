@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 	"github.com/algorand/go-deadlock"
 )
@@ -38,22 +39,34 @@ type Manager struct {
 	Initialized     bool
 	walletHandles   map[string]walletHandle
 	sessionLifetime time.Duration
+	policies        *policy.Engine
 	Kill            context.CancelFunc
 	ctx             context.Context
 	mux             deadlock.Mutex
 }
 
 // MakeManager initializes and returns a *Manager using the kmd global
-// configuration
-func MakeManager(cfg config.KMDConfig) *Manager {
+// configuration. If policies is not nil, it is enforced on every wallet
+// initialized through the Manager.
+func MakeManager(cfg config.KMDConfig, policies *policy.Engine) *Manager {
 	ctx, cancel := context.WithCancel(context.Background())
 	sm := &Manager{
 		Initialized:     true,
 		walletHandles:   make(map[string]walletHandle),
 		sessionLifetime: time.Duration(cfg.SessionLifetimeSecs * uint64(time.Second)),
+		policies:        policies,
 		Kill:            cancel,
 		ctx:             ctx,
 	}
 	go sm.cleanUpExpiredHandles()
 	return sm
 }
+
+// CheckRename returns an error if the wallet described by md may not be
+// renamed because of its signing policy
+func (sm *Manager) CheckRename(md wallet.Metadata) error {
+	if sm.policies == nil {
+		return nil
+	}
+	return sm.policies.CheckRename(md)
+}
@@ -28,6 +28,8 @@ import (
 var walletDrivers = map[string]Driver{
 	sqliteWalletDriverName: &SQLiteWalletDriver{},
 	ledgerWalletDriverName: &LedgerWalletDriver{},
+	remoteWalletDriverName: &RemoteWalletDriver{},
+	hdWalletDriverName:     &HDWalletDriver{},
 }
 
 // Driver is the interface that all wallet drivers must expose in order to be
@@ -42,6 +44,12 @@ type Driver interface {
 	FetchWallet(id []byte) (wallet.Wallet, error)
 }
 
+// MnemonicDriver is implemented by wallet drivers which can create a wallet
+// from an existing BIP39 mnemonic and optional BIP39 passphrase.
+type MnemonicDriver interface {
+	CreateWalletFromMnemonic(name []byte, id []byte, pw []byte, mnemonic string, passphrase string) error
+}
+
 // InitWalletDrivers accepts a KMDConfig and uses it to initialize each driver
 func InitWalletDrivers(cfg config.KMDConfig, log logging.Logger) error {
 	for _, driver := range walletDrivers {
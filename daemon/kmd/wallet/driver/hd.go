@@ -0,0 +1,748 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	hdWalletDriverName    = "hd"
+	hdWalletDriverVersion = 1
+	hdWalletsDirName      = "hd_wallets"
+	hdWalletHasMnemonicUX = false
+	hdWalletHasMasterKey  = false
+	hdNewEntropyBytes     = 32
+)
+
+// hdWalletSchema is like the sqlite wallet schema, except that HD wallets
+// keep a BIP39 seed instead of a master derivation key, and store the
+// derivation path of each key instead of its secret key.
+var hdWalletSchema = `
+CREATE TABLE IF NOT EXISTS metadata (
+	driver_name TEXT NOT NULL,
+	driver_version INT NOT NULL,
+	wallet_id TEXT NOT NULL UNIQUE,
+	wallet_name TEXT NOT NULL,
+	mep_encrypted BLOB NOT NULL,
+	entropy_encrypted BLOB NOT NULL,
+	seed_encrypted BLOB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS keys (
+	address BLOB PRIMARY KEY,
+	path TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS msig_addrs (
+	address BLOB PRIMARY KEY,
+	version INT NOT NULL,
+	threshold INT NOT NULL,
+	pks BLOB NOT NULL
+);
+`
+
+// HDWalletDriver stores wallets whose keys are derived from a BIP39 mnemonic
+// with BIP32-Ed25519, using the ARC-52 derivation.
+// Wallets are sqlite 3 databases holding the encrypted BIP39 seed and the
+// derivation path of each key; secret keys are derived when signing.
+type HDWalletDriver struct {
+	globalCfg config.KMDConfig
+	hdCfg     config.HDWalletDriverConfig
+	scrypt    config.ScryptParams
+	mux       *deadlock.Mutex
+}
+
+// HDWallet represents a particular wallet under the HDWalletDriver
+type HDWallet struct {
+	masterEncryptionKey  []byte
+	root                 *crypto.HDPrivateKey
+	walletPasswordSalt   [saltLen]byte
+	walletPasswordHash   crypto.Digest
+	walletPasswordHashed bool
+	dbPath               string
+}
+
+// InitWithConfig accepts a driver configuration so that the HD driver knows
+// where to store wallets
+func (hwd *HDWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	hwd.globalCfg = cfg
+	hwd.hdCfg = cfg.DriverConfig.HDWalletDriverConfig
+	hwd.scrypt = cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams
+	hwd.mux = &deadlock.Mutex{}
+
+	if hwd.hdCfg.Disable {
+		return nil
+	}
+
+	wDir := hwd.walletsDir()
+	err := os.Mkdir(wDir, sqliteWalletsDirPermissions)
+	if err != nil && !os.IsExist(err) {
+		return fmt.Errorf("couldn't create hd wallets directory at %s: %v", wDir, err)
+	}
+	return nil
+}
+
+func (hwd *HDWalletDriver) walletsDir() string {
+	if hwd.hdCfg.WalletsDir != "" {
+		return hwd.hdCfg.WalletsDir
+	}
+	return filepath.Join(hwd.globalCfg.DataDir, hdWalletsDirName)
+}
+
+func hdWalletMetadataFromDBPath(dbPath string) (metadata wallet.Metadata, err error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var driverName string
+	var walletID, walletName []byte
+	var driverVersion uint32
+	metadataRow := db.QueryRow("SELECT driver_name, driver_version, wallet_id, wallet_name FROM metadata LIMIT 1")
+	err = metadataRow.Scan(&driverName, &driverVersion, &walletID, &walletName)
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	if driverName != hdWalletDriverName {
+		err = errWrongDriver
+		return
+	}
+	if driverVersion != hdWalletDriverVersion {
+		err = errWrongDriverVer
+		return
+	}
+
+	metadata = wallet.Metadata{
+		ID:                    walletID,
+		Name:                  walletName,
+		DriverName:            driverName,
+		DriverVersion:         driverVersion,
+		SupportsMnemonicUX:    hdWalletHasMnemonicUX,
+		SupportsMasterKey:     hdWalletHasMasterKey,
+		SupportedTransactions: sqliteWalletSupportedTxs,
+	}
+	return
+}
+
+// walletPaths returns the paths and metadata of the wallets in walletsDir()
+// that match. Files which aren't hd wallets are skipped.
+func (hwd *HDWalletDriver) walletPaths(match func(wallet.Metadata) bool) (paths []string, metadatas []wallet.Metadata, err error) {
+	if hwd.hdCfg.Disable {
+		return nil, nil, nil
+	}
+
+	wDir := hwd.walletsDir()
+	files, err := os.ReadDir(wDir)
+	if err != nil {
+		return
+	}
+	for _, f := range files {
+		if f.IsDir() || !databaseFilenameRegex.Match([]byte(f.Name())) {
+			continue
+		}
+		path := filepath.Join(wDir, f.Name())
+		md, mdErr := hdWalletMetadataFromDBPath(path)
+		if mdErr != nil || !match(md) {
+			continue
+		}
+		paths = append(paths, path)
+		metadatas = append(metadatas, md)
+	}
+	return paths, metadatas, nil
+}
+
+// ListWalletMetadatas lists the hd wallets in walletsDir()
+func (hwd *HDWalletDriver) ListWalletMetadatas() ([]wallet.Metadata, error) {
+	_, metadatas, err := hwd.walletPaths(func(wallet.Metadata) bool { return true })
+	return metadatas, err
+}
+
+// CreateWallet creates an hd wallet from a new random 24 word mnemonic
+func (hwd *HDWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	if mdk != (crypto.MasterDerivationKey{}) {
+		return errHDMasterKey
+	}
+
+	entropy := make([]byte, hdNewEntropyBytes)
+	err := fillRandomBytes(entropy)
+	if err != nil {
+		return err
+	}
+	return hwd.createWallet(name, id, pw, entropy, "")
+}
+
+// CreateWalletFromMnemonic creates an hd wallet from an existing BIP39
+// mnemonic and optional BIP39 passphrase
+func (hwd *HDWalletDriver) CreateWalletFromMnemonic(name []byte, id []byte, pw []byte, mnemonic string, bip39Passphrase string) error {
+	entropy, err := passphrase.BIP39MnemonicToEntropy(mnemonic)
+	if err != nil {
+		return err
+	}
+	return hwd.createWallet(name, id, pw, entropy, bip39Passphrase)
+}
+
+func (hwd *HDWalletDriver) createWallet(name []byte, id []byte, pw []byte, entropy []byte, bip39Passphrase string) error {
+	if hwd.hdCfg.Disable {
+		return errHDDisabled
+	}
+	if len(name) > sqliteMaxWalletNameLen {
+		return errNameTooLong
+	}
+	if len(id) > sqliteMaxWalletIDLen {
+		return errIDTooLong
+	}
+
+	mnemonic, err := passphrase.EntropyToBIP39Mnemonic(entropy)
+	if err != nil {
+		return err
+	}
+	seed := passphrase.BIP39Seed(mnemonic, bip39Passphrase)
+
+	hwd.mux.Lock()
+	defer hwd.mux.Unlock()
+
+	// Ensure no other hd wallet has this name or id
+	_, existing, err := hwd.walletPaths(func(md wallet.Metadata) bool {
+		return bytes.Equal(md.Name, name) || bytes.Equal(md.ID, id)
+	})
+	if err != nil {
+		return err
+	}
+	for _, md := range existing {
+		if bytes.Equal(md.Name, name) {
+			return errSameName
+		}
+		return errSameID
+	}
+
+	safeName := disallowedFilenameRegex.ReplaceAll(name, nil)
+	safeID := disallowedFilenameRegex.ReplaceAll(id, nil)
+	dbPath := filepath.Join(hwd.walletsDir(), fmt.Sprintf("%s.%s.db", safeName, safeID))
+	_, err = os.Stat(dbPath)
+	if !os.IsNotExist(err) {
+		return errSameID
+	}
+
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	_, err = db.Exec(hdWalletSchema)
+	if err != nil {
+		return errDatabase
+	}
+
+	// As in sqlite wallets, the password protects a random master encryption
+	// key, which in turn protects everything else
+	var masterKey [masterKeyLen]byte
+	err = fillRandomBytes(masterKey[:])
+	if err != nil {
+		return err
+	}
+	encryptedMEPBlob, err := encryptBlobWithPasswordBlankOK(masterKey[:], PTMasterKey, pw, &hwd.scrypt)
+	if err != nil {
+		return err
+	}
+	encryptedEntropyBlob, err := encryptBlobWithKey(entropy, PTBIP39Entropy, masterKey[:])
+	if err != nil {
+		return err
+	}
+	encryptedSeedBlob, err := encryptBlobWithKey(seed, PTBIP39Seed, masterKey[:])
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec("INSERT INTO metadata (driver_name, driver_version, wallet_id, wallet_name, mep_encrypted, entropy_encrypted, seed_encrypted) VALUES(?, ?, ?, ?, ?, ?, ?)", hdWalletDriverName, hdWalletDriverVersion, id, name, encryptedMEPBlob, encryptedEntropyBlob, encryptedSeedBlob)
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// FetchWallet looks up a wallet by ID and returns it, failing if there's more
+// than one wallet with the given ID
+func (hwd *HDWalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	hwd.mux.Lock()
+	defer hwd.mux.Unlock()
+	return hwd.fetchWalletLocked(id)
+}
+
+func (hwd *HDWalletDriver) fetchWalletLocked(id []byte) (*HDWallet, error) {
+	paths, _, err := hwd.walletPaths(func(md wallet.Metadata) bool { return bytes.Equal(md.ID, id) })
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errWalletNotFound
+	}
+	if len(paths) > 1 {
+		return nil, errIDConflict
+	}
+	return &HDWallet{dbPath: paths[0]}, nil
+}
+
+// RenameWallet renames the wallet with the given id to newName
+func (hwd *HDWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	hwd.mux.Lock()
+	defer hwd.mux.Unlock()
+
+	sameName, _, err := hwd.walletPaths(func(md wallet.Metadata) bool { return bytes.Equal(md.Name, newName) })
+	if err != nil {
+		return err
+	}
+	if len(sameName) != 0 {
+		return errSameName
+	}
+
+	hw, err := hwd.fetchWalletLocked(id)
+	if err != nil {
+		return err
+	}
+	err = hw.CheckPassword(pw)
+	if err != nil {
+		return err
+	}
+
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	_, err = db.Exec("UPDATE metadata SET wallet_name=? WHERE wallet_id=?", newName, id)
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// Metadata builds a wallet.Metadata from our metadata table
+func (hw *HDWallet) Metadata() (wallet.Metadata, error) {
+	return hdWalletMetadataFromDBPath(hw.dbPath)
+}
+
+// decryptMetadataBlob decrypts one of the encrypted columns of the metadata table
+func (hw *HDWallet) decryptMetadataBlob(column string, ptType plaintextType, key []byte) ([]byte, error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	defer db.Close()
+
+	var blob []byte
+	err = db.Get(&blob, fmt.Sprintf("SELECT %s FROM metadata LIMIT 1", column))
+	if err != nil {
+		return nil, errDatabase
+	}
+	return decryptBlobWithPassword(blob, ptType, key)
+}
+
+// Init decrypts the master encryption key and the BIP39 seed, and keeps the
+// root extended key in memory for subsequent operations
+func (hw *HDWallet) Init(pw []byte) error {
+	masterEncryptionKey, err := hw.decryptMetadataBlob("mep_encrypted", PTMasterKey, pw)
+	if err != nil {
+		return err
+	}
+	seed, err := hw.decryptMetadataBlob("seed_encrypted", PTBIP39Seed, masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+
+	hw.masterEncryptionKey = masterEncryptionKey
+	hw.root = crypto.HDRootKey(seed)
+	err = fillRandomBytes(hw.walletPasswordSalt[:])
+	if err != nil {
+		return err
+	}
+	hw.walletPasswordHash = fastHashWithSalt(pw, hw.walletPasswordSalt[:])
+	hw.walletPasswordHashed = true
+	return nil
+}
+
+// CheckPassword checks that the database can be decrypted with the password
+func (hw *HDWallet) CheckPassword(pw []byte) error {
+	if hw.walletPasswordHashed {
+		pwhash := fastHashWithSalt(pw, hw.walletPasswordSalt[:])
+		if subtle.ConstantTimeCompare(pwhash[:], hw.walletPasswordHash[:]) == 1 {
+			return nil
+		}
+		return errDecrypt
+	}
+
+	_, err := hw.decryptMetadataBlob("mep_encrypted", PTMasterKey, pw)
+	return err
+}
+
+// ExportMasterDerivationKey is not supported: HD wallets are backed up with
+// their mnemonic, see ExportMnemonic
+func (hw *HDWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errHDMasterKey
+}
+
+// ExportMnemonic returns the BIP39 mnemonic of the wallet. The BIP39
+// passphrase, if the wallet was created with one, is not stored and must be
+// backed up separately.
+func (hw *HDWallet) ExportMnemonic(pw []byte) (string, error) {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return "", err
+	}
+	entropy, err := hw.decryptMetadataBlob("entropy_encrypted", PTBIP39Entropy, hw.masterEncryptionKey)
+	if err != nil {
+		return "", err
+	}
+	return passphrase.EntropyToBIP39Mnemonic(entropy)
+}
+
+// ListKeys lists all the addresses in the wallet
+func (hw *HDWallet) ListKeys() (addrs []crypto.Digest, err error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	defer db.Close()
+
+	var addrByteSlices [][]byte
+	err = db.Select(&addrByteSlices, "SELECT address FROM keys")
+	if err != nil {
+		return nil, errDatabase
+	}
+	for _, byteSlice := range addrByteSlices {
+		var addr crypto.Digest
+		copy(addr[:], byteSlice)
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// ImportKey is not supported, since every key must be derivable from the mnemonic
+func (hw *HDWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errHDImportKey
+}
+
+// ExportKey is not supported, since derived keys are not ed25519 seeds
+func (hw *HDWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errHDExportKey
+}
+
+// GenerateKey derives the key following the highest index in use under
+// m/44'/283'/0'/0
+func (hw *HDWallet) GenerateKey(displayMnemonic bool) (addr crypto.Digest, err error) {
+	if displayMnemonic {
+		return crypto.Digest{}, errHDNoMnemonicUX
+	}
+
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		return crypto.Digest{}, errDatabaseConnect
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return crypto.Digest{}, errDatabase
+	}
+
+	var paths []string
+	err = tx.Select(&paths, "SELECT path FROM keys")
+	if err != nil {
+		tx.Rollback()
+		return crypto.Digest{}, errDatabase
+	}
+
+	prefix := crypto.FormatHDPath(crypto.AlgorandHDPath(0, 0, 0))
+	prefix = prefix[:strings.LastIndex(prefix, "/")+1]
+	var next uint32
+	for _, p := range paths {
+		if !strings.HasPrefix(p, prefix) {
+			continue
+		}
+		parsed, parseErr := crypto.ParseHDPath(p)
+		if parseErr != nil || len(parsed) != 5 {
+			continue
+		}
+		if index := parsed[4]; index < crypto.HDHardened && index >= next {
+			next = index + 1
+		}
+	}
+	if next >= crypto.HDHardened {
+		tx.Rollback()
+		return crypto.Digest{}, errTooManyKeys
+	}
+
+	addr, err = hw.insertKeyTx(tx, crypto.AlgorandHDPath(0, 0, next))
+	if err != nil {
+		tx.Rollback()
+		return crypto.Digest{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return crypto.Digest{}, errDatabase
+	}
+	return addr, nil
+}
+
+// DeriveKey derives the key at path and adds it to the wallet
+func (hw *HDWallet) DeriveKey(path []uint32) (crypto.Digest, error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		return crypto.Digest{}, errDatabaseConnect
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return crypto.Digest{}, errDatabase
+	}
+	addr, err := hw.insertKeyTx(tx, path)
+	if err != nil {
+		tx.Rollback()
+		return crypto.Digest{}, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return crypto.Digest{}, errDatabase
+	}
+	return addr, nil
+}
+
+func (hw *HDWallet) insertKeyTx(tx *sqlx.Tx, path []uint32) (crypto.Digest, error) {
+	key := hw.root.Derive(path)
+	addr := publicKeyToAddress(key.PublicKey())
+	_, err := tx.Exec("INSERT INTO keys (address, path) VALUES(?, ?)", addr[:], crypto.FormatHDPath(path))
+	err = checkDBError(err)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	return addr, nil
+}
+
+// fetchKey derives the extended private key for addr from its stored path
+func (hw *HDWallet) fetchKey(addr crypto.Digest) (*crypto.HDPrivateKey, error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	defer db.Close()
+
+	var pathStr string
+	err = db.Get(&pathStr, "SELECT path FROM keys WHERE address=?", addr[:])
+	if err != nil {
+		return nil, errKeyNotFound
+	}
+	path, err := crypto.ParseHDPath(pathStr)
+	if err != nil {
+		return nil, errTampering
+	}
+
+	// The path is not encrypted, so make sure it still leads to addr
+	key := hw.root.Derive(path)
+	if publicKeyToAddress(key.PublicKey()) != addr {
+		return nil, errTampering
+	}
+	return key, nil
+}
+
+// DeleteKey removes the key from the wallet. It can be derived again later.
+func (hw *HDWallet) DeleteKey(addr crypto.Digest, pw []byte) error {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return err
+	}
+
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	_, err = db.Exec("DELETE FROM keys WHERE address=?", addr[:])
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// msigWallet returns a SQLiteWallet sharing our database, whose multisig
+// preimage methods work unchanged on the msig_addrs table
+func (hw *HDWallet) msigWallet() *SQLiteWallet {
+	return &SQLiteWallet{
+		dbPath:               hw.dbPath,
+		walletPasswordSalt:   hw.walletPasswordSalt,
+		walletPasswordHash:   hw.walletPasswordHash,
+		walletPasswordHashed: hw.walletPasswordHashed,
+	}
+}
+
+// ImportMultisigAddr imports a multisig address, taking in version, threshold,
+// and public keys
+func (hw *HDWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	return hw.msigWallet().ImportMultisigAddr(version, threshold, pks)
+}
+
+// LookupMultisigPreimage exports the preimage of a multisig address
+func (hw *HDWallet) LookupMultisigPreimage(addr crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	return hw.msigWallet().LookupMultisigPreimage(addr)
+}
+
+// ListMultisigAddrs lists the multisig addresses whose preimages we know
+func (hw *HDWallet) ListMultisigAddrs() ([]crypto.Digest, error) {
+	return hw.msigWallet().ListMultisigAddrs()
+}
+
+// DeleteMultisigAddr deletes the multisig address and preimage from the database
+func (hw *HDWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	return hw.msigWallet().DeleteMultisigAddr(addr, pw)
+}
+
+// SignTransaction signs the passed transaction with the key whose public key
+// is provided, or the key of the transaction's sender if pk is zero
+func (hw *HDWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Src())
+	}
+	key, err := hw.fetchKey(publicKeyToAddress(pk))
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: key.Sign(tx),
+	}
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram signs the passed data for the src address
+func (hw *HDWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hw.fetchKey(src)
+	if err != nil {
+		return nil, err
+	}
+	progb := logic.Program(data)
+	sig := key.Sign(&progb)
+	return sig[:], nil
+}
+
+// MultisigSignTransaction starts a multisig signature or adds a signature to a
+// partially signed multisig transaction signature of the passed transaction
+// using the key
+func (hw *HDWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return partial, err
+	}
+
+	partial, addr, err := hw.multisigPartial(partial, crypto.Digest(tx.Src()), pk)
+	if err != nil {
+		return partial, err
+	}
+	if addr != crypto.Digest(tx.Src()) && addr != signer {
+		return partial, errMsigWrongAddr
+	}
+
+	key, err := hw.fetchKey(publicKeyToAddress(pk))
+	if err != nil {
+		return partial, err
+	}
+	return setMultisigSubsig(partial, pk, key.Sign(tx)), nil
+}
+
+// MultisigSignProgram starts a multisig signature or adds a signature to a
+// partially signed multisig program signature using the key
+func (hw *HDWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return partial, err
+	}
+
+	partial, addr, err := hw.multisigPartial(partial, src, pk)
+	if err != nil {
+		return partial, err
+	}
+	if addr != src {
+		return partial, errMsigWrongAddr
+	}
+
+	key, err := hw.fetchKey(publicKeyToAddress(pk))
+	if err != nil {
+		return partial, err
+	}
+	progb := logic.Program(data)
+	return setMultisigSubsig(partial, pk, key.Sign(&progb)), nil
+}
+
+// multisigPartial returns the partial multisig to add a signature to,
+// starting a new one from the stored preimage of src if partial is empty,
+// along with its address. pk must be one of the multisig's keys.
+func (hw *HDWallet) multisigPartial(partial crypto.MultisigSig, src crypto.Digest, pk crypto.PublicKey) (crypto.MultisigSig, crypto.Digest, error) {
+	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
+		version, threshold, pks, err := hw.LookupMultisigPreimage(src)
+		if err != nil {
+			return partial, crypto.Digest{}, err
+		}
+		partial = crypto.MultisigSig{Version: version, Threshold: threshold}
+		for _, key := range pks {
+			partial.Subsigs = append(partial.Subsigs, crypto.MultisigSubsig{Key: key})
+		}
+	}
+
+	addr, err := multisigAddrWithKey(partial, pk)
+	if err != nil {
+		return partial, crypto.Digest{}, err
+	}
+	return partial, addr, nil
+}
@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"fmt"
+)
+
+var errHDDisabled = fmt.Errorf("hd wallet driver is disabled")
+var errHDMasterKey = fmt.Errorf("hd wallets are created from a bip39 mnemonic, not a master derivation key")
+var errHDImportKey = fmt.Errorf("hd wallets only hold keys derived from their mnemonic")
+var errHDExportKey = fmt.Errorf("hd wallet keys are not ed25519 seeds and cannot be exported, back up the wallet mnemonic instead")
+var errHDNoMnemonicUX = fmt.Errorf("hd wallet driver cannot display mnemonics")
@@ -0,0 +1,173 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testBIP39Mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// testARC52Mnemonic is the mnemonic of the ARC-52 reference implementation tests
+const testARC52Mnemonic = "salon zoo engage submit smile frost later decide wing sight chaos renew lizard rely canal coral scene hobby scare step bus leaf tobacco slice"
+
+func makeTestHDDriver(t *testing.T) *HDWalletDriver {
+	cfg := config.KMDConfig{
+		DataDir: t.TempDir(),
+		DriverConfig: config.DriverConfig{
+			SQLiteWalletDriverConfig: config.SQLiteWalletDriverConfig{
+				ScryptParams: config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1},
+			},
+		},
+	}
+	hwd := &HDWalletDriver{}
+	require.NoError(t, hwd.InitWithConfig(cfg, logging.TestingLog(t)))
+	return hwd
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestHDWalletDriver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	hwd := makeTestHDDriver(t)
+	pw := []byte("password")
+	err := hwd.CreateWalletFromMnemonic([]byte("hd"), []byte("hd-id"), pw, testARC52Mnemonic, "")
+	require.NoError(t, err)
+
+	// names and ids must be unique
+	err = hwd.CreateWalletFromMnemonic([]byte("hd"), []byte("other-id"), pw, testBIP39Mnemonic, "")
+	require.Error(t, err)
+
+	// hd wallets have no master derivation key
+	err = hwd.CreateWallet([]byte("mdk"), []byte("mdk-id"), pw, crypto.MasterDerivationKey{1})
+	require.ErrorIs(t, err, errHDMasterKey)
+
+	mds, err := hwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, mds, 1)
+	require.Equal(t, []byte("hd"), mds[0].Name)
+
+	w, err := hwd.FetchWallet([]byte("hd-id"))
+	require.NoError(t, err)
+	require.Error(t, w.Init([]byte("wrong")))
+	require.NoError(t, w.Init(pw))
+
+	hw, ok := w.(wallet.HDWallet)
+	require.True(t, ok)
+	mnemonic, err := hw.ExportMnemonic(pw)
+	require.NoError(t, err)
+	require.Equal(t, testARC52Mnemonic, mnemonic)
+	_, err = hw.ExportMnemonic([]byte("wrong"))
+	require.Error(t, err)
+
+	// keys are generated along m/44'/283'/0'/0/i, matching the ARC-52 reference vectors
+	addr0, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, mustDecodeHex(t, "7bda7ac12627b2c259f1df6875d30c10b35f55b33ad2cc8ea2736eaa3ebcfab9"), addr0[:])
+	addr1, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, mustDecodeHex(t, "5bae8828f111064637ac5061bd63bc4fcfe4a833252305f25eeab9c64ecdf519"), addr1[:])
+
+	// deriving an explicit path skips over it when generating
+	addr5, err := hw.DeriveKey(crypto.AlgorandHDPath(0, 0, 5))
+	require.NoError(t, err)
+	_, err = hw.DeriveKey(crypto.AlgorandHDPath(0, 0, 5))
+	require.Error(t, err)
+	addr6, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	root := crypto.HDRootKey(mustDecodeHex(t, "3aff2db416b895ec3cf9a4f8d1e970bc9819920e7bf44a5e350477af0ef557b1511b0986debf78dd38c7c520cd44ff7c7231618f958e21ef0250733a8c1915ea"))
+	require.Equal(t, crypto.Digest(root.Derive(crypto.AlgorandHDPath(0, 0, 6)).PublicKey()), addr6)
+
+	keys, err := w.ListKeys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []crypto.Digest{addr0, addr1, addr5, addr6}, keys)
+
+	// private keys never leave the wallet
+	_, err = w.ExportKey(addr0, pw)
+	require.ErrorIs(t, err, errHDExportKey)
+	_, err = w.ExportMasterDerivationKey(pw)
+	require.ErrorIs(t, err, errHDMasterKey)
+
+	// signatures verify against the derived public key
+	tx := transactions.Transaction{
+		Type:             protocol.PaymentTx,
+		Header:           transactions.Header{Sender: basics.Address(addr0), Fee: basics.MicroAlgos{Raw: 1000}, LastValid: 10},
+		PaymentTxnFields: transactions.PaymentTxnFields{Receiver: basics.Address(addr1)},
+	}
+	stxBytes, err := w.SignTransaction(tx, crypto.PublicKey(addr0), pw)
+	require.NoError(t, err)
+	var stx transactions.SignedTxn
+	require.NoError(t, protocol.Decode(stxBytes, &stx))
+	require.True(t, crypto.PublicKey(addr0).Verify(tx, stx.Sig))
+
+	require.NoError(t, w.DeleteKey(addr0, pw))
+	keys, err = w.ListKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+
+	// the wallet survives a restart, rederiving its keys from the mnemonic
+	w, err = hwd.FetchWallet([]byte("hd-id"))
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+	addr, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	require.NotEqual(t, addr6, addr)
+}
+
+func TestHDWalletDriverNewMnemonic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	hwd := makeTestHDDriver(t)
+	pw := []byte("password")
+	require.NoError(t, hwd.CreateWallet([]byte("a"), []byte("a-id"), pw, crypto.MasterDerivationKey{}))
+	w, err := hwd.FetchWallet([]byte("a-id"))
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+	mnemonic, err := w.(wallet.HDWallet).ExportMnemonic(pw)
+	require.NoError(t, err)
+	addr, err := w.GenerateKey(false)
+	require.NoError(t, err)
+
+	// restoring the exported mnemonic yields the same keys
+	require.NoError(t, hwd.CreateWalletFromMnemonic([]byte("b"), []byte("b-id"), pw, mnemonic, ""))
+	w, err = hwd.FetchWallet([]byte("b-id"))
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+	restored, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, addr, restored)
+
+	// a mnemonic with a bad checksum is rejected
+	require.Error(t, hwd.CreateWalletFromMnemonic([]byte("c"), []byte("c-id"), pw, testBIP39Mnemonic[:len(testBIP39Mnemonic)-len("about")]+"above", ""))
+}
@@ -0,0 +1,535 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	remoteWalletDriverName    = "remote"
+	remoteWalletDriverVersion = 1
+	remoteIDLen               = 16
+
+	remoteSignerKeysPath       = "/v1/keys"
+	remoteSignerSignPath       = "/v1/sign"
+	remoteSignerDefaultTimeout = 10 * time.Second
+	remoteSignerMaxErrorBytes  = 1024
+	remoteSignerMaxReplyBytes  = 1 << 20
+
+	// scrypt parameters for the optional wallet password
+	remotePasswordSaltLen = 16
+	remotePasswordKeyLen  = 32
+	remotePasswordScryptN = 1 << 15
+	remotePasswordScryptR = 8
+	remotePasswordScryptP = 1
+)
+
+var remoteWalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+// RemoteWalletDriver exposes external signing services as kmd wallets. Each
+// configured signer becomes one wallet; keys never leave the signer; kmd
+// forwards the canonical bytes to sign and verifies the returned signatures.
+type RemoteWalletDriver struct {
+	mu      deadlock.Mutex
+	wallets map[string]*RemoteWallet
+	log     logging.Logger
+	cfg     config.RemoteWalletDriverConfig
+}
+
+// RemoteWallet represents a single remote signer under the RemoteWalletDriver.
+type RemoteWallet struct {
+	id     string
+	name   string
+	url    *url.URL
+	client *http.Client
+
+	// passwordSalt and passwordKey are empty when no password is configured
+	passwordSalt []byte
+	passwordKey  []byte
+}
+
+// remoteKeysResponse is the reply to a GET on remoteSignerKeysPath
+type remoteKeysResponse struct {
+	PublicKeys [][]byte `json:"public_keys"`
+}
+
+// remoteSignRequest is the body of a POST to remoteSignerSignPath. BytesToSign
+// is already domain separated (e.g. "TX" followed by the encoded transaction)
+// and must be signed as-is.
+type remoteSignRequest struct {
+	PublicKey   []byte `json:"public_key"`
+	BytesToSign []byte `json:"bytes_to_sign"`
+}
+
+// remoteSignResponse is the reply to a POST to remoteSignerSignPath
+type remoteSignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// InitWithConfig accepts a driver configuration and sets up a wallet for
+// each configured remote signer.
+func (rwd *RemoteWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	rwd.log = log
+	rwd.cfg = cfg.DriverConfig.RemoteWalletDriverConfig
+	rwd.wallets = make(map[string]*RemoteWallet)
+
+	for _, signerCfg := range rwd.cfg.Signers {
+		rw, err := makeRemoteWallet(signerCfg)
+		if err != nil {
+			return fmt.Errorf("remote signer %s: %w", signerCfg.Name, err)
+		}
+		rwd.wallets[rw.id] = rw
+	}
+	return nil
+}
+
+func makeRemoteWallet(cfg config.RemoteSignerConfig) (*RemoteWallet, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	// the signer settings below only apply to TLS connections; never send
+	// bytes to sign and signatures in the clear
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, config.ErrRemoteSignerURL
+	}
+	if (cfg.ClientCertFile == "") != (cfg.ClientKeyFile == "") {
+		return nil, config.ErrRemoteSignerClientCert
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CACertFile != "" {
+		pem, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errRemoteCACert
+		}
+	}
+	if cfg.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	timeout := remoteSignerDefaultTimeout
+	if cfg.TimeoutSecs != 0 {
+		timeout = time.Duration(cfg.TimeoutSecs) * time.Second
+	}
+
+	var salt, key []byte
+	if cfg.PasswordHash != "" {
+		salt, key, err = parseRemotePasswordHash(cfg.PasswordHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	idHash := sha512.Sum512_256([]byte(cfg.Name))
+	return &RemoteWallet{
+		id:   fmt.Sprintf("%x", idHash[:remoteIDLen]),
+		name: cfg.Name,
+		url:  u,
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		passwordSalt: salt,
+		passwordKey:  key,
+	}, nil
+}
+
+// HashRemoteSignerPassword returns the value to use as a remote signer's
+// PasswordHash for the given password: a hex encoded random salt and the hex
+// encoded scrypt key derived from it, separated by a colon.
+func HashRemoteSignerPassword(pw []byte) (string, error) {
+	salt := make([]byte, remotePasswordSaltLen)
+	err := fillRandomBytes(salt)
+	if err != nil {
+		return "", err
+	}
+	key, err := remotePasswordKey(pw, salt)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(salt) + ":" + hex.EncodeToString(key), nil
+}
+
+func parseRemotePasswordHash(passwordHash string) (salt []byte, key []byte, err error) {
+	parts := strings.Split(passwordHash, ":")
+	if len(parts) != 2 {
+		return nil, nil, errRemotePasswordHash
+	}
+	salt, err = hex.DecodeString(parts[0])
+	if err != nil || len(salt) == 0 {
+		return nil, nil, errRemotePasswordHash
+	}
+	key, err = hex.DecodeString(parts[1])
+	if err != nil || len(key) != remotePasswordKeyLen {
+		return nil, nil, errRemotePasswordHash
+	}
+	return salt, key, nil
+}
+
+func remotePasswordKey(pw []byte, salt []byte) ([]byte, error) {
+	return scrypt.Key(pw, salt, remotePasswordScryptN, remotePasswordScryptR, remotePasswordScryptP, remotePasswordKeyLen)
+}
+
+// ListWalletMetadatas returns all wallets supported by this driver.
+func (rwd *RemoteWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	for _, w := range rwd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		metadatas = append(metadatas, md)
+	}
+
+	// Sort metadatas by ID
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+	return metadatas, nil
+}
+
+// CreateWallet implements the Driver interface. Remote wallets are defined
+// by the kmd configuration, so they cannot be created through kmd.
+func (rwd *RemoteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	return errNotSupported
+}
+
+// RenameWallet implements the Driver interface.
+func (rwd *RemoteWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID and returns it
+func (rwd *RemoteWalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	rw, ok := rwd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+	return rw, nil
+}
+
+// Init implements the Wallet interface. The password is only checked when
+// the signer is configured with a PasswordHash; otherwise any password is
+// accepted, see config.RemoteSignerConfig.
+func (rw *RemoteWallet) Init(pw []byte) error {
+	return rw.CheckPassword(pw)
+}
+
+// CheckPassword implements the Wallet interface.
+func (rw *RemoteWallet) CheckPassword(pw []byte) error {
+	if len(rw.passwordKey) == 0 {
+		return nil
+	}
+	key, err := remotePasswordKey(pw, rw.passwordSalt)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(key, rw.passwordKey) != 1 {
+		return errDecrypt
+	}
+	return nil
+}
+
+// ExportMasterDerivationKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// Metadata implements the Wallet interface.
+func (rw *RemoteWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(rw.id),
+		Name:                  []byte(rw.name),
+		DriverName:            remoteWalletDriverName,
+		DriverVersion:         remoteWalletDriverVersion,
+		SupportedTransactions: remoteWalletSupportedTxs,
+	}, nil
+}
+
+// ListKeys implements the Wallet interface by asking the remote signer for
+// the public keys it holds.
+func (rw *RemoteWallet) ListKeys() ([]crypto.Digest, error) {
+	var resp remoteKeysResponse
+	err := rw.call(http.MethodGet, remoteSignerKeysPath, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]crypto.Digest, len(resp.PublicKeys))
+	for i, pk := range resp.PublicKeys {
+		if len(pk) != len(keys[i]) {
+			return nil, errRemoteBadKey
+		}
+		copy(keys[i][:], pk)
+	}
+	return keys, nil
+}
+
+// ImportKey implements the Wallet interface.
+func (rw *RemoteWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey implements the Wallet interface.
+func (rw *RemoteWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// DeleteKey implements the Wallet interface.
+func (rw *RemoteWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// ImportMultisigAddr implements the Wallet interface.
+func (rw *RemoteWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// LookupMultisigPreimage implements the Wallet interface.
+func (rw *RemoteWallet) LookupMultisigPreimage(crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	return 0, 0, nil, errNotSupported
+}
+
+// ListMultisigAddrs implements the Wallet interface.
+func (rw *RemoteWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	return nil, nil
+}
+
+// DeleteMultisigAddr implements the Wallet interface.
+func (rw *RemoteWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// SignTransaction implements the Wallet interface.
+func (rw *RemoteWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	// Check the password
+	err := rw.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Src())
+	}
+
+	sig, err := rw.sign(pk, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram implements the Wallet interface.
+func (rw *RemoteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	// Check the password
+	err := rw.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	progb := logic.Program(data)
+	sig, err := rw.sign(crypto.PublicKey(src), &progb)
+	if err != nil {
+		return nil, err
+	}
+	return sig[:], nil
+}
+
+// MultisigSignTransaction implements the Wallet interface. Remote wallets do
+// not store multisig preimages, so partial must already describe the multisig
+// account.
+func (rw *RemoteWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	// Check the password
+	err := rw.CheckPassword(pw)
+	if err != nil {
+		return partial, err
+	}
+
+	addr, err := remoteMultisigAddr(partial, pk)
+	if err != nil {
+		return partial, err
+	}
+	if addr != crypto.Digest(tx.Src()) && addr != signer {
+		return partial, errMsigWrongAddr
+	}
+
+	sig, err := rw.sign(pk, tx)
+	if err != nil {
+		return partial, err
+	}
+	return setMultisigSubsig(partial, pk, sig), nil
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (rw *RemoteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	// Check the password
+	err := rw.CheckPassword(pw)
+	if err != nil {
+		return partial, err
+	}
+
+	addr, err := remoteMultisigAddr(partial, pk)
+	if err != nil {
+		return partial, err
+	}
+	if addr != src {
+		return partial, errMsigWrongAddr
+	}
+
+	progb := logic.Program(data)
+	sig, err := rw.sign(pk, &progb)
+	if err != nil {
+		return partial, err
+	}
+	return setMultisigSubsig(partial, pk, sig), nil
+}
+
+// remoteMultisigAddr is multisigAddrWithKey for wallets which cannot fill in
+// the multisig preimage themselves
+func remoteMultisigAddr(partial crypto.MultisigSig, pk crypto.PublicKey) (crypto.Digest, error) {
+	if len(partial.Subsigs) == 0 {
+		return crypto.Digest{}, errRemoteMsigPreimage
+	}
+	return multisigAddrWithKey(partial, pk)
+}
+
+// sign asks the remote signer to sign the canonical bytes of msg with pk, and
+// verifies the returned signature before handing it back.
+func (rw *RemoteWallet) sign(pk crypto.PublicKey, msg crypto.Hashable) (sig crypto.Signature, err error) {
+	toSign := crypto.HashRep(msg)
+	req := remoteSignRequest{
+		PublicKey:   pk[:],
+		BytesToSign: toSign,
+	}
+
+	var resp remoteSignResponse
+	err = rw.call(http.MethodPost, remoteSignerSignPath, &req, &resp)
+	if err != nil {
+		return
+	}
+
+	if len(resp.Signature) != len(sig) {
+		return crypto.Signature{}, errRemoteBadSignature
+	}
+	copy(sig[:], resp.Signature)
+
+	if !crypto.SignatureVerifier(pk).VerifyBytes(toSign, sig) {
+		return crypto.Signature{}, errRemoteBadSignature
+	}
+	return sig, nil
+}
+
+// call performs a JSON request against the remote signer
+func (rw *RemoteWallet) call(method string, endpoint string, req interface{}, resp interface{}) error {
+	u := *rw.url
+	u.Path = path.Join(u.Path, endpoint)
+
+	var body io.Reader
+	if req != nil {
+		enc, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(enc)
+	}
+
+	httpReq, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return err
+	}
+	if req != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := rw.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errRemoteSigner, err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(httpResp.Body, remoteSignerMaxErrorBytes))
+		return fmt.Errorf("%w: %s returned %d: %s", errRemoteSigner, endpoint, httpResp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	err = json.NewDecoder(io.LimitReader(httpResp.Body, remoteSignerMaxReplyBytes)).Decode(resp)
+	if err != nil {
+		return fmt.Errorf("%w: could not decode %s reply: %v", errRemoteSigner, endpoint, err)
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testRemoteSigner is a local stand-in for a remote signing service
+type testRemoteSigner struct {
+	keys map[crypto.PublicKey]*crypto.SignatureSecrets
+	// corrupt makes the signer return signatures which do not verify
+	corrupt bool
+}
+
+func (s *testRemoteSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/signer" + remoteSignerKeysPath:
+		var resp remoteKeysResponse
+		for pk := range s.keys {
+			pk := pk
+			resp.PublicKeys = append(resp.PublicKeys, pk[:])
+		}
+		json.NewEncoder(w).Encode(&resp)
+	case "/signer" + remoteSignerSignPath:
+		var req remoteSignRequest
+		if json.NewDecoder(r.Body).Decode(&req) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var pk crypto.PublicKey
+		copy(pk[:], req.PublicKey)
+		secrets, ok := s.keys[pk]
+		if !ok {
+			http.Error(w, "unknown key", http.StatusNotFound)
+			return
+		}
+		sig := secrets.SignBytes(req.BytesToSign)
+		if s.corrupt {
+			sig[0] ^= 1
+		}
+		json.NewEncoder(w).Encode(&remoteSignResponse{Signature: sig[:]})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, pem.Encode(f, &pem.Block{Type: blockType, Bytes: der}))
+}
+
+// makeTestClientCert creates a self-signed client certificate, writing it
+// and its key to dir.
+func makeTestClientCert(t *testing.T, dir string) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "kmd"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDer)
+	return cert, certFile, keyFile
+}
+
+func TestRemoteWalletDriver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	signer := &testRemoteSigner{keys: map[crypto.PublicKey]*crypto.SignatureSecrets{secrets.SignatureVerifier: secrets}}
+
+	// the stand-in signer requires kmd to present its client certificate
+	clientCert, clientCertFile, clientKeyFile := makeTestClientCert(t, dir)
+	server := httptest.NewUnstartedServer(signer)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	writePEM(t, caFile, "CERTIFICATE", server.Certificate().Raw)
+
+	cfg := config.KMDConfig{DriverConfig: config.DriverConfig{
+		RemoteWalletDriverConfig: config.RemoteWalletDriverConfig{
+			Signers: []config.RemoteSignerConfig{{
+				Name:           "hsm",
+				URL:            server.URL + "/signer",
+				CACertFile:     caFile,
+				ClientCertFile: clientCertFile,
+				ClientKeyFile:  clientKeyFile,
+			}},
+		},
+	}}
+	require.NoError(t, cfg.Validate())
+
+	rwd := &RemoteWalletDriver{}
+	require.NoError(t, rwd.InitWithConfig(cfg, logging.TestingLog(t)))
+
+	mds, err := rwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, mds, 1)
+	require.Equal(t, "hsm", string(mds[0].Name))
+	require.Equal(t, remoteWalletDriverName, mds[0].DriverName)
+
+	w, err := rwd.FetchWallet(mds[0].ID)
+	require.NoError(t, err)
+
+	keys, err := w.ListKeys()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{crypto.Digest(secrets.SignatureVerifier)}, keys)
+
+	// transactions are signed remotely and come back as valid signed transactions
+	tx := transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Sender: basics.Address(secrets.SignatureVerifier), Fee: basics.MicroAlgos{Raw: 1000}},
+	}
+	stxBytes, err := w.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+	var stx transactions.SignedTxn
+	require.NoError(t, protocol.Decode(stxBytes, &stx))
+	require.Equal(t, tx.Sign(secrets), stx)
+
+	program := []byte{0x01, 0x20, 0x01, 0x01, 0x22}
+	sig, err := w.SignProgram(program, crypto.Digest(secrets.SignatureVerifier), nil)
+	require.NoError(t, err)
+	progb := logic.Program(program)
+	expectedSig := secrets.Sign(&progb)
+	require.Equal(t, expectedSig[:], sig)
+
+	// multisig signing requires the partial signature to carry the preimage
+	var otherSeed crypto.Seed
+	crypto.RandBytes(otherSeed[:])
+	other := crypto.GenerateSignatureSecrets(otherSeed)
+	pks := []crypto.PublicKey{secrets.SignatureVerifier, other.SignatureVerifier}
+	msigAddr, err := crypto.MultisigAddrGen(1, 1, pks)
+	require.NoError(t, err)
+	tx.Sender = basics.Address(msigAddr)
+
+	_, err = w.MultisigSignTransaction(tx, secrets.SignatureVerifier, crypto.MultisigSig{}, nil, crypto.Digest{})
+	require.ErrorIs(t, err, errRemoteMsigPreimage)
+
+	partial := crypto.MultisigSig{Version: 1, Threshold: 1, Subsigs: []crypto.MultisigSubsig{{Key: pks[0]}, {Key: pks[1]}}}
+	msig, err := w.MultisigSignTransaction(tx, secrets.SignatureVerifier, partial, nil, crypto.Digest{})
+	require.NoError(t, err)
+	expected, err := crypto.MultisigSign(tx, msigAddr, 1, 1, pks, *secrets)
+	require.NoError(t, err)
+	require.Equal(t, expected, msig)
+	require.Equal(t, crypto.Signature{}, partial.Subsigs[0].Sig)
+
+	_, err = w.MultisigSignTransaction(tx, crypto.PublicKey{0x01}, partial, nil, crypto.Digest{})
+	require.ErrorIs(t, err, errMsigWrongKey)
+
+	// keys unknown to the signer, and signatures which do not verify, are errors
+	_, err = w.SignTransaction(tx, other.SignatureVerifier, nil)
+	require.ErrorIs(t, err, errRemoteSigner)
+
+	signer.corrupt = true
+	_, err = w.SignProgram(program, crypto.Digest(secrets.SignatureVerifier), nil)
+	require.ErrorIs(t, err, errRemoteBadSignature)
+}
+
+func TestRemoteWalletRequiresClientCert(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	clientCert, _, _ := makeTestClientCert(t, dir)
+	server := httptest.NewUnstartedServer(&testRemoteSigner{})
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	writePEM(t, caFile, "CERTIFICATE", server.Certificate().Raw)
+
+	rw, err := makeRemoteWallet(config.RemoteSignerConfig{Name: "hsm", URL: server.URL + "/signer", CACertFile: caFile})
+	require.NoError(t, err)
+	_, err = rw.ListKeys()
+	require.ErrorIs(t, err, errRemoteSigner)
+}
+
+func TestRemoteSignerConfigValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	validate := func(signers ...config.RemoteSignerConfig) error {
+		cfg := config.KMDConfig{DriverConfig: config.DriverConfig{
+			RemoteWalletDriverConfig: config.RemoteWalletDriverConfig{Signers: signers},
+		}}
+		return cfg.Validate()
+	}
+
+	good := config.RemoteSignerConfig{Name: "a", URL: "https://signer.example.com"}
+	require.NoError(t, validate(good))
+	require.ErrorIs(t, validate(good, good), config.ErrRemoteSignerName)
+	require.ErrorIs(t, validate(config.RemoteSignerConfig{Name: "a", URL: "http://signer.example.com"}), config.ErrRemoteSignerURL)
+	require.ErrorIs(t, validate(config.RemoteSignerConfig{Name: "a", URL: good.URL, ClientCertFile: "cert.pem"}), config.ErrRemoteSignerClientCert)
+}
+
+func TestRemoteWalletRequiresTLS(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, err := makeRemoteWallet(config.RemoteSignerConfig{Name: "hsm", URL: "http://signer.example.com"})
+	require.ErrorIs(t, err, config.ErrRemoteSignerURL)
+	_, err = makeRemoteWallet(config.RemoteSignerConfig{Name: "hsm", URL: "https://signer.example.com", ClientKeyFile: "key.pem"})
+	require.ErrorIs(t, err, config.ErrRemoteSignerClientCert)
+	_, err = makeRemoteWallet(config.RemoteSignerConfig{Name: "hsm", URL: "https://signer.example.com"})
+	require.NoError(t, err)
+}
+
+func TestRemoteWalletPassword(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// without a password hash, any password is accepted
+	rw, err := makeRemoteWallet(config.RemoteSignerConfig{Name: "hsm", URL: "https://signer.example.com"})
+	require.NoError(t, err)
+	require.NoError(t, rw.Init([]byte("anything")))
+
+	passwordHash, err := HashRemoteSignerPassword([]byte("secret"))
+	require.NoError(t, err)
+	rw, err = makeRemoteWallet(config.RemoteSignerConfig{Name: "hsm", URL: "https://signer.example.com", PasswordHash: passwordHash})
+	require.NoError(t, err)
+	require.NoError(t, rw.Init([]byte("secret")))
+	require.NoError(t, rw.CheckPassword([]byte("secret")))
+	require.ErrorIs(t, rw.Init([]byte("wrong")), errDecrypt)
+	require.ErrorIs(t, rw.CheckPassword(nil), errDecrypt)
+
+	// the password is checked before anything is sent to the signer
+	_, err = rw.SignTransaction(transactions.Transaction{}, crypto.PublicKey{}, []byte("wrong"))
+	require.ErrorIs(t, err, errDecrypt)
+	_, err = rw.SignProgram(nil, crypto.Digest{}, nil)
+	require.ErrorIs(t, err, errDecrypt)
+	_, err = rw.MultisigSignTransaction(transactions.Transaction{}, crypto.PublicKey{}, crypto.MultisigSig{}, []byte("wrong"), crypto.Digest{})
+	require.ErrorIs(t, err, errDecrypt)
+	_, err = rw.MultisigSignProgram(nil, crypto.Digest{}, crypto.PublicKey{}, crypto.MultisigSig{}, nil)
+	require.ErrorIs(t, err, errDecrypt)
+
+	_, err = makeRemoteWallet(config.RemoteSignerConfig{Name: "hsm", URL: "https://signer.example.com", PasswordHash: "nothex"})
+	require.ErrorIs(t, err, errRemotePasswordHash)
+}
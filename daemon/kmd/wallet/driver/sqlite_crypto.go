@@ -51,6 +51,10 @@ var (
 	PTMasterDerivationKey plaintextType = "master_derivation_key"
 	// PTMaxKeyIdx is the plaintext type for the maximum key index
 	PTMaxKeyIdx plaintextType = "max_key_idx"
+	// PTBIP39Entropy is the plaintext type for the entropy of an HD wallet's mnemonic
+	PTBIP39Entropy plaintextType = "bip39_entropy"
+	// PTBIP39Seed is the plaintext type for the BIP39 seed of an HD wallet
+	PTBIP39Seed plaintextType = "bip39_seed"
 )
 
 // typedPlaintext prevents us from confusing differently typed data encrypted
@@ -24,3 +24,32 @@ func publicKeyToAddress(pk crypto.PublicKey) (addr crypto.Digest) {
 	copy(addr[:], pk[:])
 	return
 }
+
+// multisigAddrWithKey checks that pk is one of the keys of the partial multisig
+// and returns the multisig address.
+func multisigAddrWithKey(partial crypto.MultisigSig, pk crypto.PublicKey) (crypto.Digest, error) {
+	addr, err := crypto.MultisigAddrGenWithSubsigs(partial.Version, partial.Threshold, partial.Subsigs)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+
+	for _, subsig := range partial.Subsigs {
+		if subsig.Key == pk {
+			return addr, nil
+		}
+	}
+	return crypto.Digest{}, errMsigWrongKey
+}
+
+// setMultisigSubsig returns a copy of partial with sig set as the signature of pk
+func setMultisigSubsig(partial crypto.MultisigSig, pk crypto.PublicKey, sig crypto.Signature) crypto.MultisigSig {
+	subsigs := make([]crypto.MultisigSubsig, len(partial.Subsigs))
+	copy(subsigs, partial.Subsigs)
+	for i := range subsigs {
+		if subsigs[i].Key == pk {
+			subsigs[i].Sig = sig
+		}
+	}
+	partial.Subsigs = subsigs
+	return partial
+}
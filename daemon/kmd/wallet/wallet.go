@@ -59,6 +59,16 @@ type Wallet interface {
 	MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error)
 }
 
+// HDWallet is implemented by wallets whose keys are derived from a BIP39
+// mnemonic using BIP32-Ed25519 derivation paths
+type HDWallet interface {
+	// DeriveKey derives the key at path, adds it to the wallet, and returns
+	// its address
+	DeriveKey(path []uint32) (crypto.Digest, error)
+	// ExportMnemonic returns the BIP39 mnemonic the wallet's keys are derived from
+	ExportMnemonic(pw []byte) (string, error)
+}
+
 // Metadata represents high-level information about a wallet, like its name, id
 // and what operations it supports
 type Metadata struct {
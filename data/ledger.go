@@ -119,6 +119,15 @@ func LoadLedger(
 	return l, nil
 }
 
+// RestoreSnapshot reverts the ledger to a snapshot taken with SaveSnapshot. It also drops the
+// cached circulation and seed values, since rounds past the snapshot are going to be rewritten.
+func (l *Ledger) RestoreSnapshot(dir string) (basics.Round, error) {
+	rnd, err := l.Ledger.RestoreSnapshot(dir)
+	l.lastRoundCirculation.Store(roundCirculation{})
+	l.lastRoundSeed.Store(roundSeed{})
+	return rnd, err
+}
+
 // AddressTxns returns the list of transactions to/from a given address in specific round
 func (l *Ledger) AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error) {
 	blk, err := l.Block(r)
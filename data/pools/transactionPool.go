@@ -29,6 +29,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntrace"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
@@ -457,15 +458,18 @@ func (pool *TransactionPool) RememberOne(t transactions.SignedTxn) error {
 
 // Remember stores the provided transaction group.
 // Precondition: Only Remember() properly-signed and well-formed transactions (i.e., ensure t.WellFormed())
-func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
-	if err := pool.checkPendingQueueSize(txgroup); err != nil {
+func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) (err error) {
+	timer := txntrace.Start(txntrace.StageRemember, txgroup)
+	defer func() { timer.End(err) }()
+
+	if err = pool.checkPendingQueueSize(txgroup); err != nil {
 		return err
 	}
 
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 
-	err := pool.remember(txgroup)
+	err = pool.remember(txgroup)
 	if err != nil {
 		return fmt.Errorf("TransactionPool.Remember: %w", err)
 	}
@@ -503,6 +507,7 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 	var unknownCommitted uint
 
 	committedTxids := delta.Txids
+	traceBlockTxns(txntrace.StageCommit, committedTxids, block.Round(), time.Now())
 	if pool.logProcessBlockStats {
 		pool.pendingMu.RLock()
 		for txid := range committedTxids {
@@ -820,6 +825,13 @@ func (pool *TransactionPool) getStateProofStats(txib *transactions.SignedTxnInBl
 func (pool *TransactionPool) AssembleBlock(round basics.Round, deadline time.Time) (assembled *ledgercore.ValidatedBlock, err error) {
 	var stats telemetryspec.AssembleBlockMetrics
 
+	assembleStart := time.Now()
+	defer func() {
+		if err == nil && assembled != nil {
+			traceBlockTxns(txntrace.StageAssemble, assembled.Delta().Txids, round, assembleStart)
+		}
+	}()
+
 	if pool.logAssembleStats {
 		start := time.Now()
 		defer func() {
@@ -997,3 +1009,20 @@ func (pool *TransactionPool) AssembleDevModeBlock() (assembled *ledgercore.Valid
 	assembled, err = pool.AssembleBlock(pool.pendingBlockEvaluator.Round(), time.Now().Add(pool.proposalAssemblyTime))
 	return
 }
+
+// traceBlockTxns records a lifecycle stage for the transactions included in a
+// block. Only transactions which are already traced get the stage, so that the
+// transactions of every block do not evict the traces of submitted ones.
+func traceBlockTxns(stage txntrace.Stage, txids map[transactions.Txid]ledgercore.IncludedTransactions, round basics.Round, start time.Time) {
+	tracer := txntrace.Active()
+	if tracer == nil || len(txids) == 0 {
+		return
+	}
+	ids := make([]transactions.Txid, 0, len(txids))
+	for txid := range txids {
+		ids = append(ids, txid)
+	}
+	timer := txntrace.StartIDsAt(stage, tracer.Traced(ids), start)
+	timer.SetRound(round)
+	timer.End(nil)
+}
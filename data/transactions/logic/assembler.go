@@ -56,6 +56,9 @@ type labelReference struct {
 
 	// ending position of the opcode containing the label reference.
 	offsetPosition int
+
+	// index into OpStream.Sources of the source containing the reference
+	source int
 }
 
 type constReference interface {
@@ -252,24 +255,49 @@ type OpStream struct {
 	// map opcode offsets to source line
 	OffsetToLine map[int]int
 
+	// Sources names the source texts assembled into the program when
+	// #include is in use. The first is the program text itself, followed by
+	// each included source in the order it was first included.
+	Sources []string
+
+	// map opcode offsets to the index in Sources of the source they were
+	// assembled from. Offsets that are absent come from Sources[0].
+	OffsetToSource map[int]int
+
 	HasStatefulOps bool
 
 	// Need new copy for each opstream
 	versionedPseudoOps map[string]map[int]OpSpec
 
 	macros map[string][]token
+
+	// locates sources named by #include. nil if #include is not allowed.
+	loader SourceLoader
+
+	// index into Sources of the source currently being assembled
+	source int
+
+	// namespace under which the exported labels of each source are visible
+	namespaces []string
+
+	// labels marked by #export, made visible program-wide by resolveExports
+	exports []labelExport
+
+	// number of nested #includes currently being assembled
+	includeDepth int
 }
 
 // newOpStream constructs OpStream instances ready to invoke assemble. A new
 // OpStream must be used for each call to assemble().
 func newOpStream(version uint64) OpStream {
 	o := OpStream{
-		labels:       make(map[string]int),
-		OffsetToLine: make(map[int]int),
-		typeTracking: true,
-		Version:      version,
-		macros:       make(map[string][]token),
-		known:        ProgramKnowledge{fp: -1},
+		labels:         make(map[string]int),
+		OffsetToLine:   make(map[int]int),
+		OffsetToSource: make(map[int]int),
+		typeTracking:   true,
+		Version:        version,
+		macros:         make(map[string][]token),
+		known:          ProgramKnowledge{fp: -1},
 	}
 
 	for i := range o.known.scratchSpace {
@@ -358,21 +386,25 @@ func (pgm *ProgramKnowledge) reset() {
 // error for a duplicate.
 func (ops *OpStream) createLabel(withColon token) {
 	label := strings.TrimSuffix(withColon.str, ":")
-	if _, ok := ops.labels[label]; ok {
+	scoped := scopedLabel(ops.source, label)
+	if _, ok := ops.labels[scoped]; ok {
 		ops.record(withColon.errorf("duplicate label %#v", label))
 	}
-	ops.labels[label] = ops.pending.Len()
+	ops.labels[scoped] = ops.pending.Len()
 	ops.known.label()
 }
 
 // recordSourceLine adds an entry to pc to line mapping
 func (ops *OpStream) recordSourceLine() {
 	ops.OffsetToLine[ops.pending.Len()] = ops.sourceLine - 1
+	if ops.source != 0 {
+		ops.OffsetToSource[ops.pending.Len()] = ops.source
+	}
 }
 
 // referToLabel records an opcode label reference to resolve later
 func (ops *OpStream) referToLabel(pc int, label token, offsetPosition int) {
-	ops.labelReferences = append(ops.labelReferences, labelReference{pc, label, offsetPosition})
+	ops.labelReferences = append(ops.labelReferences, labelReference{pc, label, offsetPosition, ops.source})
 }
 
 type refineFunc func(pgm *ProgramKnowledge, immediates []token) (StackTypes, StackTypes, error)
@@ -1816,6 +1848,12 @@ type sourceError struct {
 }
 
 func (se sourceError) Error() string {
+	if ie, ok := se.Err.(includedError); ok {
+		if se.Column != 0 {
+			return fmt.Sprintf("%s:%d:%d: %s", ie.source, se.Line, se.Column, ie.Error())
+		}
+		return fmt.Sprintf("%s:%d: %s", ie.source, se.Line, ie.Error())
+	}
 	if se.Column != 0 {
 		return fmt.Sprintf("%d:%d: %s", se.Line, se.Column, se.Err.Error())
 	}
@@ -2036,7 +2074,13 @@ func nextStatement(ops *OpStream, tokens []token) (current, rest []token) {
 
 type directiveFunc func(*OpStream, []token) *sourceError
 
-var directives = map[string]directiveFunc{"pragma": pragma, "define": define}
+var directives map[string]directiveFunc
+
+func init() {
+	// include refers back to directives through assembleSource, so the map
+	// is populated here to avoid an initialization cycle.
+	directives = map[string]directiveFunc{"pragma": pragma, "define": define, "include": include, "export": export}
+}
 
 // assemble reads text from an input and accumulates the program
 func (ops *OpStream) assemble(text string) error {
@@ -2050,6 +2094,30 @@ func (ops *OpStream) assemble(text string) error {
 		ops.record(&sourceError{0, 0, err})
 		return err
 	}
+	ops.assembleSource(text)
+
+	if ops.Version >= optimizeConstantsEnabledVersion {
+		ops.optimizeIntcBlock()
+		ops.optimizeBytecBlock()
+	}
+
+	ops.resolveExports()
+	ops.resolveLabels()
+	program := ops.prependCBlocks()
+	if ops.Errors != nil {
+		l := len(ops.Errors)
+		if l == 1 {
+			return fmt.Errorf("1 error: %w", ops.Errors[0])
+		}
+		return fmt.Errorf("%d errors", l)
+	}
+	ops.Program = program
+	return nil
+}
+
+// assembleSource accumulates the instructions of a single source text,
+// which is either the program text itself or an #included source.
+func (ops *OpStream) assembleSource(text string) {
 	fin := strings.NewReader(text)
 	scanner := bufio.NewScanner(fin)
 	for scanner.Scan() {
@@ -2140,23 +2208,6 @@ func (ops *OpStream) assemble(text string) error {
 		}
 		ops.record(&sourceError{ops.sourceLine, 0, err})
 	}
-
-	if ops.Version >= optimizeConstantsEnabledVersion {
-		ops.optimizeIntcBlock()
-		ops.optimizeBytecBlock()
-	}
-
-	ops.resolveLabels()
-	program := ops.prependCBlocks()
-	if ops.Errors != nil {
-		l := len(ops.Errors)
-		if l == 1 {
-			return fmt.Errorf("1 error: %w", ops.Errors[0])
-		}
-		return fmt.Errorf("%d errors", l)
-	}
-	ops.Program = program
-	return nil
 }
 
 // cycle return a slice of strings that constitute a cycle, if one is
@@ -2293,7 +2344,7 @@ func pragma(ops *OpStream, tokens []token) *sourceError {
 			return tokens[3].errorf("unexpected extra tokens:%s", reJoin("", tokens[3:]))
 		}
 		var ver uint64
-		if ops.pending.Len() > 0 {
+		if ops.pending.Len() > 0 && ops.source == 0 {
 			return tokens[0].errorf("#pragma version is only allowed before instructions")
 		}
 		value := tokens[2].str
@@ -2305,6 +2356,15 @@ func pragma(ops *OpStream, tokens []token) *sourceError {
 			return tokens[2].errorf("unsupported version: %d", ver)
 		}
 
+		// An included source states the version it was written for, which
+		// may be older than the program including it.
+		if ops.source != 0 && ops.Version != assemblerNoVersion {
+			if ver > ops.Version {
+				return tokens[2].errorf("included source requires v%d but program is v%d", ver, ops.Version)
+			}
+			return nil
+		}
+
 		// We initialize Version with assemblerNoVersion as a marker for
 		// non-specified version because version 0 is valid
 		// version for v1.
@@ -2348,31 +2408,36 @@ func (ops *OpStream) resolveLabels() {
 	raw := ops.pending.Bytes()
 	reported := make(map[string]bool)
 	for _, lr := range ops.labelReferences {
-		dest, ok := ops.labels[lr.label.str]
+		scoped := scopedLabel(lr.source, lr.label.str)
+		dest, ok := ops.labels[scoped]
+		if !ok && lr.source != 0 {
+			// labels of included sources fall back to the program-wide scope
+			dest, ok = ops.labels[lr.label.str]
+		}
 		if !ok {
-			if !reported[lr.label.str] {
-				ops.record(lr.label.errorf("reference to undefined label %#v", lr.label.str))
+			if !reported[scoped] {
+				ops.recordFrom(lr.source, lr.label.errorf("reference to undefined label %#v", lr.label.str))
 			}
-			reported[lr.label.str] = true
+			reported[scoped] = true
 			continue
 		}
 
 		// backward compatibility: do not allow jumps past last instruction in v1
 		if ops.Version <= 1 {
 			if dest == ops.pending.Len() {
-				ops.record(lr.label.errorf("label %#v is too far away", lr.label.str))
+				ops.recordFrom(lr.source, lr.label.errorf("label %#v is too far away", lr.label.str))
 			}
 		}
 
 		// All branch targets are encoded as 2 offset bytes. The destination is relative to the end of the
 		// instruction they appear in, which is available in lr.offsetPostion
 		if ops.Version < backBranchEnabledVersion && dest < lr.offsetPosition {
-			ops.record(lr.label.errorf("label %#v is a back reference, back jump support was introduced in v4", lr.label.str))
+			ops.recordFrom(lr.source, lr.label.errorf("label %#v is a back reference, back jump support was introduced in v4", lr.label.str))
 			continue
 		}
 		jump := dest - lr.offsetPosition
 		if jump > 0x7fff {
-			ops.record(lr.label.errorf("label %#v is too far away", lr.label.str))
+			ops.recordFrom(lr.source, lr.label.errorf("label %#v is too far away", lr.label.str))
 			continue
 		}
 		raw[lr.position] = uint8(jump >> 8)
@@ -2622,6 +2687,16 @@ func (ops *OpStream) optimizeConstants(refs []constReference, constBlock []inter
 			}
 		}
 		ops.OffsetToLine = fixedOffsetsToLine
+
+		fixedOffsetsToSource := make(map[int]int, len(ops.OffsetToSource))
+		for pos, source := range ops.OffsetToSource {
+			if pos > position {
+				fixedOffsetsToSource[pos+positionDelta] = source
+			} else {
+				fixedOffsetsToSource[pos] = source
+			}
+		}
+		ops.OffsetToSource = fixedOffsetsToSource
 	}
 
 	ops.pending = *bytes.NewBuffer(raw)
@@ -2684,6 +2759,12 @@ func (ops *OpStream) prependCBlocks() []byte {
 	}
 	ops.OffsetToLine = newOffsetToLine
 
+	newOffsetToSource := make(map[int]int, len(ops.OffsetToSource))
+	for o, s := range ops.OffsetToSource {
+		newOffsetToSource[o+pbl] = s
+	}
+	ops.OffsetToSource = newOffsetToSource
+
 	return out
 }
 
@@ -2692,11 +2773,24 @@ func (ops *OpStream) prependCBlocks() []byte {
 // more than one TEAL error can be reported in one pass.  By explicitly
 // swallowing the error this way, we can use the linter properly.
 func (ops *OpStream) record(se *sourceError) {
+	ops.recordFrom(ops.source, se)
+}
+
+// recordFrom records an error found in the source with the given index into
+// ops.Sources, attributing it to that source if it was #included.
+func (ops *OpStream) recordFrom(source int, se *sourceError) {
+	if source != 0 {
+		se.Err = includedError{ops.Sources[source], se.Err}
+	}
 	ops.Errors = append(ops.Errors, *se)
 }
 
 func (ops *OpStream) warn(t token, format string, a ...interface{}) {
-	warning := &sourceError{Line: t.line, Column: t.col, Err: fmt.Errorf(format, a...)}
+	err := fmt.Errorf(format, a...)
+	if ops.source != 0 {
+		err = includedError{ops.Sources[ops.source], err}
+	}
+	warning := &sourceError{Line: t.line, Column: t.col, Err: err}
 	ops.Warnings = append(ops.Warnings, warning)
 }
 
@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// maxIncludeDepth bounds how deeply #include directives may nest.
+const maxIncludeDepth = 16
+
+// SourceLoader locates the sources named by #include directives.
+type SourceLoader interface {
+	// Load returns the text of the source called name, as referenced from
+	// the source called from. The returned canonical name identifies the
+	// source for include-once checks, error messages and source maps.
+	Load(name string, from string) (canonical string, text []byte, err error)
+}
+
+// DirLoader loads #included sources from the filesystem. A name is first
+// looked up relative to the directory of the including source, then in
+// each of Paths in turn.
+type DirLoader struct {
+	Paths []string
+}
+
+// Load implements SourceLoader
+func (dl DirLoader) Load(name string, from string) (string, []byte, error) {
+	dirs := append([]string{filepath.Dir(from)}, dl.Paths...)
+	if filepath.IsAbs(name) {
+		dirs = []string{""}
+	}
+	for _, dir := range dirs {
+		candidate := filepath.Clean(filepath.Join(dir, name))
+		text, err := os.ReadFile(candidate)
+		if err == nil {
+			return candidate, text, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", nil, err
+		}
+	}
+	return "", nil, fmt.Errorf("unable to find %#v in include path", name)
+}
+
+// MapLoader loads #included sources from an in-memory bundle, keyed by
+// slash separated names. A name is first looked up relative to the
+// including source, then from the root of the bundle.
+type MapLoader map[string][]byte
+
+// Load implements SourceLoader
+func (ml MapLoader) Load(name string, from string) (string, []byte, error) {
+	for _, candidate := range []string{path.Join(path.Dir(from), name), path.Clean(name)} {
+		if text, ok := ml[candidate]; ok {
+			return candidate, text, nil
+		}
+	}
+	return "", nil, fmt.Errorf("unable to find %#v in bundle", name)
+}
+
+// includedError attributes an error to the #included source it was found in.
+type includedError struct {
+	source string
+	err    error
+}
+
+func (ie includedError) Error() string {
+	return ie.err.Error()
+}
+
+func (ie includedError) Unwrap() error {
+	return ie.err
+}
+
+// labelExport records an #export directive
+type labelExport struct {
+	label  token
+	source int
+}
+
+// scopedLabel names a label within the scope of the source it is defined in.
+// Labels in the program text itself keep their plain names, so that only
+// #included sources get a private scope.
+func scopedLabel(source int, label string) string {
+	if source == 0 {
+		return label
+	}
+	return fmt.Sprintf("%d:%s", source, label)
+}
+
+// include assembles another source in place of the directive. Each source is
+// assembled at most once, so later includes of the same source are ignored.
+func include(ops *OpStream, tokens []token) *sourceError {
+	if tokens[0].str != "#include" {
+		return tokens[0].errorf("invalid syntax: %s", tokens[0].str)
+	}
+	if len(tokens) != 2 && (len(tokens) != 4 || tokens[2].str != "as") {
+		return tokens[0].errorf("#include expects a quoted name, optionally followed by: as <namespace>")
+	}
+	if ops.loader == nil {
+		return tokens[0].errorf("#include is not available when assembling a single source")
+	}
+	name, err := parseStringLiteral(tokens[1].str)
+	if err != nil {
+		return tokens[1].error(err)
+	}
+	namespace := ""
+	if len(tokens) == 4 {
+		namespace = tokens[3].str
+		if strings.ContainsAny(namespace, ".:") {
+			return tokens[3].errorf("invalid namespace: %s", namespace)
+		}
+	}
+	if ops.includeDepth >= maxIncludeDepth {
+		return tokens[0].errorf("#include nested more than %d deep", maxIncludeDepth)
+	}
+
+	canonical, text, err := ops.loader.Load(string(name), ops.Sources[ops.source])
+	if err != nil {
+		return tokens[1].error(err)
+	}
+	for i, s := range ops.Sources {
+		if s == canonical {
+			if ops.namespaces[i] != namespace {
+				return tokens[1].errorf("%s is already included with namespace %#v", canonical, ops.namespaces[i])
+			}
+			return nil
+		}
+	}
+
+	ops.Sources = append(ops.Sources, canonical)
+	ops.namespaces = append(ops.namespaces, namespace)
+	outerSource, outerLine := ops.source, ops.sourceLine
+	ops.source, ops.sourceLine = len(ops.Sources)-1, 0
+	ops.includeDepth++
+	ops.assembleSource(string(text))
+	ops.includeDepth--
+	ops.source, ops.sourceLine = outerSource, outerLine
+	return nil
+}
+
+// export makes labels of an #included source visible to the rest of the
+// program, qualified by the namespace the source was included with.
+func export(ops *OpStream, tokens []token) *sourceError {
+	if tokens[0].str != "#export" {
+		return tokens[0].errorf("invalid syntax: %s", tokens[0].str)
+	}
+	if len(tokens) < 2 {
+		return tokens[0].errorf("#export requires at least one label")
+	}
+	if ops.source == 0 {
+		return tokens[0].errorf("#export is only allowed in #included sources")
+	}
+	for _, label := range tokens[1:] {
+		ops.exports = append(ops.exports, labelExport{label, ops.source})
+	}
+	return nil
+}
+
+// resolveExports adds exported labels to the program-wide scope
+func (ops *OpStream) resolveExports() {
+	for _, ex := range ops.exports {
+		dest, ok := ops.labels[scopedLabel(ex.source, ex.label.str)]
+		if !ok {
+			ops.recordFrom(ex.source, ex.label.errorf("exported label %#v is not defined", ex.label.str))
+			continue
+		}
+		name := ex.label.str
+		if ns := ops.namespaces[ex.source]; ns != "" {
+			name = ns + "." + name
+		}
+		if _, ok := ops.labels[name]; ok {
+			ops.recordFrom(ex.source, ex.label.errorf("duplicate label %#v", name))
+			continue
+		}
+		ops.labels[name] = dest
+	}
+}
+
+// AssembleWithIncludes assembles a program that may #include other sources,
+// which are located with loader. name identifies the program text itself, and
+// is the first of the returned OpStream's Sources.
+func AssembleWithIncludes(name string, text string, loader SourceLoader) (*OpStream, error) {
+	ops := newOpStream(assemblerNoVersion)
+	ops.loader = loader
+	ops.Sources = []string{name}
+	ops.namespaces = []string{""}
+	err := ops.assemble(text)
+	return &ops, err
+}
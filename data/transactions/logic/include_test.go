@@ -0,0 +1,221 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+const mathLib = `#pragma version 6
+#export double
+double:
+  dup
+  +
+  retsub
+`
+
+func TestIncludeExportedLabels(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	bundle := MapLoader{"lib/math.teal": []byte(mathLib)}
+	main := `#pragma version 8
+b skip
+#include "lib/math.teal" as math
+skip:
+int 2
+callsub math.double
+int 4
+==
+`
+	ops, err := AssembleWithIncludes("main.teal", main, bundle)
+	require.NoError(t, err)
+	require.Equal(t, []string{"main.teal", "lib/math.teal"}, ops.Sources)
+
+	// The same program written in one piece assembles identically.
+	flat, err := AssembleString(`#pragma version 8
+b skip
+double:
+  dup
+  +
+  retsub
+skip:
+int 2
+callsub double
+int 4
+==
+`)
+	require.NoError(t, err)
+	require.Equal(t, flat.Program, ops.Program)
+
+	// Without a namespace, exported labels keep their names.
+	ops, err = AssembleWithIncludes("main.teal", `#pragma version 8
+b skip
+#include "lib/math.teal"
+skip:
+int 2; callsub double; int 4; ==`, bundle)
+	require.NoError(t, err)
+	require.Equal(t, flat.Program, ops.Program)
+}
+
+func TestIncludePrivateLabels(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	bundle := MapLoader{
+		"a.teal": []byte("#export f\nf:\nb done\ndone:\nretsub\n"),
+		"b.teal": []byte("#export g\ng:\nb done\ndone:\nretsub\n"),
+	}
+	// Both sources define "done" privately, and so may the program itself.
+	ops, err := AssembleWithIncludes("main.teal", `#pragma version 8
+b start
+#include "a.teal" as a
+#include "b.teal" as b
+start:
+callsub a.f
+callsub b.g
+b done
+done:
+int 1`, bundle)
+	require.NoError(t, err)
+
+	// Labels that are not exported remain private to their source.
+	ops, err = AssembleWithIncludes("main.teal", `#pragma version 8
+b start
+#include "a.teal" as a
+start:
+callsub a.done
+int 1`, bundle)
+	require.Error(t, err)
+	require.Len(t, ops.Errors, 1)
+	require.Equal(t, `5:8: reference to undefined label "a.done"`, ops.Errors[0].Error())
+}
+
+func TestIncludeOnce(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	bundle := MapLoader{
+		"math.teal":  []byte(mathLib),
+		"twice.teal": []byte("#include \"math.teal\"\n"),
+		"loop.teal":  []byte("#include \"main.teal\"\n"),
+	}
+	main := `#pragma version 8
+b start
+#include "math.teal"
+#include "twice.teal"
+#include "loop.teal"
+#include "math.teal"
+start:
+int 2
+callsub double`
+	bundle["main.teal"] = []byte(main)
+	ops, err := AssembleWithIncludes("main.teal", main, bundle)
+	require.NoError(t, err)
+	require.Equal(t, []string{"main.teal", "math.teal", "twice.teal", "loop.teal"}, ops.Sources)
+
+	_, err = AssembleWithIncludes("main.teal", `#pragma version 8
+b start
+#include "math.teal"
+#include "math.teal" as m
+start:
+int 1`, bundle)
+	require.ErrorContains(t, err, `math.teal is already included with namespace ""`)
+}
+
+func TestIncludeErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	bundle := MapLoader{
+		"bad.teal":    []byte("int 1\nnotanop\n"),
+		"new.teal":    []byte("#pragma version 8\nint 1\n"),
+		"unused.teal": []byte("#export nowhere\nint 1\n"),
+	}
+	ops, err := AssembleWithIncludes("main.teal", "#pragma version 8\n#include \"bad.teal\"\n", bundle)
+	require.Error(t, err)
+	require.Equal(t, "bad.teal:2: unknown opcode: notanop", ops.Errors[0].Error())
+
+	_, err = AssembleWithIncludes("main.teal", "#pragma version 6\n#include \"new.teal\"\n", bundle)
+	require.ErrorContains(t, err, "included source requires v8 but program is v6")
+
+	_, err = AssembleWithIncludes("main.teal", "#pragma version 8\n#include \"unused.teal\"\n", bundle)
+	require.ErrorContains(t, err, `exported label "nowhere" is not defined`)
+
+	_, err = AssembleWithIncludes("main.teal", "#include \"missing.teal\"\nint 1", bundle)
+	require.ErrorContains(t, err, `unable to find "missing.teal" in bundle`)
+
+	_, err = AssembleWithIncludes("main.teal", "#export x\nx:\nint 1", bundle)
+	require.ErrorContains(t, err, "#export is only allowed in #included sources")
+
+	_, err = AssembleString("#include \"bad.teal\"\nint 1")
+	require.ErrorContains(t, err, "#include is not available when assembling a single source")
+}
+
+func TestIncludeSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	bundle := MapLoader{"math.teal": []byte(mathLib)}
+	ops, err := AssembleWithIncludes("main.teal", `#pragma version 8
+b start
+#include "math.teal"
+start:
+int 2
+callsub double`, bundle)
+	require.NoError(t, err)
+
+	sources := make(map[int]int)
+	for pc, source := range ops.OffsetToSource {
+		sources[source]++
+		require.Contains(t, ops.OffsetToLine, pc)
+	}
+	require.Equal(t, map[int]int{1: 3}, sources) // dup, +, retsub
+
+	sm := GetMultiSourceMap(ops.Sources, ops.OffsetToSource, ops.OffsetToLine)
+	require.Equal(t, ops.Sources, sm.Sources)
+	require.Contains(t, sm.Mappings, MakeSourceMapLine(0, 1, 2, 0))
+}
+
+func TestDirLoader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	root := t.TempDir()
+	shared := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "lib"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "lib", "local.teal"), []byte("#include \"math.teal\"\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(shared, "math.teal"), []byte(mathLib), 0o644))
+
+	main := filepath.Join(root, "main.teal")
+	ops, err := AssembleWithIncludes(main, `#pragma version 8
+b start
+#include "lib/local.teal"
+start:
+int 2
+callsub double`, DirLoader{Paths: []string{shared}})
+	require.NoError(t, err)
+	require.Len(t, ops.Sources, 3)
+	require.True(t, strings.HasSuffix(ops.Sources[1], filepath.Join("lib", "local.teal")))
+	require.Equal(t, filepath.Join(shared, "math.teal"), ops.Sources[2])
+}
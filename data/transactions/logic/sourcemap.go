@@ -42,6 +42,13 @@ type SourceMap struct {
 // GetSourceMap returns a struct containing details about
 // the assembled file and encoded mappings to the source file.
 func GetSourceMap(sourceNames []string, offsetToLine map[int]int) SourceMap {
+	return GetMultiSourceMap(sourceNames, nil, offsetToLine)
+}
+
+// GetMultiSourceMap returns a SourceMap for a program assembled from several
+// sources, such as one using #include. offsetToSource maps opcode offsets to
+// an index into sourceNames, and offsets missing from it refer to the first.
+func GetMultiSourceMap(sourceNames []string, offsetToSource map[int]int, offsetToLine map[int]int) SourceMap {
 	maxPC := 0
 	for pc := range offsetToLine {
 		if pc > maxPC {
@@ -50,11 +57,14 @@ func GetSourceMap(sourceNames []string, offsetToLine map[int]int) SourceMap {
 	}
 
 	// Array where index is the PC and value is the line for `mappings` field.
+	prevSource := 0
 	prevSourceLine := 0
 	pcToLine := make([]string, maxPC+1)
 	for pc := range pcToLine {
 		if line, ok := offsetToLine[pc]; ok {
-			pcToLine[pc] = MakeSourceMapLine(0, 0, line-prevSourceLine, 0)
+			source := offsetToSource[pc]
+			pcToLine[pc] = MakeSourceMapLine(0, source-prevSource, line-prevSourceLine, 0)
+			prevSource = source
 			prevSourceLine = line
 		} else {
 			pcToLine[pc] = ""
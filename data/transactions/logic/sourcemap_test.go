@@ -66,3 +66,33 @@ func TestVLQ(t *testing.T) {
 	a.Equal("AAggBA", MakeSourceMapLine(0, 0, 512, 0))
 	a.Equal("ADggBD", MakeSourceMapLine(0, -1, 512, -1))
 }
+
+func TestGetMultiSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	sourceNames := []string{"main.teal", "lib.teal"}
+	offsetToLine := map[int]int{
+		1: 1,
+		2: 0,
+		4: 4,
+		5: 2,
+	}
+	offsetToSource := map[int]int{
+		2: 1,
+		4: 1,
+	}
+	actualSourceMap := GetMultiSourceMap(sourceNames, offsetToSource, offsetToLine)
+	a.Equal(sourceNames, actualSourceMap.Sources)
+
+	expected := []string{
+		"",
+		MakeSourceMapLine(0, 0, 1, 0),
+		MakeSourceMapLine(0, 1, -1, 0),
+		"",
+		MakeSourceMapLine(0, 0, 4, 0),
+		MakeSourceMapLine(0, -1, -2, 0),
+	}
+	a.Equal(strings.Join(expected, ";"), actualSourceMap.Mappings)
+}
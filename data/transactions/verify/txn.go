@@ -43,6 +43,7 @@ var msigMore10 = metrics.MakeCounter(metrics.MetricName{Name: "algod_verify_msig
 var msigLsigLessOrEqual4 = metrics.MakeCounter(metrics.MetricName{Name: "algod_verify_msig_lsig_4", Description: "Total transaction scripts with 1-4 msigs"})
 var msigLsigLessOrEqual10 = metrics.MakeCounter(metrics.MetricName{Name: "algod_verify_msig_lsig_5_10", Description: "Total transaction scripts with 5-10 msigs"})
 var msigLsigMore10 = metrics.MakeCounter(metrics.MetricName{Name: "algod_verify_msig_lsig_10", Description: "Total transaction scripts with 11+ msigs"})
+var verifyBatchSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_verify_batch_seconds", Description: "Time spent verifying a batch of transaction signatures, in seconds"}, nil)
 
 // The PaysetGroups is taking large set of transaction groups and attempt to verify their validity using multiple go-routines.
 // When doing so, it attempts to break these into smaller "worksets" where each workset takes about 2ms of execution time in order
@@ -19,6 +19,7 @@ package verify
 import (
 	"errors"
 	"sync/atomic"
+	"time"
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
@@ -164,8 +165,10 @@ func MakeSigVerifyJobProcessor(ledger LedgerForStreamVerifier, cache VerifiedTra
 }
 
 func (tbp *txnSigBatchProcessor) ProcessBatch(txns []execpool.InputJob) {
+	start := time.Now()
 	batchVerifier, ctx := tbp.preProcessUnverifiedTxns(txns)
 	failed, err := batchVerifier.VerifyWithFeedback()
+	verifyBatchSeconds.ObserveSince(start, nil)
 	// this error can only be crypto.ErrBatchHasFailedSigs
 	tbp.postProcessVerifiedJobs(ctx, failed, err)
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/data/txntrace"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
@@ -61,6 +62,9 @@ var transactionMessagesBacklogSizeGauge = metrics.MakeGauge(metrics.TransactionM
 var transactionGroupTxSyncHandled = metrics.MakeCounter(metrics.TransactionGroupTxSyncHandled)
 var transactionGroupTxSyncRemember = metrics.MakeCounter(metrics.TransactionGroupTxSyncRemember)
 var transactionGroupTxSyncAlreadyCommitted = metrics.MakeCounter(metrics.TransactionGroupTxSyncAlreadyCommitted)
+
+// errBacklogFull is recorded in transaction traces when a group is dropped because the backlog is full
+var errBacklogFull = errors.New("dropped: transaction backlog is full")
 var txBacklogDroppedCongestionManagement = metrics.MakeCounter(metrics.TransactionMessagesTxnDroppedCongestionManagement)
 
 // ErrInvalidTxPool is reported when nil is passed for the tx pool
@@ -107,6 +111,8 @@ type txBacklogMsg struct {
 	unverifiedTxGroupHash *crypto.Digest           // hash (if any) of the unverifiedTxGroup
 	verificationErr       error                    // The verification error generated by the verification function, if any.
 	capguard              *util.ErlCapacityGuard   // the structure returned from the elastic rate limiter, to be released when dequeued
+	backlogTimer          *txntrace.Timer          // lifecycle tracing of the time spent in the backlog queue, if enabled
+	verifyTimer           *txntrace.Timer          // lifecycle tracing of the time spent in verification, if enabled
 }
 
 // TxHandler handles transaction messages
@@ -297,6 +303,7 @@ func (handler *TxHandler) backlogWorker() {
 				// this is never happening since handler.backlogQueue is never closed
 				return
 			}
+			wi.backlogTimer.End(nil)
 			if wi.capguard != nil {
 				if err := wi.capguard.Release(); err != nil {
 					logging.Base().Warnf("Failed to release capacity to ElasticRateLimiter: %v", err)
@@ -309,6 +316,7 @@ func (handler *TxHandler) backlogWorker() {
 				}
 				continue
 			}
+			wi.verifyTimer = txntrace.Start(txntrace.StageVerify, wi.unverifiedTxGroup)
 			// handler.streamVerifierChan does not receive if ctx is cancled
 			select {
 			case handler.streamVerifierChan <- &verify.UnverifiedTxnSigJob{TxnGroup: wi.unverifiedTxGroup, BacklogMessage: wi}:
@@ -465,6 +473,7 @@ func (handler *TxHandler) rememberReportErrors(err error) {
 }
 
 func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
+	wi.verifyTimer.End(wi.verificationErr)
 	if wi.verificationErr != nil {
 		// disconnect from peer.
 		handler.postProcessReportErrors(wi.verificationErr)
@@ -496,7 +505,8 @@ func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
 	}
 
 	// We reencode here instead of using rawmsg.Data to avoid broadcasting non-canonical encodings
-	handler.net.Relay(handler.ctx, protocol.TxnTag, reencode(verifiedTxGroup), false, wi.rawmsg.Sender)
+	err = handler.net.Relay(handler.ctx, protocol.TxnTag, reencode(verifiedTxGroup), false, wi.rawmsg.Sender)
+	txntrace.Event(txntrace.StageRelay, verifiedTxGroup, err)
 }
 
 func (handler *TxHandler) deleteFromCaches(msgKey *crypto.Digest, canonicalKey *crypto.Digest) {
@@ -642,6 +652,7 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 		}
 	}
 
+	backlogTimer := txntrace.Start(txntrace.StageBacklog, unverifiedTxGroup)
 	select {
 	case handler.backlogQueue <- &txBacklogMsg{
 		rawmsg:                &rawmsg,
@@ -649,11 +660,13 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 		rawmsgDataHash:        msgKey,
 		unverifiedTxGroupHash: canonicalKey,
 		capguard:              capguard,
+		backlogTimer:          backlogTimer,
 	}:
 	default:
 		// if we failed here we want to increase the corresponding metric. It might suggest that we
 		// want to increase the queue size.
 		transactionMessagesDroppedFromBacklog.Inc(nil)
+		backlogTimer.End(errBacklogFull)
 
 		// additionally, remove the txn from duplicate caches to ensure it can be re-submitted
 		if handler.txCanonicalCache != nil && canonicalKey != nil {
@@ -711,7 +724,9 @@ func (handler *TxHandler) processDecoded(unverifiedTxGroup []transactions.Signed
 	}
 
 	unverifiedTxnGroups := bookkeeping.SignedTxnsToGroups(unverifiedTxGroup)
+	verifyTimer := txntrace.Start(txntrace.StageVerify, unverifiedTxGroup)
 	err = verify.PaysetGroups(context.Background(), unverifiedTxnGroups, latestHdr, handler.txVerificationPool, handler.ledger.VerifiedTransactionCache(), handler.ledger)
+	verifyTimer.End(err)
 	if err != nil {
 		// transaction is invalid
 		logging.Base().Warnf("One or more transactions were malformed: %v", err)
@@ -0,0 +1,295 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package txntrace records where transactions spend time between submission
+// and commit. Each stage a transaction passes through is kept, keyed by txid,
+// for a bounded number of recent transactions, and is also emitted as a
+// telemetry span whose trace ID is derived from the txid.
+package txntrace
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// Stage identifies a point in a transaction's lifecycle
+type Stage string
+
+const (
+	// StageSubmit is the REST submission of a transaction group
+	StageSubmit Stage = "rest.submit"
+	// StageBacklog is the time a gossiped group waits in the TxHandler backlog
+	StageBacklog Stage = "txhandler.backlog"
+	// StageVerify is signature and logic verification of a group
+	StageVerify Stage = "txhandler.verify"
+	// StageRemember is admission to the transaction pool
+	StageRemember Stage = "txpool.remember"
+	// StageRelay is the hand-off of a group to the gossip network
+	StageRelay Stage = "gossip.relay"
+	// StageAssemble is inclusion in a block assembled by this node
+	StageAssemble Stage = "block.assemble"
+	// StageCommit is the notification that a block containing the transaction was committed
+	StageCommit Stage = "block.commit"
+)
+
+// maxStagesPerTrace bounds the stages kept for one transaction, so that a
+// transaction seen over and over cannot grow its trace without limit
+const maxStagesPerTrace = 32
+
+// StageRecord is one stage a transaction went through
+type StageRecord struct {
+	Stage    Stage         `json:"stage"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration-ns"`
+	Round    basics.Round  `json:"round,omitempty"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// Trace is the recorded lifecycle of a single transaction
+type Trace struct {
+	TxID   transactions.Txid
+	Stages []StageRecord
+}
+
+// traceJSON is the JSON form of Trace, with the txid in its usual string form
+type traceJSON struct {
+	TxID   string        `json:"txid"`
+	Stages []StageRecord `json:"stages"`
+}
+
+// MarshalJSON encodes the trace with a string txid
+func (t Trace) MarshalJSON() ([]byte, error) {
+	return json.Marshal(traceJSON{TxID: t.TxID.String(), Stages: t.Stages})
+}
+
+// UnmarshalJSON decodes a trace encoded by MarshalJSON
+func (t *Trace) UnmarshalJSON(data []byte) error {
+	var tj traceJSON
+	if err := json.Unmarshal(data, &tj); err != nil {
+		return err
+	}
+	if err := t.TxID.UnmarshalText([]byte(tj.TxID)); err != nil {
+		return err
+	}
+	t.Stages = tj.Stages
+	return nil
+}
+
+// Tracer keeps the traces of the most recently seen transactions
+type Tracer struct {
+	mu     deadlock.Mutex
+	traces map[transactions.Txid]*Trace
+	// order is a ring of txids in insertion order, used for eviction
+	order []transactions.Txid
+	next  int
+}
+
+// MakeTracer creates a Tracer that keeps up to size transactions
+func MakeTracer(size int) *Tracer {
+	if size < 1 {
+		size = 1
+	}
+	return &Tracer{
+		traces: make(map[transactions.Txid]*Trace, size),
+		order:  make([]transactions.Txid, 0, size),
+	}
+}
+
+func (t *Tracer) record(txid transactions.Txid, rec StageRecord) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	trace, ok := t.traces[txid]
+	if !ok {
+		trace = &Trace{TxID: txid}
+		if len(t.order) < cap(t.order) {
+			t.order = append(t.order, txid)
+		} else {
+			delete(t.traces, t.order[t.next])
+			t.order[t.next] = txid
+			t.next = (t.next + 1) % len(t.order)
+		}
+		t.traces[txid] = trace
+	}
+	if len(trace.Stages) < maxStagesPerTrace {
+		trace.Stages = append(trace.Stages, rec)
+	}
+}
+
+// Lookup returns the trace of txid, if it is still held
+func (t *Tracer) Lookup(txid transactions.Txid) (Trace, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	trace, ok := t.traces[txid]
+	if !ok {
+		return Trace{}, false
+	}
+	return copyTrace(trace), true
+}
+
+// Traced returns the txids among txids whose traces are still held
+func (t *Tracer) Traced(txids []transactions.Txid) []transactions.Txid {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var traced []transactions.Txid
+	for _, txid := range txids {
+		if _, ok := t.traces[txid]; ok {
+			traced = append(traced, txid)
+		}
+	}
+	return traced
+}
+
+// Recent returns up to n traces, most recently first seen first
+func (t *Tracer) Recent(n int) []Trace {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if n > len(t.order) {
+		n = len(t.order)
+	}
+	traces := make([]Trace, 0, n)
+	for i := 0; i < n; i++ {
+		// t.next is the oldest entry once the ring is full, and 0 before that
+		idx := (t.next + len(t.order) - 1 - i) % len(t.order)
+		traces = append(traces, copyTrace(t.traces[t.order[idx]]))
+	}
+	return traces
+}
+
+func copyTrace(trace *Trace) Trace {
+	return Trace{TxID: trace.TxID, Stages: append([]StageRecord(nil), trace.Stages...)}
+}
+
+type tracerHolder struct {
+	tracer *Tracer
+}
+
+var active atomic.Value
+
+// Enable starts recording transaction traces, keeping up to size transactions
+func Enable(size int) *Tracer {
+	tracer := MakeTracer(size)
+	active.Store(tracerHolder{tracer: tracer})
+	return tracer
+}
+
+// Disable stops recording transaction traces and drops the recorded ones
+func Disable() {
+	active.Store(tracerHolder{})
+}
+
+// Active returns the tracer recording transaction traces, or nil if tracing is disabled
+func Active() *Tracer {
+	holder, _ := active.Load().(tracerHolder)
+	return holder.tracer
+}
+
+// Timer measures one stage for a group of transactions. A nil Timer is
+// returned when tracing is disabled, and all its methods are no-ops, so
+// callers never need to check.
+type Timer struct {
+	tracer *Tracer
+	stage  Stage
+	txids  []transactions.Txid
+	start  time.Time
+	round  basics.Round
+	spans  []*logging.Span
+}
+
+// Start starts timing stage for the transactions of txgroup
+func Start(stage Stage, txgroup []transactions.SignedTxn) *Timer {
+	if Active() == nil {
+		return nil
+	}
+	txids := make([]transactions.Txid, len(txgroup))
+	for i := range txgroup {
+		txids[i] = txgroup[i].ID()
+	}
+	return StartIDs(stage, txids)
+}
+
+// StartIDs starts timing stage for the given transactions
+func StartIDs(stage Stage, txids []transactions.Txid) *Timer {
+	return StartIDsAt(stage, txids, time.Now())
+}
+
+// StartIDsAt is like StartIDs for a stage that began at start
+func StartIDsAt(stage Stage, txids []transactions.Txid, start time.Time) *Timer {
+	tracer := Active()
+	if tracer == nil || len(txids) == 0 {
+		return nil
+	}
+	t := &Timer{tracer: tracer, stage: stage, txids: txids, start: start}
+	for _, txid := range txids {
+		var traceID [16]byte
+		copy(traceID[:], txid[:])
+		span := logging.StartTraceSpan(string(stage), traceID, start)
+		if span == nil {
+			// spans are not being exported
+			break
+		}
+		span.SetAttribute("txid", txid.String())
+		t.spans = append(t.spans, span)
+	}
+	return t
+}
+
+// SetRound attaches the round the stage relates to, such as the round of the
+// block a transaction was assembled into or committed in
+func (t *Timer) SetRound(rnd basics.Round) {
+	if t == nil {
+		return
+	}
+	t.round = rnd
+}
+
+// End finishes the stage, recording err if it failed
+func (t *Timer) End(err error) {
+	if t == nil {
+		return
+	}
+	rec := StageRecord{
+		Stage:    t.stage,
+		Start:    t.start,
+		Duration: time.Since(t.start),
+		Round:    t.round,
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	for _, txid := range t.txids {
+		t.tracer.record(txid, rec)
+	}
+	for _, span := range t.spans {
+		if t.round != 0 {
+			span.SetAttribute("round", uint64(t.round))
+		}
+		span.SetError(err)
+		span.End()
+	}
+}
+
+// Event records an instantaneous stage for the transactions of txgroup
+func Event(stage Stage, txgroup []transactions.SignedTxn, err error) {
+	Start(stage, txgroup).End(err)
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package txntrace
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func testTxid(i int) transactions.Txid {
+	return transactions.Txid(crypto.Hash([]byte{byte(i)}))
+}
+
+func TestTracerEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	tracer := MakeTracer(3)
+	for i := 0; i < 5; i++ {
+		tracer.record(testTxid(i), StageRecord{Stage: StageRemember})
+	}
+
+	// the two oldest were evicted
+	for i := 0; i < 2; i++ {
+		_, ok := tracer.Lookup(testTxid(i))
+		a.False(ok)
+	}
+	for i := 2; i < 5; i++ {
+		trace, ok := tracer.Lookup(testTxid(i))
+		a.True(ok)
+		a.Equal(testTxid(i), trace.TxID)
+	}
+
+	recent := tracer.Recent(10)
+	a.Len(recent, 3)
+	a.Equal(testTxid(4), recent[0].TxID)
+	a.Equal(testTxid(3), recent[1].TxID)
+	a.Equal(testTxid(2), recent[2].TxID)
+
+	a.Len(tracer.Recent(1), 1)
+	a.Equal(testTxid(4), tracer.Recent(1)[0].TxID)
+
+	// more stages for a known txid do not evict anything
+	tracer.record(testTxid(2), StageRecord{Stage: StageCommit})
+	trace, ok := tracer.Lookup(testTxid(2))
+	a.True(ok)
+	a.Len(trace.Stages, 2)
+	_, ok = tracer.Lookup(testTxid(3))
+	a.True(ok)
+}
+
+func TestTracerTraced(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	tracer := MakeTracer(3)
+	tracer.record(testTxid(1), StageRecord{Stage: StageRemember})
+	tracer.record(testTxid(3), StageRecord{Stage: StageRemember})
+
+	traced := tracer.Traced([]transactions.Txid{testTxid(1), testTxid(2), testTxid(3)})
+	a.Equal([]transactions.Txid{testTxid(1), testTxid(3)}, traced)
+	a.Empty(tracer.Traced([]transactions.Txid{testTxid(2)}))
+}
+
+func TestTracerRecentBeforeFull(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	tracer := MakeTracer(10)
+	a.Empty(tracer.Recent(5))
+	tracer.record(testTxid(1), StageRecord{})
+	tracer.record(testTxid(2), StageRecord{})
+	recent := tracer.Recent(5)
+	a.Len(recent, 2)
+	a.Equal(testTxid(2), recent[0].TxID)
+	a.Equal(testTxid(1), recent[1].TxID)
+}
+
+func TestTracerStageLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tracer := MakeTracer(1)
+	for i := 0; i < 2*maxStagesPerTrace; i++ {
+		tracer.record(testTxid(0), StageRecord{Stage: StageRelay})
+	}
+	trace, _ := tracer.Lookup(testTxid(0))
+	require.Len(t, trace.Stages, maxStagesPerTrace)
+}
+
+func TestTimer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	Disable()
+	defer Disable()
+
+	// disabled tracing hands out nil timers that are safe to use
+	timer := Start(StageSubmit, []transactions.SignedTxn{{}})
+	a.Nil(timer)
+	timer.SetRound(1)
+	timer.End(nil)
+
+	tracer := Enable(10)
+	a.Equal(tracer, Active())
+
+	var stxn transactions.SignedTxn
+	stxn.Txn.Type = "pay"
+	stxn.Txn.Fee = basics.MicroAlgos{Raw: 1000}
+	group := []transactions.SignedTxn{stxn}
+	txid := stxn.ID()
+
+	timer = Start(StageRemember, group)
+	time.Sleep(time.Millisecond)
+	timer.End(nil)
+	Event(StageRelay, group, errors.New("no peers"))
+
+	start := time.Now().Add(-time.Second)
+	timer = StartIDsAt(StageCommit, []transactions.Txid{txid}, start)
+	timer.SetRound(7)
+	timer.End(nil)
+
+	trace, ok := tracer.Lookup(txid)
+	a.True(ok)
+	a.Len(trace.Stages, 3)
+	a.Equal(StageRemember, trace.Stages[0].Stage)
+	a.GreaterOrEqual(trace.Stages[0].Duration, time.Millisecond)
+	a.Empty(trace.Stages[0].Error)
+	a.Equal(StageRelay, trace.Stages[1].Stage)
+	a.Equal("no peers", trace.Stages[1].Error)
+	a.Equal(StageCommit, trace.Stages[2].Stage)
+	a.Equal(basics.Round(7), trace.Stages[2].Round)
+	a.Equal(start, trace.Stages[2].Start)
+	a.GreaterOrEqual(trace.Stages[2].Duration, time.Second)
+
+	Disable()
+	a.Nil(Active())
+	a.Nil(Start(StageSubmit, group))
+}
@@ -0,0 +1,349 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// accountHistoryPruneInterval is the number of rounds the account history may grow past its
+// retention before it is pruned. Pruning scans the whole history, so it is not done on every commit.
+const accountHistoryPruneInterval = 1000
+
+var errAccountHistoryDisabled = errors.New("account history is not enabled")
+
+// ErrAccountHistoryRound is returned when looking up the account history for a round it does not cover
+var ErrAccountHistoryRound = errors.New("round is not covered by the account history")
+
+// accountHistoryDelta holds the changes a single round made to accounts, resources and kv pairs
+type accountHistoryDelta struct {
+	accts  ledgercore.AccountDeltas
+	kvMods map[string]ledgercore.KvValueDelta
+}
+
+// accountHistoryTracker records the changes every round makes to accounts, resources and
+// kv pairs, allowing archival nodes to look up their state as of rounds that are no longer
+// covered by the in-memory deltas of accountUpdates.
+type accountHistoryTracker struct {
+	// enabled is set on archival nodes configured with EnableAccountHistory
+	enabled bool
+
+	// retainRounds is the number of rounds the history is retained for, or 0 to retain it all
+	retainRounds uint64
+
+	// pruneInterval is the number of rounds by which the history may exceed retainRounds
+	pruneInterval uint64
+
+	// dbRound is the round up to which the history has been written to the database
+	dbRound basics.Round
+
+	// historyBase is the earliest round the history can answer queries on
+	historyBase basics.Round
+
+	// deltas holds the changes of the rounds following dbRound
+	deltas []accountHistoryDelta
+
+	// mu protects dbRound, historyBase and deltas
+	mu deadlock.RWMutex
+
+	log logging.Logger
+
+	l ledgerForTracker
+}
+
+func (aht *accountHistoryTracker) initialize(cfg config.Local) {
+	aht.enabled = cfg.EnableAccountHistory && cfg.Archival
+	aht.retainRounds = cfg.AccountHistoryRetentionRounds
+	aht.pruneInterval = accountHistoryPruneInterval
+}
+
+func (aht *accountHistoryTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	aht.log = l.trackerLog()
+	aht.l = l
+
+	aht.mu.Lock()
+	defer aht.mu.Unlock()
+
+	aht.dbRound = dbRound
+	aht.deltas = nil
+	if !aht.enabled {
+		return nil
+	}
+
+	return l.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		rw := tx.MakeAccountHistoryReaderWriter()
+		base, latest, ok, err := rw.AccountHistoryRounds(ctx)
+		if err != nil {
+			return err
+		}
+		if ok && latest == dbRound {
+			aht.historyBase = base
+			return nil
+		}
+		// the history is missing, or was not kept up to date while disabled; start it over.
+		if ok {
+			aht.log.Infof("accountHistoryTracker: discarding account history of rounds %d-%d, database round is %d", base, latest, dbRound)
+		}
+		aht.historyBase = dbRound
+		return rw.ResetAccountHistory(ctx, dbRound)
+	})
+}
+
+func (aht *accountHistoryTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if !aht.enabled {
+		return
+	}
+
+	aht.mu.Lock()
+	defer aht.mu.Unlock()
+
+	aht.deltas = append(aht.deltas, accountHistoryDelta{accts: delta.Accts, kvMods: delta.KvMods})
+}
+
+func (aht *accountHistoryTracker) committedUpTo(round basics.Round) (minRound, lookback basics.Round) {
+	return round, 0
+}
+
+func (aht *accountHistoryTracker) produceCommittingTask(_ basics.Round, _ basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (aht *accountHistoryTracker) prepareCommit(dcc *deferredCommitContext) error {
+	if !aht.enabled {
+		return nil
+	}
+
+	aht.mu.RLock()
+	defer aht.mu.RUnlock()
+
+	if dcc.oldBase != aht.dbRound {
+		return fmt.Errorf("accountHistoryTracker: commit range starts at %d but history is at round %d", dcc.oldBase, aht.dbRound)
+	}
+	if dcc.offset > uint64(len(aht.deltas)) {
+		return fmt.Errorf("accountHistoryTracker: commit offset %d exceeds the %d tracked rounds", dcc.offset, len(aht.deltas))
+	}
+	dcc.accountHistory.deltas = make([]accountHistoryDelta, dcc.offset)
+	copy(dcc.accountHistory.deltas, aht.deltas[:dcc.offset])
+
+	dcc.accountHistory.pruneBase = aht.historyBase
+	if aht.retainRounds > 0 && uint64(dcc.newBase()) > aht.retainRounds {
+		cutoff := dcc.newBase() - basics.Round(aht.retainRounds)
+		if cutoff >= aht.historyBase+basics.Round(aht.pruneInterval) {
+			dcc.accountHistory.pruneBase = cutoff
+		}
+	}
+	return nil
+}
+
+func (aht *accountHistoryTracker) commitRound(ctx context.Context, tx trackerdb.TransactionScope, dcc *deferredCommitContext) error {
+	if !aht.enabled {
+		return nil
+	}
+
+	rw := tx.MakeAccountHistoryReaderWriter()
+
+	// record the values of the changed keys as of oldBase, before accountUpdates overwrites them.
+	baselines := make(map[trackerdb.AccountHistoryKind]map[string]bool)
+	baseline := func(kind trackerdb.AccountHistoryKind, key []byte) error {
+		if baselines[kind] == nil {
+			baselines[kind] = make(map[string]bool)
+		}
+		if baselines[kind][string(key)] {
+			return nil
+		}
+		baselines[kind][string(key)] = true
+		return rw.WriteAccountHistoryBaseline(ctx, kind, key, dcc.oldBase)
+	}
+
+	// resources are written in full by accountUpdates, accumulating the deltas of the committed
+	// rounds; build the per-round values the same way.
+	assets := make(map[string]*trackerdb.ResourcesData)
+	apps := make(map[string]*trackerdb.ResourcesData)
+	resource := func(resources map[string]*trackerdb.ResourcesData, key []byte) *trackerdb.ResourcesData {
+		rd, ok := resources[string(key)]
+		if !ok {
+			data := trackerdb.MakeResourcesData(0)
+			rd = &data
+			resources[string(key)] = rd
+		}
+		return rd
+	}
+
+	for i, delta := range dcc.accountHistory.deltas {
+		rnd := dcc.oldBase + basics.Round(i+1)
+
+		for _, rec := range delta.accts.Accts {
+			key := rec.Addr[:]
+			if err := baseline(trackerdb.AccountHistoryAccount, key); err != nil {
+				return err
+			}
+			var ba trackerdb.BaseAccountData
+			ba.SetCoreAccountData(&rec.AccountData)
+			var data []byte
+			if !ba.IsEmpty() {
+				data = protocol.Encode(&ba)
+			}
+			if err := rw.WriteAccountHistory(ctx, trackerdb.AccountHistoryAccount, key, rnd, data); err != nil {
+				return err
+			}
+		}
+
+		for _, rec := range delta.accts.GetAllAssetResources() {
+			key := trackerdb.AccountHistoryResourceKey(rec.Addr, basics.CreatableIndex(rec.Aidx))
+			if err := baseline(trackerdb.AccountHistoryAsset, key); err != nil {
+				return err
+			}
+			rd := resource(assets, key)
+			rd.SetAssetData(rec.Params, rec.Holding)
+			if err := rw.WriteAccountHistory(ctx, trackerdb.AccountHistoryAsset, key, rnd, encodeResourceHistory(rd)); err != nil {
+				return err
+			}
+		}
+
+		for _, rec := range delta.accts.GetAllAppResources() {
+			key := trackerdb.AccountHistoryResourceKey(rec.Addr, basics.CreatableIndex(rec.Aidx))
+			if err := baseline(trackerdb.AccountHistoryApp, key); err != nil {
+				return err
+			}
+			rd := resource(apps, key)
+			rd.SetAppData(rec.Params, rec.State)
+			if err := rw.WriteAccountHistory(ctx, trackerdb.AccountHistoryApp, key, rnd, encodeResourceHistory(rd)); err != nil {
+				return err
+			}
+		}
+
+		for k, kv := range delta.kvMods {
+			key := []byte(k)
+			if err := baseline(trackerdb.AccountHistoryKv, key); err != nil {
+				return err
+			}
+			if err := rw.WriteAccountHistory(ctx, trackerdb.AccountHistoryKv, key, rnd, kv.Data); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := rw.UpdateAccountHistoryRound(ctx, dcc.newBase()); err != nil {
+		return err
+	}
+
+	if dcc.accountHistory.pruneBase > aht.historyBase {
+		return rw.PruneAccountHistory(ctx, dcc.accountHistory.pruneBase)
+	}
+	return nil
+}
+
+func encodeResourceHistory(rd *trackerdb.ResourcesData) []byte {
+	if rd.IsEmpty() {
+		return nil
+	}
+	return protocol.Encode(rd)
+}
+
+func (aht *accountHistoryTracker) postCommit(_ context.Context, dcc *deferredCommitContext) {
+	if !aht.enabled {
+		return
+	}
+
+	aht.mu.Lock()
+	defer aht.mu.Unlock()
+
+	aht.deltas = aht.deltas[len(dcc.accountHistory.deltas):]
+	aht.dbRound = dcc.newBase()
+	if dcc.accountHistory.pruneBase > aht.historyBase {
+		aht.historyBase = dcc.accountHistory.pruneBase
+	}
+}
+
+func (aht *accountHistoryTracker) postCommitUnlocked(context.Context, *deferredCommitContext) {
+}
+
+func (aht *accountHistoryTracker) handleUnorderedCommitOrError(*deferredCommitContext) {
+}
+
+func (aht *accountHistoryTracker) close() {
+}
+
+// lookup returns the encoded value of key as of rnd from the account history
+func (aht *accountHistoryTracker) lookup(rnd basics.Round, kind trackerdb.AccountHistoryKind, key []byte) (data []byte, err error) {
+	if !aht.enabled {
+		return nil, errAccountHistoryDisabled
+	}
+
+	err = aht.l.trackerDB().Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		reader := tx.MakeAccountHistoryReader()
+		base, latest, ok, err0 := reader.AccountHistoryRounds(ctx)
+		if err0 != nil {
+			return err0
+		}
+		if !ok || rnd < base || rnd > latest {
+			return fmt.Errorf("%w: round %d, history covers rounds %d-%d", ErrAccountHistoryRound, rnd, base, latest)
+		}
+		data, err0 = reader.LookupAccountHistory(ctx, kind, key, rnd)
+		return err0
+	})
+	return data, err
+}
+
+// lookupAccount returns the account data of addr as of rnd, without pending rewards applied
+func (aht *accountHistoryTracker) lookupAccount(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, error) {
+	data, err := aht.lookup(rnd, trackerdb.AccountHistoryAccount, addr[:])
+	if err != nil || data == nil {
+		return ledgercore.AccountData{}, err
+	}
+	var ba trackerdb.BaseAccountData
+	err = protocol.Decode(data, &ba)
+	if err != nil {
+		return ledgercore.AccountData{}, err
+	}
+	return ba.GetLedgerCoreAccountData(), nil
+}
+
+// lookupResource returns the asset or app resource aidx of addr as of rnd
+func (aht *accountHistoryTracker) lookupResource(rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.AccountResource, error) {
+	kind := trackerdb.AccountHistoryAsset
+	if ctype == basics.AppCreatable {
+		kind = trackerdb.AccountHistoryApp
+	}
+	data, err := aht.lookup(rnd, kind, trackerdb.AccountHistoryResourceKey(addr, aidx))
+	if err != nil || data == nil {
+		return ledgercore.AccountResource{}, err
+	}
+	prd := trackerdb.PersistedResourcesData{Aidx: aidx}
+	err = protocol.Decode(data, &prd.Data)
+	if err != nil {
+		return ledgercore.AccountResource{}, err
+	}
+	return prd.AccountResource(), nil
+}
+
+// lookupKv returns the value of the kv pair key as of rnd
+func (aht *accountHistoryTracker) lookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return aht.lookup(rnd, trackerdb.AccountHistoryKv, []byte(key))
+}
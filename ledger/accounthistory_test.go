@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// makeHistoryBlocks adds rounds of payments from addrs[1] to addrs[2], creating an asset
+// at round 3, and returns the state of addrs[2] as looked up while each round was recent.
+func makeHistoryBlocks(t *testing.T, l *Ledger, addrs []basics.Address, rounds int) (map[basics.Round]ledgercore.AccountData, basics.AssetIndex) {
+	expected := make(map[basics.Round]ledgercore.AccountData)
+	var assetID basics.AssetIndex
+	for i := 1; i <= rounds; i++ {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   addrs[1],
+			Receiver: addrs[2],
+			Amount:   uint64(1000 * i),
+		})
+		if i == 3 {
+			txn(t, l, eval, &txntest.Txn{
+				Type:   protocol.AssetConfigTx,
+				Sender: addrs[0],
+				AssetParams: basics.AssetParams{
+					Total:    1000,
+					UnitName: "hist",
+				},
+			})
+		}
+		vb := endBlock(t, l, eval)
+		for cidx := range vb.Delta().Creatables {
+			assetID = basics.AssetIndex(cidx)
+		}
+
+		ad, _, _, err := l.LookupAccount(vb.Block().Round(), addrs[2])
+		require.NoError(t, err)
+		expected[vb.Block().Round()] = ad
+	}
+	return expected, assetID
+}
+
+func TestAccountHistoryLookup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.EnableAccountHistory = true
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	genesisData, _, _, err := l.LookupAccount(0, addrs[2])
+	require.NoError(t, err)
+
+	expected, assetID := makeHistoryBlocks(t, l, addrs, 10)
+	require.NotZero(t, assetID)
+	commitRoundLookback(basics.Round(cfg.MaxAcctLookback), l)
+	dbRound := l.LatestTrackerCommitted()
+	require.Equal(t, basics.Round(8), dbRound)
+
+	// the in-memory deltas no longer cover the committed rounds
+	_, _, _, _, err = l.accts.lookupWithoutRewards(1, addrs[2], true)
+	var roundOffsetErr *RoundOffsetError
+	require.ErrorAs(t, err, &roundOffsetErr)
+
+	ad, rnd, _, err := l.LookupAccount(0, addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(0), rnd)
+	require.Equal(t, genesisData, ad)
+	for r := basics.Round(1); r < dbRound; r++ {
+		ad, rnd, _, err := l.LookupAccount(r, addrs[2])
+		require.NoError(t, err)
+		require.Equal(t, r, rnd)
+		require.Equal(t, expected[r], ad, "round %d", r)
+	}
+
+	asset, err := l.LookupAsset(2, addrs[0], assetID)
+	require.NoError(t, err)
+	require.Nil(t, asset.AssetParams)
+	require.Nil(t, asset.AssetHolding)
+	asset, err = l.LookupAsset(5, addrs[0], assetID)
+	require.NoError(t, err)
+	require.NotNil(t, asset.AssetParams)
+	require.Equal(t, "hist", asset.AssetParams.UnitName)
+	require.NotNil(t, asset.AssetHolding)
+	require.Equal(t, uint64(1000), asset.AssetHolding.Amount)
+
+	// the history survives a reload
+	err = l.reloadLedger()
+	require.NoError(t, err)
+	ad, _, _, err = l.LookupAccount(4, addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, expected[4], ad)
+}
+
+func TestAccountHistoryDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	makeHistoryBlocks(t, l, addrs, 5)
+	commitRoundLookback(basics.Round(cfg.MaxAcctLookback), l)
+
+	_, _, _, err := l.LookupAccount(1, addrs[2])
+	var roundOffsetErr *RoundOffsetError
+	require.ErrorAs(t, err, &roundOffsetErr)
+}
+
+func TestAccountHistoryRetention(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.EnableAccountHistory = true
+	cfg.AccountHistoryRetentionRounds = 4
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+	l.history.mu.Lock()
+	l.history.pruneInterval = 1
+	l.history.mu.Unlock()
+
+	expected, _ := makeHistoryBlocks(t, l, addrs, 12)
+	commitRoundLookback(basics.Round(cfg.MaxAcctLookback), l)
+	dbRound := l.LatestTrackerCommitted()
+	cutoff := dbRound - basics.Round(cfg.AccountHistoryRetentionRounds)
+
+	for r := basics.Round(1); r < dbRound; r++ {
+		ad, _, _, err := l.LookupAccount(r, addrs[2])
+		if r < cutoff {
+			require.True(t, errors.Is(err, ErrAccountHistoryRound), "round %d: %v", r, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, expected[r], ad, "round %d", r)
+	}
+}
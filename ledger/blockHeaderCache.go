@@ -41,6 +41,13 @@ func (c *blockHeaderCache) initialize() {
 	c.lruCache.maxEntries = blockHeadersLRUCacheSize
 }
 
+// reset drops all the cached headers. It is used when the ledger blocks are replaced,
+// since the cached headers might no longer match the ones stored in the database.
+func (c *blockHeaderCache) reset() {
+	c.latestHeaderCache.reset()
+	c.lruCache.reset()
+}
+
 func (c *blockHeaderCache) get(round basics.Round) (blockHeader bookkeeping.BlockHeader, exists bool) {
 	// check latestHeaderCache first
 	blockHeader, exists = c.latestHeaderCache.get(round)
@@ -84,3 +91,10 @@ func (c *latestBlockHeaderCache) put(blockHeader bookkeeping.BlockHeader) {
 		c.blockHeaders[idx] = blockHeader
 	}
 }
+
+func (c *latestBlockHeaderCache) reset() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.blockHeaders = [latestHeaderCacheSize]bookkeeping.BlockHeader{}
+}
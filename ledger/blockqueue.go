@@ -35,6 +35,13 @@ import (
 	"github.com/algorand/go-algorand/util/metrics"
 )
 
+const (
+	// blockConvertBatchSize is the number of blocks converted to a new format per transaction.
+	blockConvertBatchSize = 256
+	// blockConvertInterval is the pause between conversion batches.
+	blockConvertInterval = 100 * time.Millisecond
+)
+
 type blockEntry struct {
 	block bookkeeping.Block
 	cert  agreement.Certificate
@@ -46,6 +53,17 @@ type blockQueue struct {
 	lastCommitted basics.Round
 	q             []blockEntry
 
+	// earliest is the lowest round still present in the block database.
+	earliest basics.Round
+	// retainRounds is the minimum number of committed blocks kept in the block
+	// database, in addition to whatever the trackers require.
+	retainRounds basics.Round
+	// format is the format new blocks are written in; blocks stored in other
+	// formats are converted by the converter goroutine.
+	format      blockdb.BlockFormat
+	convertStop chan struct{}
+	convertDone chan struct{}
+
 	mu      deadlock.Mutex
 	cond    *sync.Cond
 	running bool
@@ -56,6 +74,10 @@ func newBlockQueue(l *Ledger) (*blockQueue, error) {
 	bq := &blockQueue{}
 	bq.cond = sync.NewCond(&bq.mu)
 	bq.l = l
+	bq.retainRounds = basics.Round(l.cfg.BlockRetentionRounds)
+	if l.cfg.EnableBlockCompression {
+		bq.format = blockdb.BlockFormatZstd
+	}
 	return bq, nil
 }
 
@@ -82,6 +104,10 @@ func (bq *blockQueue) start() error {
 	err := bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		var err0 error
 		bq.lastCommitted, err0 = blockdb.BlockLatest(tx)
+		if err0 != nil {
+			return err0
+		}
+		bq.earliest, err0 = blockdb.BlockEarliest(tx)
 		return err0
 	})
 	ledgerBlockqInitMicros.AddMicrosecondsSince(start, nil)
@@ -90,6 +116,10 @@ func (bq *blockQueue) start() error {
 	}
 
 	go bq.syncer()
+
+	bq.convertStop = make(chan struct{})
+	bq.convertDone = make(chan struct{})
+	go bq.converter(bq.convertStop, bq.convertDone)
 	return nil
 }
 
@@ -100,8 +130,15 @@ func (bq *blockQueue) stop() {
 		bq.running = false
 		bq.cond.Broadcast()
 	}
+	convertStop, convertDone := bq.convertStop, bq.convertDone
+	bq.convertStop, bq.convertDone = nil, nil
 	bq.mu.Unlock()
 
+	if convertStop != nil {
+		close(convertStop)
+		<-convertDone
+	}
+
 	// we want to block here until the sync go routine is done.
 	// it's not (just) for the sake of a complete cleanup, but rather
 	// to ensure that the sync goroutine isn't busy in a notifyCommit
@@ -132,7 +169,7 @@ func (bq *blockQueue) syncer() {
 		ledgerSyncBlockputCount.Inc(nil)
 		err := bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 			for _, e := range workQ {
-				err0 := blockdb.BlockPut(tx, e.block, e.cert)
+				err0 := blockdb.BlockPutWithFormat(tx, e.block, e.cert, bq.format)
 				if err0 != nil {
 					return err0
 				}
@@ -163,7 +200,7 @@ func (bq *blockQueue) syncer() {
 			bq.cond.Broadcast()
 			bq.mu.Unlock()
 
-			minToSave := bq.l.notifyCommit(committed)
+			minToSave := bq.retainedMinimum(committed, bq.l.notifyCommit(committed))
 			bfstart := time.Now()
 			ledgerSyncBlockforgetCount.Inc(nil)
 			err = bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
@@ -175,10 +212,65 @@ func (bq *blockQueue) syncer() {
 			}
 
 			bq.mu.Lock()
+			if err == nil && minToSave > bq.earliest {
+				bq.earliest = minToSave
+			}
 		}
 	}
 }
 
+// converter rewrites blocks stored in a format other than bq.format, in small
+// batches so that it does not hold up the syncer for long.
+func (bq *blockQueue) converter(stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	total := 0
+	for {
+		var converted int
+		err := bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+			converted, err = blockdb.BlockConvertFormat(tx, bq.format, blockConvertBatchSize)
+			return err
+		})
+		if err != nil {
+			bq.l.log.Warnf("blockQueue.converter: could not convert blocks to format %d: %v", bq.format, err)
+			return
+		}
+		total += converted
+		if converted < blockConvertBatchSize {
+			if total > 0 {
+				bq.l.log.Infof("blockQueue.converter: converted %d blocks to format %d", total, bq.format)
+			}
+			return
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(blockConvertInterval):
+		}
+	}
+}
+
+// retainedMinimum lowers the minimal round the trackers need to keep so that
+// at least retainRounds committed blocks remain in the block database.
+func (bq *blockQueue) retainedMinimum(committed basics.Round, minToSave basics.Round) basics.Round {
+	if bq.retainRounds == 0 {
+		return minToSave
+	}
+	if committed < bq.retainRounds {
+		return 0
+	}
+	if retained := committed + 1 - bq.retainRounds; retained < minToSave {
+		return retained
+	}
+	return minToSave
+}
+
+// earliestAvailable returns the lowest round available from the block queue.
+func (bq *blockQueue) earliestAvailable() basics.Round {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.earliest
+}
+
 func (bq *blockQueue) waitCommit(r basics.Round) {
 	bq.mu.Lock()
 	defer bq.mu.Unlock()
@@ -338,6 +430,51 @@ func (bq *blockQueue) getEncodedBlockCert(r basics.Round) (blk []byte, cert []by
 	return
 }
 
+// getCompressedBlockCert returns the encoded block and certificate for round r,
+// each compressed as a zstd frame. Blocks already stored compressed are returned as-is.
+func (bq *blockQueue) getCompressedBlockCert(r basics.Round) (blk []byte, cert []byte, err error) {
+	e, lastCommitted, latest, err := bq.checkEntry(r)
+	if e != nil {
+		blk, err = blockdb.CompressBlockData(blockdb.BlockFormatZstd, protocol.Encode(&e.block))
+		if err != nil {
+			return nil, nil, err
+		}
+		cert, err = blockdb.CompressBlockData(blockdb.BlockFormatZstd, protocol.Encode(&e.cert))
+		return
+	}
+
+	if err != nil {
+		return
+	}
+
+	start := time.Now()
+	ledgerGetcblockcertCount.Inc(nil)
+	var format blockdb.BlockFormat
+	err = bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var err0 error
+		blk, cert, format, err0 = blockdb.BlockGetStoredCert(tx, r)
+		return err0
+	})
+	ledgerGetcblockcertMicros.AddMicrosecondsSince(start, nil)
+	err = updateErrNoEntry(err, lastCommitted, latest)
+	if err != nil || format == blockdb.BlockFormatZstd {
+		return
+	}
+
+	blk, err = blockdb.DecompressBlockData(format, blk)
+	if err == nil {
+		blk, err = blockdb.CompressBlockData(blockdb.BlockFormatZstd, blk)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err = blockdb.DecompressBlockData(format, cert)
+	if err == nil {
+		cert, err = blockdb.CompressBlockData(blockdb.BlockFormatZstd, cert)
+	}
+	return
+}
+
 func (bq *blockQueue) getBlockCert(r basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
 	e, lastCommitted, latest, err := bq.checkEntry(r)
 	if e != nil {
@@ -372,5 +509,7 @@ var ledgerGetblockhdrCount = metrics.NewCounter("ledger_blockq_getblockhdr_count
 var ledgerGetblockhdrMicros = metrics.NewCounter("ledger_blockq_getblockhdr_micros", "µs spent")
 var ledgerGeteblockcertCount = metrics.NewCounter("ledger_blockq_geteblockcert_count", "calls")
 var ledgerGeteblockcertMicros = metrics.NewCounter("ledger_blockq_geteblockcert_micros", "µs spent")
+var ledgerGetcblockcertCount = metrics.NewCounter("ledger_blockq_getcblockcert_count", "calls")
+var ledgerGetcblockcertMicros = metrics.NewCounter("ledger_blockq_getcblockcert_micros", "µs spent")
 var ledgerGetblockcertCount = metrics.NewCounter("ledger_blockq_getblockcert_count", "calls")
 var ledgerGetblockcertMicros = metrics.NewCounter("ledger_blockq_getblockcert_micros", "µs spent")
@@ -17,9 +17,12 @@
 package ledger
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -29,6 +32,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -128,3 +132,108 @@ func TestGetEncodedBlockCert(t *testing.T) {
 	expectedErr := &ledgercore.ErrNoEntry{}
 	require.True(t, errors.As(err, expectedErr))
 }
+
+func TestBlockQueueRetainedMinimum(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	bq := &blockQueue{}
+	// without a retention policy, the trackers decide.
+	require.Equal(t, basics.Round(90), bq.retainedMinimum(100, 90))
+
+	bq.retainRounds = 50
+	require.Equal(t, basics.Round(51), bq.retainedMinimum(100, 90))
+	// the trackers may require more blocks than the retention policy.
+	require.Equal(t, basics.Round(40), bq.retainedMinimum(100, 40))
+	// not enough blocks committed yet to prune anything.
+	require.Equal(t, basics.Round(0), bq.retainedMinimum(30, 20))
+	// archival nodes never forget blocks.
+	require.Equal(t, basics.Round(0), bq.retainedMinimum(100, 0))
+}
+
+func TestBlockQueueBlockRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _, _ := ledgertesting.Genesis(10)
+
+	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	cfg.BlockRetentionRounds = 5
+	l, err := OpenLedger(logging.Base(), dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	for i := 1; i <= 3; i++ {
+		blkent := randomBlock(basics.Round(i))
+		blkent.block.CurrentProtocol = genesisInitState.Block.CurrentProtocol
+		require.NoError(t, l.blockQ.putBlock(blkent.block, blkent.cert))
+	}
+	l.WaitForCommit(3)
+
+	earliest, latest := l.BlockRange()
+	require.Equal(t, basics.Round(0), earliest)
+	require.Equal(t, basics.Round(3), latest)
+
+	// forget the oldest blocks, as a previous run would have, and make sure
+	// the block queue picks up the new range on restart.
+	l.blockQ.stop()
+	err = l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockdb.BlockForgetBefore(tx, 2)
+	})
+	require.NoError(t, err)
+	require.NoError(t, l.blockQ.start())
+
+	earliest, latest = l.BlockRange()
+	require.Equal(t, basics.Round(2), earliest)
+	require.Equal(t, basics.Round(3), latest)
+}
+
+func TestBlockQueueCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _, _ := ledgertesting.Genesis(10)
+
+	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.EnableBlockCompression = true
+	l, err := OpenLedger(logging.Base(), dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	blkent := randomBlock(1)
+	blkent.block.CurrentProtocol = genesisInitState.Block.CurrentProtocol
+	require.NoError(t, l.blockQ.putBlock(blkent.block, blkent.cert))
+	l.WaitForCommit(1)
+
+	storedFormat := func(rnd basics.Round) (format blockdb.BlockFormat) {
+		err := l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+			_, _, format, err = blockdb.BlockGetStoredCert(tx, rnd)
+			return err
+		})
+		require.NoError(t, err)
+		return format
+	}
+
+	require.Equal(t, blockdb.BlockFormatZstd, storedFormat(1))
+	// the genesis block was written uncompressed and is converted in the background.
+	require.Eventually(t, func() bool {
+		return storedFormat(0) == blockdb.BlockFormatZstd
+	}, 10*time.Second, 10*time.Millisecond)
+
+	blk, err := l.Block(1)
+	require.NoError(t, err)
+	require.Equal(t, blkent.block, blk)
+
+	compressedBlk, compressedCert, err := l.CompressedBlockCert(1)
+	require.NoError(t, err)
+	encodedBlk, encodedCert, err := l.EncodedBlockCert(1)
+	require.NoError(t, err)
+	decompressedBlk, err := blockdb.DecompressBlockData(blockdb.BlockFormatZstd, compressedBlk)
+	require.NoError(t, err)
+	decompressedCert, err := blockdb.DecompressBlockData(blockdb.BlockFormatZstd, compressedCert)
+	require.NoError(t, err)
+	require.Equal(t, encodedBlk, decompressedBlk)
+	require.Equal(t, encodedCert, decompressedCert)
+}
@@ -81,8 +81,16 @@ func (b *bulletin) Wait(round basics.Round) chan struct{} {
 }
 
 func (b *bulletin) loadFromDisk(l ledgerForTracker, _ basics.Round) error {
-	b.pendingNotificationRequests = make(map[basics.Round]notifier)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// requests made before a ledger reload are kept, so that their callers are
+	// signaled once the reloaded ledger reaches the requested round.
+	if b.pendingNotificationRequests == nil {
+		b.pendingNotificationRequests = make(map[basics.Round]notifier)
+	}
 	b.latestRound = l.Latest()
+	b.notifyUpTo(b.latestRound)
 	return nil
 }
 
@@ -96,6 +104,13 @@ func (b *bulletin) committedUpTo(rnd basics.Round) (retRound, lookback basics.Ro
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	b.notifyUpTo(rnd)
+	b.latestRound = rnd
+	return rnd, basics.Round(0)
+}
+
+// notifyUpTo signals all the pending requests for rounds up to rnd. The caller must hold b.mu.
+func (b *bulletin) notifyUpTo(rnd basics.Round) {
 	for pending, signal := range b.pendingNotificationRequests {
 		if pending > rnd {
 			continue
@@ -104,9 +119,6 @@ func (b *bulletin) committedUpTo(rnd basics.Round) (retRound, lookback basics.Ro
 		delete(b.pendingNotificationRequests, pending)
 		signal.notify()
 	}
-
-	b.latestRound = rnd
-	return rnd, basics.Round(0)
 }
 
 func (b *bulletin) prepareCommit(dcc *deferredCommitContext) error {
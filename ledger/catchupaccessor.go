@@ -67,6 +67,10 @@ type CatchpointCatchupAccessor interface {
 	// GetCatchupBlockRound returns the latest block round matching the current catchpoint
 	GetCatchupBlockRound(ctx context.Context) (round basics.Round, err error)
 
+	// GetVerifyData returns the balances merkle trie root hash, the state proof verification data hash and the
+	// account totals of the staged catchpoint, which make up its label.
+	GetVerifyData(ctx context.Context) (balancesHash crypto.Digest, spverHash crypto.Digest, totals ledgercore.AccountTotals, err error)
+
 	// VerifyCatchpoint verifies that the catchpoint is valid by reconstructing the label.
 	VerifyCatchpoint(ctx context.Context, blk *bookkeeping.Block) (err error)
 
@@ -926,34 +930,11 @@ func (c *catchpointCatchupAccessorImpl) GetCatchupBlockRound(ctx context.Context
 	return basics.Round(iRound), nil
 }
 
-// VerifyCatchpoint verifies that the catchpoint is valid by reconstructing the label.
-func (c *catchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, blk *bookkeeping.Block) (err error) {
-	var balancesHash crypto.Digest
+// GetVerifyData returns the balances merkle trie root hash, the state proof verification data hash and the
+// account totals of the staged catchpoint, which make up its label.
+func (c *catchpointCatchupAccessorImpl) GetVerifyData(ctx context.Context) (balancesHash crypto.Digest, spverHash crypto.Digest, totals ledgercore.AccountTotals, err error) {
 	var rawStateProofVerificationContext []ledgercore.StateProofVerificationContext
-	var blockRound basics.Round
-	var totals ledgercore.AccountTotals
-	var catchpointLabel string
-	var version uint64
-
-	catchpointLabel, err = c.catchpointStore.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLabel)
-	if err != nil {
-		return fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupLabel, err)
-	}
-
-	version, err = c.catchpointStore.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupVersion)
-	if err != nil {
-		return fmt.Errorf("unable to retrieve catchpoint version: %v", err)
-	}
 
-	var iRound uint64
-	iRound, err = c.catchpointStore.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupBlockRound)
-	if err != nil {
-		return fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupBlockRound, err)
-	}
-	blockRound = basics.Round(iRound)
-
-	start := time.Now()
-	ledgerVerifycatchpointCount.Inc(nil)
 	err = c.ledger.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
 		arw, err := tx.MakeAccountsReaderWriter()
 		if err != nil {
@@ -988,6 +969,41 @@ func (c *catchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, bl
 
 		return
 	})
+	if err != nil {
+		return crypto.Digest{}, crypto.Digest{}, ledgercore.AccountTotals{}, err
+	}
+
+	wrappedContext := catchpointStateProofVerificationContext{Data: rawStateProofVerificationContext}
+	spverHash = crypto.HashObj(wrappedContext)
+	return balancesHash, spverHash, totals, nil
+}
+
+// VerifyCatchpoint verifies that the catchpoint is valid by reconstructing the label.
+func (c *catchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, blk *bookkeeping.Block) (err error) {
+	var blockRound basics.Round
+	var catchpointLabel string
+	var version uint64
+
+	catchpointLabel, err = c.catchpointStore.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupLabel)
+	if err != nil {
+		return fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupLabel, err)
+	}
+
+	version, err = c.catchpointStore.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupVersion)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve catchpoint version: %v", err)
+	}
+
+	var iRound uint64
+	iRound, err = c.catchpointStore.ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupBlockRound)
+	if err != nil {
+		return fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupBlockRound, err)
+	}
+	blockRound = basics.Round(iRound)
+
+	start := time.Now()
+	ledgerVerifycatchpointCount.Inc(nil)
+	balancesHash, spVerificationHash, totals, err := c.GetVerifyData(ctx)
 	ledgerVerifycatchpointMicros.AddMicrosecondsSince(start, nil)
 	if err != nil {
 		return err
@@ -996,9 +1012,6 @@ func (c *catchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, bl
 		return fmt.Errorf("block round in block header doesn't match block round in catchpoint:  %d != %d", blockRound, blk.Round())
 	}
 
-	wrappedContext := catchpointStateProofVerificationContext{Data: rawStateProofVerificationContext}
-	spVerificationHash := crypto.HashObj(wrappedContext)
-
 	var catchpointLabelMaker ledgercore.CatchpointLabelMaker
 	blockDigest := blk.Digest()
 	if version <= CatchpointFileVersionV6 {
@@ -1193,6 +1206,12 @@ func (c *catchpointCatchupAccessorImpl) finishBalances(ctx context.Context) (err
 			return err
 		}
 
+		// AccountsReset dropped the tracker plugin tables, and there are no blocks to rebuild them from
+		err = c.ledger.resetTrackerPlugins(ctx, tx, basics.Round(balancesRound))
+		if err != nil {
+			return err
+		}
+
 		err = arw.AccountsPutTotals(totals, false)
 		if err != nil {
 			return err
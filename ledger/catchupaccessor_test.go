@@ -369,6 +369,12 @@ func TestBuildMerkleTrie(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, progressCallCount > 0)
 
+	// the label data reflects the staged accounts and the header totals
+	balancesHash, _, totals, err := catchpointAccessor.GetVerifyData(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, crypto.Digest{}, balancesHash)
+	require.Equal(t, fileHeader.Totals, totals)
+
 	blockRound, err := catchpointAccessor.GetCatchupBlockRound(ctx)
 	require.NoError(t, err)
 	require.Equal(t, basics.Round(0), blockRound)
@@ -19,6 +19,7 @@ package ledger
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -87,6 +88,10 @@ type Ledger struct {
 	notifier       blockNotifier
 	metrics        metricsTracker
 	spVerification spVerificationTracker
+	history        accountHistoryTracker
+
+	// trackers registered with RegisterTrackerPlugin
+	plugins []*pluginTracker
 
 	trackers  trackerRegistry
 	trackerMu deadlock.RWMutex
@@ -137,6 +142,7 @@ func OpenLedger(
 	}
 
 	l.headerCache.initialize()
+	l.plugins = makeTrackerPlugins()
 
 	defer func() {
 		if err != nil {
@@ -214,6 +220,7 @@ func (l *Ledger) reloadLedger() error {
 
 	// set account updates tracker as a driver to calculate tracker db round and committing offsets
 	trackers := []ledgerTracker{
+		&l.history,        // record account history, before the balances are updated
 		&l.accts,          // update the balances
 		&l.catchpoint,     // catchpoints tracker : update catchpoint labels, create catchpoint files
 		&l.acctsOnline,    // update online account balances history
@@ -223,7 +230,11 @@ func (l *Ledger) reloadLedger() error {
 		&l.metrics,        // provides metrics reporting support
 		&l.spVerification, // provides state proof verification support
 	}
+	for _, pt := range l.plugins {
+		trackers = append(trackers, pt)
+	}
 
+	l.history.initialize(l.cfg)
 	l.accts.initialize(l.cfg)
 	l.acctsOnline.initialize(l.cfg)
 	l.catchpoint.initialize(l.cfg, l.dbPathPrefix)
@@ -540,6 +551,11 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	defer l.trackerMu.RUnlock()
 
 	data, rnd, rewardsVersion, rewardsLevel, err := l.accts.lookupWithoutRewards(round, addr, true /* take lock */)
+	var roundOffsetErr *RoundOffsetError
+	if errors.As(err, &roundOffsetErr) && l.history.enabled {
+		// the round is older than the in-memory deltas; answer it from the account history.
+		return l.lookupAccountHistory(round, addr)
+	}
 	if err != nil {
 		return ledgercore.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
 	}
@@ -550,6 +566,23 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	return data, rnd, withoutRewards, nil
 }
 
+// lookupAccountHistory looks up the account state of addr as of round from the account history,
+// applying the rewards as of that round. The trackerMu lock is expected to be taken.
+func (l *Ledger) lookupAccountHistory(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error) {
+	data, err := l.history.lookupAccount(round, addr)
+	if err != nil {
+		return ledgercore.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
+	}
+	hdr, err := l.BlockHdr(round)
+	if err != nil {
+		return ledgercore.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
+	}
+
+	withoutRewards := data.MicroAlgos
+	data = data.WithUpdatedRewards(config.Consensus[hdr.CurrentProtocol], hdr.RewardsLevel)
+	return data, round, withoutRewards, nil
+}
+
 // LookupApplication loads an application resource that matches the request parameters from the ledger.
 func (l *Ledger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
 	r, err := l.lookupResource(rnd, addr, basics.CreatableIndex(aidx), basics.AppCreatable)
@@ -569,6 +602,10 @@ func (l *Ledger) lookupResource(rnd basics.Round, addr basics.Address, aidx basi
 
 	// Intentionally apply (pending) rewards up to rnd.
 	res, _, err := l.accts.LookupResource(rnd, addr, aidx, ctype)
+	var roundOffsetErr *RoundOffsetError
+	if errors.As(err, &roundOffsetErr) && l.history.enabled {
+		return l.history.lookupResource(rnd, addr, aidx, ctype)
+	}
 	if err != nil {
 		return ledgercore.AccountResource{}, err
 	}
@@ -581,7 +618,12 @@ func (l *Ledger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
 
-	return l.accts.LookupKv(rnd, key)
+	value, err := l.accts.LookupKv(rnd, key)
+	var roundOffsetErr *RoundOffsetError
+	if errors.As(err, &roundOffsetErr) && l.history.enabled {
+		return l.history.lookupKv(rnd, key)
+	}
+	return value, err
 }
 
 // LookupKeysByPrefix searches keys with specific prefix, up to `maxKeyNum`
@@ -659,6 +701,12 @@ func (l *Ledger) LatestCommitted() (basics.Round, basics.Round) {
 	return l.blockQ.latestCommitted()
 }
 
+// BlockRange returns the range of block rounds, inclusive, that can currently be retrieved from the ledger.
+// Non-archival nodes forget old blocks, so earliest advances as the ledger progresses.
+func (l *Ledger) BlockRange() (earliest basics.Round, latest basics.Round) {
+	return l.blockQ.earliestAvailable(), l.blockQ.latest()
+}
+
 // Block returns the block for round rnd.
 func (l *Ledger) Block(rnd basics.Round) (blk bookkeeping.Block, err error) {
 	return l.blockQ.getBlock(rnd)
@@ -683,6 +731,12 @@ func (l *Ledger) EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, er
 	return l.blockQ.getEncodedBlockCert(rnd)
 }
 
+// CompressedBlockCert returns the encoded block and the corresponding encoded certificate of the block for round rnd,
+// each compressed as a zstd frame. Blocks stored compressed are returned without being recompressed.
+func (l *Ledger) CompressedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error) {
+	return l.blockQ.getCompressedBlockCert(rnd)
+}
+
 // BlockCert returns the block and the certificate of the block for round rnd.
 func (l *Ledger) BlockCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
 	return l.blockQ.getBlockCert(rnd)
@@ -870,7 +924,15 @@ func (l *Ledger) FlushCaches() {
 // not a valid block (e.g., it has duplicate transactions, overspends some
 // account, etc).
 func (l *Ledger) Validate(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) (*ledgercore.ValidatedBlock, error) {
+	span := logging.StartSpan("ledger.Validate")
+	span.SetAttribute("round", uint64(blk.Round()))
+	span.SetAttribute("txns", len(blk.Payset))
+	defer span.End()
+
+	start := time.Now()
 	delta, err := eval.Eval(ctx, l, blk, true, l.verifiedTxnCache, executionPool, l.tracer)
+	ledgerBlockValidationSeconds.ObserveSince(start, nil)
+	span.SetError(err)
 	if err != nil {
 		return nil, err
 	}
@@ -892,6 +954,8 @@ func MakeDebugBalances(l DebuggerLedger, round basics.Round, proto protocol.Cons
 	return eval.MakeDebugBalances(l, round, proto, prevTimestamp)
 }
 
+var ledgerBlockValidationSeconds = metrics.MakeHistogram(metrics.MetricName{Name: "algod_ledger_block_validation_seconds", Description: "Time spent validating blocks, in seconds"}, nil)
+
 var ledgerInitblocksdbCount = metrics.NewCounter("ledger_initblocksdb_count", "calls")
 var ledgerInitblocksdbMicros = metrics.NewCounter("ledger_initblocksdb_micros", "µs spent")
 var ledgerVerifygenhashCount = metrics.NewCounter("ledger_verifygenhash_count", "calls")
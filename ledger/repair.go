@@ -0,0 +1,343 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// TrackerDBReport is the outcome of checking a tracker database against its block database.
+type TrackerDBReport struct {
+	// TrackerRound is the round the accounts of the tracker database were last committed at.
+	TrackerRound basics.Round
+	// EarliestBlock and LatestBlock are the range of rounds held by the block database.
+	EarliestBlock basics.Round
+	LatestBlock   basics.Round
+
+	// HashRound is the round the merkle trie was last updated at. The trie is only
+	// compared against the accounts when it was updated at TrackerRound.
+	HashRound    basics.Round
+	TrieChecked  bool
+	StoredRoot   crypto.Digest
+	ComputedRoot crypto.Digest
+
+	StoredTotals   ledgercore.AccountTotals
+	ComputedTotals ledgercore.AccountTotals
+
+	Accounts  uint64
+	Resources uint64
+	KVs       uint64
+
+	// Problems lists the inconsistencies found. An empty list means the databases are consistent.
+	Problems []string
+}
+
+func (r *TrackerDBReport) problemf(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+// VerifyTrackerDB checks the tracker database of the ledger stored at dbPathPrefix against
+// its block database without modifying either of them. It recomputes the merkle trie root
+// from the accounts, resources and kv pairs, recomputes the account totals, and checks that
+// the block database holds the blocks needed to bring the trackers up to date. Database
+// access failures are returned as errors, while inconsistencies are listed in the report.
+func VerifyTrackerDB(ctx context.Context, dbPathPrefix string) (report TrackerDBReport, err error) {
+	trackerDBFilename := dbPathPrefix + ".tracker.sqlite"
+	blockDBFilename := dbPathPrefix + ".block.sqlite"
+	for _, filename := range []string{trackerDBFilename, blockDBFilename} {
+		if _, err = os.Stat(filename); err != nil {
+			return report, err
+		}
+	}
+
+	blockDBs, err := db.MakeAccessor(blockDBFilename, true, false)
+	if err != nil {
+		return report, fmt.Errorf("VerifyTrackerDB: unable to open the block database: %w", err)
+	}
+	defer blockDBs.Close()
+	trackerDBs, err := db.MakeAccessor(trackerDBFilename, true, false)
+	if err != nil {
+		return report, fmt.Errorf("VerifyTrackerDB: unable to open the tracker database: %w", err)
+	}
+	defer trackerDBs.Close()
+	trackerStore := sqlitedriver.CreateTrackerSQLStore(db.Pair{Rdb: trackerDBs, Wdb: trackerDBs})
+
+	err = trackerStore.TransactionContext(ctx, func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		arw, err0 := tx.MakeAccountsReaderWriter()
+		if err0 != nil {
+			return err0
+		}
+		report.TrackerRound, err0 = arw.AccountsRound()
+		if err0 != nil {
+			return fmt.Errorf("VerifyTrackerDB: unable to read the accounts round: %w", err0)
+		}
+		report.HashRound, err0 = arw.AccountsHashRound(ctx)
+		if err0 != nil {
+			return fmt.Errorf("VerifyTrackerDB: unable to read the accounts hash round: %w", err0)
+		}
+		report.StoredTotals, err0 = arw.AccountsTotals(ctx, false)
+		if err0 != nil {
+			return fmt.Errorf("VerifyTrackerDB: unable to read the account totals: %w", err0)
+		}
+		return nil
+	})
+	if err != nil {
+		return report, err
+	}
+
+	// the rewards of the accounts are computed using the protocol of the round the trackers were committed at.
+	var hdr, latestHdr bookkeeping.BlockHeader
+	hdrErr := sql.ErrNoRows
+	err = blockDBs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var err0 error
+		report.EarliestBlock, err0 = blockdb.BlockEarliest(tx)
+		if err0 != nil {
+			return err0
+		}
+		report.LatestBlock, err0 = blockdb.BlockLatest(tx)
+		if err0 != nil {
+			return err0
+		}
+		latestHdr, err0 = blockdb.BlockGetHdr(tx, report.LatestBlock)
+		if err0 != nil {
+			return err0
+		}
+		if report.EarliestBlock <= report.TrackerRound && report.TrackerRound <= report.LatestBlock {
+			hdr, hdrErr = blockdb.BlockGetHdr(tx, report.TrackerRound)
+		}
+		return nil
+	})
+	if err != nil {
+		return report, fmt.Errorf("VerifyTrackerDB: unable to read the block database: %w", err)
+	}
+
+	switch {
+	case report.TrackerRound > report.LatestBlock:
+		report.problemf("tracker database round %d is ahead of the latest block %d", report.TrackerRound, report.LatestBlock)
+	case report.TrackerRound+1 < report.EarliestBlock:
+		report.problemf("blocks %d to %d needed to replay the tracker database from round %d are missing from the block database", report.TrackerRound+1, report.EarliestBlock-1, report.TrackerRound)
+	case hdrErr != nil:
+		report.problemf("block %d matching the tracker database round is not available: %v", report.TrackerRound, hdrErr)
+	case hdr.RewardsLevel != report.StoredTotals.RewardsLevel:
+		report.problemf("account totals rewards level %d does not match the rewards level %d of block %d", report.StoredTotals.RewardsLevel, hdr.RewardsLevel, hdr.Round)
+	}
+	proto := config.Consensus[hdr.CurrentProtocol]
+	if hdrErr != nil {
+		proto = config.Consensus[latestHdr.CurrentProtocol]
+	}
+
+	// the recomputed trie is kept next to the databases rather than in memory, since it is as large as the
+	// trie of the tracker database.
+	tempDir, err := os.MkdirTemp(filepath.Dir(dbPathPrefix), "verify-")
+	if err != nil {
+		return report, fmt.Errorf("VerifyTrackerDB: unable to create the merkle trie directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+	trie, err := makeVerifyTrie(filepath.Join(tempDir, "trie.sqlite"), &report)
+	if err != nil {
+		return report, fmt.Errorf("VerifyTrackerDB: unable to create the merkle trie database: %w", err)
+	}
+	defer trie.close()
+
+	err = verifyTrackerAccounts(ctx, trackerStore, proto, trie, &report)
+	if err != nil {
+		return report, err
+	}
+
+	if report.ComputedTotals != report.StoredTotals {
+		report.problemf("stored account totals %+v do not match the totals %+v computed from the accounts", report.StoredTotals, report.ComputedTotals)
+	}
+	if report.TrieChecked && report.StoredRoot != report.ComputedRoot {
+		report.problemf("stored merkle trie root %v does not match the root %v computed from the accounts", report.StoredRoot, report.ComputedRoot)
+	}
+	return report, nil
+}
+
+// verifyTrie computes a merkle trie on a temporary database, committing and evicting its nodes every
+// trieRebuildCommitFrequency hashes, the same way catchpoint catchup builds the trie of the staging tables.
+type verifyTrie struct {
+	dbs    db.Accessor
+	trie   *merkletrie.Trie
+	hashes [][]byte
+	report *TrackerDBReport
+}
+
+func makeVerifyTrie(filename string, report *TrackerDBReport) (*verifyTrie, error) {
+	dbs, err := db.MakeAccessor(filename, false, false)
+	if err != nil {
+		return nil, err
+	}
+	vt := &verifyTrie{dbs: dbs, report: report}
+	err = dbs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err0 := tx.Exec("CREATE TABLE accounthashes (id integer primary key, data blob)")
+		if err0 != nil {
+			return err0
+		}
+		mc, err0 := sqlitedriver.MakeMerkleCommitter(tx, false)
+		if err0 != nil {
+			return err0
+		}
+		vt.trie, err0 = merkletrie.MakeTrie(mc, trackerdb.TrieMemoryConfig)
+		return err0
+	})
+	if err != nil {
+		dbs.Close()
+		return nil, err
+	}
+	return vt, nil
+}
+
+// add queues hash to be added to the trie
+func (vt *verifyTrie) add(hash []byte) error {
+	vt.hashes = append(vt.hashes, hash)
+	if len(vt.hashes) < trieRebuildCommitFrequency {
+		return nil
+	}
+	return vt.commit(nil)
+}
+
+// commit adds the queued hashes to the trie and writes its nodes to the database, evicting them from memory.
+// If root is not nil, it is set to the root hash of the trie.
+func (vt *verifyTrie) commit(root *crypto.Digest) error {
+	err := vt.dbs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err0 := db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(30*time.Second))
+		if err0 != nil {
+			return err0
+		}
+		mc, err0 := sqlitedriver.MakeMerkleCommitter(tx, false)
+		if err0 != nil {
+			return err0
+		}
+		vt.trie.SetCommitter(mc)
+		for _, hash := range vt.hashes {
+			added, err0 := vt.trie.Add(hash)
+			if err0 != nil {
+				return err0
+			}
+			if !added {
+				vt.report.problemf("duplicate merkle trie hash %s", hex.EncodeToString(hash))
+			}
+		}
+		_, err0 = vt.trie.Evict(true)
+		if err0 != nil || root == nil {
+			return err0
+		}
+		*root, err0 = vt.trie.RootHash()
+		return err0
+	})
+	vt.hashes = vt.hashes[:0]
+	return err
+}
+
+func (vt *verifyTrie) close() {
+	vt.dbs.Close()
+}
+
+// verifyTrackerAccounts recomputes the merkle trie root, using trie, and the account totals from
+// the accounts, resources and kv pairs of the tracker database.
+func verifyTrackerAccounts(ctx context.Context, trackerStore trackerdb.TrackerStore, proto config.ConsensusParams, trie *verifyTrie, report *TrackerDBReport) error {
+	return trackerStore.TransactionContext(ctx, func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		committer, err := tx.MakeMerkleCommitter(false)
+		if err != nil {
+			return err
+		}
+		storedTrie, err := merkletrie.MakeTrie(committer, trackerdb.TrieMemoryConfig)
+		if err != nil {
+			return fmt.Errorf("VerifyTrackerDB: unable to load the merkle trie: %w", err)
+		}
+		report.StoredRoot, err = storedTrie.RootHash()
+		if err != nil {
+			return fmt.Errorf("VerifyTrackerDB: unable to read the merkle trie root: %w", err)
+		}
+		report.TrieChecked = report.HashRound == report.TrackerRound
+
+		report.ComputedTotals = ledgercore.AccountTotals{RewardsLevel: report.StoredTotals.RewardsLevel}
+		var ot basics.OverflowTracker
+		accountsIt := tx.MakeEncodedAccoutsBatchIter()
+		defer accountsIt.Close()
+		for {
+			bals, _, err := accountsIt.Next(ctx, BalancesPerCatchpointFileChunk, ResourcesPerCatchpointFileChunk)
+			if err != nil {
+				return fmt.Errorf("VerifyTrackerDB: unable to read the accounts: %w", err)
+			}
+			if len(bals) == 0 {
+				break
+			}
+			normalized, err := prepareNormalizedBalancesV6(bals, proto)
+			if err != nil {
+				return fmt.Errorf("VerifyTrackerDB: unable to decode the accounts: %w", err)
+			}
+			for _, balance := range normalized {
+				for _, hash := range balance.AccountHashes {
+					err = trie.add(hash)
+					if err != nil {
+						return err
+					}
+				}
+				report.Resources += uint64(len(balance.Resources))
+				if balance.PartialBalance {
+					continue
+				}
+				report.ComputedTotals.AddAccount(proto, balance.AccountData.GetLedgerCoreAccountData(), &ot)
+				report.Accounts++
+			}
+			_, err = tx.ResetTransactionWarnDeadline(ctx, time.Now().Add(30*time.Second))
+			if err != nil {
+				return err
+			}
+		}
+		if ot.Overflowed {
+			report.problemf("account totals overflow")
+		}
+
+		kvs, err := tx.MakeKVsIter(ctx)
+		if err != nil {
+			return err
+		}
+		defer kvs.Close()
+		for kvs.Next() {
+			k, v, err := kvs.KeyValue()
+			if err != nil {
+				return fmt.Errorf("VerifyTrackerDB: unable to read the kv pairs: %w", err)
+			}
+			err = trie.add(trackerdb.KvHashBuilderV6(string(k), v))
+			if err != nil {
+				return err
+			}
+			report.KVs++
+		}
+
+		return trie.commit(&report.ComputedRoot)
+	})
+}
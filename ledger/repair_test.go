@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// makeRepairTestLedger writes a ledger with a few payments and an asset to disk and returns its path prefix.
+func makeRepairTestLedger(t *testing.T) string {
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	var genHash crypto.Digest
+	crypto.RandBytes(genHash[:])
+	genBlock, err := bookkeeping.MakeGenesisBlock(protocol.ConsensusCurrentVersion, genBalances, "test", genHash)
+	require.NoError(t, err)
+
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.CatchpointTracking = 1
+	dbPrefix := filepath.Join(t.TempDir(), "ledger")
+	l, err := OpenLedger(logging.TestingLog(t), dbPrefix, false, ledgercore.InitState{
+		Block:       genBlock,
+		Accounts:    genBalances.Balances,
+		GenesisHash: genHash,
+	}, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	for i := 1; i <= 8; i++ {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{Type: protocol.PaymentTx, Sender: addrs[0], Receiver: addrs[1], Amount: uint64(1000 * i)})
+		if i == 3 {
+			txn(t, l, eval, &txntest.Txn{
+				Type:        protocol.AssetConfigTx,
+				Sender:      addrs[0],
+				AssetParams: basics.AssetParams{Total: 1000, UnitName: "rep"},
+			})
+		}
+		endBlock(t, l, eval)
+	}
+	commitRoundLookback(basics.Round(cfg.MaxAcctLookback), l)
+	return dbPrefix
+}
+
+func TestVerifyTrackerDB(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dbPrefix := makeRepairTestLedger(t)
+	report, err := VerifyTrackerDB(context.Background(), dbPrefix)
+	require.NoError(t, err)
+	require.Empty(t, report.Problems)
+	require.Equal(t, basics.Round(6), report.TrackerRound)
+	require.Equal(t, basics.Round(8), report.LatestBlock)
+	require.True(t, report.TrieChecked)
+	require.False(t, report.StoredRoot.IsZero())
+	require.Equal(t, report.StoredRoot, report.ComputedRoot)
+	require.Equal(t, report.StoredTotals, report.ComputedTotals)
+	require.NotZero(t, report.Accounts)
+	require.Equal(t, uint64(1), report.Resources)
+
+	// the temporary trie database is gone
+	entries, err := os.ReadDir(filepath.Dir(dbPrefix))
+	require.NoError(t, err)
+	for _, entry := range entries {
+		require.False(t, strings.HasPrefix(entry.Name(), "verify-"), entry.Name())
+	}
+
+	_, err = VerifyTrackerDB(context.Background(), filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestVerifyTrie(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var report TrackerDBReport
+	vt, err := makeVerifyTrie(filepath.Join(t.TempDir(), "trie.sqlite"), &report)
+	require.NoError(t, err)
+	defer vt.close()
+	memTrie, err := merkletrie.MakeTrie(nil, trackerdb.TrieMemoryConfig)
+	require.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+		hash := crypto.Hash([]byte{byte(i), byte(i >> 8)})
+		require.NoError(t, vt.add(hash[:]))
+		_, err = memTrie.Add(hash[:])
+		require.NoError(t, err)
+		// the nodes are written out and evicted along the way
+		if i%300 == 0 {
+			require.NoError(t, vt.commit(nil))
+		}
+	}
+	hash := crypto.Hash([]byte{0, 0})
+	require.NoError(t, vt.add(hash[:]))
+
+	var root crypto.Digest
+	require.NoError(t, vt.commit(&root))
+	expected, err := memTrie.RootHash()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+	require.Len(t, report.Problems, 1)
+	require.Contains(t, report.Problems[0], "duplicate merkle trie hash")
+}
+
+func TestVerifyTrackerDBInconsistencies(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dbPrefix := makeRepairTestLedger(t)
+	modify := func(filename string, fn func(ctx context.Context, tx *sql.Tx) error) {
+		dbs, err := db.MakeAccessor(filename, false, false)
+		require.NoError(t, err)
+		defer dbs.Close()
+		require.NoError(t, dbs.Atomic(fn))
+	}
+
+	// alter the balance of an account behind the back of the trie and the totals
+	modify(dbPrefix+".tracker.sqlite", func(ctx context.Context, tx *sql.Tx) error {
+		var rowid int64
+		var buf []byte
+		err := tx.QueryRow("SELECT rowid, data FROM accountbase ORDER BY rowid LIMIT 1").Scan(&rowid, &buf)
+		if err != nil {
+			return err
+		}
+		var data trackerdb.BaseAccountData
+		err = protocol.Decode(buf, &data)
+		if err != nil {
+			return err
+		}
+		data.MicroAlgos.Raw++
+		_, err = tx.Exec("UPDATE accountbase SET data = ? WHERE rowid = ?", protocol.Encode(&data), rowid)
+		return err
+	})
+	// and drop the blocks the tracker database was committed at
+	modify(dbPrefix+".block.sqlite", func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM blocks WHERE rnd >= 5")
+		return err
+	})
+
+	report, err := VerifyTrackerDB(context.Background(), dbPrefix)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(4), report.LatestBlock)
+	require.Len(t, report.Problems, 3)
+	require.True(t, strings.HasPrefix(report.Problems[0], "tracker database round 6 is ahead of the latest block 4"), report.Problems[0])
+	require.Contains(t, report.Problems[1], "stored account totals")
+	require.Contains(t, report.Problems[2], "stored merkle trie root")
+	require.NotEqual(t, report.StoredRoot, report.ComputedRoot)
+}
@@ -111,6 +111,13 @@ func (hlc *heapLRUCache) Put(r basics.Round, data interface{}) {
 	hlc.inc()
 }
 
+// reset removes all the entries from the cache.
+func (hlc *heapLRUCache) reset() {
+	hlc.lock.Lock()
+	defer hlc.lock.Unlock()
+	hlc.entries = lruHeap{}
+}
+
 // MaxInt is the maximum int which might be int32 or int64
 const MaxInt = int((^uint(0)) >> 1)
 
@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+const (
+	snapshotBlockDBFilename   = "ledger.block.sqlite"
+	snapshotTrackerDBFilename = "ledger.tracker.sqlite"
+)
+
+// SaveSnapshot copies the ledger databases into dir, which must not already exist, and
+// returns the latest round captured by the snapshot. The caller is expected to prevent
+// new blocks from being added while the snapshot is taken.
+func (l *Ledger) SaveSnapshot(dir string) (basics.Round, error) {
+	// make sure all the blocks were written to the block database, so that the
+	// trackers are able to replay them once the snapshot is restored.
+	l.WaitForCommit(l.Latest())
+
+	err := os.Mkdir(dir, 0700)
+	if err != nil {
+		return 0, err
+	}
+
+	// the tracker database is copied first: trackers only commit rounds whose blocks are
+	// already in the block database, so the block database copy that follows contains
+	// every block needed to bring the copied trackers up to date.
+	ctx := context.Background()
+	err = l.trackerDBs.Backup(ctx, filepath.Join(dir, snapshotTrackerDBFilename))
+	if err != nil {
+		os.RemoveAll(dir)
+		return 0, fmt.Errorf("SaveSnapshot: unable to copy the tracker database: %w", err)
+	}
+	err = l.blockDBs.Wdb.Backup(ctx, filepath.Join(dir, snapshotBlockDBFilename))
+	if err != nil {
+		os.RemoveAll(dir)
+		return 0, fmt.Errorf("SaveSnapshot: unable to copy the block database: %w", err)
+	}
+
+	hdr, err := SnapshotHeader(dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return 0, err
+	}
+	return hdr.Round, nil
+}
+
+// RestoreSnapshot reverts the ledger to a snapshot previously written by SaveSnapshot,
+// discarding every block added after it was taken, and returns the restored latest round.
+// The caller is expected to prevent new blocks from being added during the restore.
+func (l *Ledger) RestoreSnapshot(dir string) (basics.Round, error) {
+	hdr, err := SnapshotHeader(dir)
+	if err != nil {
+		return 0, err
+	}
+	if !hdr.GenesisHash.IsZero() && hdr.GenesisHash != l.genesisHash {
+		return 0, fmt.Errorf("RestoreSnapshot: snapshot genesis hash %v does not match ledger genesis hash %v", hdr.GenesisHash, l.genesisHash)
+	}
+
+	// stop the block queue and the trackers the same way reloadLedger does, so
+	// that nothing accesses the databases while their content is replaced.
+	l.blockQ.stop()
+	l.trackerMu.Lock()
+	l.trackers.close()
+
+	ctx := context.Background()
+	err = l.trackerDBs.Restore(ctx, filepath.Join(dir, snapshotTrackerDBFilename))
+	if err != nil {
+		err = fmt.Errorf("RestoreSnapshot: unable to restore the tracker database: %w", err)
+	} else {
+		err = l.blockDBs.Wdb.Restore(ctx, filepath.Join(dir, snapshotBlockDBFilename))
+		if err != nil {
+			err = fmt.Errorf("RestoreSnapshot: unable to restore the block database: %w", err)
+		}
+	}
+	l.headerCache.reset()
+	l.trackerMu.Unlock()
+
+	// the ledger is reloaded even if the restore failed, so that it keeps serving
+	// whatever content the databases hold.
+	reloadErr := l.reloadLedger()
+	if err != nil {
+		return 0, err
+	}
+	if reloadErr != nil {
+		return 0, fmt.Errorf("RestoreSnapshot: unable to reload the ledger: %w", reloadErr)
+	}
+	return l.Latest(), nil
+}
+
+// SnapshotHeader returns the header of the latest block stored in a snapshot written by SaveSnapshot.
+func SnapshotHeader(dir string) (hdr bookkeeping.BlockHeader, err error) {
+	filename := filepath.Join(dir, snapshotBlockDBFilename)
+	_, err = os.Stat(filename)
+	if err != nil {
+		return
+	}
+	dbs, err := db.MakeAccessor(filename, true, false)
+	if err != nil {
+		return
+	}
+	defer dbs.Close()
+
+	err = dbs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		latest, err0 := blockdb.BlockLatest(tx)
+		if err0 != nil {
+			return err0
+		}
+		hdr, err0 = blockdb.BlockGetHdr(tx, latest)
+		return err0
+	})
+	return
+}
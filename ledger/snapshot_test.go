@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/txntest"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLedgerSnapshotRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	pay := func(amount uint64) {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{Type: protocol.PaymentTx, Sender: addrs[0], Receiver: addrs[1], Amount: amount})
+		endBlock(t, l, eval)
+	}
+
+	for i := 0; i < 5; i++ {
+		pay(1000)
+	}
+	commitRoundLookback(basics.Round(cfg.MaxAcctLookback), l)
+	require.Less(t, l.LatestTrackerCommitted(), l.Latest())
+
+	dir := filepath.Join(t.TempDir(), "snapshot")
+	rnd, err := l.SaveSnapshot(dir)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), rnd)
+	_, err = l.SaveSnapshot(dir)
+	require.Error(t, err)
+
+	hdr, err := SnapshotHeader(dir)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), hdr.Round)
+	require.Equal(t, l.GenesisHash(), hdr.GenesisHash)
+
+	snapshotBalance := micros(t, l, addrs[1])
+	snapshotHdr, err := l.BlockHdr(5)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		pay(2000)
+	}
+	commitRoundLookback(basics.Round(cfg.MaxAcctLookback), l)
+	require.Equal(t, basics.Round(10), l.Latest())
+	require.NotEqual(t, snapshotBalance, micros(t, l, addrs[1]))
+
+	// a waiter registered before the restore is signaled once the reverted ledger gets there again
+	waitCh := l.Wait(12)
+
+	rnd, err = l.RestoreSnapshot(dir)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), rnd)
+	require.Equal(t, basics.Round(5), l.Latest())
+	require.Equal(t, snapshotBalance, micros(t, l, addrs[1]))
+	hdr, err = l.BlockHdr(5)
+	require.NoError(t, err)
+	require.Equal(t, snapshotHdr, hdr)
+	_, err = l.BlockHdr(6)
+	require.Error(t, err)
+
+	// the reverted ledger accepts a different history from the snapshot round
+	pay(3000)
+	blk, err := l.Block(6)
+	require.NoError(t, err)
+	require.Equal(t, snapshotHdr.Hash(), blk.Branch)
+	payset, err := blk.DecodePaysetFlat()
+	require.NoError(t, err)
+	require.Len(t, payset, 1)
+	require.Equal(t, basics.MicroAlgos{Raw: 3000}, payset[0].Txn.Amount)
+
+	select {
+	case <-waitCh:
+		t.Fatal("waiter signaled before its round was reached")
+	default:
+	}
+	for i := 0; i < 6; i++ {
+		pay(1)
+	}
+	commitRoundLookback(basics.Round(cfg.MaxAcctLookback), l)
+	<-waitCh
+}
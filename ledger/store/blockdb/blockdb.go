@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/DataDog/zstd"
 	"github.com/mattn/go-sqlite3"
 
 	"github.com/algorand/go-algorand/agreement"
@@ -30,14 +31,37 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 )
 
+// BlockFormat identifies how the block and certificate data of a row in the blocks table are stored.
+// The block header is always stored uncompressed so that header lookups remain cheap.
+type BlockFormat int
+
+const (
+	// BlockFormatRaw stores msgpack-encoded blocks and certificates as-is.
+	BlockFormatRaw BlockFormat = 0
+	// BlockFormatZstd stores msgpack-encoded blocks and certificates as individual zstd frames.
+	BlockFormatZstd BlockFormat = 1
+)
+
+var blockFormats = []BlockFormat{BlockFormatRaw, BlockFormatZstd}
+
+const blockCompressionLevel = zstd.DefaultCompression
+
 // 2019-12-15: removed column 'auxdata blob' from 'CREATE TABLE' statement. It was not explicitly removed from databases and may continue to exist with empty entries in some old databases.
+// Databases created before the 'format' column was introduced are upgraded by BlockInit.
 var blockSchema = []string{
 	`CREATE TABLE IF NOT EXISTS blocks (
 		rnd integer primary key,
 		proto text,
 		hdrdata blob,
 		blkdata blob,
-		certdata blob)`,
+		certdata blob,
+		format integer NOT NULL DEFAULT 0)`,
+}
+
+// blockIndexes are created separately from blockSchema since index names are global
+// and cannot follow the table renaming done during catchpoint catchup.
+var blockIndexes = []string{
+	`CREATE INDEX IF NOT EXISTS blocks_format_idx ON blocks (format, rnd)`,
 }
 
 var blockResetExprs = []string{
@@ -53,6 +77,18 @@ func BlockInit(tx *sql.Tx, initBlocks []bookkeeping.Block) error {
 		}
 	}
 
+	err := blockEnsureFormatColumn(tx)
+	if err != nil {
+		return fmt.Errorf("blockdb blockInit could not add format column %v", err)
+	}
+
+	for _, indexCreate := range blockIndexes {
+		_, err = tx.Exec(indexCreate)
+		if err != nil {
+			return fmt.Errorf("blockdb blockInit could not create index %v", err)
+		}
+	}
+
 	next, err := BlockNext(tx)
 	if err != nil {
 		return err
@@ -74,6 +110,21 @@ func BlockInit(tx *sql.Tx, initBlocks []bookkeeping.Block) error {
 	return nil
 }
 
+// blockEnsureFormatColumn adds the format column to blocks tables created by older versions.
+// Existing rows default to BlockFormatRaw, which is how they were written.
+func blockEnsureFormatColumn(tx *sql.Tx) error {
+	var count int
+	err := tx.QueryRow("SELECT COUNT(*) FROM pragma_table_info('blocks') WHERE name='format'").Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	_, err = tx.Exec("ALTER TABLE blocks ADD COLUMN format integer NOT NULL DEFAULT 0")
+	return err
+}
+
 // BlockResetDB resets blockdb
 func BlockResetDB(tx *sql.Tx) error {
 	for _, stmt := range blockResetExprs {
@@ -88,7 +139,8 @@ func BlockResetDB(tx *sql.Tx) error {
 // BlockGet retrieves a block by a round number
 func BlockGet(tx *sql.Tx, rnd basics.Round) (blk bookkeeping.Block, err error) {
 	var buf []byte
-	err = tx.QueryRow("SELECT blkdata FROM blocks WHERE rnd=?", rnd).Scan(&buf)
+	var format BlockFormat
+	err = tx.QueryRow("SELECT blkdata, format FROM blocks WHERE rnd=?", rnd).Scan(&buf, &format)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			err = ledgercore.ErrNoEntry{Round: rnd}
@@ -97,6 +149,10 @@ func BlockGet(tx *sql.Tx, rnd basics.Round) (blk bookkeeping.Block, err error) {
 		return
 	}
 
+	buf, err = DecompressBlockData(format, buf)
+	if err != nil {
+		return
+	}
 	err = protocol.Decode(buf, &blk)
 	return
 }
@@ -119,7 +175,22 @@ func BlockGetHdr(tx *sql.Tx, rnd basics.Round) (hdr bookkeeping.BlockHeader, err
 
 // BlockGetEncodedCert retrieves raw block and cert by a round number
 func BlockGetEncodedCert(tx *sql.Tx, rnd basics.Round) (blk []byte, cert []byte, err error) {
-	err = tx.QueryRow("SELECT blkdata, certdata FROM blocks WHERE rnd=?", rnd).Scan(&blk, &cert)
+	blk, cert, format, err := BlockGetStoredCert(tx, rnd)
+	if err != nil {
+		return
+	}
+	blk, err = DecompressBlockData(format, blk)
+	if err != nil {
+		return
+	}
+	cert, err = DecompressBlockData(format, cert)
+	return
+}
+
+// BlockGetStoredCert retrieves block and cert by a round number as they are stored,
+// along with the format they are stored in.
+func BlockGetStoredCert(tx *sql.Tx, rnd basics.Round) (blk []byte, cert []byte, format BlockFormat, err error) {
+	err = tx.QueryRow("SELECT blkdata, certdata, format FROM blocks WHERE rnd=?", rnd).Scan(&blk, &cert, &format)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			err = ledgercore.ErrNoEntry{Round: rnd}
@@ -153,6 +224,11 @@ func BlockGetCert(tx *sql.Tx, rnd basics.Round) (blk bookkeeping.Block, cert agr
 
 // BlockPut stores block and certificate
 func BlockPut(tx *sql.Tx, blk bookkeeping.Block, cert agreement.Certificate) error {
+	return BlockPutWithFormat(tx, blk, cert, BlockFormatRaw)
+}
+
+// BlockPutWithFormat stores block and certificate using the given format
+func BlockPutWithFormat(tx *sql.Tx, blk bookkeeping.Block, cert agreement.Certificate, format BlockFormat) error {
 	var max sql.NullInt64
 	err := tx.QueryRow("SELECT MAX(rnd) FROM blocks").Scan(&max)
 	if err != nil {
@@ -171,16 +247,119 @@ func BlockPut(tx *sql.Tx, blk bookkeeping.Block, cert agreement.Certificate) err
 		}
 	}
 
-	_, err = tx.Exec("INSERT INTO blocks (rnd, proto, hdrdata, blkdata, certdata) VALUES (?, ?, ?, ?, ?)",
+	blkdata, err := CompressBlockData(format, protocol.Encode(&blk))
+	if err != nil {
+		return err
+	}
+	certdata, err := CompressBlockData(format, protocol.Encode(&cert))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO blocks (rnd, proto, hdrdata, blkdata, certdata, format) VALUES (?, ?, ?, ?, ?, ?)",
 		blk.Round(),
 		blk.CurrentProtocol,
 		protocol.Encode(&blk.BlockHeader),
-		protocol.Encode(&blk),
-		protocol.Encode(&cert),
+		blkdata,
+		certdata,
+		format,
 	)
 	return err
 }
 
+// BlockConvertFormat rewrites up to maxRows blocks which are not stored in the given format,
+// oldest first, and returns the number of blocks converted.
+func BlockConvertFormat(tx *sql.Tx, format BlockFormat, maxRows int) (converted int, err error) {
+	type storedBlock struct {
+		rnd    basics.Round
+		blk    []byte
+		cert   []byte
+		format BlockFormat
+	}
+	var stored []storedBlock
+	for _, from := range blockFormats {
+		if from == format || len(stored) >= maxRows {
+			continue
+		}
+		var rows *sql.Rows
+		rows, err = tx.Query("SELECT rnd, blkdata, certdata FROM blocks WHERE format=? ORDER BY rnd LIMIT ?", from, maxRows-len(stored))
+		if err != nil {
+			return 0, err
+		}
+		for rows.Next() {
+			sb := storedBlock{format: from}
+			err = rows.Scan(&sb.rnd, &sb.blk, &sb.cert)
+			if err != nil {
+				rows.Close()
+				return 0, err
+			}
+			stored = append(stored, sb)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for _, sb := range stored {
+		sb.blk, err = convertBlockData(sb.format, format, sb.blk)
+		if err != nil {
+			return converted, fmt.Errorf("block %d: %w", sb.rnd, err)
+		}
+		sb.cert, err = convertBlockData(sb.format, format, sb.cert)
+		if err != nil {
+			return converted, fmt.Errorf("block %d: %w", sb.rnd, err)
+		}
+		_, err = tx.Exec("UPDATE blocks SET blkdata=?, certdata=?, format=? WHERE rnd=?", sb.blk, sb.cert, format, sb.rnd)
+		if err != nil {
+			return converted, err
+		}
+		converted++
+	}
+	return converted, nil
+}
+
+func convertBlockData(from BlockFormat, to BlockFormat, data []byte) ([]byte, error) {
+	raw, err := DecompressBlockData(from, data)
+	if err != nil {
+		return nil, err
+	}
+	return CompressBlockData(to, raw)
+}
+
+// CompressBlockData converts msgpack-encoded block or certificate data into the given format.
+// A nil input, such as a missing certificate, is returned as nil.
+func CompressBlockData(format BlockFormat, data []byte) ([]byte, error) {
+	switch format {
+	case BlockFormatRaw:
+		return data, nil
+	case BlockFormatZstd:
+		if data == nil {
+			return nil, nil
+		}
+		return zstd.CompressLevel(nil, data, blockCompressionLevel)
+	default:
+		return nil, fmt.Errorf("unknown block format %d", format)
+	}
+}
+
+// DecompressBlockData converts block or certificate data stored in the given format back into msgpack.
+// A nil input, such as a missing certificate, is returned as nil.
+func DecompressBlockData(format BlockFormat, data []byte) ([]byte, error) {
+	switch format {
+	case BlockFormatRaw:
+		return data, nil
+	case BlockFormatZstd:
+		if data == nil {
+			return nil, nil
+		}
+		return zstd.Decompress(nil, data)
+	default:
+		return nil, fmt.Errorf("unknown block format %d", format)
+	}
+}
+
 // BlockNext returns the next expected round number
 func BlockNext(tx *sql.Tx) (basics.Round, error) {
 	var max sql.NullInt64
@@ -288,6 +467,12 @@ func BlockCompleteCatchup(tx *sql.Tx) (err error) {
 	if err != nil {
 		return err
 	}
+	for _, stmt := range blockIndexes {
+		_, err = tx.Exec(stmt)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
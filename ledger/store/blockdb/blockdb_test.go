@@ -174,3 +174,92 @@ func TestBlockDBAppend(t *testing.T) {
 		checkBlockDB(t, tx, blocks)
 	}
 }
+
+func TestBlockDBCompressed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbs, _ := storetesting.DbOpenTest(t, true)
+	storetesting.SetDbLogging(t, dbs)
+	defer dbs.Close()
+
+	tx, err := dbs.Wdb.Handle.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	blocks := randomInitChain(protocol.ConsensusCurrentVersion, 10)
+
+	err = BlockInit(tx, blockChainBlocks(blocks))
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		blkent := randomBlock(basics.Round(len(blocks)))
+		err = BlockPutWithFormat(tx, blkent.block, blkent.cert, BlockFormatZstd)
+		require.NoError(t, err)
+
+		blocks = append(blocks, blkent)
+		checkBlockDB(t, tx, blocks)
+	}
+
+	_, _, format, err := BlockGetStoredCert(tx, 15)
+	require.NoError(t, err)
+	require.Equal(t, BlockFormatZstd, format)
+
+	// convert everything to compressed storage, in batches.
+	converted, err := BlockConvertFormat(tx, BlockFormatZstd, 7)
+	require.NoError(t, err)
+	require.Equal(t, 7, converted)
+	converted, err = BlockConvertFormat(tx, BlockFormatZstd, 7)
+	require.NoError(t, err)
+	require.Equal(t, 3, converted)
+	converted, err = BlockConvertFormat(tx, BlockFormatZstd, 7)
+	require.NoError(t, err)
+	require.Equal(t, 0, converted)
+	checkBlockDB(t, tx, blocks)
+
+	// and back again.
+	converted, err = BlockConvertFormat(tx, BlockFormatRaw, 100)
+	require.NoError(t, err)
+	require.Equal(t, len(blocks), converted)
+	checkBlockDB(t, tx, blocks)
+
+	blk, _, format, err := BlockGetStoredCert(tx, 15)
+	require.NoError(t, err)
+	require.Equal(t, BlockFormatRaw, format)
+	require.Equal(t, protocol.Encode(&blocks[15].block), blk)
+}
+
+func TestBlockDBFormatUpgrade(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbs, _ := storetesting.DbOpenTest(t, true)
+	storetesting.SetDbLogging(t, dbs)
+	defer dbs.Close()
+
+	tx, err := dbs.Wdb.Handle.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	// a blocks table as created before the format column was introduced.
+	_, err = tx.Exec(`CREATE TABLE blocks (
+		rnd integer primary key,
+		proto text,
+		hdrdata blob,
+		blkdata blob,
+		certdata blob)`)
+	require.NoError(t, err)
+	blocks := randomInitChain(protocol.ConsensusCurrentVersion, 3)
+	for _, e := range blocks {
+		_, err = tx.Exec("INSERT INTO blocks (rnd, proto, hdrdata, blkdata, certdata) VALUES (?, ?, ?, ?, ?)",
+			e.block.Round(), e.block.CurrentProtocol, protocol.Encode(&e.block.BlockHeader), protocol.Encode(&e.block), protocol.Encode(&e.cert))
+		require.NoError(t, err)
+	}
+
+	err = BlockInit(tx, nil)
+	require.NoError(t, err)
+	checkBlockDB(t, tx, blocks)
+
+	converted, err := BlockConvertFormat(tx, BlockFormatZstd, 100)
+	require.NoError(t, err)
+	require.Equal(t, len(blocks), converted)
+	checkBlockDB(t, tx, blocks)
+}
@@ -18,6 +18,7 @@ package trackerdb
 
 import (
 	"context"
+	"encoding/binary"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
@@ -251,3 +252,87 @@ type SpVerificationCtxReaderWriter interface {
 	SpVerificationCtxReader
 	SpVerificationCtxWriter
 }
+
+// AccountHistoryKind identifies the kind of ledger state an account history record holds
+type AccountHistoryKind uint8
+
+const (
+	// AccountHistoryAccount records hold a BaseAccountData, keyed by address
+	AccountHistoryAccount AccountHistoryKind = iota + 1
+	// AccountHistoryAsset records hold a ResourcesData, keyed by AccountHistoryResourceKey
+	AccountHistoryAsset
+	// AccountHistoryApp records hold a ResourcesData, keyed by AccountHistoryResourceKey
+	AccountHistoryApp
+	// AccountHistoryKv records hold the value of a kv pair, keyed by the kv key
+	AccountHistoryKv
+)
+
+// AccountHistoryResourceKey returns the account history key of an account's asset or app resource
+func AccountHistoryResourceKey(addr basics.Address, aidx basics.CreatableIndex) []byte {
+	key := make([]byte, len(addr)+8)
+	copy(key, addr[:])
+	binary.BigEndian.PutUint64(key[len(addr):], uint64(aidx))
+	return key
+}
+
+// AccountHistoryReader is a reader abstraction for the account history tracker
+// Use with SnapshotScope
+type AccountHistoryReader interface {
+	// AccountHistoryRounds returns the earliest round the history can answer for,
+	// and the round up to which it has been recorded. ok is false if no history is kept.
+	AccountHistoryRounds(ctx context.Context) (base basics.Round, latest basics.Round, ok bool, err error)
+	// LookupAccountHistory returns the encoded value of key as of rnd, or nil if
+	// the key did not exist at rnd.
+	LookupAccountHistory(ctx context.Context, kind AccountHistoryKind, key []byte, rnd basics.Round) (data []byte, err error)
+}
+
+// AccountHistoryWriter is a writer abstraction for the account history tracker
+// Use with TransactionScope
+type AccountHistoryWriter interface {
+	// ResetAccountHistory creates the history table if needed and discards all its
+	// records, so that the history starts over at rnd.
+	ResetAccountHistory(ctx context.Context, rnd basics.Round) error
+	// WriteAccountHistoryBaseline records the current value of key as of rnd,
+	// unless the history already has records of the key.
+	WriteAccountHistoryBaseline(ctx context.Context, kind AccountHistoryKind, key []byte, rnd basics.Round) error
+	WriteAccountHistory(ctx context.Context, kind AccountHistoryKind, key []byte, rnd basics.Round, data []byte) error
+	UpdateAccountHistoryRound(ctx context.Context, rnd basics.Round) error
+	// PruneAccountHistory deletes records that are not needed for answering queries
+	// on rounds at or after base, and moves the history base round up to base.
+	PruneAccountHistory(ctx context.Context, base basics.Round) error
+}
+
+// AccountHistoryReaderWriter is AccountHistoryReader+AccountHistoryWriter
+// Use with TransactionScope
+type AccountHistoryReaderWriter interface {
+	AccountHistoryReader
+	AccountHistoryWriter
+}
+
+// TrackerPluginReader is a reader abstraction for the key/value table of a tracker plugin
+// Use with SnapshotScope
+type TrackerPluginReader interface {
+	// TrackerPluginRound returns the round the plugin's table was last committed for.
+	// ok is false if the plugin has not been initialized yet.
+	TrackerPluginRound(ctx context.Context) (rnd basics.Round, ok bool, err error)
+	Get(ctx context.Context, key []byte) (value []byte, found bool, err error)
+	// Range calls fn on the keys starting with prefix in ascending order, until fn returns false.
+	Range(ctx context.Context, prefix []byte, fn func(key []byte, value []byte) bool) error
+}
+
+// TrackerPluginWriter is a writer abstraction for the key/value table of a tracker plugin
+// Use with TransactionScope
+type TrackerPluginWriter interface {
+	// InitTrackerPlugin creates the plugin's table, if it does not exist yet.
+	InitTrackerPlugin(ctx context.Context) error
+	SetTrackerPluginRound(ctx context.Context, rnd basics.Round) error
+	Put(ctx context.Context, key []byte, value []byte) error
+	Delete(ctx context.Context, key []byte) error
+}
+
+// TrackerPluginReaderWriter is TrackerPluginReader+TrackerPluginWriter
+// Use with TransactionScope
+type TrackerPluginReaderWriter interface {
+	TrackerPluginReader
+	TrackerPluginWriter
+}
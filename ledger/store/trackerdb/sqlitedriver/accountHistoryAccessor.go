@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+type accountHistoryReader struct {
+	q db.Queryable
+}
+
+type accountHistoryWriter struct {
+	e db.Executable
+}
+
+type accountHistoryReaderWriter struct {
+	accountHistoryReader
+	accountHistoryWriter
+}
+
+func makeAccountHistoryReader(q db.Queryable) *accountHistoryReader {
+	return &accountHistoryReader{q: q}
+}
+
+func makeAccountHistoryReaderWriter(q db.Queryable, e db.Executable) *accountHistoryReaderWriter {
+	return &accountHistoryReaderWriter{
+		accountHistoryReader{q: q},
+		accountHistoryWriter{e: e},
+	}
+}
+
+// AccountHistoryRounds returns the range of rounds covered by the account history.
+func (r *accountHistoryReader) AccountHistoryRounds(ctx context.Context) (base basics.Round, latest basics.Round, ok bool, err error) {
+	err = r.q.QueryRowContext(ctx, "SELECT rnd FROM acctrounds WHERE id='historybase'").Scan(&base)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, false, nil
+	}
+	if err != nil {
+		return
+	}
+	err = r.q.QueryRowContext(ctx, "SELECT rnd FROM acctrounds WHERE id='historyround'").Scan(&latest)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, false, nil
+	}
+	return base, latest, err == nil, err
+}
+
+// LookupAccountHistory returns the value of key as of round rnd.
+func (r *accountHistoryReader) LookupAccountHistory(ctx context.Context, kind trackerdb.AccountHistoryKind, key []byte, rnd basics.Round) (data []byte, err error) {
+	// the latest record at or before rnd holds the value as of rnd. Failing that,
+	// the key did not change between rnd and its earliest record, which holds the
+	// value the key had before that change.
+	err = r.q.QueryRowContext(ctx, "SELECT data FROM accounthistory WHERE kind=? AND key=? AND rnd<=? ORDER BY rnd DESC LIMIT 1", kind, key, rnd).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = r.q.QueryRowContext(ctx, "SELECT data FROM accounthistory WHERE kind=? AND key=? AND rnd>? ORDER BY rnd ASC LIMIT 1", kind, key, rnd).Scan(&data)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		// the key has not changed since the history base round.
+		var query string
+		var args []interface{}
+		query, args, err = currentValueQuery(kind, key)
+		if err != nil {
+			return nil, err
+		}
+		err = r.q.QueryRowContext(ctx, query, args...).Scan(&data)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+	}
+	return data, err
+}
+
+// currentValueQuery returns a query selecting the current value of key from the accounts tables.
+func currentValueQuery(kind trackerdb.AccountHistoryKind, key []byte) (query string, args []interface{}, err error) {
+	switch kind {
+	case trackerdb.AccountHistoryAccount:
+		return "SELECT data FROM accountbase WHERE address=?", []interface{}{key}, nil
+	case trackerdb.AccountHistoryAsset, trackerdb.AccountHistoryApp:
+		if len(key) != len(basics.Address{})+8 {
+			return "", nil, fmt.Errorf("invalid resource history key %x", key)
+		}
+		aidx := binary.BigEndian.Uint64(key[len(basics.Address{}):])
+		return "SELECT resources.data FROM resources JOIN accountbase ON resources.addrid = accountbase.rowid WHERE accountbase.address=? AND resources.aidx=?",
+			[]interface{}{key[:len(basics.Address{})], aidx}, nil
+	case trackerdb.AccountHistoryKv:
+		return "SELECT value FROM kvstore WHERE key=?", []interface{}{key}, nil
+	}
+	return "", nil, fmt.Errorf("unknown account history kind %d", kind)
+}
+
+// ResetAccountHistory discards all account history records, starting the history over at rnd.
+func (w *accountHistoryWriter) ResetAccountHistory(ctx context.Context, rnd basics.Round) error {
+	_, err := w.e.ExecContext(ctx, createAccountHistoryTableQuery)
+	if err != nil {
+		return err
+	}
+	_, err = w.e.ExecContext(ctx, "DELETE FROM accounthistory")
+	if err != nil {
+		return err
+	}
+	_, err = w.e.ExecContext(ctx, "INSERT OR REPLACE INTO acctrounds(id, rnd) VALUES('historybase', ?)", rnd)
+	if err != nil {
+		return err
+	}
+	return w.UpdateAccountHistoryRound(ctx, rnd)
+}
+
+// WriteAccountHistoryBaseline records the current value of key as of round rnd, if key has no records yet.
+func (w *accountHistoryWriter) WriteAccountHistoryBaseline(ctx context.Context, kind trackerdb.AccountHistoryKind, key []byte, rnd basics.Round) error {
+	query, args, err := currentValueQuery(kind, key)
+	if err != nil {
+		return err
+	}
+	args = append([]interface{}{kind, key, rnd}, args...)
+	args = append(args, kind, key)
+	_, err = w.e.ExecContext(ctx, "INSERT INTO accounthistory(kind, key, rnd, data) SELECT ?, ?, ?, ("+query+
+		") WHERE NOT EXISTS (SELECT 1 FROM accounthistory WHERE kind=? AND key=?)", args...)
+	return err
+}
+
+// WriteAccountHistory records the value of key as of round rnd. A nil data records that the key does not exist.
+func (w *accountHistoryWriter) WriteAccountHistory(ctx context.Context, kind trackerdb.AccountHistoryKind, key []byte, rnd basics.Round, data []byte) error {
+	_, err := w.e.ExecContext(ctx, "INSERT OR REPLACE INTO accounthistory(kind, key, rnd, data) VALUES(?, ?, ?, ?)", kind, key, rnd, data)
+	return err
+}
+
+// UpdateAccountHistoryRound sets the round up to which the account history has been recorded.
+func (w *accountHistoryWriter) UpdateAccountHistoryRound(ctx context.Context, rnd basics.Round) error {
+	_, err := w.e.ExecContext(ctx, "INSERT OR REPLACE INTO acctrounds(id, rnd) VALUES('historyround', ?)", rnd)
+	return err
+}
+
+// PruneAccountHistory drops the account history before round base.
+func (w *accountHistoryWriter) PruneAccountHistory(ctx context.Context, base basics.Round) error {
+	// keep the latest record at or before base for every key, as it holds the value as of base.
+	_, err := w.e.ExecContext(ctx, `DELETE FROM accounthistory WHERE rnd < ? AND rnd < (
+		SELECT MAX(h.rnd) FROM accounthistory h WHERE h.kind = accounthistory.kind AND h.key = accounthistory.key AND h.rnd <= ?)`, base, base)
+	if err != nil {
+		return err
+	}
+	_, err = w.e.ExecContext(ctx, "UPDATE acctrounds SET rnd=? WHERE id='historybase' AND rnd<?", base, base)
+	return err
+}
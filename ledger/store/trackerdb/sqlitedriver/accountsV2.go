@@ -886,12 +886,16 @@ func (w *accountsV2Writer) AccountsPruneOnlineRoundParams(deleteBeforeRound basi
 }
 
 func (w *accountsV2Writer) AccountsReset(ctx context.Context) error {
+	err := dropTrackerPluginTables(ctx, w.e)
+	if err != nil {
+		return err
+	}
 	for _, stmt := range accountsResetExprs {
 		_, err := w.e.ExecContext(ctx, stmt)
 		if err != nil {
 			return err
 		}
 	}
-	_, err := db.SetUserVersion(ctx, w.e, 0)
+	_, err = db.SetUserVersion(ctx, w.e, 0)
 	return err
 }
@@ -154,6 +154,25 @@ const createStateProofVerificationTableQuery = `
 	lastattestedround integer primary key NOT NULL,
 	verificationcontext blob NOT NULL)`
 
+// Table containing the values accounts, resources and kv pairs held over time.
+// Each row holds the value of key as of round rnd; a NULL data column means
+// that the key did not exist at that round. The table is only created on
+// nodes which keep account history.
+const createAccountHistoryTableQuery = `
+	CREATE TABLE IF NOT EXISTS accounthistory (
+	kind integer NOT NULL,
+	key blob NOT NULL,
+	rnd integer NOT NULL,
+	data blob,
+	PRIMARY KEY (kind, key, rnd) ) WITHOUT ROWID`
+
+// Table containing the round each tracker plugin was last committed for. Each
+// plugin keeps its own state in a key/value table named by trackerPluginTable.
+const createTrackerPluginsTable = `
+	CREATE TABLE IF NOT EXISTS trackerplugins (
+	name text primary key NOT NULL,
+	rnd integer NOT NULL)`
+
 const createVoteLastValidIndex = `
 	CREATE INDEX IF NOT EXISTS onlineaccounts_votelastvalid_idx
 	ON onlineaccounts ( votelastvalid )`
@@ -174,6 +193,8 @@ var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS catchpointfirststageinfo`,
 	`DROP TABLE IF EXISTS unfinishedcatchpoints`,
 	`DROP TABLE IF EXISTS stateproofverification`,
+	`DROP TABLE IF EXISTS accounthistory`,
+	`DROP TABLE IF EXISTS trackerplugins`,
 }
 
 // accountsInit fills the database using tx with initAccounts if the
@@ -127,6 +127,14 @@ func (s *trackerSQLStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err
 	return
 }
 
+func (s *trackerSQLStore) Backup(ctx context.Context, filename string) error {
+	return s.pair.Wdb.Backup(ctx, filename)
+}
+
+func (s *trackerSQLStore) Restore(ctx context.Context, filename string) error {
+	return s.pair.Wdb.Restore(ctx, filename)
+}
+
 func (s *trackerSQLStore) CleanupTest(dbName string, inMemory bool) {
 	s.pair.Close()
 	if !inMemory {
@@ -208,6 +216,14 @@ func (txs sqlTransactionScope) MakeSpVerificationCtxReaderWriter() trackerdb.SpV
 	return makeStateProofVerificationReaderWriter(txs.tx, txs.tx)
 }
 
+func (txs sqlTransactionScope) MakeAccountHistoryReaderWriter() trackerdb.AccountHistoryReaderWriter {
+	return makeAccountHistoryReaderWriter(txs.tx, txs.tx)
+}
+
+func (txs sqlTransactionScope) MakeTrackerPluginReaderWriter(name string) trackerdb.TrackerPluginReaderWriter {
+	return makeTrackerPluginReaderWriter(name, txs.tx, txs.tx)
+}
+
 func (txs sqlTransactionScope) RunMigrations(ctx context.Context, params trackerdb.Params, log logging.Logger, targetVersion int32) (mgr trackerdb.InitParams, err error) {
 	return RunMigrations(ctx, txs.tx, params, log, targetVersion)
 }
@@ -286,3 +302,11 @@ func (ss sqlSnapshotScope) MakeCatchpointPendingHashesIterator(hashCount int) tr
 func (ss sqlSnapshotScope) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxReader {
 	return makeStateProofVerificationReader(ss.tx)
 }
+
+func (ss sqlSnapshotScope) MakeAccountHistoryReader() trackerdb.AccountHistoryReader {
+	return makeAccountHistoryReader(ss.tx)
+}
+
+func (ss sqlSnapshotScope) MakeTrackerPluginReader(name string) trackerdb.TrackerPluginReader {
+	return makeTrackerPluginReader(name, ss.tx)
+}
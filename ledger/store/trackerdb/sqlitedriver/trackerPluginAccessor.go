@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+type trackerPluginReader struct {
+	name string
+	q    db.Queryable
+}
+
+type trackerPluginWriter struct {
+	name string
+	e    db.Executable
+}
+
+type trackerPluginReaderWriter struct {
+	trackerPluginReader
+	trackerPluginWriter
+}
+
+func makeTrackerPluginReader(name string, q db.Queryable) *trackerPluginReader {
+	return &trackerPluginReader{name: name, q: q}
+}
+
+func makeTrackerPluginReaderWriter(name string, q db.Queryable, e db.Executable) *trackerPluginReaderWriter {
+	return &trackerPluginReaderWriter{
+		trackerPluginReader{name: name, q: q},
+		trackerPluginWriter{name: name, e: e},
+	}
+}
+
+// trackerPluginTable returns the quoted name of the key/value table of the named tracker plugin.
+// Plugin names are restricted by the ledger to lowercase letters, digits and underscores.
+func trackerPluginTable(name string) string {
+	return `"trackerplugin_` + name + `"`
+}
+
+// dropTrackerPluginTables drops the key/value tables of all tracker plugins. The tables are
+// named after their plugins, which may no longer be registered, so they are found in the schema.
+func dropTrackerPluginTables(ctx context.Context, e db.Executable) error {
+	rows, err := e.QueryContext(ctx, `SELECT name FROM sqlite_master WHERE type='table' AND name LIKE 'trackerplugin\_%' ESCAPE '\'`)
+	if err != nil {
+		return err
+	}
+	var tables []string
+	for rows.Next() {
+		var table string
+		err = rows.Scan(&table)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		tables = append(tables, table)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		_, err = e.ExecContext(ctx, `DROP TABLE IF EXISTS "`+table+`"`)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TrackerPluginRound returns the round the plugin was last committed for.
+func (r *trackerPluginReader) TrackerPluginRound(ctx context.Context) (rnd basics.Round, ok bool, err error) {
+	err = r.q.QueryRowContext(ctx, "SELECT rnd FROM trackerplugins WHERE name=?", r.name).Scan(&rnd)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	return rnd, err == nil, err
+}
+
+// Get returns the value stored for key in the plugin's table.
+func (r *trackerPluginReader) Get(ctx context.Context, key []byte) (value []byte, found bool, err error) {
+	err = r.q.QueryRowContext(ctx, "SELECT value FROM "+trackerPluginTable(r.name)+" WHERE key=?", key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	return value, err == nil, err
+}
+
+// Range iterates over the keys of the plugin's table starting with prefix.
+func (r *trackerPluginReader) Range(ctx context.Context, prefix []byte, fn func(key []byte, value []byte) bool) error {
+	start, end := keyPrefixIntervalPreprocessing(prefix)
+	query := "SELECT key, value FROM " + trackerPluginTable(r.name) + " WHERE key >= ?"
+	args := []interface{}{start}
+	if end != nil {
+		query += " AND key < ?"
+		args = append(args, end)
+	}
+	rows, err := r.q.QueryContext(ctx, query+" ORDER BY key", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key, value []byte
+		err = rows.Scan(&key, &value)
+		if err != nil {
+			return err
+		}
+		if !fn(key, value) {
+			return nil
+		}
+	}
+	return rows.Err()
+}
+
+// InitTrackerPlugin creates the plugin's table.
+func (w *trackerPluginWriter) InitTrackerPlugin(ctx context.Context) error {
+	_, err := w.e.ExecContext(ctx, createTrackerPluginsTable)
+	if err != nil {
+		return err
+	}
+	_, err = w.e.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+trackerPluginTable(w.name)+" (key blob primary key, value blob)")
+	return err
+}
+
+// SetTrackerPluginRound sets the round the plugin was last committed for.
+func (w *trackerPluginWriter) SetTrackerPluginRound(ctx context.Context, rnd basics.Round) error {
+	_, err := w.e.ExecContext(ctx, "INSERT OR REPLACE INTO trackerplugins(name, rnd) VALUES(?, ?)", w.name, rnd)
+	return err
+}
+
+// Put stores value for key in the plugin's table.
+func (w *trackerPluginWriter) Put(ctx context.Context, key []byte, value []byte) error {
+	_, err := w.e.ExecContext(ctx, "INSERT OR REPLACE INTO "+trackerPluginTable(w.name)+"(key, value) VALUES(?, ?)", key, value)
+	return err
+}
+
+// Delete removes key from the plugin's table.
+func (w *trackerPluginWriter) Delete(ctx context.Context, key []byte) error {
+	_, err := w.e.ExecContext(ctx, "DELETE FROM "+trackerPluginTable(w.name)+" WHERE key=?", key)
+	return err
+}
@@ -41,6 +41,8 @@ type SnapshotScope interface {
 	MakeCatchpointPendingHashesIterator(hashCount int) CatchpointPendingHashesIter
 
 	MakeSpVerificationCtxReader() SpVerificationCtxReader
+	MakeAccountHistoryReader() AccountHistoryReader
+	MakeTrackerPluginReader(name string) TrackerPluginReader
 }
 
 // TransactionScope is the read/write scope to the store.
@@ -57,6 +59,8 @@ type TransactionScope interface {
 	ResetTransactionWarnDeadline(ctx context.Context, deadline time.Time) (prevDeadline time.Time, err error)
 	Testing() TestTransactionScope
 	MakeSpVerificationCtxReaderWriter() SpVerificationCtxReaderWriter
+	MakeAccountHistoryReaderWriter() AccountHistoryReaderWriter
+	MakeTrackerPluginReaderWriter(name string) TrackerPluginReaderWriter
 }
 
 // BatchFn is the callback lambda used in `Batch`.
@@ -89,6 +93,8 @@ type TrackerStore interface {
 	MakeCatchpointReaderWriter() (CatchpointReaderWriter, error)
 
 	Vacuum(ctx context.Context) (stats db.VacuumStats, err error)
+	Backup(ctx context.Context, filename string) error
+	Restore(ctx context.Context, filename string) error
 	Close()
 	CleanupTest(dbName string, inMemory bool)
 
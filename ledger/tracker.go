@@ -273,6 +273,14 @@ type deferredCommitContext struct {
 		// state proof verification commit information
 		commitContext []verificationCommitContext
 	}
+
+	accountHistory struct {
+		// per-round changes to be recorded in the account history
+		deltas []accountHistoryDelta
+
+		// the round the account history is pruned up to
+		pruneBase basics.Round
+	}
 }
 
 func (dcc deferredCommitContext) newBase() basics.Round {
@@ -0,0 +1,289 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+)
+
+// TrackerPlugin is a ledger tracker implemented outside of the ledger package. A plugin derives
+// state from the blocks added to the ledger, and keeps it in its own table of the tracker
+// database. The table is written in the same transaction that commits the rest of the ledger
+// state, so the plugin's state is always consistent with the ledger's committed round, including
+// after a crash.
+//
+// NewBlock is called while the ledger evaluates new blocks, and may run concurrently with Commit
+// and PostCommit; plugins are responsible for synchronizing their in-memory state.
+type TrackerPlugin interface {
+	// Load is called whenever the ledger is loaded, with the plugin's table and the round its
+	// state was committed for. The plugin should discard any in-memory state it holds.
+	Load(ctx context.Context, table TrackerTable, rnd basics.Round) error
+
+	// Rebuild is called before Load when the plugin's table is behind the ledger, e.g. when
+	// the plugin has just been added, in which case from is 0 and the table is empty. It
+	// should bring the table from round from up to round to, using the blocks of the rounds in
+	// between. Blocks may not be available on non-archival nodes; returning an error fails
+	// loading the ledger. After a fast catchup, the table is not rebuilt but starts out empty
+	// at the catchpoint round.
+	Rebuild(ctx context.Context, table TrackerTable, from basics.Round, to basics.Round, blocks BlockReader) error
+
+	// NewBlock is called for every block added to the ledger after Load, with the changes
+	// the block made to the ledger state.
+	NewBlock(blk bookkeeping.Block, delta ledgercore.StateDelta)
+
+	// Commit writes the plugin's state as of round rnd to its table. Nothing is written if
+	// the ledger commit fails, and Commit is retried with a later round.
+	Commit(ctx context.Context, table TrackerTable, rnd basics.Round) error
+
+	// PostCommit is called once the state written by Commit for round rnd is durable, allowing
+	// the plugin to release its in-memory state of rounds up to rnd.
+	PostCommit(rnd basics.Round)
+
+	// Close is called when the ledger is closed or reloaded.
+	Close()
+}
+
+// BlockReader provides the blocks a TrackerPlugin rebuilds its state from
+type BlockReader interface {
+	Block(rnd basics.Round) (bookkeeping.Block, error)
+}
+
+// TrackerTableReader reads the key/value table of a tracker plugin
+type TrackerTableReader interface {
+	// Get returns the value stored for key, and whether there is one.
+	Get(key []byte) (value []byte, found bool, err error)
+	// Range calls fn on the keys starting with prefix in ascending order, until fn returns false.
+	Range(prefix []byte, fn func(key []byte, value []byte) bool) error
+}
+
+// TrackerTable reads and writes the key/value table of a tracker plugin, within a ledger commit
+type TrackerTable interface {
+	TrackerTableReader
+	Put(key []byte, value []byte) error
+	Delete(key []byte) error
+}
+
+var trackerPluginNameRe = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+// trackerPlugins holds the registered tracker plugin constructors, by plugin name
+var trackerPlugins = struct {
+	mu        deadlock.Mutex
+	factories map[string]func() TrackerPlugin
+}{factories: make(map[string]func() TrackerPlugin)}
+
+// RegisterTrackerPlugin makes a tracker plugin part of every ledger opened afterwards, each of
+// which creates its own instance with newPlugin. The name identifies the plugin's table in the
+// tracker database, so it must not change between releases of the plugin. It may contain up to
+// 64 lowercase letters, digits and underscores. RegisterTrackerPlugin panics if the name is
+// invalid or already registered.
+func RegisterTrackerPlugin(name string, newPlugin func() TrackerPlugin) {
+	if !trackerPluginNameRe.MatchString(name) {
+		panic(fmt.Sprintf("ledger: invalid tracker plugin name %#v", name))
+	}
+
+	trackerPlugins.mu.Lock()
+	defer trackerPlugins.mu.Unlock()
+	if _, ok := trackerPlugins.factories[name]; ok {
+		panic(fmt.Sprintf("ledger: tracker plugin %s is already registered", name))
+	}
+	trackerPlugins.factories[name] = newPlugin
+}
+
+// makeTrackerPlugins instantiates the registered tracker plugins, ordered by name
+func makeTrackerPlugins() []*pluginTracker {
+	trackerPlugins.mu.Lock()
+	defer trackerPlugins.mu.Unlock()
+
+	names := make([]string, 0, len(trackerPlugins.factories))
+	for name := range trackerPlugins.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	plugins := make([]*pluginTracker, len(names))
+	for i, name := range names {
+		plugins[i] = &pluginTracker{name: name, plugin: trackerPlugins.factories[name]()}
+	}
+	return plugins
+}
+
+// pluginTable implements TrackerTable on top of the tracker database
+type pluginTable struct {
+	ctx context.Context
+	rw  trackerdb.TrackerPluginReaderWriter
+}
+
+func (pt pluginTable) Get(key []byte) ([]byte, bool, error) {
+	return pt.rw.Get(pt.ctx, key)
+}
+
+func (pt pluginTable) Range(prefix []byte, fn func(key []byte, value []byte) bool) error {
+	return pt.rw.Range(pt.ctx, prefix, fn)
+}
+
+func (pt pluginTable) Put(key []byte, value []byte) error {
+	return pt.rw.Put(pt.ctx, key, value)
+}
+
+func (pt pluginTable) Delete(key []byte) error {
+	return pt.rw.Delete(pt.ctx, key)
+}
+
+// pluginTableReader implements TrackerTableReader on top of a tracker database snapshot
+type pluginTableReader struct {
+	ctx context.Context
+	r   trackerdb.TrackerPluginReader
+}
+
+func (pt pluginTableReader) Get(key []byte) ([]byte, bool, error) {
+	return pt.r.Get(pt.ctx, key)
+}
+
+func (pt pluginTableReader) Range(prefix []byte, fn func(key []byte, value []byte) bool) error {
+	return pt.r.Range(pt.ctx, prefix, fn)
+}
+
+// pluginTracker adapts a TrackerPlugin to the ledgerTracker interface
+type pluginTracker struct {
+	name   string
+	plugin TrackerPlugin
+}
+
+func (pt *pluginTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	return l.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		rw := tx.MakeTrackerPluginReaderWriter(pt.name)
+		err := rw.InitTrackerPlugin(ctx)
+		if err != nil {
+			return err
+		}
+		rnd, _, err := rw.TrackerPluginRound(ctx)
+		if err != nil {
+			return err
+		}
+		if rnd > dbRound {
+			return fmt.Errorf("tracker plugin %s is at round %d, ahead of the ledger at round %d", pt.name, rnd, dbRound)
+		}
+
+		table := pluginTable{ctx: ctx, rw: rw}
+		if rnd < dbRound {
+			l.trackerLog().Infof("rebuilding tracker plugin %s from round %d to %d", pt.name, rnd, dbRound)
+			err = pt.plugin.Rebuild(ctx, table, rnd, dbRound, l)
+			if err != nil {
+				return fmt.Errorf("tracker plugin %s failed to rebuild from round %d to %d: %w", pt.name, rnd, dbRound, err)
+			}
+		}
+		err = rw.SetTrackerPluginRound(ctx, dbRound)
+		if err != nil {
+			return err
+		}
+		return pt.plugin.Load(ctx, table, dbRound)
+	})
+}
+
+// resetTrackerPlugins empties the tables of the ledger's tracker plugins, starting them at round
+// rnd. It is used by catchpoint catchup, which leaves no blocks before rnd to rebuild them from.
+func (l *Ledger) resetTrackerPlugins(ctx context.Context, tx trackerdb.TransactionScope, rnd basics.Round) error {
+	for _, pt := range l.plugins {
+		rw := tx.MakeTrackerPluginReaderWriter(pt.name)
+		err := rw.InitTrackerPlugin(ctx)
+		if err != nil {
+			return err
+		}
+		err = rw.SetTrackerPluginRound(ctx, rnd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pt *pluginTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	pt.plugin.NewBlock(blk, delta)
+}
+
+func (pt *pluginTracker) committedUpTo(round basics.Round) (minRound, lookback basics.Round) {
+	return round, 0
+}
+
+func (pt *pluginTracker) produceCommittingTask(_ basics.Round, _ basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (pt *pluginTracker) prepareCommit(*deferredCommitContext) error {
+	return nil
+}
+
+func (pt *pluginTracker) commitRound(ctx context.Context, tx trackerdb.TransactionScope, dcc *deferredCommitContext) error {
+	rw := tx.MakeTrackerPluginReaderWriter(pt.name)
+	err := pt.plugin.Commit(ctx, pluginTable{ctx: ctx, rw: rw}, dcc.newBase())
+	if err != nil {
+		return fmt.Errorf("tracker plugin %s failed to commit round %d: %w", pt.name, dcc.newBase(), err)
+	}
+	return rw.SetTrackerPluginRound(ctx, dcc.newBase())
+}
+
+func (pt *pluginTracker) postCommit(_ context.Context, dcc *deferredCommitContext) {
+	pt.plugin.PostCommit(dcc.newBase())
+}
+
+func (pt *pluginTracker) postCommitUnlocked(context.Context, *deferredCommitContext) {
+}
+
+func (pt *pluginTracker) handleUnorderedCommitOrError(*deferredCommitContext) {
+}
+
+func (pt *pluginTracker) close() {
+	pt.plugin.Close()
+}
+
+// TrackerPlugin returns the ledger's instance of the named tracker plugin
+func (l *Ledger) TrackerPlugin(name string) (TrackerPlugin, bool) {
+	for _, pt := range l.plugins {
+		if pt.name == name {
+			return pt.plugin, true
+		}
+	}
+	return nil, false
+}
+
+// ReadTrackerPluginTable calls fn with a reader of the named tracker plugin's table, as committed
+// for the returned round. The reader is only valid while fn runs.
+func (l *Ledger) ReadTrackerPluginTable(name string, fn func(table TrackerTableReader) error) (rnd basics.Round, err error) {
+	if _, ok := l.TrackerPlugin(name); !ok {
+		return 0, fmt.Errorf("tracker plugin %s is not registered", name)
+	}
+	err = l.trackerDB().Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		r := tx.MakeTrackerPluginReader(name)
+		var err0 error
+		rnd, _, err0 = r.TrackerPluginRound(ctx)
+		if err0 != nil {
+			return err0
+		}
+		return fn(pluginTableReader{ctx: ctx, r: r})
+	})
+	return rnd, err
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const txnCountPluginName = "test_txncount"
+
+var txnCountPluginKey = []byte("total")
+
+// txnCountPlugin keeps the total number of transactions in the committed blocks
+type txnCountPlugin struct {
+	mu       sync.Mutex
+	pending  map[basics.Round]uint64
+	rebuilds int
+}
+
+func (p *txnCountPlugin) Load(ctx context.Context, table TrackerTable, rnd basics.Round) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending = make(map[basics.Round]uint64)
+	return nil
+}
+
+func (p *txnCountPlugin) Rebuild(ctx context.Context, table TrackerTable, from basics.Round, to basics.Round, blocks BlockReader) error {
+	p.mu.Lock()
+	p.rebuilds++
+	p.mu.Unlock()
+
+	var count uint64
+	for rnd := from + 1; rnd <= to; rnd++ {
+		blk, err := blocks.Block(rnd)
+		if err != nil {
+			return err
+		}
+		count += uint64(len(blk.Payset))
+	}
+	return addTxnCount(table, count)
+}
+
+func (p *txnCountPlugin) NewBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending[blk.Round()] = uint64(len(blk.Payset))
+}
+
+func (p *txnCountPlugin) Commit(ctx context.Context, table TrackerTable, rnd basics.Round) error {
+	p.mu.Lock()
+	var count uint64
+	for r, c := range p.pending {
+		if r <= rnd {
+			count += c
+		}
+	}
+	p.mu.Unlock()
+	return addTxnCount(table, count)
+}
+
+func (p *txnCountPlugin) PostCommit(rnd basics.Round) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for r := range p.pending {
+		if r <= rnd {
+			delete(p.pending, r)
+		}
+	}
+}
+
+func (p *txnCountPlugin) Close() {
+}
+
+func addTxnCount(table TrackerTable, count uint64) error {
+	total, err := readTxnCount(table)
+	if err != nil {
+		return err
+	}
+	return table.Put(txnCountPluginKey, binary.BigEndian.AppendUint64(nil, total+count))
+}
+
+func readTxnCount(table TrackerTableReader) (uint64, error) {
+	value, found, err := table.Get(txnCountPluginKey)
+	if err != nil || !found {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(value), nil
+}
+
+var registerTxnCountPlugin sync.Once
+
+func TestTrackerPlugin(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	registerTxnCountPlugin.Do(func() {
+		RegisterTrackerPlugin(txnCountPluginName, func() TrackerPlugin { return &txnCountPlugin{} })
+	})
+	require.Panics(t, func() {
+		RegisterTrackerPlugin(txnCountPluginName, func() TrackerPlugin { return &txnCountPlugin{} })
+	})
+	require.Panics(t, func() {
+		RegisterTrackerPlugin("Bad-Name", func() TrackerPlugin { return &txnCountPlugin{} })
+	})
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	plugin, ok := l.TrackerPlugin(txnCountPluginName)
+	require.True(t, ok)
+	p := plugin.(*txnCountPlugin)
+
+	expected := make(map[basics.Round]uint64)
+	var total uint64
+	for i := 1; i <= 8; i++ {
+		eval := nextBlock(t, l)
+		for j := 0; j < i; j++ {
+			txn(t, l, eval, &txntest.Txn{
+				Type:     protocol.PaymentTx,
+				Sender:   addrs[1],
+				Receiver: addrs[2],
+				Amount:   uint64(1000*i + j),
+			})
+		}
+		vb := endBlock(t, l, eval)
+		total += uint64(i)
+		expected[vb.Block().Round()] = total
+	}
+	commitRoundLookback(basics.Round(cfg.MaxAcctLookback), l)
+
+	var count uint64
+	rnd, err := l.ReadTrackerPluginTable(txnCountPluginName, func(table TrackerTableReader) (err error) {
+		count, err = readTxnCount(table)
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, l.LatestTrackerCommitted(), rnd)
+	require.Equal(t, expected[rnd], count)
+
+	// forget the plugin's state, as if it had just been added, and have it rebuilt
+	err = l.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		rw := tx.MakeTrackerPluginReaderWriter(txnCountPluginName)
+		err0 := rw.Delete(ctx, txnCountPluginKey)
+		if err0 != nil {
+			return err0
+		}
+		return rw.SetTrackerPluginRound(ctx, 0)
+	})
+	require.NoError(t, err)
+	rebuilds := p.rebuilds
+	err = l.reloadLedger()
+	require.NoError(t, err)
+	require.Equal(t, rebuilds+1, p.rebuilds)
+
+	rnd, err = l.ReadTrackerPluginTable(txnCountPluginName, func(table TrackerTableReader) (err error) {
+		count, err = readTxnCount(table)
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected[rnd], count)
+
+	// resetting the accounts, as catchpoint catchup does, drops the plugin's table
+	errRollback := errors.New("rollback")
+	err = l.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		arw, err0 := tx.MakeAccountsReaderWriter()
+		if err0 != nil {
+			return err0
+		}
+		err0 = arw.AccountsReset(ctx)
+		if err0 != nil {
+			return err0
+		}
+		_, _, err0 = tx.MakeTrackerPluginReaderWriter(txnCountPluginName).Get(ctx, txnCountPluginKey)
+		require.ErrorContains(t, err0, "no such table")
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+
+	// after a catchpoint catchup the plugin starts out empty at the catchpoint round, and is
+	// not rebuilt from blocks which are not there
+	catchpointRound := l.LatestTrackerCommitted()
+	err = l.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		err0 := tx.MakeTrackerPluginReaderWriter(txnCountPluginName).Delete(ctx, txnCountPluginKey)
+		if err0 != nil {
+			return err0
+		}
+		return l.resetTrackerPlugins(ctx, tx, catchpointRound)
+	})
+	require.NoError(t, err)
+	rebuilds = p.rebuilds
+	err = l.reloadLedger()
+	require.NoError(t, err)
+	require.Equal(t, rebuilds, p.rebuilds)
+
+	rnd, err = l.ReadTrackerPluginTable(txnCountPluginName, func(table TrackerTableReader) (err error) {
+		count, err = readTxnCount(table)
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, catchpointRound, rnd)
+	require.Zero(t, count)
+
+	_, err = l.ReadTrackerPluginTable("missing", func(TrackerTableReader) error { return nil })
+	require.Error(t, err)
+}
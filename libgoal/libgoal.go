@@ -383,6 +383,21 @@ func (c *Client) GenerateAddress(walletHandle []byte) (string, error) {
 	return resp.Address, nil
 }
 
+// DeriveAddress takes a handle to an HD wallet, derives the address at the given
+// BIP32 derivation path, adds it to the wallet and returns the public address
+func (c *Client) DeriveAddress(walletHandle []byte, path string) (string, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return "", err
+	}
+	resp, err := kmd.DeriveKey(walletHandle, path)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Address, nil
+}
+
 // CreateMultisigAccount takes a wallet handle, a list of (nonmultisig) addresses, and a threshold and creates (and returns) a multisig address
 // TODO: Should these be raw public keys instead of addresses so users can't shoot themselves in the foot by passing in a multisig addr? Probably will become irrelevant after CSID changes.
 func (c *Client) CreateMultisigAccount(walletHandle []byte, threshold uint8, addrs []string) (string, error) {
@@ -1133,6 +1148,33 @@ func (c *Client) Catchup(catchpointLabel string) error {
 	return nil
 }
 
+// SaveLedgerSnapshot saves a snapshot of the ledger of a node running in dev mode.
+func (c *Client) SaveLedgerSnapshot(name string) (resp model.LedgerSnapshotResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.SaveLedgerSnapshot(name)
+	}
+	return
+}
+
+// RevertLedgerSnapshot reverts the ledger of a node running in dev mode to a saved snapshot.
+func (c *Client) RevertLedgerSnapshot(name string) (resp model.LedgerSnapshotResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.RevertLedgerSnapshot(name)
+	}
+	return
+}
+
+// LedgerSnapshots lists the ledger snapshots saved on a node running in dev mode.
+func (c *Client) LedgerSnapshots() (resp model.LedgerSnapshotsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.LedgerSnapshots()
+	}
+	return
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
@@ -1298,6 +1340,15 @@ func (c *Client) TransactionProof(txid string, round uint64, hashType crypto.Has
 	return
 }
 
+// StateProof returns the state proof that covers the given round.
+func (c *Client) StateProof(round uint64) (resp model.StateProofResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.StateProofs(round)
+	}
+	return
+}
+
 // LightBlockHeaderProof returns a Merkle proof for a block.
 func (c *Client) LightBlockHeaderProof(round uint64) (resp model.LightBlockHeaderProofResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -26,6 +26,7 @@ import (
 
 const (
 	defaultWalletDriver = "sqlite"
+	hdWalletDriver      = "hd"
 )
 
 // CreateWallet creates a kmd wallet with the specified parameters
@@ -45,6 +46,28 @@ func (c *Client) CreateWallet(name []byte, password []byte, mdk crypto.MasterDer
 	return []byte(resp.Wallet.ID), nil
 }
 
+// CreateHDWallet creates a kmd HD wallet whose keys are derived from a BIP39
+// mnemonic. If mnemonic is empty, kmd generates a new one.
+func (c *Client) CreateHDWallet(name []byte, password []byte, mnemonic string, passphrase string) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the wallet
+	var resp kmdapi.APIV1POSTWalletResponse
+	if mnemonic == "" {
+		resp, err = kmd.CreateWallet(name, hdWalletDriver, password, crypto.MasterDerivationKey{})
+	} else {
+		resp, err = kmd.CreateWalletFromMnemonic(name, hdWalletDriver, password, mnemonic, passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Wallet.ID), nil
+}
+
 // GetWalletHandleToken inits the wallet with the given id, returning a wallet handle token
 func (c *Client) GetWalletHandleToken(wid, pw []byte) ([]byte, error) {
 	kmd, err := c.ensureKmdClient()
@@ -220,3 +243,17 @@ func (c *Client) ExportMasterDerivationKey(wh []byte, pw []byte) (mdk crypto.Mas
 	// Return the mdk from the response
 	return resp.MasterDerivationKey, nil
 }
+
+// ExportMnemonic returns the BIP39 mnemonic of the given HD wallet
+func (c *Client) ExportMnemonic(wh []byte, pw []byte) (string, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := kmd.ExportMnemonic(wh, pw)
+	if err != nil {
+		return "", err
+	}
+	return resp.BIP39Mnemonic, nil
+}
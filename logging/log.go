@@ -385,6 +385,9 @@ func (l logger) EnableTelemetry(cfg TelemetryConfig) (err error) {
 
 func (l logger) UpdateTelemetryURI(uri string) (err error) {
 	err = l.loggerState.telemetry.hook.UpdateHookURI(uri)
+	if err == nil {
+		err = l.loggerState.telemetry.updateOTLPURI(uri)
+	}
 	if err == nil {
 		l.loggerState.telemetry.telemetryConfig.URI = uri
 	}
@@ -0,0 +1,561 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// otlpExportInterval is how often metrics and finished spans are pushed to the collector
+var otlpExportInterval = 30 * time.Second
+
+const otlpRequestTimeout = 10 * time.Second
+
+const (
+	otlpLogsPath    = "/v1/logs"
+	otlpMetricsPath = "/v1/metrics"
+	otlpTracesPath  = "/v1/traces"
+)
+
+// The types below mirror the OTLP/JSON encoding of the opentelemetry-proto
+// messages. Only the fields we populate are declared.
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string         `json:"timeUnixNano"`
+	SeverityNumber int            `json:"severityNumber"`
+	SeverityText   string         `json:"severityText"`
+	Body           otlpAnyValue   `json:"body"`
+	Attributes     []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpLogsRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpNumberDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	AsDouble          float64        `json:"asDouble"`
+}
+
+type otlpGauge struct {
+	DataPoints []otlpNumberDataPoint `json:"dataPoints"`
+}
+
+type otlpSum struct {
+	DataPoints             []otlpNumberDataPoint `json:"dataPoints"`
+	AggregationTemporality int                   `json:"aggregationTemporality"`
+	IsMonotonic            bool                  `json:"isMonotonic"`
+}
+
+// fixed64 fields are encoded as strings in OTLP/JSON
+type otlpHistogramDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	Count             string         `json:"count"`
+	Sum               float64        `json:"sum"`
+	BucketCounts      []string       `json:"bucketCounts"`
+	ExplicitBounds    []float64      `json:"explicitBounds"`
+}
+
+type otlpHistogram struct {
+	DataPoints             []otlpHistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                      `json:"aggregationTemporality"`
+}
+
+type otlpValueAtQuantile struct {
+	Quantile float64 `json:"quantile"`
+	Value    float64 `json:"value"`
+}
+
+type otlpSummaryDataPoint struct {
+	Attributes        []otlpKeyValue        `json:"attributes,omitempty"`
+	StartTimeUnixNano string                `json:"startTimeUnixNano"`
+	TimeUnixNano      string                `json:"timeUnixNano"`
+	Count             string                `json:"count"`
+	Sum               float64               `json:"sum"`
+	QuantileValues    []otlpValueAtQuantile `json:"quantileValues,omitempty"`
+}
+
+type otlpSummary struct {
+	DataPoints []otlpSummaryDataPoint `json:"dataPoints"`
+}
+
+// otlpMetric holds exactly one of Gauge, Sum, Histogram and Summary
+type otlpMetric struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Gauge       *otlpGauge     `json:"gauge,omitempty"`
+	Sum         *otlpSum       `json:"sum,omitempty"`
+	Histogram   *otlpHistogram `json:"histogram,omitempty"`
+	Summary     *otlpSummary   `json:"summary,omitempty"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   otlpScope    `json:"scope"`
+	Metrics []otlpMetric `json:"metrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     otlpResource       `json:"resource"`
+	ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpMetricsRequest struct {
+	ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpTracesRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+	otlpStatusOk         = 1
+	otlpStatusError      = 2
+
+	otlpAggregationTemporalityCumulative = 2
+)
+
+// otlpExporter posts OTLP/JSON payloads to a collector
+type otlpExporter struct {
+	client   *http.Client
+	endpoint string
+	headers  map[string]string
+	resource otlpResource
+	scope    otlpScope
+}
+
+func makeOTLPExporter(cfg TelemetryConfig) (*otlpExporter, error) {
+	u, err := url.Parse(cfg.URI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint '%s' : %w", cfg.URI, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid OTLP endpoint '%s' : scheme must be http or https, OTLP over gRPC is not supported", cfg.URI)
+	}
+	headers, err := parseOTLPHeaders(cfg.OTLPHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := otlpResource{Attributes: []otlpKeyValue{
+		otlpAttribute("service.name", "algod"),
+		otlpAttribute("service.version", cfg.Version),
+		otlpAttribute("service.instance.id", cfg.getInstanceName()),
+		otlpAttribute("host.id", cfg.getHostGUID()),
+		otlpAttribute("algorand.chain_id", cfg.ChainID),
+		otlpAttribute("algorand.session", cfg.SessionGUID),
+	}}
+
+	return &otlpExporter{
+		client:   &http.Client{Timeout: otlpRequestTimeout},
+		endpoint: strings.TrimSuffix(cfg.URI, "/"),
+		headers:  headers,
+		resource: resource,
+		scope:    otlpScope{Name: "github.com/algorand/go-algorand", Version: cfg.Version},
+	}, nil
+}
+
+// parseOTLPHeaders parses the key=value,key=value format used by OTEL_EXPORTER_OTLP_HEADERS
+func parseOTLPHeaders(headers string) (map[string]string, error) {
+	parsed := make(map[string]string)
+	for _, pair := range strings.Split(headers, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid OTLP header '%s' : expected key=value", pair)
+		}
+		parsed[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return parsed, nil
+}
+
+func (e *otlpExporter) export(path string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, e.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("OTLP export to %s failed with status %d : %s", path, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func (e *otlpExporter) exportLogs(records []otlpLogRecord) error {
+	return e.export(otlpLogsPath, otlpLogsRequest{ResourceLogs: []otlpResourceLogs{{
+		Resource:  e.resource,
+		ScopeLogs: []otlpScopeLogs{{Scope: e.scope, LogRecords: records}},
+	}}})
+}
+
+// exportMetrics exports metric snapshots. Counters, histograms and summaries
+// are cumulative since start.
+func (e *otlpExporter) exportMetrics(snapshots []metrics.MetricSnapshot, start, now time.Time) error {
+	otlpMetrics := make([]otlpMetric, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		otlpMetrics = append(otlpMetrics, makeOTLPMetric(snapshot, otlpTime(start), otlpTime(now)))
+	}
+	return e.export(otlpMetricsPath, otlpMetricsRequest{ResourceMetrics: []otlpResourceMetrics{{
+		Resource:     e.resource,
+		ScopeMetrics: []otlpScopeMetrics{{Scope: e.scope, Metrics: otlpMetrics}},
+	}}})
+}
+
+func makeOTLPMetric(snapshot metrics.MetricSnapshot, start, ts string) otlpMetric {
+	m := otlpMetric{Name: snapshot.Name, Description: snapshot.Description}
+	switch snapshot.Type {
+	case metrics.CounterType:
+		m.Sum = &otlpSum{AggregationTemporality: otlpAggregationTemporalityCumulative, IsMonotonic: true}
+		for _, p := range snapshot.Points {
+			m.Sum.DataPoints = append(m.Sum.DataPoints, otlpNumberDataPoint{
+				Attributes:        otlpLabels(p.Labels),
+				StartTimeUnixNano: start,
+				TimeUnixNano:      ts,
+				AsDouble:          p.Value,
+			})
+		}
+	case metrics.HistogramType:
+		m.Histogram = &otlpHistogram{AggregationTemporality: otlpAggregationTemporalityCumulative}
+		for _, p := range snapshot.Points {
+			counts := make([]string, len(p.BucketCounts))
+			for i, c := range p.BucketCounts {
+				counts[i] = strconv.FormatUint(c, 10)
+			}
+			m.Histogram.DataPoints = append(m.Histogram.DataPoints, otlpHistogramDataPoint{
+				Attributes:        otlpLabels(p.Labels),
+				StartTimeUnixNano: start,
+				TimeUnixNano:      ts,
+				Count:             strconv.FormatUint(p.Count, 10),
+				Sum:               p.Sum,
+				BucketCounts:      counts,
+				ExplicitBounds:    p.Bounds,
+			})
+		}
+	case metrics.SummaryType:
+		m.Summary = &otlpSummary{}
+		for _, p := range snapshot.Points {
+			dp := otlpSummaryDataPoint{
+				Attributes:        otlpLabels(p.Labels),
+				StartTimeUnixNano: start,
+				TimeUnixNano:      ts,
+				Count:             strconv.FormatUint(p.Count, 10),
+				Sum:               p.Sum,
+			}
+			for i, q := range p.Quantiles {
+				// there is no quantile without recent observations, and JSON has no NaN
+				if math.IsNaN(p.QuantileValues[i]) {
+					continue
+				}
+				dp.QuantileValues = append(dp.QuantileValues, otlpValueAtQuantile{Quantile: q, Value: p.QuantileValues[i]})
+			}
+			m.Summary.DataPoints = append(m.Summary.DataPoints, dp)
+		}
+	default:
+		m.Gauge = &otlpGauge{}
+		for _, p := range snapshot.Points {
+			m.Gauge.DataPoints = append(m.Gauge.DataPoints, otlpNumberDataPoint{
+				Attributes:   otlpLabels(p.Labels),
+				TimeUnixNano: ts,
+				AsDouble:     p.Value,
+			})
+		}
+	}
+	return m
+}
+
+// otlpLabels returns metric labels as attributes, sorted by name
+func otlpLabels(labels map[string]string) []otlpKeyValue {
+	if len(labels) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	attrs := make([]otlpKeyValue, len(names))
+	for i, name := range names {
+		attrs[i] = otlpAttribute(name, labels[name])
+	}
+	return attrs
+}
+
+func (e *otlpExporter) exportSpans(spans []otlpSpan) error {
+	return e.export(otlpTracesPath, otlpTracesRequest{ResourceSpans: []otlpResourceSpans{{
+		Resource:   e.resource,
+		ScopeSpans: []otlpScopeSpans{{Scope: e.scope, Spans: spans}},
+	}}})
+}
+
+func otlpTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func otlpAttribute(key string, value interface{}) otlpKeyValue {
+	var v otlpAnyValue
+	switch x := value.(type) {
+	case string:
+		v.StringValue = &x
+	case bool:
+		v.BoolValue = &x
+	case int:
+		s := strconv.FormatInt(int64(x), 10)
+		v.IntValue = &s
+	case int64:
+		s := strconv.FormatInt(x, 10)
+		v.IntValue = &s
+	case uint64:
+		s := strconv.FormatUint(x, 10)
+		v.IntValue = &s
+	case float64:
+		v.DoubleValue = &x
+	case error:
+		s := x.Error()
+		v.StringValue = &s
+	case fmt.Stringer:
+		s := x.String()
+		v.StringValue = &s
+	default:
+		// structured details are flattened to their JSON form
+		var s string
+		if enc, err := json.Marshal(x); err == nil {
+			s = string(enc)
+		} else {
+			s = fmt.Sprintf("%v", x)
+		}
+		v.StringValue = &s
+	}
+	return otlpKeyValue{Key: key, Value: v}
+}
+
+func otlpSeverity(level logrus.Level) int {
+	switch level {
+	case logrus.PanicLevel, logrus.FatalLevel:
+		return 21
+	case logrus.ErrorLevel:
+		return 17
+	case logrus.WarnLevel:
+		return 13
+	case logrus.InfoLevel:
+		return 9
+	case logrus.DebugLevel:
+		return 5
+	default:
+		return 1
+	}
+}
+
+// otlpHook is a logrus hook that exports log entries as OTLP log records
+type otlpHook struct {
+	exporter *otlpExporter
+	levels   []logrus.Level
+}
+
+func createOTLPHook(cfg TelemetryConfig) (hook logrus.Hook, err error) {
+	// Like the elastic hook, a hook without an endpoint is not an error; events
+	// are queued until one is configured.
+	if cfg.URI == "" {
+		return nil, nil
+	}
+	exporter, err := makeOTLPExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &otlpHook{exporter: exporter, levels: makeLevels(cfg.MinLogLevel)}, nil
+}
+
+// Levels is required to implement logrus hook interface
+func (hook *otlpHook) Levels() []logrus.Level {
+	return hook.levels
+}
+
+// Fire is required to implement logrus hook interface
+func (hook *otlpHook) Fire(entry *logrus.Entry) error {
+	body := entry.Message
+	record := otlpLogRecord{
+		TimeUnixNano:   otlpTime(entry.Time),
+		SeverityNumber: otlpSeverity(entry.Level),
+		SeverityText:   strings.ToUpper(entry.Level.String()),
+		Body:           otlpAnyValue{StringValue: &body},
+	}
+	for k, v := range entry.Data {
+		record.Attributes = append(record.Attributes, otlpAttribute(k, v))
+	}
+	return hook.exporter.exportLogs([]otlpLogRecord{record})
+}
+
+// otlpPusher periodically exports the default metrics registry and finished
+// spans to the collector
+type otlpPusher struct {
+	exporter *otlpExporter
+	// start is the start time of the cumulative metrics
+	start time.Time
+	spans *spanBuffer
+	quit  chan struct{}
+	done  chan struct{}
+}
+
+func startOTLPPusher(cfg TelemetryConfig) (*otlpPusher, error) {
+	exporter, err := makeOTLPExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p := &otlpPusher{
+		exporter: exporter,
+		start:    time.Now(),
+		spans:    makeSpanBuffer(maxBufferedSpans),
+		quit:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	setSpanSink(p.spans)
+	go p.run()
+	return p, nil
+}
+
+func (p *otlpPusher) run() {
+	defer close(p.done)
+	ticker := time.NewTicker(otlpExportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.push()
+		case <-p.quit:
+			p.push()
+			return
+		}
+	}
+}
+
+func (p *otlpPusher) push() {
+	snapshots := metrics.DefaultRegistry().Snapshot()
+	if len(snapshots) > 0 {
+		if err := p.exporter.exportMetrics(snapshots, p.start, time.Now()); err != nil {
+			Base().WithFields(Fields{"TelemetryError": true}).Warnf("Unable to export metrics to telemetry : %v", err)
+			telemetryErrors.Inc(nil)
+		}
+	}
+
+	spans := p.spans.drain()
+	if len(spans) > 0 {
+		if err := p.exporter.exportSpans(spans); err != nil {
+			Base().WithFields(Fields{"TelemetryError": true}).Warnf("Unable to export spans to telemetry : %v", err)
+			telemetryErrors.Inc(nil)
+		}
+	}
+}
+
+// Close stops span collection and pushes whatever is left
+func (p *otlpPusher) Close() {
+	setSpanSink(nil)
+	close(p.quit)
+	<-p.done
+}
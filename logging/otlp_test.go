@@ -0,0 +1,319 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// otlpCollector is a stand-in for an OpenTelemetry collector's OTLP/HTTP receiver
+type otlpCollector struct {
+	mu      deadlock.Mutex
+	logs    []otlpLogsRequest
+	metrics []otlpMetricsRequest
+	traces  []otlpTracesRequest
+	headers []http.Header
+}
+
+func (c *otlpCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.headers = append(c.headers, r.Header.Clone())
+
+	var err error
+	switch r.URL.Path {
+	case otlpLogsPath:
+		var req otlpLogsRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+		c.logs = append(c.logs, req)
+	case otlpMetricsPath:
+		var req otlpMetricsRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+		c.metrics = append(c.metrics, req)
+	case otlpTracesPath:
+		var req otlpTracesRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+		c.traces = append(c.traces, req)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Write([]byte("{}"))
+}
+
+func attributeValue(attrs []otlpKeyValue, key string) *otlpAnyValue {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return &kv.Value
+		}
+	}
+	return nil
+}
+
+func TestOTLPExporter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	collector := &otlpCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	counter := metrics.MakeCounter(metrics.MetricName{Name: "algod_otlp_test_total", Description: "test counter"})
+	defer counter.Deregister(nil)
+	counter.AddUint64(3, map[string]string{"peer": "relay"})
+	histogram := metrics.MakeHistogram(metrics.MetricName{Name: "algod_otlp_test_seconds", Description: "test histogram"}, []float64{1, 10})
+	defer histogram.Deregister(nil)
+	histogram.Observe(0.5, nil)
+	histogram.Observe(5, nil)
+	histogram.Observe(50, nil)
+
+	cfg := createTelemetryConfig()
+	cfg.Enable = true
+	cfg.MinLogLevel = logrus.InfoLevel
+	cfg.Exporter = TelemetryExporterOTLP
+	cfg.URI = server.URL + "/"
+	cfg.OTLPHeaders = "authorization=Bearer abc, x-tenant = algod"
+	cfg.ChainID = "test-chain"
+
+	telem, err := makeTelemetryState(cfg, createOTLPHook)
+	a.NoError(err)
+	a.NotNil(telem.otlp)
+
+	l := NewLogger().(logger)
+	l.SetOutput(io.Discard)
+	enableTelemetryState(telem, &l)
+
+	l.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.StartupEvent, telemetryspec.StartupEventDetails{Version: "v1"})
+
+	span := StartSpan("parent")
+	span.SetAttribute("round", uint64(7))
+	child := span.StartChild("child")
+	child.SetError(errors.New("boom"))
+	child.End()
+	span.End()
+
+	l.FlushTelemetry()
+	l.CloseTelemetry()
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	a.Len(collector.logs, 1)
+	resource := collector.logs[0].ResourceLogs[0].Resource
+	a.Equal("algod", *attributeValue(resource.Attributes, "service.name").StringValue)
+	a.Equal("test-chain", *attributeValue(resource.Attributes, "algorand.chain_id").StringValue)
+	record := collector.logs[0].ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	a.Equal("/ApplicationState/Startup", *record.Body.StringValue)
+	a.Equal(9, record.SeverityNumber)
+	a.Contains(*attributeValue(record.Attributes, "details").StringValue, `"Version":"v1"`)
+
+	a.Len(collector.metrics, 1)
+	found := 0
+	for _, m := range collector.metrics[0].ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		switch m.Name {
+		case "algod_otlp_test_total":
+			a.Nil(m.Gauge)
+			a.NotNil(m.Sum)
+			a.True(m.Sum.IsMonotonic)
+			a.Equal(otlpAggregationTemporalityCumulative, m.Sum.AggregationTemporality)
+			a.Len(m.Sum.DataPoints, 1)
+			a.Equal(3.0, m.Sum.DataPoints[0].AsDouble)
+			a.NotEmpty(m.Sum.DataPoints[0].StartTimeUnixNano)
+			a.Equal("relay", *attributeValue(m.Sum.DataPoints[0].Attributes, "peer").StringValue)
+			found++
+		case "algod_otlp_test_seconds":
+			a.NotNil(m.Histogram)
+			a.Equal(otlpAggregationTemporalityCumulative, m.Histogram.AggregationTemporality)
+			a.Len(m.Histogram.DataPoints, 1)
+			dp := m.Histogram.DataPoints[0]
+			a.Equal("3", dp.Count)
+			a.Equal(55.5, dp.Sum)
+			a.Equal([]float64{1, 10}, dp.ExplicitBounds)
+			a.Equal([]string{"1", "1", "1"}, dp.BucketCounts)
+			found++
+		}
+	}
+	a.Equal(2, found)
+
+	a.Len(collector.traces, 1)
+	spans := collector.traces[0].ResourceSpans[0].ScopeSpans[0].Spans
+	a.Len(spans, 2)
+	a.Equal("child", spans[0].Name)
+	a.Equal("parent", spans[1].Name)
+	a.Equal(spans[1].TraceID, spans[0].TraceID)
+	a.Equal(spans[1].SpanID, spans[0].ParentSpanID)
+	a.Empty(spans[1].ParentSpanID)
+	a.Equal(otlpStatusError, spans[0].Status.Code)
+	a.Equal("boom", spans[0].Status.Message)
+	a.Equal("7", *attributeValue(spans[1].Attributes, "round").IntValue)
+
+	for _, h := range collector.headers {
+		a.Equal("Bearer abc", h.Get("authorization"))
+		a.Equal("algod", h.Get("x-tenant"))
+		a.Equal("application/json", h.Get("Content-Type"))
+	}
+
+	// spans are not collected once telemetry is closed
+	a.Nil(StartSpan("after close"))
+}
+
+func TestOTLPLateURI(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	collector := &otlpCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	counter := metrics.MakeCounter(metrics.MetricName{Name: "algod_otlp_late_total", Description: "test counter"})
+	defer counter.Deregister(nil)
+	counter.Inc(nil)
+
+	// the endpoint is only known once telemetry is enabled, e.g. from DNS
+	cfg := createTelemetryConfig()
+	cfg.Enable = true
+	cfg.MinLogLevel = logrus.InfoLevel
+	cfg.Exporter = TelemetryExporterOTLP
+	telem, err := makeTelemetryState(cfg, createOTLPHook)
+	a.NoError(err)
+	a.Nil(telem.otlp)
+
+	l := NewLogger().(logger)
+	l.SetOutput(io.Discard)
+	enableTelemetryState(telem, &l)
+	a.Nil(StartSpan("before uri"))
+
+	a.NoError(l.UpdateTelemetryURI(server.URL))
+	a.NotNil(telem.otlp)
+	a.Equal(server.URL, l.GetTelemetryURI())
+	StartSpan("after uri").End()
+	l.CloseTelemetry()
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	a.Len(collector.metrics, 1)
+	a.Len(collector.traces, 1)
+	spans := collector.traces[0].ResourceSpans[0].ScopeSpans[0].Spans
+	a.Len(spans, 1)
+	a.Equal("after uri", spans[0].Name)
+}
+
+func TestOTLPSpanNoop(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	setSpanSink(nil)
+	span := StartSpan("noop")
+	require.Nil(t, span)
+	// all methods are safe on a nil span
+	span.SetAttribute("k", "v")
+	span.SetError(errors.New("err"))
+	span.StartChild("child").End()
+	span.End()
+}
+
+func TestOTLPConfigErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	cfg := createTelemetryConfig()
+	cfg.Enable = true
+	cfg.Exporter = TelemetryExporterOTLP
+
+	// no endpoint yet: events are queued, nothing is pushed
+	hook, err := createOTLPHook(cfg)
+	a.NoError(err)
+	a.Nil(hook)
+
+	cfg.URI = "localhost:4318"
+	_, err = createOTLPHook(cfg)
+	a.Error(err)
+
+	cfg.URI = "http://localhost:4318"
+	cfg.OTLPHeaders = "novalue"
+	_, err = createOTLPHook(cfg)
+	a.Error(err)
+
+	cfg.Exporter = "splunk"
+	err = EnableTelemetry(cfg, &logger{})
+	a.Error(err)
+}
+
+func TestOTLPExportError(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	cfg := createTelemetryConfig()
+	cfg.URI = server.URL
+	exporter, err := makeOTLPExporter(cfg)
+	require.NoError(t, err)
+	snapshots := []metrics.MetricSnapshot{{Name: "m", Points: []metrics.MetricPoint{{Value: 1}}}}
+	err = exporter.exportMetrics(snapshots, time.Now(), time.Now())
+	require.ErrorContains(t, err, "503")
+}
+
+func TestOTLPSummaryMetric(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	snapshot := metrics.MetricSnapshot{
+		Name: "s",
+		Type: metrics.SummaryType,
+		Points: []metrics.MetricPoint{{
+			Labels:         map[string]string{"b": "2", "a": "1"},
+			Count:          4,
+			Sum:            10,
+			Quantiles:      []float64{0.5, 0.99},
+			QuantileValues: []float64{2, math.NaN()},
+		}},
+	}
+	m := makeOTLPMetric(snapshot, "1", "2")
+	a.Nil(m.Gauge)
+	a.NotNil(m.Summary)
+	a.Len(m.Summary.DataPoints, 1)
+	dp := m.Summary.DataPoints[0]
+	a.Equal("4", dp.Count)
+	a.Equal(10.0, dp.Sum)
+	// NaN quantiles are dropped, they cannot be encoded
+	a.Equal([]otlpValueAtQuantile{{Quantile: 0.5, Value: 2}}, dp.QuantileValues)
+	a.Equal("a", dp.Attributes[0].Key)
+	a.Equal("b", dp.Attributes[1].Key)
+	_, err := json.Marshal(m)
+	a.NoError(err)
+}
@@ -37,7 +37,16 @@ const logBufferDepth = 2
 
 // EnableTelemetry configures and enables telemetry based on the config provided
 func EnableTelemetry(cfg TelemetryConfig, l *logger) (err error) {
-	telemetry, err := makeTelemetryState(cfg, createElasticHook)
+	var factory hookFactory
+	switch cfg.Exporter {
+	case "", TelemetryExporterElastic:
+		factory = createElasticHook
+	case TelemetryExporterOTLP:
+		factory = createOTLPHook
+	default:
+		return fmt.Errorf("unknown telemetry exporter '%s'", cfg.Exporter)
+	}
+	telemetry, err := makeTelemetryState(cfg, factory)
 	if err != nil {
 		return
 	}
@@ -82,6 +91,13 @@ func makeTelemetryState(cfg TelemetryConfig, hookFactory hookFactory) (*telemetr
 			return nil, err
 		}
 		telemetry.hook = createAsyncHookLevels(hook, 32, 100, makeLevels(cfg.MinLogLevel))
+		if cfg.Exporter == TelemetryExporterOTLP && cfg.URI != "" {
+			telemetry.otlp, err = startOTLPPusher(cfg)
+			if err != nil {
+				telemetry.hook.Close()
+				return nil, err
+			}
+		}
 	} else {
 		telemetry.hook = new(dummyHook)
 	}
@@ -273,7 +289,38 @@ func (t *telemetryState) logTelemetry(l logger, message string, details interfac
 	t.hook.Fire(entry)
 }
 
+// updateOTLPURI restarts the OTLP pusher against uri, starting it if telemetry
+// was enabled before an endpoint was known
+func (t *telemetryState) updateOTLPURI(uri string) error {
+	cfg := t.telemetryConfig
+	if !cfg.Enable || cfg.Exporter != TelemetryExporterOTLP {
+		return nil
+	}
+	cfg.URI = uri
+
+	t.otlpMu.Lock()
+	defer t.otlpMu.Unlock()
+	if t.otlp != nil {
+		t.otlp.Close()
+		t.otlp = nil
+	}
+	if uri == "" {
+		return nil
+	}
+	p, err := startOTLPPusher(cfg)
+	if err != nil {
+		return err
+	}
+	t.otlp = p
+	return nil
+}
+
 func (t *telemetryState) Close() {
+	t.otlpMu.Lock()
+	if t.otlp != nil {
+		t.otlp.Close()
+	}
+	t.otlpMu.Unlock()
 	if t.hook != nil {
 		t.hook.Close()
 	}
@@ -37,6 +37,9 @@ type telemetryState struct {
 	history         *logBuffer
 	hook            telemetryHook
 	telemetryConfig TelemetryConfig
+
+	otlpMu deadlock.Mutex
+	otlp   *otlpPusher
 }
 
 // TelemetryConfig represents the configuration of Telemetry logging
@@ -54,8 +57,25 @@ type TelemetryConfig struct {
 	Version            string       `json:"-"`
 	UserName           string
 	Password           string
+
+	// Exporter selects the telemetry backend: "elastic" (the default when
+	// empty) or "otlp". The OTLP exporter sends logs, metrics and traces to
+	// an OpenTelemetry collector at URI using OTLP over HTTP with JSON encoding.
+	// OTLP over gRPC is not supported, so URI must be the http or https address
+	// of the collector's OTLP/HTTP receiver, e.g. http://localhost:4318.
+	Exporter string
+	// OTLPHeaders is a comma-separated list of key=value pairs added to every
+	// OTLP request, typically for collector authentication.
+	OTLPHeaders string
 }
 
+const (
+	// TelemetryExporterElastic sends telemetry events to Elasticsearch
+	TelemetryExporterElastic = "elastic"
+	// TelemetryExporterOTLP sends telemetry to an OpenTelemetry collector
+	TelemetryExporterOTLP = "otlp"
+)
+
 // MarshalingTelemetryConfig is used for json serialization of the TelemetryConfig
 // so that we could replace the MinLogLevel/ReportHistoryLevel with our own types.
 type MarshalingTelemetryConfig struct {
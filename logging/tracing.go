@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// maxBufferedSpans bounds the number of finished spans held between exports
+const maxBufferedSpans = 4096
+
+// Span records a timed operation. Spans are exported to the telemetry
+// collector when telemetry uses the OTLP exporter; otherwise StartSpan
+// returns nil, and all Span methods are no-ops on a nil Span, so callers
+// never need to check.
+type Span struct {
+	traceID  [16]byte
+	spanID   [8]byte
+	parentID [8]byte
+	name     string
+	start    time.Time
+	attrs    []otlpKeyValue
+	err      error
+	sink     *spanBuffer
+}
+
+// StartSpan starts a new root span, or returns nil if spans are not being collected.
+func StartSpan(name string) *Span {
+	sink := currentSpanSink()
+	if sink == nil {
+		return nil
+	}
+	s := &Span{name: name, start: time.Now(), sink: sink}
+	rand.Read(s.traceID[:])
+	rand.Read(s.spanID[:])
+	return s
+}
+
+// StartTraceSpan starts a new root span in an existing trace, so that spans
+// recorded separately for the same entity are grouped together. The span is
+// considered started at start, which lets callers record operations whose
+// subject is only known once they have begun. It returns nil if spans are
+// not being collected.
+func StartTraceSpan(name string, traceID [16]byte, start time.Time) *Span {
+	sink := currentSpanSink()
+	if sink == nil {
+		return nil
+	}
+	s := &Span{name: name, start: start, sink: sink, traceID: traceID}
+	rand.Read(s.spanID[:])
+	return s
+}
+
+// StartChild starts a span nested under s.
+func (s *Span) StartChild(name string) *Span {
+	if s == nil {
+		return nil
+	}
+	c := &Span{name: name, start: time.Now(), sink: s.sink, traceID: s.traceID, parentID: s.spanID}
+	rand.Read(c.spanID[:])
+	return c
+}
+
+// SetAttribute attaches a key/value pair to the span.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.attrs = append(s.attrs, otlpAttribute(key, value))
+}
+
+// SetError marks the span as failed with err. A nil err is ignored.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.err = err
+}
+
+// End finishes the span and queues it for export.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	span := otlpSpan{
+		TraceID:           hex.EncodeToString(s.traceID[:]),
+		SpanID:            hex.EncodeToString(s.spanID[:]),
+		Name:              s.name,
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: otlpTime(s.start),
+		EndTimeUnixNano:   otlpTime(time.Now()),
+		Attributes:        s.attrs,
+		Status:            otlpStatus{Code: otlpStatusOk},
+	}
+	if s.parentID != ([8]byte{}) {
+		span.ParentSpanID = hex.EncodeToString(s.parentID[:])
+	}
+	if s.err != nil {
+		span.Status = otlpStatus{Code: otlpStatusError, Message: s.err.Error()}
+	}
+	s.sink.add(span)
+}
+
+// spanBuffer holds finished spans until the next export
+type spanBuffer struct {
+	mu    deadlock.Mutex
+	spans []otlpSpan
+	max   int
+}
+
+func makeSpanBuffer(max int) *spanBuffer {
+	return &spanBuffer{max: max}
+}
+
+func (b *spanBuffer) add(span otlpSpan) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.spans) >= b.max {
+		b.spans = b.spans[1:]
+		telemetryDrops.Inc(nil)
+	}
+	b.spans = append(b.spans, span)
+}
+
+func (b *spanBuffer) drain() []otlpSpan {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	spans := b.spans
+	b.spans = nil
+	return spans
+}
+
+type spanSinkHolder struct {
+	sink *spanBuffer
+}
+
+var spanSink atomic.Value
+
+func setSpanSink(sink *spanBuffer) {
+	spanSink.Store(spanSinkHolder{sink: sink})
+}
+
+func currentSpanSink() *spanBuffer {
+	holder, _ := spanSink.Load().(spanSinkHolder)
+	return holder.sink
+}
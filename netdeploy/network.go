@@ -45,6 +45,8 @@ type NetworkCfg struct {
 	// They are stored relative to root dir (e.g. "Primary")
 	RelayDirs    []string `json:"RelayDirs,omitempty"`
 	TemplateFile string   `json:"TemplateFile,omitempty"` // Template file used to create the network
+	// Links are the explicit peerings between the nodes, when the template specifies them
+	Links []TopologyLink `json:"Links,omitempty"`
 }
 
 // Network represents an instance of a deployed network
@@ -108,6 +110,7 @@ func CreateNetworkFromTemplate(name, rootDir string, templateReader io.Reader, b
 		return n, err
 	}
 	n.gen = template.Genesis
+	n.cfg.Links = template.Links
 
 	err = n.Save(rootDir)
 	n.SetConsensus(binDir, consensus)
@@ -276,6 +279,10 @@ func (n Network) Start(binDir string, redirectOutput bool) error {
 	// Start remaining nodes, pointing at the relays
 	// Wait for all to start, collect errors if any
 
+	if len(n.cfg.Links) > 0 {
+		return n.startTopology(binDir, redirectOutput)
+	}
+
 	// Start Prime Relay and get its listening address
 
 	var peerAddressListBuilder strings.Builder
@@ -311,6 +318,61 @@ func (n Network) Start(binDir string, redirectOutput bool) error {
 	return err
 }
 
+// startTopology starts the relays in order, followed by the remaining nodes, connecting
+// each of them only to the relays it is linked to.
+func (n Network) startTopology(binDir string, redirectOutput bool) error {
+	relayAddresses := make(map[string]string)
+	for _, relayDir := range n.cfg.RelayDirs {
+		peerAddress, err := n.writeLinkEmulation(relayDir, n.cfg.Links, relayAddresses)
+		if err != nil {
+			return err
+		}
+		nc := nodecontrol.MakeNodeController(binDir, n.getNodeFullPath(relayDir))
+		_, err = nc.StartAlgod(nodecontrol.AlgodStartArgs{
+			PeerAddress:       peerAddress,
+			RedirectOutput:    redirectOutput,
+			ExitErrorCallback: n.nodeExitCallback,
+		})
+		if err != nil {
+			return err
+		}
+		relayAddresses[relayDir], err = n.getRelayAddress(nc)
+		if err != nil {
+			return err
+		}
+	}
+	for _, nodeDir := range n.nodeDirs {
+		peerAddress, err := n.writeLinkEmulation(nodeDir, n.cfg.Links, relayAddresses)
+		if err != nil {
+			return err
+		}
+		nc := nodecontrol.MakeNodeController(binDir, n.getNodeFullPath(nodeDir))
+		_, err = nc.StartAlgod(nodecontrol.AlgodStartArgs{
+			PeerAddress:       peerAddress,
+			RedirectOutput:    redirectOutput,
+			ExitErrorCallback: n.nodeExitCallback,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// relayAddresses returns the listening addresses of the running relays, keyed by relay name.
+func (n Network) relayAddresses(binDir string) map[string]string {
+	addresses := make(map[string]string)
+	for _, relayDir := range n.cfg.RelayDirs {
+		nc := nodecontrol.MakeNodeController(binDir, n.getNodeFullPath(relayDir))
+		relayAddress, err := nc.GetListeningAddress()
+		if err != nil {
+			continue
+		}
+		addresses[relayDir] = relayAddress
+	}
+	return addresses
+}
+
 // retry fetching the relay address
 func (n Network) getRelayAddress(nc nodecontrol.NodeController) (relayAddress string, err error) {
 	for i := 1; ; i++ {
@@ -365,8 +427,16 @@ func (n Network) startNodes(binDir, relayAddress string, redirectOutput bool) er
 // It determines the correct PeerAddresses to use.
 func (n Network) StartNode(binDir, nodeDir string, redirectOutput bool) (err error) {
 	controller := nodecontrol.MakeNodeController(binDir, nodeDir)
-	peers := n.GetPeerAddresses(binDir)
-	peerAddresses := strings.Join(peers, ";")
+	var peerAddresses string
+	if len(n.cfg.Links) > 0 {
+		peerAddresses, err = n.writeLinkEmulation(filepath.Base(nodeDir), n.cfg.Links, n.relayAddresses(binDir))
+		if err != nil {
+			return
+		}
+	} else {
+		peers := n.GetPeerAddresses(binDir)
+		peerAddresses = strings.Join(peers, ";")
+	}
 	_, err = controller.StartAlgod(nodecontrol.AlgodStartArgs{
 		PeerAddress:    peerAddresses,
 		RedirectOutput: redirectOutput,
@@ -41,6 +41,8 @@ type NetworkTemplate struct {
 	Genesis   gen.GenesisData
 	Nodes     []remote.NodeConfigGoal
 	Consensus config.ConsensusProtocols
+	// Links, when present, replace the default topology in which every node connects to all the relays
+	Links []TopologyLink `json:",omitempty"`
 }
 
 var defaultNetworkTemplate = NetworkTemplate{
@@ -149,7 +151,18 @@ func (t NetworkTemplate) createNodeDirectories(targetFolder string, binDir strin
 
 		// Create any necessary config.json file for this node
 		nodeCfg := filepath.Join(nodeDir, config.ConfigFilename)
-		err = createConfigFile(cfg, nodeCfg, len(t.Nodes)-1, relaysCount) // minus 1 to avoid counting self
+		numPeers := len(t.Nodes) - 1 // minus 1 to avoid counting self
+		linkEmulationFile := ""
+		if len(t.Links) > 0 {
+			numPeers = countOutgoingLinks(t.Links, cfg.Name)
+			if numPeers > 0 {
+				linkEmulationFile, err = filepath.Abs(filepath.Join(nodeDir, linkEmulationFileName))
+				if err != nil {
+					return
+				}
+			}
+		}
+		err = createConfigFile(cfg, nodeCfg, numPeers, relaysCount, linkEmulationFile)
 		if err != nil {
 			return
 		}
@@ -225,6 +238,10 @@ func (t NetworkTemplate) Validate() error {
 		return fmt.Errorf("invalid template: at least one relay is required when more than a single node presents")
 	}
 
+	if err := validateLinks(t.Nodes, t.Links); err != nil {
+		return err
+	}
+
 	// Validate JSONOverride decoding
 	for _, cfg := range t.Nodes {
 		local := config.GetDefaultLocal()
@@ -285,9 +302,10 @@ func decodeJSONOverride(override string, cfg *config.Local) error {
 	return nil
 }
 
-func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes int, relaysCount int) error {
+func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes int, relaysCount int, linkEmulationFile string) error {
 	cfg := config.GetDefaultLocal()
 	cfg.GossipFanout = numNodes
+	cfg.LinkEmulationFile = linkEmulationFile
 	// Override default :8080 REST endpoint, and disable SRV lookup
 	cfg.EndpointAddress = "127.0.0.1:0"
 	cfg.DNSBootstrapID = ""
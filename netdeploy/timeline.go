@@ -0,0 +1,225 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/nodecontrol"
+)
+
+// Timeline is a script of topology changes applied to a running network, such as links going down or
+// relays failing, for testing network partitions and relay failover.
+type Timeline struct {
+	Events []TimelineEvent
+}
+
+// TimelineEvent is a change applied to the network at a given time after the timeline starts.
+// Each event either updates a link, stops a node or starts a node.
+type TimelineEvent struct {
+	// At is the time the event is applied at, relative to the start of the timeline, e.g. "1m30s"
+	At string
+	// Link replaces the conditions emulated on the template link with the same From and To nodes
+	Link *TopologyLink `json:",omitempty"`
+	// StopNode is the name of a node to stop
+	StopNode string `json:",omitempty"`
+	// StartNode is the name of a node to start
+	StartNode string `json:",omitempty"`
+}
+
+// LoadTimeline loads a timeline from a json file
+func LoadTimeline(file string) (Timeline, error) {
+	var timeline Timeline
+	f, err := os.Open(file)
+	if err != nil {
+		return timeline, err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&timeline)
+	return timeline, err
+}
+
+func (e TimelineEvent) String() string {
+	switch {
+	case e.Link != nil:
+		return fmt.Sprintf("%s: link %s -> %s %+v", e.At, e.Link.From, e.Link.To, e.Link.LinkProfile)
+	case e.StopNode != "":
+		return fmt.Sprintf("%s: stop %s", e.At, e.StopNode)
+	default:
+		return fmt.Sprintf("%s: start %s", e.At, e.StartNode)
+	}
+}
+
+// ValidateTimeline ensures that every event of the timeline has a valid time and applies to
+// a node or link of the network.
+func (n Network) ValidateTimeline(timeline Timeline) error {
+	for i, event := range timeline.Events {
+		at, err := time.ParseDuration(event.At)
+		if err != nil {
+			return fmt.Errorf("invalid timeline event %d: %w", i, err)
+		}
+		if at < 0 {
+			return fmt.Errorf("invalid timeline event %d: negative time %s", i, event.At)
+		}
+		actions := 0
+		if event.Link != nil {
+			actions++
+			if n.findLink(event.Link.From, event.Link.To) < 0 {
+				return fmt.Errorf("invalid timeline event %d: no link from '%s' to '%s' in the network", i, event.Link.From, event.Link.To)
+			}
+			if event.Link.Loss < 0 || event.Link.Loss > 1 {
+				return fmt.Errorf("invalid timeline event %d: loss outside of [0, 1]", i)
+			}
+		}
+		for _, nodeName := range []string{event.StopNode, event.StartNode} {
+			if nodeName == "" {
+				continue
+			}
+			actions++
+			if _, err := n.GetNodeDir(nodeName); err != nil {
+				return fmt.Errorf("invalid timeline event %d: %w", i, err)
+			}
+		}
+		if actions != 1 {
+			return fmt.Errorf("invalid timeline event %d: exactly one of Link, StopNode or StartNode is required", i)
+		}
+	}
+	return nil
+}
+
+// findLink returns the index of the link from a node to a relay, or -1 if there is none.
+func (n Network) findLink(from, to string) int {
+	for i, link := range n.cfg.Links {
+		if link.From == from && link.To == to {
+			return i
+		}
+	}
+	return -1
+}
+
+func (n Network) isRelay(nodeName string) bool {
+	for _, relayDir := range n.cfg.RelayDirs {
+		if relayDir == nodeName {
+			return true
+		}
+	}
+	return false
+}
+
+// RunTimeline applies the timeline events to the running network in order, each at its time relative to
+// the call. It returns once the last event is applied, or when ctx is canceled. Relays started by the
+// timeline listen on the address they had when the timeline started, so that the nodes linked to them
+// can reconnect. The applied events are reported to verboseOut, if not nil.
+func (n Network) RunTimeline(ctx context.Context, binDir string, timeline Timeline, redirectOutput bool, verboseOut io.Writer) error {
+	err := n.ValidateTimeline(timeline)
+	if err != nil {
+		return err
+	}
+	events := make([]TimelineEvent, len(timeline.Events))
+	copy(events, timeline.Events)
+	sort.SliceStable(events, func(i, j int) bool {
+		atI, _ := time.ParseDuration(events[i].At)
+		atJ, _ := time.ParseDuration(events[j].At)
+		return atI < atJ
+	})
+
+	links := make([]TopologyLink, len(n.cfg.Links))
+	copy(links, n.cfg.Links)
+	relayAddresses := n.relayAddresses(binDir)
+	start := time.Now()
+	for _, event := range events {
+		at, _ := time.ParseDuration(event.At)
+		timer := time.NewTimer(time.Until(start.Add(at)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		switch {
+		case event.Link != nil:
+			links[n.findLink(event.Link.From, event.Link.To)] = *event.Link
+			_, err = n.writeLinkEmulation(event.Link.From, links, relayAddresses)
+		case event.StopNode != "":
+			nc := nodecontrol.MakeNodeController(binDir, n.getNodeFullPath(event.StopNode))
+			err = nc.StopAlgod()
+		default:
+			err = n.startTimelineNode(binDir, event.StartNode, links, relayAddresses, redirectOutput)
+		}
+		if err != nil {
+			return fmt.Errorf("timeline event %s failed: %w", event, err)
+		}
+		if verboseOut != nil {
+			fmt.Fprintf(verboseOut, "%s\n", event)
+		}
+	}
+	return nil
+}
+
+// startTimelineNode starts a node with the current state of its links, reusing the previous
+// listening address of relays and recording the address of the ones started for the first time.
+func (n Network) startTimelineNode(binDir, nodeName string, links []TopologyLink, relayAddresses map[string]string, redirectOutput bool) error {
+	var peerAddress string
+	var err error
+	if len(links) > 0 {
+		peerAddress, err = n.writeLinkEmulation(nodeName, links, relayAddresses)
+	} else {
+		for _, relayDir := range n.cfg.RelayDirs {
+			if address, ok := relayAddresses[relayDir]; ok && relayDir != nodeName {
+				if peerAddress != "" {
+					peerAddress += ";"
+				}
+				peerAddress += address
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	args := nodecontrol.AlgodStartArgs{
+		PeerAddress:       peerAddress,
+		RedirectOutput:    redirectOutput,
+		ExitErrorCallback: n.nodeExitCallback,
+	}
+	relayAddress, hadAddress := relayAddresses[nodeName]
+	if hadAddress {
+		args.ListenIP = relayAddress
+		if listenURL, err := url.Parse(relayAddress); err == nil && listenURL.Host != "" {
+			args.ListenIP = listenURL.Host
+		}
+	}
+	nc := nodecontrol.MakeNodeController(binDir, n.getNodeFullPath(nodeName))
+	_, err = nc.StartAlgod(args)
+	if err != nil {
+		return err
+	}
+	if n.isRelay(nodeName) && !hadAddress {
+		relayAddresses[nodeName], err = n.getRelayAddress(nc)
+	}
+	return err
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netdeploy
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-algorand/netdeploy/remote"
+	"github.com/algorand/go-algorand/network"
+)
+
+// linkEmulationFileName is the name of the file, in the data directory of a node, describing the
+// conditions emulated on the links the node makes.
+const linkEmulationFileName = "linkemulation.json"
+
+// TopologyLink describes an explicit peering between two nodes of a network template, and the
+// latency, bandwidth and loss emulated on it. The emulation is applied by the From node to both
+// directions of the link.
+type TopologyLink struct {
+	// From is the name of the node connecting to the To node.
+	From string
+	// To is the name of the relay the From node connects to.
+	To string
+
+	network.LinkProfile
+}
+
+// validateLinks ensures that the template links connect existing nodes to relays, that relays only
+// connect to relays listed before them so they can be started in order, and that every node other
+// than a relay has at least one link.
+func validateLinks(nodes []remote.NodeConfigGoal, links []TopologyLink) error {
+	if len(links) == 0 {
+		return nil
+	}
+	nodeIndex := make(map[string]int)
+	for i, cfg := range nodes {
+		nodeIndex[cfg.Name] = i
+	}
+	linked := make(map[string]bool)
+	pairs := make(map[[2]string]bool)
+	for _, link := range links {
+		from, ok := nodeIndex[link.From]
+		if !ok {
+			return fmt.Errorf("invalid template: link from unknown node '%s'", link.From)
+		}
+		to, ok := nodeIndex[link.To]
+		if !ok {
+			return fmt.Errorf("invalid template: link to unknown node '%s'", link.To)
+		}
+		if from == to {
+			return fmt.Errorf("invalid template: node '%s' is linked to itself", link.From)
+		}
+		if !nodes[to].IsRelay {
+			return fmt.Errorf("invalid template: link from '%s' to '%s' must be to a relay", link.From, link.To)
+		}
+		if nodes[from].IsRelay && from < to {
+			return fmt.Errorf("invalid template: relay '%s' may only link to relays listed before it, not '%s'", link.From, link.To)
+		}
+		if link.Loss < 0 || link.Loss > 1 {
+			return fmt.Errorf("invalid template: link from '%s' to '%s' has a loss outside of [0, 1]", link.From, link.To)
+		}
+		pair := [2]string{link.From, link.To}
+		if link.To < link.From {
+			pair = [2]string{link.To, link.From}
+		}
+		if pairs[pair] {
+			return fmt.Errorf("invalid template: duplicate link between '%s' and '%s'", link.From, link.To)
+		}
+		pairs[pair] = true
+		linked[link.From] = true
+	}
+	for _, cfg := range nodes {
+		if !cfg.IsRelay && !linked[cfg.Name] {
+			return fmt.Errorf("invalid template: node '%s' has no links", cfg.Name)
+		}
+	}
+	return nil
+}
+
+// countOutgoingLinks counts the links the given node makes
+func countOutgoingLinks(links []TopologyLink, nodeName string) (count int) {
+	for _, link := range links {
+		if link.From == nodeName {
+			count++
+		}
+	}
+	return
+}
+
+// writeLinkEmulation writes the link emulation file of the given node for its outgoing links, and returns
+// the peer addresses the node should connect to. relayAddresses maps the names of the running relays to
+// their listening addresses.
+func (n Network) writeLinkEmulation(nodeName string, links []TopologyLink, relayAddresses map[string]string) (peerAddresses string, err error) {
+	emulation := network.LinkEmulationConfig{Links: make(map[string]network.LinkProfile)}
+	var peers []string
+	for _, link := range links {
+		if link.From != nodeName {
+			continue
+		}
+		address, ok := relayAddresses[link.To]
+		if !ok {
+			return "", fmt.Errorf("relay '%s' must be running before starting '%s'", link.To, nodeName)
+		}
+		emulation.Links[address] = link.LinkProfile
+		peers = append(peers, address)
+	}
+	if len(peers) == 0 {
+		return "", nil
+	}
+	err = emulation.SaveToFile(filepath.Join(n.getNodeFullPath(nodeName), linkEmulationFileName))
+	return strings.Join(peers, ";"), err
+}
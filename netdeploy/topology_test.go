@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netdeploy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/netdeploy/remote"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestValidateLinks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	templateDir, err := filepath.Abs("../test/testdata/nettemplates")
+	require.NoError(t, err)
+	template, err := loadTemplate(filepath.Join(templateDir, "TwoRelaysTopology.json"))
+	require.NoError(t, err)
+	require.NoError(t, template.Validate())
+
+	nodes := []remote.NodeConfigGoal{
+		{Name: "Relay1", IsRelay: true},
+		{Name: "Relay2", IsRelay: true},
+		{Name: "Node1"},
+	}
+	require.NoError(t, validateLinks(nodes, nil))
+	require.NoError(t, validateLinks(nodes, []TopologyLink{{From: "Relay2", To: "Relay1"}, {From: "Node1", To: "Relay2"}}))
+
+	invalid := map[string][]TopologyLink{
+		"unknown from":       {{From: "Node9", To: "Relay1"}, {From: "Node1", To: "Relay1"}},
+		"unknown to":         {{From: "Node1", To: "Relay9"}},
+		"self":               {{From: "Relay1", To: "Relay1"}, {From: "Node1", To: "Relay1"}},
+		"to non-relay":       {{From: "Relay1", To: "Node1"}},
+		"relay start order":  {{From: "Relay1", To: "Relay2"}, {From: "Node1", To: "Relay1"}},
+		"duplicate":          {{From: "Relay2", To: "Relay1"}, {From: "Relay2", To: "Relay1"}, {From: "Node1", To: "Relay1"}},
+		"loss":               {{From: "Node1", To: "Relay1", LinkProfile: network.LinkProfile{Loss: 1.5}}},
+		"node without links": {{From: "Relay2", To: "Relay1"}},
+	}
+	for name, links := range invalid {
+		require.Error(t, validateLinks(nodes, links), name)
+	}
+}
+
+func TestTopologyNetwork(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	templateDir, err := filepath.Abs("../test/testdata/nettemplates")
+	require.NoError(t, err)
+	template, err := loadTemplate(filepath.Join(templateDir, "TwoRelaysTopology.json"))
+	require.NoError(t, err)
+
+	rootDir := t.TempDir()
+	binDir := os.ExpandEnv("${GOPATH}/bin")
+	require.NoError(t, template.generateGenesisAndWallets(rootDir, "testTopology", binDir))
+	relayDirs, nodeDirs, err := template.createNodeDirectories(rootDir, binDir, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Relay1", "Relay2"}, relayDirs)
+
+	// nodes only dial their links, and emulate them
+	expected := map[string]int{"Relay1": 0, "Relay2": 1, "Node1": 1, "Node2": 2, "Follower": 1}
+	for name, fanout := range expected {
+		cfg, err := config.LoadConfigFromDisk(filepath.Join(rootDir, name))
+		require.NoError(t, err)
+		require.Equal(t, fanout, cfg.GossipFanout, name)
+		if fanout == 0 {
+			require.Empty(t, cfg.LinkEmulationFile, name)
+		} else {
+			require.Equal(t, filepath.Join(rootDir, name, linkEmulationFileName), cfg.LinkEmulationFile, name)
+		}
+	}
+	cfg, err := config.LoadConfigFromDisk(filepath.Join(rootDir, "Relay1"))
+	require.NoError(t, err)
+	require.True(t, cfg.Archival)
+	cfg, err = config.LoadConfigFromDisk(filepath.Join(rootDir, "Follower"))
+	require.NoError(t, err)
+	require.True(t, cfg.EnableFollowMode)
+
+	n := Network{rootDir: rootDir, cfg: NetworkCfg{RelayDirs: relayDirs, Links: template.Links}, nodeDirs: nodeDirs}
+	relayAddresses := map[string]string{"Relay1": "http://127.0.0.1:4160"}
+	_, err = n.writeLinkEmulation("Node2", n.cfg.Links, relayAddresses)
+	require.Error(t, err)
+
+	relayAddresses["Relay2"] = "http://127.0.0.1:4161"
+	peers, err := n.writeLinkEmulation("Node2", n.cfg.Links, relayAddresses)
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:4161;http://127.0.0.1:4160", peers)
+	emulation, err := network.LoadLinkEmulationConfig(filepath.Join(rootDir, "Node2", linkEmulationFileName))
+	require.NoError(t, err)
+	require.Equal(t, map[string]network.LinkProfile{
+		"http://127.0.0.1:4161": {LatencyMs: 20, Loss: 0.01},
+		"http://127.0.0.1:4160": {LatencyMs: 150, Down: true},
+	}, emulation.Links)
+
+	peers, err = n.writeLinkEmulation("Relay1", n.cfg.Links, relayAddresses)
+	require.NoError(t, err)
+	require.Empty(t, peers)
+
+	// timelines may only refer to the network nodes and links
+	timelineFile := filepath.Join(rootDir, "timeline.json")
+	require.NoError(t, os.WriteFile(timelineFile, []byte(`{"Events": [
+		{"At": "1m", "StartNode": "Relay2"},
+		{"At": "30s", "StopNode": "Relay2"},
+		{"At": "10s", "Link": {"From": "Node2", "To": "Relay1", "LatencyMs": 100}}
+	]}`), 0644))
+	timeline, err := LoadTimeline(timelineFile)
+	require.NoError(t, err)
+	require.Len(t, timeline.Events, 3)
+	require.NoError(t, n.ValidateTimeline(timeline))
+
+	invalid := map[string]TimelineEvent{
+		"bad time":     {At: "soon", StopNode: "Relay2"},
+		"negative":     {At: "-1s", StopNode: "Relay2"},
+		"unknown node": {At: "1s", StopNode: "Relay9"},
+		"unknown link": {At: "1s", Link: &TopologyLink{From: "Node1", To: "Relay2"}},
+		"loss":         {At: "1s", Link: &TopologyLink{From: "Node1", To: "Relay1", LinkProfile: network.LinkProfile{Loss: -1}}},
+		"no action":    {At: "1s"},
+		"two actions":  {At: "1s", StopNode: "Relay2", StartNode: "Relay1"},
+	}
+	for name, event := range invalid {
+		require.Error(t, n.ValidateTimeline(Timeline{Events: []TimelineEvent{event}}), name)
+	}
+}
@@ -31,9 +31,10 @@ type netDialer interface {
 
 // Dialer establish tcp-level connection with the destination
 type Dialer struct {
-	phonebook   Phonebook
-	innerDialer netDialer
-	resolver    *net.Resolver
+	phonebook    Phonebook
+	innerDialer  netDialer
+	resolver     *net.Resolver
+	linkEmulator *linkEmulator
 }
 
 // makeRateLimitingDialer creates a rate limiting dialer that would limit the connections
@@ -90,6 +91,9 @@ func (d *Dialer) DialContext(ctx context.Context, network, address string) (net.
 }
 
 func (d *Dialer) innerDialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if d.linkEmulator != nil && d.linkEmulator.isDown(address) {
+		return nil, errLinkDown
+	}
 	// this would be a good place to have the dnssec evaluated.
 	return d.innerDialer.DialContext(ctx, network, address)
 }
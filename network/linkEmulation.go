@@ -0,0 +1,368 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// linkEmulationRefreshInterval is the interval at which the link emulation file is checked for changes.
+const linkEmulationRefreshInterval = time.Second
+
+// emulatedConnQueueSize is the number of messages an emulated connection holds in each direction
+// before blocking the sender.
+const emulatedConnQueueSize = 1024
+
+var errLinkDown = errors.New("link to peer is down")
+var errEmulatedConnClosed = errors.New("emulated connection closed")
+
+// LinkProfile describes the conditions emulated on the link to a single peer. Latency, bandwidth and loss
+// are applied to each direction of the link independently.
+type LinkProfile struct {
+	// LatencyMs is the one-way delay, in milliseconds, added to every message.
+	LatencyMs uint64 `json:",omitempty"`
+	// BandwidthBytesPerSec caps the throughput of the link. Zero means unlimited.
+	BandwidthBytesPerSec uint64 `json:",omitempty"`
+	// Loss is the probability, between 0 and 1, of a message being dropped.
+	Loss float64 `json:",omitempty"`
+	// Down disconnects the peer and prevents connecting to it again until the link is up.
+	Down bool `json:",omitempty"`
+}
+
+// LinkEmulationConfig is the content of the file named by the LinkEmulationFile configuration.
+type LinkEmulationConfig struct {
+	// Links maps peer addresses, as given in the phonebook, to the conditions emulated on the connections
+	// made to them. Connections accepted from peers are not emulated, so the dialing side of a link
+	// is the one to describe it.
+	Links map[string]LinkProfile
+}
+
+// LoadLinkEmulationConfig reads a link emulation configuration from the given file.
+func LoadLinkEmulationConfig(file string) (LinkEmulationConfig, error) {
+	var cfg LinkEmulationConfig
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return cfg, err
+	}
+	err = json.Unmarshal(data, &cfg)
+	return cfg, err
+}
+
+// SaveToFile writes the link emulation configuration to the given file. The file is replaced
+// atomically, so that a node reloading it never observes a partially written configuration.
+func (cfg LinkEmulationConfig) SaveToFile(file string) error {
+	data, err := json.MarshalIndent(cfg, "", "\t")
+	if err != nil {
+		return err
+	}
+	tmpFile := file + ".tmp"
+	err = os.WriteFile(tmpFile, data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, file)
+}
+
+// linkEmulationAddress normalizes a peer address so that "http://host:port" and "host:port" match the same link.
+func linkEmulationAddress(addr string) string {
+	if strings.Contains(addr, "://") {
+		if parsedURL, err := url.Parse(addr); err == nil {
+			return parsedURL.Host
+		}
+	}
+	return addr
+}
+
+// linkEmulator holds the link profiles loaded from the link emulation file.
+type linkEmulator struct {
+	file string
+	log  logging.Logger
+
+	mu      deadlock.RWMutex
+	links   map[string]LinkProfile
+	modTime time.Time
+	size    int64
+}
+
+func makeLinkEmulator(file string, log logging.Logger) *linkEmulator {
+	le := &linkEmulator{
+		file:  file,
+		log:   log,
+		links: make(map[string]LinkProfile),
+	}
+	le.reload()
+	return le
+}
+
+// reload reads the link emulation file again if it has changed since it was last loaded,
+// and returns true if the link profiles were updated. A missing file disables all emulation.
+func (le *linkEmulator) reload() bool {
+	links := make(map[string]LinkProfile)
+	var modTime time.Time
+	var size int64
+	info, err := os.Stat(le.file)
+	if err == nil {
+		modTime, size = info.ModTime(), info.Size()
+	} else if !os.IsNotExist(err) {
+		le.log.Warnf("unable to read link emulation file %s: %v", le.file, err)
+		return false
+	}
+
+	le.mu.RLock()
+	unchanged := modTime.Equal(le.modTime) && size == le.size
+	le.mu.RUnlock()
+	if unchanged {
+		return false
+	}
+
+	if err == nil {
+		cfg, err := LoadLinkEmulationConfig(le.file)
+		if err != nil {
+			le.log.Warnf("unable to load link emulation file %s: %v", le.file, err)
+			return false
+		}
+		for addr, profile := range cfg.Links {
+			links[linkEmulationAddress(addr)] = profile
+		}
+	}
+
+	le.mu.Lock()
+	le.links, le.modTime, le.size = links, modTime, size
+	le.mu.Unlock()
+	le.log.Infof("loaded link emulation for %d peers from %s", len(links), le.file)
+	return true
+}
+
+// profile returns the link profile emulated for the given peer address, if any.
+func (le *linkEmulator) profile(addr string) (LinkProfile, bool) {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	profile, ok := le.links[linkEmulationAddress(addr)]
+	return profile, ok
+}
+
+// isDown returns true if the link to the given peer address is down.
+func (le *linkEmulator) isDown(addr string) bool {
+	profile, _ := le.profile(addr)
+	return profile.Down
+}
+
+// wrap returns a connection emulating the link to the given peer address on top of conn. Peers without
+// a link profile are not delayed, but are still wrapped so that a profile added later applies to them.
+func (le *linkEmulator) wrap(conn wsPeerWebsocketConn, addr string) wsPeerWebsocketConn {
+	c := &emulatedConn{
+		wsPeerWebsocketConn: conn,
+		emulator:            le,
+		addr:                addr,
+		outgoing:            make(chan emulatedMessage, emulatedConnQueueSize),
+		incoming:            make(chan emulatedMessage, emulatedConnQueueSize),
+		closing:             make(chan struct{}),
+		writerDone:          make(chan struct{}),
+	}
+	go c.writeLoop()
+	return c
+}
+
+type emulatedMessage struct {
+	messageType int
+	data        []byte
+	deliverAt   time.Time
+	err         error
+}
+
+// emulatedConn delays, throttles and drops the messages sent and received over a websocket connection
+// according to the link profile of its peer. The profile is looked up for every message, so changes to
+// the link emulation file apply to existing connections as well.
+type emulatedConn struct {
+	wsPeerWebsocketConn
+
+	emulator *linkEmulator
+	addr     string
+
+	outgoing   chan emulatedMessage
+	incoming   chan emulatedMessage
+	closing    chan struct{}
+	closeOnce  sync.Once
+	writerDone chan struct{}
+	readerOnce sync.Once
+
+	// sendIdle and recvIdle are the times at which each direction of the link finishes transmitting
+	// the messages queued so far. sendIdle is only accessed by WriteMessage, and recvIdle by readLoop.
+	sendIdle time.Time
+	recvIdle time.Time
+
+	writeErrMu deadlock.Mutex
+	writeErr   error
+}
+
+// schedule returns the time at which a message of the given size is delivered, given the time
+// at which the link becomes idle, and updates it.
+func (p LinkProfile) schedule(idle *time.Time, size int) time.Time {
+	start := time.Now()
+	if idle.After(start) {
+		start = *idle
+	}
+	end := start
+	if p.BandwidthBytesPerSec > 0 {
+		end = end.Add(time.Duration(uint64(size) * uint64(time.Second) / p.BandwidthBytesPerSec))
+	}
+	*idle = end
+	return end.Add(time.Duration(p.LatencyMs) * time.Millisecond)
+}
+
+// drop returns true if the next message should be lost.
+func (p LinkProfile) drop() bool {
+	if p.Down || p.Loss >= 1 {
+		return true
+	}
+	if p.Loss <= 0 {
+		return false
+	}
+	const lossResolution = 1_000_000
+	return crypto.RandUint64()%lossResolution < uint64(p.Loss*lossResolution)
+}
+
+// wait blocks until the given time, and returns false if the connection was closed in the meantime.
+func (c *emulatedConn) wait(until time.Time) bool {
+	delay := time.Until(until)
+	if delay <= 0 {
+		return true
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-c.closing:
+		return false
+	}
+}
+
+func (c *emulatedConn) writeError() error {
+	c.writeErrMu.Lock()
+	defer c.writeErrMu.Unlock()
+	return c.writeErr
+}
+
+// WriteMessage queues the message for delivery once the emulated link would have carried it.
+func (c *emulatedConn) WriteMessage(messageType int, data []byte) error {
+	if err := c.writeError(); err != nil {
+		return err
+	}
+	profile, _ := c.emulator.profile(c.addr)
+	if profile.drop() {
+		return nil
+	}
+	msg := emulatedMessage{messageType: messageType, data: data, deliverAt: profile.schedule(&c.sendIdle, len(data))}
+	select {
+	case c.outgoing <- msg:
+		return nil
+	case <-c.writerDone:
+		if err := c.writeError(); err != nil {
+			return err
+		}
+		return errEmulatedConnClosed
+	}
+}
+
+func (c *emulatedConn) writeLoop() {
+	defer close(c.writerDone)
+	for {
+		select {
+		case <-c.closing:
+			return
+		case msg := <-c.outgoing:
+			if !c.wait(msg.deliverAt) {
+				return
+			}
+			err := c.wsPeerWebsocketConn.WriteMessage(msg.messageType, msg.data)
+			if err != nil {
+				c.writeErrMu.Lock()
+				c.writeErr = err
+				c.writeErrMu.Unlock()
+				return
+			}
+		}
+	}
+}
+
+// readLoop reads the messages from the underlying connection as they arrive, and queues them
+// for NextReader to return once the emulated link would have carried them.
+func (c *emulatedConn) readLoop() {
+	for {
+		messageType, reader, err := c.wsPeerWebsocketConn.NextReader()
+		var data []byte
+		if err == nil {
+			data, err = io.ReadAll(reader)
+		}
+		if err != nil {
+			select {
+			case c.incoming <- emulatedMessage{err: err}:
+			case <-c.closing:
+			}
+			return
+		}
+		profile, _ := c.emulator.profile(c.addr)
+		if profile.drop() {
+			continue
+		}
+		msg := emulatedMessage{messageType: messageType, data: data, deliverAt: profile.schedule(&c.recvIdle, len(data))}
+		select {
+		case c.incoming <- msg:
+		case <-c.closing:
+			return
+		}
+	}
+}
+
+// NextReader returns the next message received once the emulated link would have carried it.
+// The underlying connection is only read from once NextReader is first called, so that the
+// read limit can be set beforehand.
+func (c *emulatedConn) NextReader() (int, io.Reader, error) {
+	c.readerOnce.Do(func() { go c.readLoop() })
+	select {
+	case msg := <-c.incoming:
+		if msg.err != nil {
+			return 0, nil, msg.err
+		}
+		if !c.wait(msg.deliverAt) {
+			return 0, nil, errEmulatedConnClosed
+		}
+		return msg.messageType, bytes.NewReader(msg.data), nil
+	case <-c.closing:
+		return 0, nil, errEmulatedConnClosed
+	}
+}
+
+// CloseWithoutFlush closes the underlying connection, discarding the queued messages.
+func (c *emulatedConn) CloseWithoutFlush() error {
+	c.closeOnce.Do(func() { close(c.closing) })
+	return c.wsPeerWebsocketConn.CloseWithoutFlush()
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLinkEmulationConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "linkemulation.json")
+	le := makeLinkEmulator(file, logging.TestingLog(t))
+	_, ok := le.profile("127.0.0.1:4160")
+	require.False(t, ok)
+	require.False(t, le.reload())
+
+	cfg := LinkEmulationConfig{Links: map[string]LinkProfile{
+		"http://127.0.0.1:4160": {LatencyMs: 100, Loss: 0.5},
+		"127.0.0.1:4161":        {Down: true},
+	}}
+	require.NoError(t, cfg.SaveToFile(file))
+	loaded, err := LoadLinkEmulationConfig(file)
+	require.NoError(t, err)
+	require.Equal(t, cfg, loaded)
+
+	require.True(t, le.reload())
+	require.False(t, le.reload())
+	profile, ok := le.profile("127.0.0.1:4160")
+	require.True(t, ok)
+	require.Equal(t, uint64(100), profile.LatencyMs)
+	require.False(t, le.isDown("127.0.0.1:4160"))
+	require.True(t, le.isDown("http://127.0.0.1:4161"))
+	require.False(t, le.isDown("127.0.0.1:4162"))
+
+	// removing the file disables the emulation
+	require.NoError(t, os.Remove(file))
+	require.True(t, le.reload())
+	require.False(t, le.isDown("127.0.0.1:4161"))
+}
+
+func TestLinkProfileSchedule(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var idle time.Time
+	p := LinkProfile{LatencyMs: 50, BandwidthBytesPerSec: 1000}
+	start := time.Now()
+	first := p.schedule(&idle, 500)
+	second := p.schedule(&idle, 500)
+	// the second message waits for the first one to be transmitted
+	require.GreaterOrEqual(t, first.Sub(start), 550*time.Millisecond)
+	require.Equal(t, 500*time.Millisecond, second.Sub(first))
+	require.Equal(t, second.Add(-50*time.Millisecond), idle)
+
+	require.False(t, LinkProfile{}.drop())
+	require.True(t, LinkProfile{Loss: 1}.drop())
+	require.True(t, LinkProfile{Down: true}.drop())
+}
+
+func TestLinkEmulationNetwork(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	file := filepath.Join(t.TempDir(), "linkemulation.json")
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer netStop(t, netA, "A")
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+
+	const latency = 300 * time.Millisecond
+	cfg := LinkEmulationConfig{Links: map[string]LinkProfile{addrA: {LatencyMs: uint64(latency / time.Millisecond)}}}
+	require.NoError(t, cfg.SaveToFile(file))
+
+	conf := defaultConfig
+	conf.GossipFanout = 1
+	conf.LinkEmulationFile = file
+	netB := makeTestWebsocketNodeWithConfig(t, conf)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	received := make(chan struct{}, 10)
+	counter := HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		received <- struct{}{}
+		return OutgoingMessage{Action: Ignore}
+	})
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+
+	// both directions of the link are delayed by the dialing side
+	for _, sender := range []*WebsocketNetwork{netA, netB} {
+		start := time.Now()
+		require.NoError(t, sender.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), true, nil))
+		select {
+		case <-received:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "message not received")
+		}
+		require.GreaterOrEqual(t, time.Since(start), latency)
+	}
+
+	// taking the link down disconnects the peers, and bringing it up again reconnects them
+	cfg.Links[addrA] = LinkProfile{Down: true}
+	require.NoError(t, cfg.SaveToFile(file))
+	require.Eventually(t, func() bool { return len(netB.GetPeers(PeersConnectedOut)) == 0 }, 10*time.Second, 50*time.Millisecond)
+	time.Sleep(2 * linkEmulationRefreshInterval)
+	require.Empty(t, netB.GetPeers(PeersConnectedOut))
+
+	cfg.Links[addrA] = LinkProfile{}
+	require.NoError(t, cfg.SaveToFile(file))
+	require.Eventually(t, func() bool { return len(netB.GetPeers(PeersConnectedOut)) == 1 }, 10*time.Second, 50*time.Millisecond)
+}
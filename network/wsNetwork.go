@@ -429,6 +429,9 @@ type WebsocketNetwork struct {
 	transport rateLimitingTransport
 	dialer    Dialer
 
+	// linkEmulator emulates the conditions of the links to specific peers, when a link emulation file is configured.
+	linkEmulator *linkEmulator
+
 	// messagesOfInterest specifies the message types that this node
 	// wants to receive.  nil means default.  non-nil causes this
 	// map to be sent to new peers as a MsgOfInterest message type.
@@ -727,6 +730,10 @@ func (wn *WebsocketNetwork) setup() {
 	}
 	maxIdleConnsPerHost := int(wn.config.ConnectionsRateLimitingCount)
 	wn.dialer = makeRateLimitingDialer(wn.phonebook, preferredResolver)
+	if wn.config.LinkEmulationFile != "" {
+		wn.linkEmulator = makeLinkEmulator(wn.config.LinkEmulationFile, wn.log)
+		wn.dialer.linkEmulator = wn.linkEmulator
+	}
 	wn.transport = makeRateLimitingTransport(wn.phonebook, 10*time.Second, &wn.dialer, maxIdleConnsPerHost)
 
 	wn.upgrader.ReadBufferSize = 4096
@@ -899,6 +906,11 @@ func (wn *WebsocketNetwork) Start() {
 
 	go wn.postMessagesOfInterestThread()
 
+	if wn.linkEmulator != nil {
+		wn.wg.Add(1)
+		go wn.linkEmulationThread()
+	}
+
 	wn.log.Infof("serving genesisID=%s on %#v with RandomID=%s", wn.GenesisID, wn.PublicAddress(), wn.RandomID)
 }
 
@@ -1770,6 +1782,9 @@ func (wn *WebsocketNetwork) checkNewConnectionsNeeded() bool {
 			// filter out self-public address, so we won't try to connect to ourselves.
 			continue
 		}
+		if wn.linkEmulator != nil && wn.linkEmulator.isDown(na) {
+			continue
+		}
 		gossipAddr, ok := wn.tryConnectReserveAddr(na)
 		if ok {
 			wn.wg.Add(1)
@@ -2284,9 +2299,14 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		atomic.AddInt32(&wn.throttledOutgoingConnections, int32(1))
 	}
 
+	var peerConn wsPeerWebsocketConn = conn
+	if wn.linkEmulator != nil {
+		peerConn = wn.linkEmulator.wrap(conn, addr)
+	}
+
 	peer := &wsPeer{
 		wsPeerCore:                  makePeerCore(wn, addr, wn.GetRoundTripper(), "" /* origin */),
-		conn:                        conn,
+		conn:                        peerConn,
 		outgoing:                    true,
 		incomingMsgFilter:           wn.incomingMsgFilter,
 		createTime:                  time.Now(),
@@ -2614,6 +2634,33 @@ func (wn *WebsocketNetwork) postMessagesOfInterestThread() {
 	}
 }
 
+// linkEmulationThread reloads the link emulation file as it changes, disconnecting the peers whose
+// link went down and connecting to the peers whose link came back up.
+func (wn *WebsocketNetwork) linkEmulationThread() {
+	defer wn.wg.Done()
+	ticker := time.NewTicker(linkEmulationRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-wn.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !wn.linkEmulator.reload() {
+			continue
+		}
+		var peers []*wsPeer
+		peers, _ = wn.peerSnapshot(peers)
+		for _, peer := range peers {
+			if peer.outgoing && wn.linkEmulator.isDown(peer.GetAddress()) {
+				wn.log.Infof("disconnecting from %s: link is down", peer.GetAddress())
+				wn.disconnect(peer, disconnectLinkDown)
+			}
+		}
+		wn.RequestConnectOutgoing(false, wn.ctx.Done())
+	}
+}
+
 // SubstituteGenesisID substitutes the "{genesisID}" with their network-specific genesisID.
 func (wn *WebsocketNetwork) SubstituteGenesisID(rawURL string) string {
 	return strings.Replace(rawURL, "{genesisID}", wn.GenesisID, -1)
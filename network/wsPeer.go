@@ -168,6 +168,7 @@ const disconnectRequestReceived disconnectReason = "DisconnectRequest"
 const disconnectStaleWrite disconnectReason = "DisconnectStaleWrite"
 const disconnectDuplicateConnection disconnectReason = "DuplicateConnection"
 const disconnectBadIdentityData disconnectReason = "BadIdentityData"
+const disconnectLinkDown disconnectReason = "LinkDown"
 
 // Response is the structure holding the response from the server
 type Response struct {
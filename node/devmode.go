@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+var errRoundsNotInDevMode = errors.New("rounds can only be advanced in dev mode")
+var errTimeStampNotInDevMode = errors.New("cannot set next block timestamp when not in dev mode")
+var errBlockIntervalNotInDevMode = errors.New("block interval is only available in dev mode")
+
+// AdvanceRounds writes the given number of blocks to the ledger, each holding whatever transactions
+// are pending in the transaction pool, and returns the latest round. This is only available in dev mode.
+func (node *AlgorandFullNode) AdvanceRounds(rounds uint64) (basics.Round, error) {
+	if !node.devMode {
+		return 0, errRoundsNotInDevMode
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	for i := uint64(0); i < rounds; i++ {
+		err := node.writeDevmodeBlock()
+		if err != nil {
+			return node.ledger.Latest(), err
+		}
+	}
+	return node.ledger.Latest(), nil
+}
+
+// SetNextBlockTimeStamp sets the exact timestamp of the next block, taking precedence over the
+// block timestamp offset for that block only. This is only available in dev mode.
+func (node *AlgorandFullNode) SetNextBlockTimeStamp(timestamp int64) error {
+	if !node.devMode {
+		return errTimeStampNotInDevMode
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	latest, err := node.ledger.BlockHdr(node.ledger.Latest())
+	if err != nil {
+		return err
+	}
+	if timestamp < latest.TimeStamp {
+		return fmt.Errorf("next block timestamp %d cannot be before the latest block timestamp %d", timestamp, latest.TimeStamp)
+	}
+	node.nextTimestamp = &timestamp
+	return nil
+}
+
+// GetBlockInterval returns the interval at which blocks are automatically written, or zero if blocks are
+// only written when transactions are submitted. This is only available in dev mode.
+func (node *AlgorandFullNode) GetBlockInterval() (time.Duration, error) {
+	if !node.devMode {
+		return 0, errBlockIntervalNotInDevMode
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return node.blockInterval, nil
+}
+
+// SetBlockInterval sets the interval at which blocks are automatically written, regardless of submitted
+// transactions. An interval of zero stops the automatic block production. This is only available in dev mode.
+func (node *AlgorandFullNode) SetBlockInterval(interval time.Duration) error {
+	if !node.devMode {
+		return errBlockIntervalNotInDevMode
+	}
+	if interval < 0 {
+		return fmt.Errorf("block interval cannot be negative")
+	}
+	node.mu.Lock()
+	node.blockInterval = interval
+	node.mu.Unlock()
+
+	// Wake up devModeBlockThread(), non-blocking.
+	select {
+	case node.blockIntervalNotify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// devModeBlockThread writes a block every time the block interval elapses, for as long as an interval is set.
+func (node *AlgorandFullNode) devModeBlockThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	var ticker *time.Ticker
+	var tick <-chan time.Time
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			return
+		case <-node.blockIntervalNotify:
+			if ticker != nil {
+				ticker.Stop()
+				ticker, tick = nil, nil
+			}
+			node.mu.Lock()
+			interval := node.blockInterval
+			node.mu.Unlock()
+			if interval > 0 {
+				ticker = time.NewTicker(interval)
+				tick = ticker.C
+			}
+		case <-tick:
+			var err error
+			node.mu.Lock()
+			// the node might have been stopped while we were waiting for the lock.
+			select {
+			case <-done:
+			default:
+				err = node.writeDevmodeBlock()
+			}
+			node.mu.Unlock()
+			if err != nil {
+				node.log.Warnf("devModeBlockThread: unable to write block: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDevModeRoundsNotInDevMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.DisableNetworking = true
+	node, err := MakeFull(logging.TestingLog(t), t.TempDir(), cfg, []string{}, followNodeDefaultGenesis())
+	require.NoError(t, err)
+
+	_, err = node.AdvanceRounds(1)
+	require.ErrorIs(t, err, errRoundsNotInDevMode)
+	require.ErrorIs(t, node.SetNextBlockTimeStamp(1), errTimeStampNotInDevMode)
+	require.ErrorIs(t, node.SetBlockInterval(time.Second), errBlockIntervalNotInDevMode)
+	_, err = node.GetBlockInterval()
+	require.ErrorIs(t, err, errBlockIntervalNotInDevMode)
+}
+
+func TestDevModeRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.DisableNetworking = true
+	genesis := followNodeDefaultGenesis()
+	genesis.DevMode = true
+	node, err := MakeFull(logging.TestingLog(t), t.TempDir(), cfg, []string{}, genesis)
+	require.NoError(t, err)
+	node.Start()
+	defer node.Stop()
+
+	latest, err := node.AdvanceRounds(5)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), latest)
+	require.Equal(t, latest, node.Ledger().Latest())
+
+	// the exact timestamp is used for the next block, and later blocks cannot go back in time
+	hdr, err := node.Ledger().BlockHdr(latest)
+	require.NoError(t, err)
+	require.Error(t, node.SetNextBlockTimeStamp(hdr.TimeStamp-1))
+	next := hdr.TimeStamp + 1_000_000
+	require.NoError(t, node.SetNextBlockTimeStamp(next))
+	latest, err = node.AdvanceRounds(2)
+	require.NoError(t, err)
+	hdr, err = node.Ledger().BlockHdr(latest - 1)
+	require.NoError(t, err)
+	require.Equal(t, next, hdr.TimeStamp)
+	hdr, err = node.Ledger().BlockHdr(latest)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, hdr.TimeStamp, next)
+
+	// blocks are written on their own while an interval is set
+	require.NoError(t, node.SetBlockInterval(10*time.Millisecond))
+	interval, err := node.GetBlockInterval()
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Millisecond, interval)
+	require.Eventually(t, func() bool {
+		return node.Ledger().Latest() >= latest+3
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, node.SetBlockInterval(0))
+	time.Sleep(50 * time.Millisecond)
+	stopped := node.Ledger().Latest()
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, stopped, node.Ledger().Latest())
+}
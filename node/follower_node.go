@@ -42,6 +42,7 @@ import (
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/util/execpool"
 )
 
@@ -460,3 +461,43 @@ func (node *AlgorandFollowerNode) SetBlockTimeStampOffset(offset int64) error {
 func (node *AlgorandFollowerNode) GetBlockTimeStampOffset() (*int64, error) {
 	return nil, fmt.Errorf("cannot get block timestamp offset in follower mode")
 }
+
+// StateProofWorkerStatus returns an error in follower mode, since no state proof worker runs.
+func (node *AlgorandFollowerNode) StateProofWorkerStatus() (stateproof.WorkerStatus, error) {
+	return stateproof.WorkerStatus{}, stateproof.ErrWorkerNotRunning
+}
+
+// SaveLedgerSnapshot returns an error in follower mode.
+func (node *AlgorandFollowerNode) SaveLedgerSnapshot(name string) (LedgerSnapshot, error) {
+	return LedgerSnapshot{}, fmt.Errorf("cannot save a ledger snapshot in follower mode")
+}
+
+// RevertLedgerSnapshot returns an error in follower mode.
+func (node *AlgorandFollowerNode) RevertLedgerSnapshot(name string) (LedgerSnapshot, error) {
+	return LedgerSnapshot{}, fmt.Errorf("cannot revert to a ledger snapshot in follower mode")
+}
+
+// ListLedgerSnapshots returns an error in follower mode.
+func (node *AlgorandFollowerNode) ListLedgerSnapshots() ([]LedgerSnapshot, error) {
+	return nil, fmt.Errorf("cannot list ledger snapshots in follower mode")
+}
+
+// AdvanceRounds returns an error in follower mode.
+func (node *AlgorandFollowerNode) AdvanceRounds(rounds uint64) (basics.Round, error) {
+	return 0, fmt.Errorf("cannot advance rounds in follower mode")
+}
+
+// SetNextBlockTimeStamp returns an error in follower mode.
+func (node *AlgorandFollowerNode) SetNextBlockTimeStamp(timestamp int64) error {
+	return fmt.Errorf("cannot set next block timestamp in follower mode")
+}
+
+// GetBlockInterval returns an error in follower mode.
+func (node *AlgorandFollowerNode) GetBlockInterval() (time.Duration, error) {
+	return 0, fmt.Errorf("cannot get block interval in follower mode")
+}
+
+// SetBlockInterval returns an error in follower mode.
+func (node *AlgorandFollowerNode) SetBlockInterval(interval time.Duration) error {
+	return fmt.Errorf("cannot set block interval in follower mode")
+}
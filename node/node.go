@@ -41,6 +41,7 @@ import (
 	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/data/txntrace"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
@@ -135,6 +136,8 @@ type AlgorandFullNode struct {
 	genesisHash     crypto.Digest
 	devMode         bool // is this node operating in a developer mode ? ( benign agreement, broadcasting transaction generates a new block )
 	timestampOffset *int64
+	nextTimestamp   *int64 // exact timestamp of the next devmode block, cleared once used
+	blockInterval   time.Duration
 
 	log logging.Logger
 
@@ -150,6 +153,7 @@ type AlgorandFullNode struct {
 	catchupBlockAuth                   blockAuthenticatorImpl
 
 	oldKeyDeletionNotify        chan struct{}
+	blockIntervalNotify         chan struct{}
 	monitoringRoutinesWaitGroup sync.WaitGroup
 
 	tracer messagetracer.MessageTracer
@@ -187,6 +191,10 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.devMode = genesis.DevMode
 	node.config = cfg
 
+	if cfg.EnableTxnLifecycleTracing {
+		txntrace.Enable(cfg.TxnLifecycleTraceSize)
+	}
+
 	// tie network, block fetcher, and agreement services together
 	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
 	if err != nil {
@@ -309,6 +317,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 
 	node.oldKeyDeletionNotify = make(chan struct{}, 1)
+	node.blockIntervalNotify = make(chan struct{}, 1)
 
 	catchpointCatchupState, err := node.ledger.GetCatchpointCatchupState(context.Background())
 	if err != nil {
@@ -394,6 +403,11 @@ func (node *AlgorandFullNode) startMonitoringRoutines() {
 	// Delete old participation keys
 	go node.oldKeyDeletionThread(node.ctx.Done())
 
+	if node.devMode {
+		node.monitoringRoutinesWaitGroup.Add(1)
+		go node.devModeBlockThread(node.ctx.Done())
+	}
+
 	if node.config.EnableUsageLog {
 		node.monitoringRoutinesWaitGroup.Add(1)
 		go logging.UsageLogThread(node.ctx, node.log, 100*time.Millisecond, &node.monitoringRoutinesWaitGroup)
@@ -485,6 +499,12 @@ func (node *AlgorandFullNode) writeDevmodeBlock() (err error) {
 	if node.timestampOffset != nil && *node.timestampOffset < math.MaxInt64-prev.TimeStamp {
 		blk.TimeStamp = prev.TimeStamp + *node.timestampOffset
 	}
+	if node.nextTimestamp != nil {
+		if *node.nextTimestamp >= prev.TimeStamp {
+			blk.TimeStamp = *node.nextTimestamp
+		}
+		node.nextTimestamp = nil
+	}
 	blk.BlockHeader.Seed = committee.Seed(prev.Hash())
 	vb2 := ledgercore.MakeValidatedBlock(blk, vb.Delta())
 	vb = &vb2
@@ -528,7 +548,9 @@ func (node *AlgorandFullNode) broadcastSignedTxGroup(txgroup []transactions.Sign
 		return err
 	}
 
+	verifyTimer := txntrace.Start(txntrace.StageVerify, txgroup)
 	_, err = verify.TxnGroup(txgroup, &b, node.ledger.VerifiedTransactionCache(), node.ledger)
+	verifyTimer.End(err)
 	if err != nil {
 		node.log.Warnf("malformed transaction: %v", err)
 		return err
@@ -555,6 +577,7 @@ func (node *AlgorandFullNode) broadcastSignedTxGroup(txgroup []transactions.Sign
 		txids = append(txids, tx.ID())
 	}
 	err = node.net.Broadcast(context.TODO(), protocol.TxnTag, enc, false, nil)
+	txntrace.Event(txntrace.StageRelay, txgroup, err)
 	if err != nil {
 		node.log.Infof("failure broadcasting transaction to network: %v - transaction group was %+v", err, txgroup)
 		return err
@@ -1469,3 +1492,8 @@ func (node *AlgorandFullNode) GetBlockTimeStampOffset() (*int64, error) {
 	}
 	return nil, fmt.Errorf("cannot get block timestamp offset when not in dev mode")
 }
+
+// StateProofWorkerStatus reports the progress of the state proof worker on upcoming state proofs.
+func (node *AlgorandFullNode) StateProofWorkerStatus() (stateproof.WorkerStatus, error) {
+	return node.stateProofWorker.Status()
+}
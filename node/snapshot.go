@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+)
+
+// ledgerSnapshotsDirectory is the directory, within the genesis directory, where DevMode ledger snapshots are kept.
+const ledgerSnapshotsDirectory = "snapshots"
+
+// ErrLedgerSnapshotNotFound is returned when reverting to a ledger snapshot that does not exist.
+var ErrLedgerSnapshotNotFound = errors.New("ledger snapshot not found")
+
+var errSnapshotsNotInDevMode = errors.New("ledger snapshots are only available in dev mode")
+
+var ledgerSnapshotNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// LedgerSnapshot describes a ledger snapshot saved by a DevMode node.
+type LedgerSnapshot struct {
+	Name  string
+	Round basics.Round
+}
+
+func (node *AlgorandFullNode) ledgerSnapshotDir(name string) (string, error) {
+	if !node.devMode {
+		return "", errSnapshotsNotInDevMode
+	}
+	if !ledgerSnapshotNameRegexp.MatchString(name) {
+		return "", fmt.Errorf("invalid ledger snapshot name '%s': only letters, digits, '-' and '_' are allowed", name)
+	}
+	return filepath.Join(node.rootDir, node.genesisID, ledgerSnapshotsDirectory, name), nil
+}
+
+// SaveLedgerSnapshot saves the ledger state at the latest round under the given name, replacing
+// any previous snapshot with the same name. This is only available in dev mode.
+func (node *AlgorandFullNode) SaveLedgerSnapshot(name string) (LedgerSnapshot, error) {
+	dir, err := node.ledgerSnapshotDir(name)
+	if err != nil {
+		return LedgerSnapshot{}, err
+	}
+
+	// holding the node lock prevents BroadcastSignedTxGroup from adding dev mode blocks meanwhile.
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	err = os.MkdirAll(filepath.Dir(dir), 0700)
+	if err != nil {
+		return LedgerSnapshot{}, err
+	}
+	// the snapshot is written aside first, so that a failure does not destroy an existing snapshot.
+	tmpDir := dir + ".tmp"
+	os.RemoveAll(tmpDir)
+	rnd, err := node.ledger.SaveSnapshot(tmpDir)
+	if err != nil {
+		return LedgerSnapshot{}, err
+	}
+	err = os.RemoveAll(dir)
+	if err == nil {
+		err = os.Rename(tmpDir, dir)
+	}
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return LedgerSnapshot{}, err
+	}
+	node.log.Infof("saved ledger snapshot '%s' at round %d", name, rnd)
+	return LedgerSnapshot{Name: name, Round: rnd}, nil
+}
+
+// RevertLedgerSnapshot reverts the ledger to the snapshot saved under the given name, and clears
+// the transaction pool. The snapshot is kept, so it can be reverted to again. This is only
+// available in dev mode.
+func (node *AlgorandFullNode) RevertLedgerSnapshot(name string) (LedgerSnapshot, error) {
+	dir, err := node.ledgerSnapshotDir(name)
+	if err != nil {
+		return LedgerSnapshot{}, err
+	}
+
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	if _, err = os.Stat(dir); os.IsNotExist(err) {
+		return LedgerSnapshot{}, fmt.Errorf("%w: '%s'", ErrLedgerSnapshotNotFound, name)
+	}
+	rnd, err := node.ledger.RestoreSnapshot(dir)
+	// the pool is reset even if the restore failed, since the ledger was reloaded either way.
+	node.transactionPool.Reset()
+	if err != nil {
+		return LedgerSnapshot{}, err
+	}
+	node.log.Infof("reverted ledger to snapshot '%s' at round %d", name, rnd)
+	return LedgerSnapshot{Name: name, Round: rnd}, nil
+}
+
+// ListLedgerSnapshots returns the saved ledger snapshots, sorted by name. This is only available in dev mode.
+func (node *AlgorandFullNode) ListLedgerSnapshots() ([]LedgerSnapshot, error) {
+	if !node.devMode {
+		return nil, errSnapshotsNotInDevMode
+	}
+	entries, err := os.ReadDir(filepath.Join(node.rootDir, node.genesisID, ledgerSnapshotsDirectory))
+	if os.IsNotExist(err) {
+		return []LedgerSnapshot{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]LedgerSnapshot, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || !ledgerSnapshotNameRegexp.MatchString(entry.Name()) {
+			continue
+		}
+		hdr, err := ledger.SnapshotHeader(filepath.Join(node.rootDir, node.genesisID, ledgerSnapshotsDirectory, entry.Name()))
+		if err != nil {
+			node.log.Warnf("skipping unreadable ledger snapshot '%s': %v", entry.Name(), err)
+			continue
+		}
+		snapshots = append(snapshots, LedgerSnapshot{Name: entry.Name(), Round: hdr.Round})
+	}
+	return snapshots, nil
+}
@@ -17,15 +17,19 @@
 package rpcs
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"path"
 	"strconv"
 	"strings"
 	"sync"
 
+	"github.com/DataDog/zstd"
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-codec/codec"
@@ -45,11 +49,22 @@ import (
 
 // BlockResponseContentType is the HTTP Content-Type header for a raw binary block
 const BlockResponseContentType = "application/x-algorand-block-v1"
+
+// BlockResponseZstdContentType is the HTTP Content-Type header for a block and certificate which are
+// individually zstd-compressed. It is only served to clients that list it in their Accept header.
+const BlockResponseZstdContentType = "application/x-algorand-block-v1+zstd"
 const blockResponseHasBlockCacheControl = "public, max-age=31536000, immutable"    // 31536000 seconds are one year.
 const blockResponseMissingBlockCacheControl = "public, max-age=1, must-revalidate" // cache for 1 second, and force revalidation afterward
 const blockServerMaxBodyLength = 512                                               // we don't really pass meaningful content here, so 512 bytes should be a safe limit
 const blockServerCatchupRequestBufferSize = 10
 
+// BlockServiceEarliestRoundHeader and BlockServiceLatestRoundHeader are the HTTP headers used to advertise
+// the range of block rounds a node is able to serve.
+const (
+	BlockServiceEarliestRoundHeader = "X-Algorand-Block-Earliest-Round"
+	BlockServiceLatestRoundHeader   = "X-Algorand-Block-Latest-Round"
+)
+
 // BlockServiceBlockPath is the path to register BlockService as a handler for when using gorilla/mux
 // e.g. .Handle(BlockServiceBlockPath, &ls)
 const BlockServiceBlockPath = "/v{version:[0-9.]+}/{genesisID}/block/{round:[0-9a-z]+}"
@@ -70,6 +85,18 @@ type LedgerForBlockService interface {
 	EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error)
 }
 
+// ledgerCompressedBlockCert is implemented by ledgers which can provide zstd-compressed
+// blocks and certificates, typically without having to compress them on every request.
+type ledgerCompressedBlockCert interface {
+	CompressedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error)
+}
+
+// ledgerBlockRange is implemented by ledgers which may not retain every block,
+// allowing BlockService to advertise and enforce the range it can serve.
+type ledgerBlockRange interface {
+	BlockRange() (earliest basics.Round, latest basics.Round)
+}
+
 // BlockService represents the Block RPC API
 type BlockService struct {
 	ledger                  LedgerForBlockService
@@ -102,6 +129,14 @@ type PreEncodedBlockCert struct {
 	Certificate codec.Raw `codec:"cert"`
 }
 
+// CompressedBlockCert defines how a block and its certificate are encoded when served
+// with BlockResponseZstdContentType; each field holds a zstd frame of the msgpack encoding.
+//msgp:ignore CompressedBlockCert
+type CompressedBlockCert struct {
+	Block       []byte `codec:"block"`
+	Certificate []byte `codec:"cert"`
+}
+
 type fallbackEndpoints struct {
 	endpoints []string
 	lastUsed  int
@@ -213,7 +248,29 @@ func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Re
 		response.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	encodedBlockCert, err := bs.rawBlockBytes(basics.Round(round))
+	// the body depends on the Accept header, so shared caches must key on it
+	response.Header().Set("Vary", "Accept")
+	if lr, ok := bs.ledger.(ledgerBlockRange); ok {
+		earliest, latest := lr.BlockRange()
+		response.Header().Set(BlockServiceEarliestRoundHeader, strconv.FormatUint(uint64(earliest), 10))
+		response.Header().Set(BlockServiceLatestRoundHeader, strconv.FormatUint(uint64(latest), 10))
+		if basics.Round(round) < earliest {
+			// the block was already pruned from this node.
+			ok = bs.redirectRequest(round, response, request)
+			if !ok {
+				response.Header().Set("Cache-Control", blockResponseMissingBlockCacheControl)
+				response.WriteHeader(http.StatusNotFound)
+			}
+			return
+		}
+	}
+	contentType := BlockResponseContentType
+	if strings.Contains(request.Header.Get("Accept"), BlockResponseZstdContentType) {
+		if _, ok := bs.ledger.(ledgerCompressedBlockCert); ok {
+			contentType = BlockResponseZstdContentType
+		}
+	}
+	encodedBlockCert, err := bs.rawBlockBytes(basics.Round(round), contentType)
 	if err != nil {
 		switch err.(type) {
 		case ledgercore.ErrNoEntry:
@@ -232,7 +289,7 @@ func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Re
 		}
 	}
 
-	response.Header().Set("Content-Type", BlockResponseContentType)
+	response.Header().Set("Content-Type", contentType)
 	response.Header().Set("Content-Length", strconv.Itoa(len(encodedBlockCert)))
 	response.Header().Set("Cache-Control", blockResponseHasBlockCacheControl)
 	response.WriteHeader(http.StatusOK)
@@ -369,9 +426,9 @@ func (bs *BlockService) getRandomArchiver() (endpointAddress string) {
 	return
 }
 
-// rawBlockBytes returns the block/cert for a given round, while taking the lock
+// rawBlockBytes returns the block/cert for a given round encoded for the given content type, while taking the lock
 // to ensure the block service is currently active.
-func (bs *BlockService) rawBlockBytes(round basics.Round) ([]byte, error) {
+func (bs *BlockService) rawBlockBytes(round basics.Round, contentType string) ([]byte, error) {
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
 	select {
@@ -382,6 +439,9 @@ func (bs *BlockService) rawBlockBytes(round basics.Round) ([]byte, error) {
 		}
 	default:
 	}
+	if contentType == BlockResponseZstdContentType {
+		return compressedBlockBytes(bs.ledger.(ledgerCompressedBlockCert), round)
+	}
 	return RawBlockBytes(bs.ledger, round)
 }
 
@@ -427,6 +487,58 @@ func RawBlockBytes(l LedgerForBlockService, round basics.Round) ([]byte, error)
 	}), nil
 }
 
+func compressedBlockBytes(l ledgerCompressedBlockCert, round basics.Round) ([]byte, error) {
+	blk, cert, err := l.CompressedBlockCert(round)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cert) == 0 {
+		return nil, ledgercore.ErrNoEntry{Round: round}
+	}
+
+	return protocol.EncodeReflect(CompressedBlockCert{
+		Block:       blk,
+		Certificate: cert,
+	}), nil
+}
+
+// DecompressBlockCert converts a response served with BlockResponseZstdContentType into the
+// encoding served with BlockResponseContentType. The block and the certificate are each limited
+// to limit bytes once decompressed.
+func DecompressBlockCert(data []byte, limit uint64) ([]byte, error) {
+	var cbc CompressedBlockCert
+	err := protocol.DecodeReflect(data, &cbc)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := zstdDecompressLimited(cbc.Block, limit)
+	if err != nil {
+		return nil, fmt.Errorf("block: %w", err)
+	}
+	cert, err := zstdDecompressLimited(cbc.Certificate, limit)
+	if err != nil {
+		return nil, fmt.Errorf("certificate: %w", err)
+	}
+	return protocol.EncodeReflect(PreEncodedBlockCert{
+		Block:       blk,
+		Certificate: cert,
+	}), nil
+}
+
+func zstdDecompressLimited(data []byte, limit uint64) ([]byte, error) {
+	r := zstd.NewReader(bytes.NewReader(data))
+	defer r.Close()
+	out, err := io.ReadAll(io.LimitReader(r, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(out)) > limit {
+		return nil, network.ErrIncomingMsgTooLarge
+	}
+	return out, nil
+}
+
 // FormatBlockQuery formats a block request query for the given network and round number
 func FormatBlockQuery(round uint64, parsedURL string, net network.GossipNode) string {
 	return net.SubstituteGenesisID(path.Join(parsedURL, "/v1/{genesisID}/block/"+strconv.FormatUint(uint64(round), 36)))
@@ -329,6 +329,141 @@ func TestRedirectExceptions(t *testing.T) {
 	require.Contains(t, err.Error(), "stopped after 10 redirects")
 }
 
+// prunedLedger pretends blocks before earliest were forgotten.
+type prunedLedger struct {
+	*data.Ledger
+	earliest basics.Round
+}
+
+func (l prunedLedger) BlockRange() (basics.Round, basics.Round) {
+	_, latest := l.Ledger.BlockRange()
+	return l.earliest, latest
+}
+
+// TestRedirectPrunedBlock tests that blocks older than the advertised range are redirected
+func TestRedirectPrunedBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+
+	ledger1 := makeLedger(t, "l1")
+	defer ledger1.Close()
+	addBlock(t, ledger1)
+	addBlock(t, ledger1)
+	ledger2 := makeLedger(t, "l2")
+	defer ledger2.Close()
+	addBlock(t, ledger2)
+
+	net1 := &httpTestPeerSource{}
+	net2 := &httpTestPeerSource{}
+
+	nodeA := &basicRPCNode{}
+	nodeB := &basicRPCNode{}
+	nodeC := &basicRPCNode{}
+	nodeA.start()
+	defer nodeA.stop()
+	nodeB.start()
+	defer nodeB.stop()
+	nodeC.start()
+	defer nodeC.stop()
+
+	// nodeA and nodeB both claim to have pruned round 1; only nodeB redirects to nodeC, which has it.
+	config := config.GetDefaultLocal()
+	bs1 := MakeBlockService(log, config, prunedLedger{Ledger: ledger1, earliest: 2}, net1, "{genesisID}")
+	config.BlockServiceCustomFallbackEndpoints = nodeC.rootURL()
+	bs2 := MakeBlockService(log, config, prunedLedger{Ledger: ledger1, earliest: 2}, net1, "{genesisID}")
+	bs3 := MakeBlockService(log, config, ledger2, net2, "{genesisID}")
+
+	nodeA.RegisterHTTPHandler(BlockServiceBlockPath, bs1)
+	nodeB.RegisterHTTPHandler(BlockServiceBlockPath, bs2)
+	nodeC.RegisterHTTPHandler(BlockServiceBlockPath, bs3)
+
+	client := http.Client{}
+	get := func(node *basicRPCNode, round uint64) *http.Response {
+		parsedURL, err := network.ParseHostOrURL(node.rootURL())
+		require.NoError(t, err)
+		parsedURL.Path = FormatBlockQuery(round, parsedURL.Path, net1)
+		request, err := http.NewRequest("GET", parsedURL.String(), nil)
+		require.NoError(t, err)
+		network.SetUserAgentHeader(request.Header)
+		response, err := client.Do(request)
+		require.NoError(t, err)
+		response.Body.Close()
+		return response
+	}
+
+	response := get(nodeA, 1)
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+	require.Equal(t, "2", response.Header.Get(BlockServiceEarliestRoundHeader))
+	require.Equal(t, "2", response.Header.Get(BlockServiceLatestRoundHeader))
+
+	response = get(nodeA, 2)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "2", response.Header.Get(BlockServiceEarliestRoundHeader))
+
+	// the redirected response comes from nodeC, which advertises its own range.
+	response = get(nodeB, 1)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "0", response.Header.Get(BlockServiceEarliestRoundHeader))
+	require.Equal(t, "1", response.Header.Get(BlockServiceLatestRoundHeader))
+}
+
+// TestBlockServiceCompressed tests that compressed blocks are only served to clients accepting them
+func TestBlockServiceCompressed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+
+	ledger1 := makeLedger(t, "l1")
+	defer ledger1.Close()
+	addBlock(t, ledger1)
+
+	net1 := &httpTestPeerSource{}
+	nodeA := &basicRPCNode{}
+	nodeA.start()
+	defer nodeA.stop()
+
+	config := config.GetDefaultLocal()
+	bs1 := MakeBlockService(log, config, ledger1, net1, "{genesisID}")
+	nodeA.RegisterHTTPHandler(BlockServiceBlockPath, bs1)
+
+	expected, err := RawBlockBytes(ledger1, 1)
+	require.NoError(t, err)
+
+	client := http.Client{}
+	for _, accept := range []string{"", BlockResponseContentType + ", " + BlockResponseZstdContentType} {
+		parsedURL, err := network.ParseHostOrURL(nodeA.rootURL())
+		require.NoError(t, err)
+		parsedURL.Path = FormatBlockQuery(1, parsedURL.Path, net1)
+		request, err := http.NewRequest("GET", parsedURL.String(), nil)
+		require.NoError(t, err)
+		network.SetUserAgentHeader(request.Header)
+		if accept != "" {
+			request.Header.Set("Accept", accept)
+		}
+		response, err := client.Do(request)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		require.Equal(t, "Accept", response.Header.Get("Vary"))
+		body, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		require.NoError(t, err)
+
+		if accept == "" {
+			require.Equal(t, BlockResponseContentType, response.Header.Get("Content-Type"))
+			require.Equal(t, expected, body)
+			continue
+		}
+		require.Equal(t, BlockResponseZstdContentType, response.Header.Get("Content-Type"))
+		decompressed, err := DecompressBlockCert(body, 1<<20)
+		require.NoError(t, err)
+		require.Equal(t, expected, decompressed)
+
+		_, err = DecompressBlockCert(body, 16)
+		require.ErrorIs(t, err, network.ErrIncomingMsgTooLarge)
+	}
+}
+
 var poolAddr = basics.Address{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
 
@@ -925,8 +925,8 @@ func (pps *WorkerState) makeNextUniqueNoteField() []byte {
 
 var errNotOptedIn = errors.New("not opted in")
 
-func (pps *WorkerState) constructTxn(from, to string, fee uint64, client *libgoal.Client) (txn transactions.Transaction, sender string, update txnUpdate, err error) {
-	var noteField []byte
+// makeNoteAndLease returns the note and lease fields of the next transaction, randomized as configured.
+func (pps *WorkerState) makeNoteAndLease() (noteField []byte, lease [32]byte) {
 	const pingpongTag = "pingpong"
 	const tagLen = len(pingpongTag)
 	// if random note flag set, then append a random number of additional bytes
@@ -941,10 +941,14 @@ func (pps *WorkerState) constructTxn(from, to string, fee uint64, client *libgoa
 	}
 
 	// if random lease flag set, fill the lease field with random bytes
-	var lease [32]byte
 	if pps.cfg.RandomLease {
 		crypto.RandBytes(lease[:])
 	}
+	return
+}
+
+func (pps *WorkerState) constructTxn(from, to string, fee uint64, client *libgoal.Client) (txn transactions.Transaction, sender string, update txnUpdate, err error) {
+	noteField, lease := pps.makeNoteAndLease()
 
 	// weighted random selection of traffic type
 	// TODO: construct*Txn() have the same signature, make this data structures and loop over them?
@@ -979,22 +983,27 @@ weightdone:
 		_, _ = fmt.Fprintf(os.Stdout, "error constructing transaction %v\n", err)
 		return
 	}
+	err = pps.adjustValidityAndFee(&txn, client)
+	return
+}
+
+// adjustValidityAndFee shortens the validity window of the transaction and, if no MaxFee is configured, raises its fee to the suggested fee.
+func (pps *WorkerState) adjustValidityAndFee(txn *transactions.Transaction, client *libgoal.Client) error {
 	// adjust transaction duration for 5 rounds. That would prevent it from getting stuck in the transaction pool for too long.
 	txn.LastValid = txn.FirstValid + 5
 
 	// if pps.cfg.MaxFee == 0, automatically adjust the fee amount to required min fee
 	if pps.cfg.MaxFee == 0 {
-		var suggestedFee uint64
-		suggestedFee, err = client.SuggestedFee()
+		suggestedFee, err := client.SuggestedFee()
 		if err != nil {
 			_, _ = fmt.Fprintf(os.Stdout, "error retrieving suggestedFee: %v\n", err)
-			return
+			return err
 		}
 		if suggestedFee > txn.Fee.Raw {
 			txn.Fee.Raw = suggestedFee
 		}
 	}
-	return
+	return nil
 }
 
 type txnUpdate interface {
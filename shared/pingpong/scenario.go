@@ -0,0 +1,1080 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pingpong
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+// scenario action types
+const (
+	// a payment between two pingpong accounts
+	scenarioPayment = "payment"
+	// a transfer of one of the assets created by pingpong, requires NumAsset
+	scenarioAsset = "asset"
+	// a call to one of the apps created by pingpong, requires NumApp. The app updates or reads its boxes as
+	// configured by NumBoxUpdate and NumBoxRead.
+	scenarioApp = "app"
+	// a call to an ARC-4 method of an existing app
+	scenarioMethod = "method"
+	// a group of two payments, the first rekeying the sender to the receiver and the second, signed by the
+	// receiver, rekeying it back
+	scenarioRekey = "rekey"
+)
+
+var scenarioActionTypes = []string{
+	scenarioPayment,
+	scenarioAsset,
+	scenarioApp,
+	scenarioMethod,
+	scenarioRekey,
+}
+
+// method argument generator kinds
+const (
+	// always the same JSON value
+	argFixed = "fixed"
+	// one of several JSON values, picked uniformly
+	argChoice = "choice"
+	// a random value of the argument type
+	argRandom = "random"
+	// an increasing integer
+	argSequence = "sequence"
+	// the address of the sender of the call
+	argSender = "sender"
+	// the name of one of the boxes referenced by the call
+	argBox = "box"
+)
+
+// maxMethodArgs is the number of method arguments that fit in the app arguments of a call, after the method
+// selector. Methods with more arguments would need their trailing arguments packed into a tuple, which
+// scenarios do not support.
+const maxMethodArgs = 15
+
+// defaultCommitWait is how long a scenario keeps waiting for its last transactions to be committed.
+const defaultCommitWait = 10 * time.Second
+
+// scenarioCommitTimeout is how long after being sent a transaction is given up on and counted as uncommitted.
+const scenarioCommitTimeout = time.Minute
+
+// scenarioLatencySampleSize is the number of latencies kept per phase to compute the percentiles.
+const scenarioLatencySampleSize = 100000
+
+const scenarioCommitPoll = 100 * time.Millisecond
+
+// Scenario is a sequence of load phases. The phases run one after the other against the accounts, assets and
+// apps prepared from the PpConfig, and the latency from submission to commit is reported per phase.
+//
+// For example, ramping up to a steady load of payments and calls to a contract writing its boxes, followed by
+// a burst with some rekeying:
+//
+//	{
+//		"Name": "kv-store",
+//		"Phases": [
+//			{"Name": "ramp-up", "Duration": "30s", "StartTxnPerSec": 10, "TxnPerSec": 500, "Actions": [{"Type": "payment"}]},
+//			{"Name": "steady", "Duration": "2m", "TxnPerSec": 500, "Actions": [
+//				{"Type": "payment", "Weight": 3},
+//				{"Type": "method", "AppID": 1234, "Method": "put(byte[],uint64)void",
+//					"Boxes": [{"Prefix": "k", "Keys": 1000}],
+//					"Args": [{"Kind": "box", "Box": 0}, {"Kind": "random", "Max": 1000000}]}
+//			]},
+//			{"Name": "burst", "Duration": "10s", "TxnPerSec": 2000, "Actions": [{"Type": "payment"}, {"Type": "rekey"}]}
+//		]
+//	}
+type Scenario struct {
+	// Name identifies the scenario in the report
+	Name string
+	// Phases are run in order
+	Phases []ScenarioPhase
+	// CommitWait is how long to keep waiting for the transactions of the last phase to be committed, e.g. "30s".
+	// It defaults to 10s.
+	CommitWait string `json:",omitempty"`
+
+	commitWait time.Duration
+}
+
+// ScenarioPhase is one step of a Scenario, sending a weighted mix of transactions at a given rate.
+type ScenarioPhase struct {
+	// Name identifies the phase in the report
+	Name string
+	// Duration is how long the phase runs, e.g. "1m"
+	Duration string
+	// StartTxnPerSec, if set, ramps the rate linearly from StartTxnPerSec at the start of the phase to
+	// TxnPerSec at its end
+	StartTxnPerSec uint64 `json:",omitempty"`
+	// TxnPerSec is the rate of the phase
+	TxnPerSec uint64
+	// Actions are the transactions sent during the phase
+	Actions []ScenarioAction
+
+	duration    time.Duration
+	totalWeight float64
+}
+
+// ScenarioAction is one kind of transaction sent during a phase.
+type ScenarioAction struct {
+	// Type is one of "payment", "asset", "app", "method" and "rekey"
+	Type string
+	// Weight is the relative frequency of the action within its phase, and defaults to 1
+	Weight float64 `json:",omitempty"`
+	// AppID is the app called by a method action
+	AppID uint64 `json:",omitempty"`
+	// Method is the ARC-4 signature of the method called by a method action, e.g. "put(byte[],uint64)void"
+	Method string `json:",omitempty"`
+	// Args generate the arguments of the method, one per argument
+	Args []ScenarioArg `json:",omitempty"`
+	// Boxes generate the names of the boxes referenced by a method action, so that the method can write them
+	Boxes []ScenarioBox `json:",omitempty"`
+
+	selector []byte
+}
+
+// ScenarioArg generates the value of a method argument.
+type ScenarioArg struct {
+	// Kind is one of "fixed", "choice", "random", "sequence", "sender" and "box"
+	Kind string
+	// Value is the JSON value of a fixed argument
+	Value json.RawMessage `json:",omitempty"`
+	// Choices are the JSON values a choice argument picks from
+	Choices []json.RawMessage `json:",omitempty"`
+	// Min and Max bound random and sequence integers, inclusive. A zero Max stands for the largest value of
+	// the argument type. A sequence starts at Min and wraps around after Max.
+	Min uint64 `json:",omitempty"`
+	Max uint64 `json:",omitempty"`
+	// Length is the length of random strings and byte[] arguments
+	Length int `json:",omitempty"`
+	// Box is the index in Boxes of the box whose name a box argument is
+	Box int `json:",omitempty"`
+
+	abiType abi.Type
+	values  []interface{}
+	next    uint64
+}
+
+// ScenarioBox generates the name of a box referenced by a method call.
+type ScenarioBox struct {
+	// Prefix starts the box name
+	Prefix string
+	// Keys, if set, appends to Prefix a random 8 byte big-endian key lower than Keys, spreading the calls
+	// over that many boxes
+	Keys uint64 `json:",omitempty"`
+}
+
+// LoadScenarioFromFile reads a scenario and checks it.
+func LoadScenarioFromFile(file string) (*Scenario, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var sc Scenario
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&sc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	err = sc.Check()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return &sc, nil
+}
+
+// Check returns an error if the scenario is invalid, and otherwise prepares it to be run.
+func (sc *Scenario) Check() (err error) {
+	if len(sc.Phases) == 0 {
+		return errors.New("scenario has no phases")
+	}
+	sc.commitWait = defaultCommitWait
+	if sc.CommitWait != "" {
+		sc.commitWait, err = time.ParseDuration(sc.CommitWait)
+		if err != nil || sc.commitWait < 0 {
+			return fmt.Errorf("invalid CommitWait %#v", sc.CommitWait)
+		}
+	}
+	for i := range sc.Phases {
+		err = sc.Phases[i].check()
+		if err != nil {
+			return fmt.Errorf("phase %d (%s): %w", i, sc.Phases[i].Name, err)
+		}
+	}
+	return nil
+}
+
+func (phase *ScenarioPhase) check() (err error) {
+	phase.duration, err = time.ParseDuration(phase.Duration)
+	if err != nil || phase.duration <= 0 {
+		return fmt.Errorf("invalid Duration %#v", phase.Duration)
+	}
+	if phase.TxnPerSec == 0 {
+		return errors.New("zero TxnPerSec")
+	}
+	if len(phase.Actions) == 0 {
+		return errors.New("phase has no actions")
+	}
+	phase.totalWeight = 0
+	for i := range phase.Actions {
+		action := &phase.Actions[i]
+		err = action.check()
+		if err != nil {
+			return fmt.Errorf("action %d (%s): %w", i, action.Type, err)
+		}
+		phase.totalWeight += action.weight()
+	}
+	return nil
+}
+
+// rate returns the target number of transactions per second after the phase has been running for elapsed.
+func (phase *ScenarioPhase) rate(elapsed time.Duration) uint64 {
+	if phase.StartTxnPerSec == 0 || elapsed >= phase.duration {
+		return phase.TxnPerSec
+	}
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	progress := float64(elapsed) / float64(phase.duration)
+	rate := float64(phase.StartTxnPerSec) + (float64(phase.TxnPerSec)-float64(phase.StartTxnPerSec))*progress
+	if rate < 1 {
+		return 1
+	}
+	return uint64(math.Round(rate))
+}
+
+// pickAction picks one of the actions of the phase according to their weights.
+func (phase *ScenarioPhase) pickAction() *ScenarioAction {
+	target := rand.Float64() * phase.totalWeight
+	for i := range phase.Actions {
+		target -= phase.Actions[i].weight()
+		if target < 0 {
+			return &phase.Actions[i]
+		}
+	}
+	return &phase.Actions[len(phase.Actions)-1]
+}
+
+func (action *ScenarioAction) weight() float64 {
+	if action.Weight == 0 {
+		return 1
+	}
+	return action.Weight
+}
+
+func (action *ScenarioAction) check() error {
+	known := false
+	for _, t := range scenarioActionTypes {
+		if action.Type == t {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return fmt.Errorf("unknown action type, expected one of %s", strings.Join(scenarioActionTypes, ", "))
+	}
+	if action.Weight < 0 {
+		return errors.New("negative Weight")
+	}
+	if action.Type != scenarioMethod {
+		if action.AppID != 0 || action.Method != "" || len(action.Args) != 0 || len(action.Boxes) != 0 {
+			return errors.New("only method actions use AppID, Method, Args and Boxes")
+		}
+		return nil
+	}
+
+	if action.AppID == 0 {
+		return errors.New("method action needs an AppID")
+	}
+	_, argTypes, _, err := abi.ParseMethodSignature(action.Method)
+	if err != nil {
+		return fmt.Errorf("invalid Method %#v: %w", action.Method, err)
+	}
+	if len(argTypes) > maxMethodArgs {
+		return fmt.Errorf("method has %d arguments, at most %d are supported", len(argTypes), maxMethodArgs)
+	}
+	if len(argTypes) != len(action.Args) {
+		return fmt.Errorf("method has %d arguments but %d are generated", len(argTypes), len(action.Args))
+	}
+	for i, argType := range argTypes {
+		err = action.Args[i].check(argType, action.Boxes)
+		if err != nil {
+			return fmt.Errorf("argument %d (%s): %w", i, argType, err)
+		}
+	}
+	hash := sha512.Sum512_256([]byte(action.Method))
+	action.selector = hash[0:4]
+	return nil
+}
+
+// methodArgs returns the app arguments of a call to the method of the action, made by sender and referencing
+// the given boxes.
+func (action *ScenarioAction) methodArgs(sender basics.Address, boxNames [][]byte) ([][]byte, error) {
+	appArgs := make([][]byte, 0, len(action.Args)+1)
+	appArgs = append(appArgs, action.selector)
+	for i := range action.Args {
+		arg := &action.Args[i]
+		encoded, err := arg.abiType.Encode(arg.generate(sender, boxNames))
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		appArgs = append(appArgs, encoded)
+	}
+	return appArgs, nil
+}
+
+// boxNames returns the names of the boxes referenced by the next call of the action.
+func (action *ScenarioAction) boxNames() [][]byte {
+	names := make([][]byte, len(action.Boxes))
+	for i, box := range action.Boxes {
+		name := []byte(box.Prefix)
+		if box.Keys > 0 {
+			var key [8]byte
+			binary.BigEndian.PutUint64(key[:], rand.Uint64()%box.Keys)
+			name = append(name, key[:]...)
+		}
+		names[i] = name
+	}
+	return names
+}
+
+func isUintType(typeName string) bool {
+	return strings.HasPrefix(typeName, "uint") || strings.HasPrefix(typeName, "ufixed")
+}
+
+// uintTypeMax returns the largest value of a uint or ufixed type, capped to the largest uint64.
+func uintTypeMax(typeName string) uint64 {
+	bits := strings.TrimPrefix(strings.TrimPrefix(typeName, "uint"), "ufixed")
+	if i := strings.IndexByte(bits, 'x'); i >= 0 {
+		bits = bits[:i]
+	}
+	bitSize, err := strconv.Atoi(bits)
+	if err != nil || bitSize >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<bitSize - 1
+}
+
+func (arg *ScenarioArg) check(argType string, boxes []ScenarioBox) (err error) {
+	if abi.IsTransactionType(argType) || abi.IsReferenceType(argType) {
+		return errors.New("transaction and reference arguments are not supported")
+	}
+	arg.abiType, err = abi.TypeOf(argType)
+	if err != nil {
+		return err
+	}
+	typeName := arg.abiType.String()
+
+	switch arg.Kind {
+	case argFixed, argChoice:
+		jsonValues := arg.Choices
+		if arg.Kind == argFixed {
+			if len(arg.Value) == 0 {
+				return errors.New("fixed argument needs a Value")
+			}
+			jsonValues = []json.RawMessage{arg.Value}
+		} else if len(arg.Choices) == 0 {
+			return errors.New("choice argument needs Choices")
+		}
+		arg.values = make([]interface{}, len(jsonValues))
+		for i, jsonValue := range jsonValues {
+			arg.values[i], err = arg.abiType.UnmarshalFromJSON(jsonValue)
+			if err != nil {
+				return err
+			}
+		}
+	case argRandom:
+		switch {
+		case isUintType(typeName), typeName == "byte", typeName == "bool", typeName == "address", typeName == "string", typeName == "byte[]":
+		case strings.HasPrefix(typeName, "byte["):
+			// static byte array, its length comes from the type
+			arg.Length, err = arg.abiType.ByteLen()
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("random values of type %s are not supported", typeName)
+		}
+		if arg.Length < 0 {
+			return errors.New("negative Length")
+		}
+	case argSequence:
+		if !isUintType(typeName) {
+			return errors.New("sequence arguments must be uint or ufixed")
+		}
+	case argSender:
+		if typeName != "address" {
+			return errors.New("sender arguments must be addresses")
+		}
+	case argBox:
+		if typeName != "byte[]" && typeName != "string" {
+			return errors.New("box arguments must be byte[] or string")
+		}
+		if arg.Box < 0 || arg.Box >= len(boxes) {
+			return fmt.Errorf("box argument references box %d out of %d", arg.Box, len(boxes))
+		}
+	default:
+		return fmt.Errorf("unknown argument kind %#v", arg.Kind)
+	}
+
+	if isUintType(typeName) && (arg.Kind == argRandom || arg.Kind == argSequence) {
+		typeMax := uintTypeMax(typeName)
+		if arg.Max == 0 {
+			arg.Max = typeMax
+		}
+		if arg.Max > typeMax {
+			return fmt.Errorf("maximum %d is too large for %s", arg.Max, typeName)
+		}
+		if arg.Min > arg.Max {
+			return fmt.Errorf("minimum %d is larger than maximum %d", arg.Min, arg.Max)
+		}
+		arg.next = arg.Min
+	}
+	return nil
+}
+
+const randomStringChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// generate returns the next value of the argument, in a form its ABI type can encode.
+func (arg *ScenarioArg) generate(sender basics.Address, boxNames [][]byte) interface{} {
+	typeName := arg.abiType.String()
+	switch arg.Kind {
+	case argFixed:
+		return arg.values[0]
+	case argChoice:
+		return arg.values[rand.Intn(len(arg.values))]
+	case argSequence:
+		v := arg.next
+		if arg.next == arg.Max {
+			arg.next = arg.Min
+		} else {
+			arg.next++
+		}
+		return v
+	case argSender:
+		return sender[:]
+	case argBox:
+		if typeName == "string" {
+			return string(boxNames[arg.Box])
+		}
+		return boxNames[arg.Box]
+	}
+
+	// random
+	switch {
+	case isUintType(typeName):
+		span := arg.Max - arg.Min
+		if span == math.MaxUint64 {
+			return rand.Uint64()
+		}
+		return arg.Min + rand.Uint64()%(span+1)
+	case typeName == "byte":
+		return uint8(rand.Intn(256))
+	case typeName == "bool":
+		return rand.Intn(2) == 1
+	case typeName == "address":
+		var addr basics.Address
+		crypto.RandBytes(addr[:])
+		return addr[:]
+	case typeName == "string":
+		s := make([]byte, arg.Length)
+		for i := range s {
+			s[i] = randomStringChars[rand.Intn(len(randomStringChars))]
+		}
+		return string(s)
+	default:
+		b := make([]byte, arg.Length)
+		crypto.RandBytes(b)
+		return b
+	}
+}
+
+// ScenarioReport is the machine-readable result of running a scenario.
+type ScenarioReport struct {
+	Scenario string
+	Start    time.Time
+	End      time.Time
+	Phases   []ScenarioPhaseReport
+}
+
+// ScenarioPhaseReport is the result of one phase of a scenario. Transactions are accounted to the phase that
+// sent them, even when they are committed after it ended.
+type ScenarioPhaseReport struct {
+	Name           string
+	Start          time.Time
+	End            time.Time
+	StartTxnPerSec uint64 `json:",omitempty"`
+	TxnPerSec      uint64
+	// Sent is the number of transactions accepted by algod, and Failed the number it rejected
+	Sent   uint64
+	Failed uint64
+	// Committed is the number of sent transactions found in a block, and Uncommitted the number never found
+	Committed   uint64
+	Uncommitted uint64
+	// SentPerSec and CommittedPerSec are measured over the duration of the phase
+	SentPerSec      float64
+	CommittedPerSec float64
+	// Actions is the number of transactions sent per action type
+	Actions map[string]uint64
+	// Latency is the time from submission to commit. Groups are measured once, from their first transaction.
+	Latency ScenarioLatency
+}
+
+// ScenarioLatency summarizes the submission to commit latencies of a phase, in milliseconds.
+type ScenarioLatency struct {
+	Samples uint64
+	MinMs   float64
+	MeanMs  float64
+	P50Ms   float64
+	P90Ms   float64
+	P99Ms   float64
+	MaxMs   float64
+}
+
+// Save writes the report to a file
+func (report *ScenarioReport) Save(file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	enc := codecs.NewFormattedJSONEncoder(f)
+	return enc.Encode(report)
+}
+
+// Dump writes the report to an output stream
+func (report *ScenarioReport) Dump(stream io.Writer) {
+	enc := codecs.NewFormattedJSONEncoder(stream)
+	enc.Encode(report)
+}
+
+type scenarioSent struct {
+	phase int
+	txns  uint64
+	when  time.Time
+}
+
+type scenarioPhaseStats struct {
+	start time.Time
+	end   time.Time
+
+	sent        uint64
+	failed      uint64
+	committed   uint64
+	uncommitted uint64
+	actions     map[string]uint64
+
+	latencies    []time.Duration
+	latencyCount uint64
+	latencyTotal time.Duration
+	latencyMin   time.Duration
+	latencyMax   time.Duration
+}
+
+// scenarioTracker matches the transactions sent by a scenario with the blocks committing them.
+type scenarioTracker struct {
+	mu       deadlock.Mutex
+	pending  map[string]scenarioSent
+	phases   []scenarioPhaseStats
+	finished bool
+}
+
+func makeScenarioTracker(phases int) *scenarioTracker {
+	t := &scenarioTracker{
+		pending: make(map[string]scenarioSent),
+		phases:  make([]scenarioPhaseStats, phases),
+	}
+	for i := range t.phases {
+		t.phases[i].actions = make(map[string]uint64)
+	}
+	return t
+}
+
+func (t *scenarioTracker) startPhase(phase int, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.phases[phase].start = now
+}
+
+func (t *scenarioTracker) endPhase(phase int, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.phases[phase].end = now
+}
+
+// sending records a transaction, or a group of txns transactions identified by its first transaction, about
+// to be sent. It is recorded before being sent since a node in dev mode commits it right away.
+func (t *scenarioTracker) sending(phase int, txid string, txns uint64, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending[txid] = scenarioSent{phase: phase, txns: txns, when: now}
+}
+
+// sent records that algod accepted the transactions being sent.
+func (t *scenarioTracker) sent(phase int, actionType string, txns uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ps := &t.phases[phase]
+	ps.sent += txns
+	ps.actions[actionType] += txns
+}
+
+// failed records that algod rejected the transactions being sent.
+func (t *scenarioTracker) failed(phase int, txid string, txns uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.pending, txid)
+	t.phases[phase].failed += txns
+}
+
+// committed records the transactions of a block committed at the given time, and gives up on the
+// transactions sent too long ago.
+func (t *scenarioTracker) committed(txids []string, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.finished {
+		return
+	}
+	for _, txid := range txids {
+		st, ok := t.pending[txid]
+		if !ok {
+			continue
+		}
+		delete(t.pending, txid)
+		ps := &t.phases[st.phase]
+		ps.committed += st.txns
+		ps.addLatency(now.Sub(st.when))
+	}
+	for txid, st := range t.pending {
+		if now.Sub(st.when) > scenarioCommitTimeout {
+			delete(t.pending, txid)
+			t.phases[st.phase].uncommitted += st.txns
+		}
+	}
+}
+
+func (t *scenarioTracker) pendingCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.pending)
+}
+
+// finish counts the transactions still pending as uncommitted, and ignores any block received afterwards.
+func (t *scenarioTracker) finish() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for txid, st := range t.pending {
+		delete(t.pending, txid)
+		t.phases[st.phase].uncommitted += st.txns
+	}
+	t.finished = true
+}
+
+// run feeds the tracker with the blocks received on the channel, until it is closed.
+func (t *scenarioTracker) run(blocks <-chan bookkeeping.Block) {
+	for bl := range blocks {
+		now := time.Now()
+		txns, err := bl.DecodePaysetFlat()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "block[%d] payset err %v\n", bl.Round(), err)
+			continue
+		}
+		txids := make([]string, len(txns))
+		for i, stxn := range txns {
+			txids[i] = stxn.ID().String()
+		}
+		t.committed(txids, now)
+	}
+}
+
+func (ps *scenarioPhaseStats) addLatency(latency time.Duration) {
+	if ps.latencyCount == 0 || latency < ps.latencyMin {
+		ps.latencyMin = latency
+	}
+	if latency > ps.latencyMax {
+		ps.latencyMax = latency
+	}
+	ps.latencyCount++
+	ps.latencyTotal += latency
+	if len(ps.latencies) < scenarioLatencySampleSize {
+		ps.latencies = append(ps.latencies, latency)
+	} else if i := rand.Int63n(int64(ps.latencyCount)); i < scenarioLatencySampleSize {
+		// reservoir sampling, keeping every latency with the same probability
+		ps.latencies[i] = latency
+	}
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// percentile returns the p-th percentile, 0 < p <= 1, of sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+// report returns the results of the phases of the scenario that were started.
+func (t *scenarioTracker) report(sc *Scenario) []ScenarioPhaseReport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var reports []ScenarioPhaseReport
+	for i := range t.phases {
+		ps := &t.phases[i]
+		if ps.start.IsZero() {
+			break
+		}
+		pr := ScenarioPhaseReport{
+			Name:           sc.Phases[i].Name,
+			Start:          ps.start,
+			End:            ps.end,
+			StartTxnPerSec: sc.Phases[i].StartTxnPerSec,
+			TxnPerSec:      sc.Phases[i].TxnPerSec,
+			Sent:           ps.sent,
+			Failed:         ps.failed,
+			Committed:      ps.committed,
+			Uncommitted:    ps.uncommitted,
+			Actions:        ps.actions,
+		}
+		if elapsed := ps.end.Sub(ps.start).Seconds(); elapsed > 0 {
+			pr.SentPerSec = float64(ps.sent) / elapsed
+			pr.CommittedPerSec = float64(ps.committed) / elapsed
+		}
+		if ps.latencyCount > 0 {
+			sorted := make([]time.Duration, len(ps.latencies))
+			copy(sorted, ps.latencies)
+			sort.Slice(sorted, func(a, b int) bool { return sorted[a] < sorted[b] })
+			pr.Latency = ScenarioLatency{
+				Samples: ps.latencyCount,
+				MinMs:   durationMs(ps.latencyMin),
+				MeanMs:  durationMs(ps.latencyTotal / time.Duration(ps.latencyCount)),
+				P50Ms:   durationMs(percentile(sorted, 0.5)),
+				P90Ms:   durationMs(percentile(sorted, 0.9)),
+				P99Ms:   durationMs(percentile(sorted, 0.99)),
+				MaxMs:   durationMs(ps.latencyMax),
+			}
+		}
+		reports = append(reports, pr)
+	}
+	return reports
+}
+
+// checkScenarioActions returns an error if the scenario uses assets or apps that pingpong was not configured
+// to create.
+func (pps *WorkerState) checkScenarioActions(sc *Scenario) error {
+	for _, phase := range sc.Phases {
+		for _, action := range phase.Actions {
+			if action.Type == scenarioAsset && pps.cfg.NumAsset == 0 {
+				return fmt.Errorf("phase %s sends asset transfers but NumAsset is zero", phase.Name)
+			}
+			if action.Type == scenarioApp && pps.cfg.NumApp == 0 {
+				return fmt.Errorf("phase %s calls pingpong apps but NumApp is zero", phase.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// RunScenario runs the phases of a checked scenario one after the other, and reports the transactions sent
+// and committed by each of them. The accounts, assets and apps must have been prepared with PrepareAccounts.
+// If the context is canceled the scenario stops early, and the report covers the phases started so far.
+func (pps *WorkerState) RunScenario(ctx context.Context, ac *libgoal.Client, sc *Scenario) (*ScenarioReport, error) {
+	err := pps.checkScenarioActions(sc)
+	if err != nil {
+		return nil, err
+	}
+	ac.SetSuggestedParamsCacheAge(200 * time.Millisecond)
+	pps.client = ac
+
+	tracker := makeScenarioTracker(len(sc.Phases))
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	pps.latencyBlocks = make(chan bookkeeping.Block, 1)
+	go pps.txidLatencyBlockWaiter(waitCtx, ac)
+	go tracker.run(pps.latencyBlocks)
+
+	report := &ScenarioReport{
+		Scenario: sc.Name,
+		Start:    time.Now(),
+	}
+	refreshTime := time.Now().Add(pps.cfg.RefreshTime)
+	for i := range sc.Phases {
+		if ctx.Err() != nil {
+			break
+		}
+		pps.runScenarioPhase(ctx, ac, sc, i, tracker, &refreshTime)
+	}
+
+	// give the transactions of the last phase some time to be committed
+	deadline := time.Now().Add(sc.commitWait)
+	for ctx.Err() == nil && tracker.pendingCount() > 0 && time.Now().Before(deadline) {
+		time.Sleep(scenarioCommitPoll)
+	}
+	tracker.finish()
+	report.End = time.Now()
+	report.Phases = tracker.report(sc)
+	return report, ctx.Err()
+}
+
+func (pps *WorkerState) runScenarioPhase(ctx context.Context, ac *libgoal.Client, sc *Scenario, index int, tracker *scenarioTracker, refreshTime *time.Time) {
+	phase := &sc.Phases[index]
+	start := time.Now()
+	end := start.Add(phase.duration)
+	tracker.startPhase(index, start)
+	defer func() { tracker.endPhase(index, time.Now()) }()
+	fmt.Printf("phase %s: running for %s at %d txn/s\n", phase.Name, phase.duration, phase.rate(0))
+
+	pps.cfg.TxnPerSec = phase.rate(0)
+	pps.nextSendTime = start
+	var totalSent, lastTotalSent uint64
+	lastLog := start
+	nextLog := lastLog.Add(logPeriod)
+	for ctx.Err() == nil && time.Now().Before(end) {
+		minimumAmount := pps.cfg.MinAccountFunds + (pps.cfg.MaxAmt+pps.cfg.MaxFee)*2
+		fromList := listSufficientAccounts(pps.accounts, minimumAmount, pps.cfg.SrcAccount)
+		if len(fromList) == 0 {
+			_, _ = fmt.Fprintf(os.Stderr, "no account has enough funds, refreshing accounts\n")
+			err := pps.refreshAccounts(ac)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "error refreshing: %v\n", err)
+			}
+			time.Sleep(500 * time.Millisecond)
+			continue
+		}
+		toList := make([]string, len(fromList))
+		copy(toList, fromList)
+		rand.Shuffle(len(toList), func(i, j int) { toList[i], toList[j] = toList[j], toList[i] })
+
+		for i, from := range fromList {
+			now := time.Now()
+			if ctx.Err() != nil || !now.Before(end) {
+				break
+			}
+			if now.After(nextLog) {
+				dt := now.Sub(lastLog)
+				fmt.Printf("phase %s: %d sent, %0.2f/s (%d total) target %d/s\n", phase.Name, totalSent-lastTotalSent, float64(totalSent-lastTotalSent)/dt.Seconds(), totalSent, pps.cfg.TxnPerSec)
+				lastTotalSent = totalSent
+				for now.After(nextLog) {
+					nextLog = nextLog.Add(logPeriod)
+				}
+				lastLog = now
+			}
+
+			pps.cfg.TxnPerSec = phase.rate(now.Sub(start))
+			action := phase.pickAction()
+			sent, err := pps.sendScenarioAction(ac, index, action, from, toList[i], tracker)
+			totalSent += sent
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "error sending %s transaction, sleeping .5 seconds: %v\n", action.Type, err)
+				pps.nextSendTime = time.Now().Add(500 * time.Millisecond)
+				pps.schedule(1)
+				break
+			}
+		}
+
+		if pps.cfg.RefreshTime > 0 && time.Now().After(*refreshTime) {
+			err := pps.refreshAccounts(ac)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "error refreshing: %v\n", err)
+			}
+			*refreshTime = refreshTime.Add(pps.cfg.RefreshTime)
+		}
+	}
+}
+
+// sendScenarioAction sends the transactions of one action from the given account, and returns how many
+// were sent.
+func (pps *WorkerState) sendScenarioAction(client *libgoal.Client, phase int, action *ScenarioAction, from, to string, tracker *scenarioTracker) (uint64, error) {
+	fee := pps.fee()
+	var txGroup []transactions.Transaction
+	var signers []string
+	var updates []txnUpdate
+	var err error
+	if action.Type == scenarioRekey {
+		txGroup, signers, updates, err = pps.constructRekeyGroup(from, to, fee, client)
+	} else {
+		var txn transactions.Transaction
+		var sender string
+		var update txnUpdate
+		txn, sender, update, err = pps.constructScenarioTxn(action, from, to, fee, client)
+		txGroup, signers, updates = []transactions.Transaction{txn}, []string{sender}, []txnUpdate{update}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	stxGroup := make([]transactions.SignedTxn, len(txGroup))
+	for i, txn := range txGroup {
+		signer := pps.acct(signers[i])
+		if signer == nil {
+			return 0, fmt.Errorf("no account %s to sign %s transaction", signers[i], action.Type)
+		}
+		if action.Type == scenarioRekey {
+			// the second transaction is signed by the account the sender was rekeyed to, so no logicsig
+			stxGroup[i] = txn.Sign(signer.sk)
+		} else {
+			stxGroup[i], err = signTxn(signer, txn, pps.cfg)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	txns := uint64(len(stxGroup))
+	txid := stxGroup[0].ID().String()
+	pps.schedule(len(stxGroup))
+	tracker.sending(phase, txid, txns, time.Now())
+	if len(stxGroup) == 1 {
+		_, err = client.BroadcastTransaction(stxGroup[0])
+	} else {
+		err = client.BroadcastTransactionGroup(stxGroup)
+	}
+	if err != nil {
+		tracker.failed(phase, txid, txns)
+		return 0, err
+	}
+	tracker.sent(phase, action.Type, txns)
+
+	// as in sendFromTo, assume that a transaction accepted by algod gets committed
+	for _, update := range updates {
+		update.apply(pps)
+	}
+	return txns, nil
+}
+
+func (pps *WorkerState) constructScenarioTxn(action *ScenarioAction, from, to string, fee uint64, client *libgoal.Client) (txn transactions.Transaction, sender string, update txnUpdate, err error) {
+	noteField, lease := pps.makeNoteAndLease()
+	switch action.Type {
+	case scenarioAsset:
+		txn, sender, update, err = pps.constructAssetTxn(from, to, fee, client, noteField, lease)
+	case scenarioApp:
+		txn, sender, update, err = pps.constructAppTxn(from, to, fee, client, noteField, lease)
+	case scenarioMethod:
+		txn, sender, update, err = pps.constructMethodTxn(action, from, fee, client, noteField, lease)
+	}
+	if action.Type == scenarioPayment || err == errNotOptedIn {
+		// payments are also the fallback when the sender is not opted in, like in constructTxn
+		txn, sender, update, err = pps.constructPaymentTxn(from, to, fee, client, noteField, lease)
+	}
+	if err != nil {
+		return
+	}
+	err = pps.adjustValidityAndFee(&txn, client)
+	return
+}
+
+func (pps *WorkerState) constructMethodTxn(action *ScenarioAction, from string, fee uint64, client *libgoal.Client, noteField []byte, lease [32]byte) (txn transactions.Transaction, sender string, update txnUpdate, err error) {
+	senderAddr, err := basics.UnmarshalChecksumAddress(from)
+	if err != nil {
+		return
+	}
+	boxNames := action.boxNames()
+	appArgs, err := action.methodArgs(senderAddr, boxNames)
+	if err != nil {
+		return
+	}
+	boxRefs := make([]transactions.BoxRef, len(boxNames))
+	for i, name := range boxNames {
+		boxRefs[i] = transactions.BoxRef{Index: 0, Name: name}
+	}
+	txn, err = client.MakeUnsignedAppNoOpTx(action.AppID, appArgs, nil, nil, nil, boxRefs)
+	if err != nil {
+		return
+	}
+	txn.Note = noteField[:]
+	txn.Lease = lease
+	txn, err = client.FillUnsignedTxTemplate(from, 0, 0, fee, txn)
+	if !pps.cfg.Quiet {
+		_, _ = fmt.Fprintf(os.Stdout, "Calling %s of app %d : %s\n", action.Method, action.AppID, from)
+	}
+	update = &appUpdate{
+		from: from,
+		fee:  fee,
+	}
+	return txn, from, update, err
+}
+
+// constructRekeyGroup builds a group of two payments from one account to another, the first rekeying the
+// sender to the receiver and the second, to be signed by the receiver, rekeying it back.
+func (pps *WorkerState) constructRekeyGroup(from, to string, fee uint64, client *libgoal.Client) (txGroup []transactions.Transaction, signers []string, updates []txnUpdate, err error) {
+	fromAddr, err := basics.UnmarshalChecksumAddress(from)
+	if err != nil {
+		return
+	}
+	toAddr, err := basics.UnmarshalChecksumAddress(to)
+	if err != nil {
+		return
+	}
+	for i, rekeyTo := range []basics.Address{toAddr, fromAddr} {
+		noteField, lease := pps.makeNoteAndLease()
+		var txn transactions.Transaction
+		var update txnUpdate
+		txn, _, update, err = pps.constructPaymentTxn(from, to, fee, client, noteField, lease)
+		if err != nil {
+			return
+		}
+		err = pps.adjustValidityAndFee(&txn, client)
+		if err != nil {
+			return
+		}
+		txn.RekeyTo = rekeyTo
+		txGroup = append(txGroup, txn)
+		updates = append(updates, update)
+		if i == 0 {
+			signers = append(signers, from)
+		} else {
+			signers = append(signers, to)
+		}
+	}
+
+	gid, err := client.GroupID(txGroup)
+	if err != nil {
+		return
+	}
+	for i := range txGroup {
+		txGroup[i].Group = gid
+	}
+	return
+}
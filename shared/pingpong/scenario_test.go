@@ -0,0 +1,244 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pingpong
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testScenario = `{
+	"Name": "kv-store",
+	"Phases": [
+		{"Name": "ramp-up", "Duration": "30s", "StartTxnPerSec": 10, "TxnPerSec": 500, "Actions": [{"Type": "payment"}]},
+		{"Name": "steady", "Duration": "2m", "TxnPerSec": 500, "Actions": [
+			{"Type": "payment", "Weight": 3},
+			{"Type": "method", "AppID": 1234, "Method": "put(byte[],uint64,address,string)void",
+				"Boxes": [{"Prefix": "k", "Keys": 1000}],
+				"Args": [{"Kind": "box", "Box": 0}, {"Kind": "sequence", "Min": 5, "Max": 6}, {"Kind": "sender"}, {"Kind": "fixed", "Value": "hello"}]}
+		]},
+		{"Name": "burst", "Duration": "10s", "TxnPerSec": 2000, "Actions": [{"Type": "payment"}, {"Type": "rekey"}]}
+	]
+}`
+
+func TestScenarioLoad(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "scenario.json")
+	require.NoError(t, os.WriteFile(file, []byte(testScenario), 0600))
+	sc, err := LoadScenarioFromFile(file)
+	require.NoError(t, err)
+	require.Equal(t, "kv-store", sc.Name)
+	require.Len(t, sc.Phases, 3)
+	require.Equal(t, defaultCommitWait, sc.commitWait)
+	require.Equal(t, 2*time.Minute, sc.Phases[1].duration)
+	require.Equal(t, float64(4), sc.Phases[1].totalWeight)
+	require.Len(t, sc.Phases[1].Actions[1].selector, 4)
+
+	require.NoError(t, os.WriteFile(file, []byte(`{"Name": "x", "Phases": [], "Unknown": 1}`), 0600))
+	_, err = LoadScenarioFromFile(file)
+	require.ErrorContains(t, err, "unknown field")
+}
+
+func TestScenarioCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	payment := []ScenarioAction{{Type: "payment"}}
+	methodPhase := func(method string, args ...ScenarioArg) []ScenarioPhase {
+		return []ScenarioPhase{{Name: "p", Duration: "1s", TxnPerSec: 1, Actions: []ScenarioAction{
+			{Type: "method", AppID: 1, Method: method, Args: args, Boxes: []ScenarioBox{{Prefix: "b"}}},
+		}}}
+	}
+	tests := []struct {
+		name   string
+		phases []ScenarioPhase
+		err    string
+	}{
+		{"no phases", nil, "no phases"},
+		{"bad duration", []ScenarioPhase{{Duration: "1x", TxnPerSec: 1, Actions: payment}}, "invalid Duration"},
+		{"no rate", []ScenarioPhase{{Duration: "1s", Actions: payment}}, "TxnPerSec"},
+		{"no actions", []ScenarioPhase{{Duration: "1s", TxnPerSec: 1}}, "no actions"},
+		{"unknown action", []ScenarioPhase{{Duration: "1s", TxnPerSec: 1, Actions: []ScenarioAction{{Type: "swap"}}}}, "unknown action type"},
+		{"payment with app", []ScenarioPhase{{Duration: "1s", TxnPerSec: 1, Actions: []ScenarioAction{{Type: "payment", AppID: 1}}}}, "only method actions"},
+		{"bad method", methodPhase("put(uint64"), "invalid Method"},
+		{"missing arg", methodPhase("put(uint64,uint64)void", ScenarioArg{Kind: "random"}), "2 arguments but 1"},
+		{"reference arg", methodPhase("put(account)void", ScenarioArg{Kind: "sender"}), "not supported"},
+		{"unknown kind", methodPhase("put(uint64)void", ScenarioArg{Kind: "magic"}), "unknown argument kind"},
+		{"bad fixed", methodPhase("put(uint64)void", ScenarioArg{Kind: "fixed", Value: []byte(`"x"`)}), "argument 0"},
+		{"max too large", methodPhase("put(uint8)void", ScenarioArg{Kind: "random", Max: 256}), "too large"},
+		{"min over max", methodPhase("put(uint64)void", ScenarioArg{Kind: "sequence", Min: 3, Max: 2}), "larger than maximum"},
+		{"sender type", methodPhase("put(uint64)void", ScenarioArg{Kind: "sender"}), "must be addresses"},
+		{"box index", methodPhase("put(byte[])void", ScenarioArg{Kind: "box", Box: 1}), "out of 1"},
+		{"random tuple", methodPhase("put((uint64,bool))void", ScenarioArg{Kind: "random"}), "not supported"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			sc := Scenario{Phases: test.phases}
+			require.ErrorContains(t, sc.Check(), test.err)
+		})
+	}
+
+	sc := Scenario{CommitWait: "1m", Phases: methodPhase("put(uint8,byte[4],bool,byte)void",
+		ScenarioArg{Kind: "random"}, ScenarioArg{Kind: "random"}, ScenarioArg{Kind: "random"}, ScenarioArg{Kind: "choice", Choices: []json.RawMessage{[]byte("1"), []byte("2")}})}
+	require.NoError(t, sc.Check())
+	require.Equal(t, time.Minute, sc.commitWait)
+	args := sc.Phases[0].Actions[0].Args
+	require.Equal(t, uint64(255), args[0].Max)
+	require.Equal(t, 4, args[1].Length)
+}
+
+func TestScenarioPhaseRate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	phase := ScenarioPhase{Duration: "10s", TxnPerSec: 100, Actions: []ScenarioAction{{Type: "payment"}}}
+	require.NoError(t, phase.check())
+	require.Equal(t, uint64(100), phase.rate(0))
+	require.Equal(t, uint64(100), phase.rate(5*time.Second))
+
+	phase.StartTxnPerSec = 10
+	require.Equal(t, uint64(10), phase.rate(0))
+	require.Equal(t, uint64(55), phase.rate(5*time.Second))
+	require.Equal(t, uint64(100), phase.rate(10*time.Second))
+	require.Equal(t, uint64(100), phase.rate(time.Minute))
+
+	// ramping down
+	phase.StartTxnPerSec, phase.TxnPerSec = 100, 1
+	require.Equal(t, uint64(1), phase.rate(10*time.Second))
+	require.Equal(t, uint64(51), phase.rate(5*time.Second))
+}
+
+func TestScenarioMethodArgs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "scenario.json")
+	require.NoError(t, os.WriteFile(file, []byte(testScenario), 0600))
+	sc, err := LoadScenarioFromFile(file)
+	require.NoError(t, err)
+	action := &sc.Phases[1].Actions[1]
+
+	var sender basics.Address
+	sender[0] = 1
+	types := []string{"byte[]", "uint64", "address", "string"}
+	for _, expectedSeq := range []uint64{5, 6, 5} {
+		boxNames := action.boxNames()
+		require.Len(t, boxNames, 1)
+		require.Len(t, boxNames[0], 9)
+		require.Equal(t, byte('k'), boxNames[0][0])
+		require.Less(t, binary.BigEndian.Uint64(boxNames[0][1:]), uint64(1000))
+
+		appArgs, err := action.methodArgs(sender, boxNames)
+		require.NoError(t, err)
+		require.Len(t, appArgs, 5)
+		require.Equal(t, action.selector, appArgs[0])
+
+		expected := []interface{}{boxNames[0], expectedSeq, sender[:], "hello"}
+		for i, typeName := range types {
+			abiType, err := abi.TypeOf(typeName)
+			require.NoError(t, err)
+			encoded, err := abiType.Encode(expected[i])
+			require.NoError(t, err)
+			require.Equal(t, encoded, appArgs[i+1], typeName)
+		}
+	}
+}
+
+func TestScenarioTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sc := Scenario{Phases: []ScenarioPhase{
+		{Name: "first", Duration: "1s", TxnPerSec: 10, Actions: []ScenarioAction{{Type: "payment"}}},
+		{Name: "second", Duration: "1s", TxnPerSec: 10, Actions: []ScenarioAction{{Type: "rekey"}}},
+		{Name: "skipped", Duration: "1s", TxnPerSec: 10, Actions: []ScenarioAction{{Type: "payment"}}},
+	}}
+	require.NoError(t, sc.Check())
+
+	tracker := makeScenarioTracker(len(sc.Phases))
+	start := time.Now()
+	tracker.startPhase(0, start)
+	for i, txid := range []string{"a", "b", "c", "d"} {
+		tracker.sending(0, txid, 1, start.Add(time.Duration(i)*time.Second))
+		tracker.sent(0, "payment", 1)
+	}
+	tracker.sending(0, "e", 1, start)
+	tracker.failed(0, "e", 1)
+	tracker.endPhase(0, start.Add(4*time.Second))
+	tracker.startPhase(1, start.Add(4*time.Second))
+	tracker.sending(1, "g", 2, start.Add(4*time.Second))
+	tracker.sent(1, "rekey", 2)
+	tracker.endPhase(1, start.Add(5*time.Second))
+
+	// "c" and "d" are committed in a later block, "b" never is
+	tracker.committed([]string{"a", "g", "unknown"}, start.Add(5*time.Second))
+	tracker.committed([]string{"c", "d"}, start.Add(6*time.Second))
+	require.Equal(t, 1, tracker.pendingCount())
+	tracker.finish()
+	require.Zero(t, tracker.pendingCount())
+	tracker.committed([]string{"b"}, start.Add(7*time.Second))
+
+	reports := tracker.report(&sc)
+	require.Len(t, reports, 2)
+	first := reports[0]
+	require.Equal(t, "first", first.Name)
+	require.Equal(t, uint64(4), first.Sent)
+	require.Equal(t, uint64(1), first.Failed)
+	require.Equal(t, uint64(3), first.Committed)
+	require.Equal(t, uint64(1), first.Uncommitted)
+	require.Equal(t, 1.0, first.SentPerSec)
+	require.Equal(t, map[string]uint64{"payment": 4}, first.Actions)
+	require.Equal(t, uint64(3), first.Latency.Samples)
+	require.Equal(t, 3000.0, first.Latency.MinMs)
+	require.Equal(t, 4000.0, first.Latency.P50Ms)
+	require.Equal(t, 5000.0, first.Latency.MaxMs)
+	require.Equal(t, 4000.0, first.Latency.MeanMs)
+
+	second := reports[1]
+	require.Equal(t, uint64(2), second.Sent)
+	require.Equal(t, uint64(2), second.Committed)
+	require.Equal(t, 2.0, second.CommittedPerSec)
+	require.Equal(t, 1000.0, second.Latency.P99Ms)
+}
+
+func TestScenarioCommitTimeout(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tracker := makeScenarioTracker(1)
+	start := time.Now()
+	tracker.startPhase(0, start)
+	tracker.sending(0, "old", 1, start)
+	tracker.sending(0, "new", 1, start.Add(scenarioCommitTimeout))
+	tracker.committed(nil, start.Add(scenarioCommitTimeout+time.Second))
+	require.Equal(t, 1, tracker.pendingCount())
+	require.Equal(t, uint64(1), tracker.phases[0].uncommitted)
+}
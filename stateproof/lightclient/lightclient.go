@@ -0,0 +1,366 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package lightclient follows the Algorand chain through state proofs, without
+// trusting the node it talks to. Starting from a trusted checkpoint, it verifies
+// each subsequent state proof and records the block headers commitment of every
+// verified interval. Light block headers and transactions can then be checked
+// against that chain of commitments.
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	sp "github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	// ErrProofUnavailable is returned when the source could not provide the next
+	// state proof. It is usually transient: the proof has not been written yet.
+	ErrProofUnavailable = errors.New("state proof is not available")
+	// ErrInvalidProof is returned when data from the source fails verification.
+	ErrInvalidProof = errors.New("invalid proof")
+	// ErrRoundNotAttested is returned for rounds beyond the verified chain.
+	ErrRoundNotAttested = errors.New("round is not attested by a verified state proof")
+	// ErrOriginMismatch is returned when the persisted chain was started from a
+	// different checkpoint than the one given to MakeClient.
+	ErrOriginMismatch = errors.New("persisted state was started from a different checkpoint")
+)
+
+// Source is the untrusted provider of proofs, usually an algod REST client.
+type Source interface {
+	StateProofs(round uint64) (model.StateProofResponse, error)
+	LightBlockHeaderProof(round uint64) (model.LightBlockHeaderProofResponse, error)
+	TransactionProof(txid string, round uint64, hashType crypto.HashType) (model.TransactionProofResponse, error)
+}
+
+var _ Source = client.RestClient{}
+
+// Checkpoint is the trusted data needed to verify the state proof of the
+// interval that starts right after Round.
+type Checkpoint struct {
+	// Round is the last round attested by a verified state proof, or the round of
+	// the trusted starting point.
+	Round uint64 `codec:"rnd"`
+	// VotersCommitment and LnProvenWeight are the values carried by the state
+	// proof message for Round.
+	VotersCommitment []byte `codec:"voters"`
+	LnProvenWeight   uint64 `codec:"lnpw"`
+}
+
+// Interval is a range of rounds attested by a verified state proof.
+type Interval struct {
+	FirstAttestedRound     uint64 `codec:"first"`
+	LastAttestedRound      uint64 `codec:"last"`
+	BlockHeadersCommitment []byte `codec:"headers"`
+}
+
+// State is the verified chain, as persisted by a Store. Only the most recent
+// intervals are kept; the chain stays verified through Next.
+type State struct {
+	Origin    Checkpoint `codec:"origin"`
+	Next      Checkpoint `codec:"next"`
+	Intervals []Interval `codec:"intervals"`
+}
+
+// Client maintains a chain of verified block interval commitments.
+type Client struct {
+	src            Source
+	store          Store
+	strengthTarget uint64
+	maxIntervals   int
+	log            logging.Logger
+
+	// syncMu serializes SyncOnce calls, so that network requests do not hold mu.
+	syncMu deadlock.Mutex
+
+	mu    deadlock.RWMutex
+	state State
+}
+
+// MakeClient creates a light client starting at the trusted checkpoint. If the
+// store already holds a chain started from the same checkpoint, the client
+// resumes from its end. The client keeps the last maxIntervals verified
+// intervals, or all of them if maxIntervals is 0.
+func MakeClient(src Source, store Store, trusted Checkpoint, strengthTarget uint64, maxIntervals int, log logging.Logger) (*Client, error) {
+	if len(trusted.VotersCommitment) != sp.HashSize {
+		return nil, fmt.Errorf("trusted voters commitment is %d bytes, expected %d", len(trusted.VotersCommitment), sp.HashSize)
+	}
+
+	state, found, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		state = State{Origin: trusted, Next: trusted}
+	} else if !sameCheckpoint(state.Origin, trusted) {
+		return nil, ErrOriginMismatch
+	}
+	if maxIntervals < 0 {
+		return nil, fmt.Errorf("max intervals %d is negative", maxIntervals)
+	}
+	state.Intervals = recentIntervals(state.Intervals, maxIntervals)
+
+	return &Client{
+		src:            src,
+		store:          store,
+		strengthTarget: strengthTarget,
+		maxIntervals:   maxIntervals,
+		log:            log,
+		state:          state,
+	}, nil
+}
+
+func sameCheckpoint(a, b Checkpoint) bool {
+	return a.Round == b.Round && a.LnProvenWeight == b.LnProvenWeight && string(a.VotersCommitment) == string(b.VotersCommitment)
+}
+
+// recentIntervals returns the last max intervals, or all of them if max is 0.
+func recentIntervals(intervals []Interval, max int) []Interval {
+	if max == 0 || len(intervals) <= max {
+		return intervals
+	}
+	// copy, so that the dropped intervals can be collected
+	return append([]Interval(nil), intervals[len(intervals)-max:]...)
+}
+
+// LastVerifiedRound returns the last round attested by the verified chain.
+func (c *Client) LastVerifiedRound() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state.Next.Round
+}
+
+// Interval returns the verified interval attesting to round. Rounds of
+// intervals that are no longer kept are reported as not attested.
+func (c *Client) Interval(round uint64) (Interval, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	intervals := c.state.Intervals
+	i := sort.Search(len(intervals), func(i int) bool { return intervals[i].LastAttestedRound >= round })
+	if i == len(intervals) || round < intervals[i].FirstAttestedRound {
+		return Interval{}, fmt.Errorf("%w: %d", ErrRoundNotAttested, round)
+	}
+	return intervals[i], nil
+}
+
+// SyncOnce fetches and verifies the state proof following the verified chain.
+// It returns true if the chain was extended.
+func (c *Client) SyncOnce() (bool, error) {
+	c.syncMu.Lock()
+	defer c.syncMu.Unlock()
+
+	c.mu.RLock()
+	next := c.state.Next
+	c.mu.RUnlock()
+
+	resp, err := c.src.StateProofs(next.Round + 1)
+	if err != nil {
+		return false, fmt.Errorf("%w: round %d: %v", ErrProofUnavailable, next.Round+1, err)
+	}
+
+	interval, checkpoint, err := verifyStateProof(next, resp, c.strengthTarget)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state := State{
+		Origin:    c.state.Origin,
+		Next:      checkpoint,
+		Intervals: recentIntervals(append(c.state.Intervals[:len(c.state.Intervals):len(c.state.Intervals)], interval), c.maxIntervals),
+	}
+	err = c.store.Save(state)
+	if err != nil {
+		return false, err
+	}
+	c.state = state
+
+	c.log.Infof("lightclient: verified state proof for rounds %d-%d", interval.FirstAttestedRound, interval.LastAttestedRound)
+	return true, nil
+}
+
+// Run extends the verified chain until ctx is done, polling the source every
+// pollInterval once it has caught up. It returns early if a proof fails
+// verification or the chain cannot be persisted.
+func (c *Client) Run(ctx context.Context, pollInterval time.Duration) error {
+	for {
+		advanced, err := c.SyncOnce()
+		if err != nil && !errors.Is(err, ErrProofUnavailable) {
+			return err
+		}
+		if advanced {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// verifyStateProof checks resp against the trusted checkpoint and returns the
+// interval it attests to and the checkpoint for the interval after it.
+func verifyStateProof(trusted Checkpoint, resp model.StateProofResponse, strengthTarget uint64) (Interval, Checkpoint, error) {
+	msg := stateproofmsg.Message{
+		BlockHeadersCommitment: resp.Message.BlockHeadersCommitment,
+		VotersCommitment:       resp.Message.VotersCommitment,
+		LnProvenWeight:         resp.Message.LnProvenWeight,
+		FirstAttestedRound:     resp.Message.FirstAttestedRound,
+		LastAttestedRound:      resp.Message.LastAttestedRound,
+	}
+	if msg.FirstAttestedRound != trusted.Round+1 || msg.LastAttestedRound < msg.FirstAttestedRound {
+		return Interval{}, Checkpoint{}, fmt.Errorf("%w: state proof attests to rounds %d-%d, expected it to start at %d",
+			ErrInvalidProof, msg.FirstAttestedRound, msg.LastAttestedRound, trusted.Round+1)
+	}
+
+	var proof sp.StateProof
+	err := protocol.Decode(resp.StateProof, &proof)
+	if err != nil {
+		return Interval{}, Checkpoint{}, fmt.Errorf("%w: decoding state proof: %v", ErrInvalidProof, err)
+	}
+
+	verifier := sp.MkVerifierWithLnProvenWeight(trusted.VotersCommitment, trusted.LnProvenWeight, strengthTarget)
+	err = verifier.Verify(msg.LastAttestedRound, msg.Hash(), &proof)
+	if err != nil {
+		return Interval{}, Checkpoint{}, fmt.Errorf("%w: state proof for rounds %d-%d: %v", ErrInvalidProof, msg.FirstAttestedRound, msg.LastAttestedRound, err)
+	}
+
+	interval := Interval{
+		FirstAttestedRound:     msg.FirstAttestedRound,
+		LastAttestedRound:      msg.LastAttestedRound,
+		BlockHeadersCommitment: msg.BlockHeadersCommitment,
+	}
+	checkpoint := Checkpoint{
+		Round:            msg.LastAttestedRound,
+		VotersCommitment: msg.VotersCommitment,
+		LnProvenWeight:   msg.LnProvenWeight,
+	}
+	return interval, checkpoint, nil
+}
+
+// VerifyLightBlockHeader checks that hdr is committed to by the verified chain.
+func (c *Client) VerifyLightBlockHeader(hdr bookkeeping.LightBlockHeader, proofResp model.LightBlockHeaderProofResponse) error {
+	round := uint64(hdr.Round)
+	interval, err := c.Interval(round)
+	if err != nil {
+		return err
+	}
+	if proofResp.Index != round-interval.FirstAttestedRound {
+		return fmt.Errorf("%w: light block header proof index %d does not match round %d", ErrInvalidProof, proofResp.Index, round)
+	}
+
+	proof, err := merklearray.ProofDataToSingleLeafProof(crypto.Sha256.String(), proofResp.Treedepth, proofResp.Proof)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+
+	elems := map[uint64]crypto.Hashable{proofResp.Index: &hdr}
+	err = merklearray.VerifyVectorCommitment(interval.BlockHeadersCommitment, elems, proof.ToProof())
+	if err != nil {
+		return fmt.Errorf("%w: light block header for round %d: %v", ErrInvalidProof, round, err)
+	}
+	return nil
+}
+
+// VerifyTransaction checks that txn was included in the block of hdr, and that
+// hdr is committed to by the verified chain. Only SHA-256 transaction proofs
+// can be checked, since the light block header carries only that commitment.
+func (c *Client) VerifyTransaction(txn transactions.Transaction, hdr bookkeeping.LightBlockHeader, hdrProof model.LightBlockHeaderProofResponse, txnProof model.TransactionProofResponse) error {
+	err := c.VerifyLightBlockHeader(hdr, hdrProof)
+	if err != nil {
+		return err
+	}
+
+	if txnProof.Hashtype != model.TransactionProofResponseHashtype(crypto.Sha256.String()) {
+		return fmt.Errorf("%w: transaction proof uses %s, expected %s", ErrInvalidProof, txnProof.Hashtype, crypto.Sha256.String())
+	}
+	if len(txnProof.Stibhash) != crypto.DigestSize {
+		return fmt.Errorf("%w: transaction proof stibhash is %d bytes", ErrInvalidProof, len(txnProof.Stibhash))
+	}
+	proof, err := merklearray.ProofDataToSingleLeafProof(crypto.Sha256.String(), txnProof.Treedepth, txnProof.Proof)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+
+	leaf := txnLeaf{txid: txn.IDSha256()}
+	copy(leaf.stib[:], txnProof.Stibhash)
+	elems := map[uint64]crypto.Hashable{txnProof.Idx: &leaf}
+	err = merklearray.VerifyVectorCommitment(hdr.Sha256TxnCommitment, elems, proof.ToProof())
+	if err != nil {
+		return fmt.Errorf("%w: transaction %s in round %d: %v", ErrInvalidProof, txn.ID(), hdr.Round, err)
+	}
+	return nil
+}
+
+// FetchAndVerifyLightBlockHeader fetches the proof for hdr from the source and
+// verifies it.
+func (c *Client) FetchAndVerifyLightBlockHeader(hdr bookkeeping.LightBlockHeader) error {
+	proofResp, err := c.src.LightBlockHeaderProof(uint64(hdr.Round))
+	if err != nil {
+		return err
+	}
+	return c.VerifyLightBlockHeader(hdr, proofResp)
+}
+
+// FetchAndVerifyTransaction fetches the proofs for txn and hdr from the source
+// and verifies them.
+func (c *Client) FetchAndVerifyTransaction(txn transactions.Transaction, hdr bookkeeping.LightBlockHeader) error {
+	hdrProof, err := c.src.LightBlockHeaderProof(uint64(hdr.Round))
+	if err != nil {
+		return err
+	}
+	txnProof, err := c.src.TransactionProof(txn.ID().String(), uint64(hdr.Round), crypto.Sha256)
+	if err != nil {
+		return err
+	}
+	return c.VerifyTransaction(txn, hdr, hdrProof, txnProof)
+}
+
+// txnLeaf is a leaf of the SHA-256 transaction commitment, as built by
+// bookkeeping.Block.TxnMerkleTreeSHA256.
+type txnLeaf struct {
+	txid crypto.Digest
+	stib crypto.Digest
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (l *txnLeaf) ToBeHashed() (protocol.HashID, []byte) {
+	buf := make([]byte, 2*crypto.DigestSize)
+	copy(buf, l.txid[:])
+	copy(buf[crypto.DigestSize:], l.stib[:])
+	return protocol.TxnMerkleLeaf, buf
+}
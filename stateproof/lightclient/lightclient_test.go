@@ -0,0 +1,386 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	sp "github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const (
+	testInterval       = 16
+	testStrengthTarget = 16
+	testTxnRound       = 20
+)
+
+type lightHeaders []bookkeeping.LightBlockHeader
+
+func (b lightHeaders) Length() uint64 {
+	return uint64(len(b))
+}
+
+func (b lightHeaders) Marshal(pos uint64) (crypto.Hashable, error) {
+	return &b[pos], nil
+}
+
+// testChain plays the part of an algod serving state proofs for a chain whose
+// voters never change.
+type testChain struct {
+	key       *merklesignature.Secrets
+	parts     []basics.Participant
+	partTree  *merklearray.Tree
+	lnProvenW uint64
+
+	headers   map[uint64]bookkeeping.LightBlockHeader
+	hdrTrees  map[uint64]*merklearray.Tree // by last attested round
+	proofs    map[uint64]model.StateProofResponse
+	block     bookkeeping.Block
+	available uint64
+}
+
+func makeTestChain(t *testing.T, lastRound uint64) *testChain {
+	a := require.New(t)
+
+	key, err := merklesignature.New(0, lastRound+testInterval, testInterval)
+	a.NoError(err)
+
+	c := &testChain{
+		key:       key,
+		headers:   make(map[uint64]bookkeeping.LightBlockHeader),
+		hdrTrees:  make(map[uint64]*merklearray.Tree),
+		proofs:    make(map[uint64]model.StateProofResponse),
+		available: lastRound,
+	}
+	for i := 0; i < 4; i++ {
+		c.parts = append(c.parts, basics.Participant{PK: *key.GetVerifier(), Weight: 1000})
+	}
+	c.partTree, err = merklearray.BuildVectorCommitmentTree(basics.ParticipantsArray(c.parts), crypto.HashFactory{HashType: sp.HashType})
+	a.NoError(err)
+	c.lnProvenW, err = sp.LnIntApproximation(2000)
+	a.NoError(err)
+
+	c.block.CurrentProtocol = protocol.ConsensusCurrentVersion
+	for i := 0; i < 3; i++ {
+		var stxn transactions.SignedTxnInBlock
+		stxn.Txn.Type = protocol.PaymentTx
+		stxn.Txn.FirstValid = basics.Round(i)
+		c.block.Payset = append(c.block.Payset, stxn)
+	}
+	txnTree, err := c.block.TxnMerkleTreeSHA256()
+	a.NoError(err)
+
+	for last := uint64(2 * testInterval); last <= lastRound; last += testInterval {
+		hdrs := make(lightHeaders, testInterval)
+		for i := range hdrs {
+			hdrs[i].Round = basics.Round(last - testInterval + 1 + uint64(i))
+			crypto.RandBytes(hdrs[i].Seed[:])
+			if hdrs[i].Round == testTxnRound {
+				hdrs[i].Sha256TxnCommitment = txnTree.Root()
+			}
+			c.headers[uint64(hdrs[i].Round)] = hdrs[i]
+		}
+		hdrTree, err := merklearray.BuildVectorCommitmentTree(hdrs, crypto.HashFactory{HashType: crypto.Sha256})
+		a.NoError(err)
+		c.hdrTrees[last] = hdrTree
+
+		msg := stateproofmsg.Message{
+			BlockHeadersCommitment: hdrTree.Root(),
+			VotersCommitment:       c.partTree.Root(),
+			LnProvenWeight:         c.lnProvenW,
+			FirstAttestedRound:     last - testInterval + 1,
+			LastAttestedRound:      last,
+		}
+		c.proofs[last] = c.prove(t, msg)
+	}
+	return c
+}
+
+func (c *testChain) prove(t *testing.T, msg stateproofmsg.Message) model.StateProofResponse {
+	a := require.New(t)
+
+	data := msg.Hash()
+	prover, err := sp.MakeProver(data, msg.LastAttestedRound, 2000, c.parts, c.partTree, testStrengthTarget)
+	a.NoError(err)
+	sig, err := c.key.GetSigner(msg.LastAttestedRound).SignBytes(data[:])
+	a.NoError(err)
+	for i := range c.parts {
+		a.NoError(prover.Add(uint64(i), sig))
+	}
+	proof, err := prover.CreateProof()
+	a.NoError(err)
+
+	return model.StateProofResponse{
+		Message: model.StateProofMessage{
+			BlockHeadersCommitment: msg.BlockHeadersCommitment,
+			VotersCommitment:       msg.VotersCommitment,
+			LnProvenWeight:         msg.LnProvenWeight,
+			FirstAttestedRound:     msg.FirstAttestedRound,
+			LastAttestedRound:      msg.LastAttestedRound,
+		},
+		StateProof: protocol.Encode(proof),
+	}
+}
+
+func (c *testChain) trusted() Checkpoint {
+	return Checkpoint{Round: testInterval, VotersCommitment: c.partTree.Root(), LnProvenWeight: c.lnProvenW}
+}
+
+func (c *testChain) StateProofs(round uint64) (model.StateProofResponse, error) {
+	last := (round + testInterval - 1) / testInterval * testInterval
+	resp, ok := c.proofs[last]
+	if !ok || last > c.available {
+		return model.StateProofResponse{}, fmt.Errorf("no state proof for round %d", round)
+	}
+	return resp, nil
+}
+
+func (c *testChain) LightBlockHeaderProof(round uint64) (model.LightBlockHeaderProofResponse, error) {
+	last := (round + testInterval - 1) / testInterval * testInterval
+	tree, ok := c.hdrTrees[last]
+	if !ok {
+		return model.LightBlockHeaderProofResponse{}, fmt.Errorf("no light block header for round %d", round)
+	}
+	idx := round - (last - testInterval + 1)
+	proof, err := tree.ProveSingleLeaf(idx)
+	if err != nil {
+		return model.LightBlockHeaderProofResponse{}, err
+	}
+	return model.LightBlockHeaderProofResponse{Index: idx, Proof: proof.GetConcatenatedProof(), Treedepth: uint64(proof.TreeDepth)}, nil
+}
+
+func (c *testChain) TransactionProof(txid string, round uint64, hashType crypto.HashType) (model.TransactionProofResponse, error) {
+	for idx, stxn := range c.block.Payset {
+		if stxn.Txn.ID().String() != txid {
+			continue
+		}
+		tree, err := c.block.TxnMerkleTreeSHA256()
+		if err != nil {
+			return model.TransactionProofResponse{}, err
+		}
+		proof, err := tree.ProveSingleLeaf(uint64(idx))
+		if err != nil {
+			return model.TransactionProofResponse{}, err
+		}
+		stibhash := stxn.HashSHA256()
+		return model.TransactionProofResponse{
+			Hashtype:  model.TransactionProofResponseHashtype(hashType.String()),
+			Idx:       uint64(idx),
+			Proof:     proof.GetConcatenatedProof(),
+			Stibhash:  stibhash[:],
+			Treedepth: uint64(proof.TreeDepth),
+		}, nil
+	}
+	return model.TransactionProofResponse{}, fmt.Errorf("txn %s not found", txid)
+}
+
+func TestLightClientSync(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	chain := makeTestChain(t, 4*testInterval)
+	chain.available = 3 * testInterval
+	store := MakeFileStore(filepath.Join(t.TempDir(), "lightclient.json"))
+
+	c, err := MakeClient(chain, store, chain.trusted(), testStrengthTarget, 0, logging.TestingLog(t))
+	a.NoError(err)
+	a.Equal(uint64(testInterval), c.LastVerifiedRound())
+
+	for i := 0; i < 2; i++ {
+		advanced, err := c.SyncOnce()
+		a.NoError(err)
+		a.True(advanced)
+	}
+	a.Equal(uint64(3*testInterval), c.LastVerifiedRound())
+
+	_, err = c.SyncOnce()
+	a.ErrorIs(err, ErrProofUnavailable)
+
+	interval, err := c.Interval(testTxnRound)
+	a.NoError(err)
+	a.Equal(uint64(testInterval+1), interval.FirstAttestedRound)
+	a.Equal(uint64(2*testInterval), interval.LastAttestedRound)
+	_, err = c.Interval(testInterval)
+	a.ErrorIs(err, ErrRoundNotAttested)
+	_, err = c.Interval(3*testInterval + 1)
+	a.ErrorIs(err, ErrRoundNotAttested)
+
+	// resume from the store once more proofs are out
+	chain.available = 4 * testInterval
+	c, err = MakeClient(chain, store, chain.trusted(), testStrengthTarget, 0, logging.TestingLog(t))
+	a.NoError(err)
+	a.Equal(uint64(3*testInterval), c.LastVerifiedRound())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- c.Run(ctx, time.Millisecond) }()
+	a.Eventually(func() bool { return c.LastVerifiedRound() == 4*testInterval }, 10*time.Second, time.Millisecond)
+	cancel()
+	a.ErrorIs(<-done, context.Canceled)
+
+	// a chain persisted from another checkpoint is not resumed
+	other := chain.trusted()
+	other.Round = 2 * testInterval
+	_, err = MakeClient(chain, store, other, testStrengthTarget, 0, logging.TestingLog(t))
+	a.ErrorIs(err, ErrOriginMismatch)
+}
+
+func TestLightClientRejectsBadProofs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	chain := makeTestChain(t, 3*testInterval)
+	store := MakeFileStore(filepath.Join(t.TempDir(), "lightclient.json"))
+
+	// the node claims a different set of headers for the first interval
+	forged := chain.proofs[2*testInterval]
+	forged.Message.BlockHeadersCommitment = append([]byte(nil), forged.Message.BlockHeadersCommitment...)
+	forged.Message.BlockHeadersCommitment[0]++
+	chain.proofs[2*testInterval] = forged
+
+	c, err := MakeClient(chain, store, chain.trusted(), testStrengthTarget, 0, logging.TestingLog(t))
+	a.NoError(err)
+	_, err = c.SyncOnce()
+	a.ErrorIs(err, ErrInvalidProof)
+	a.Equal(uint64(testInterval), c.LastVerifiedRound())
+
+	// Run stops instead of retrying
+	a.ErrorIs(c.Run(context.Background(), time.Millisecond), ErrInvalidProof)
+
+	// a proof that skips an interval is rejected even though it verifies
+	chain.proofs[2*testInterval] = chain.proofs[3*testInterval]
+	_, err = c.SyncOnce()
+	a.ErrorIs(err, ErrInvalidProof)
+
+	_, found, err := store.Load()
+	a.NoError(err)
+	a.False(found)
+
+	_, err = MakeClient(chain, store, Checkpoint{Round: testInterval}, testStrengthTarget, 0, logging.TestingLog(t))
+	a.Error(err)
+}
+
+func TestLightClientVerifyHeadersAndTransactions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	chain := makeTestChain(t, 2*testInterval)
+	c, err := MakeClient(chain, MakeFileStore(filepath.Join(t.TempDir(), "lightclient.json")), chain.trusted(), testStrengthTarget, 0, logging.TestingLog(t))
+	a.NoError(err)
+
+	hdr := chain.headers[testTxnRound]
+	txn := chain.block.Payset[1].Txn
+
+	// nothing is verified before the first sync
+	a.ErrorIs(c.FetchAndVerifyLightBlockHeader(hdr), ErrRoundNotAttested)
+
+	_, err = c.SyncOnce()
+	a.NoError(err)
+
+	for rnd := uint64(testInterval + 1); rnd <= 2*testInterval; rnd++ {
+		a.NoError(c.FetchAndVerifyLightBlockHeader(chain.headers[rnd]))
+	}
+	a.NoError(c.FetchAndVerifyTransaction(txn, hdr))
+
+	tampered := hdr
+	tampered.Seed[0]++
+	a.ErrorIs(c.FetchAndVerifyLightBlockHeader(tampered), ErrInvalidProof)
+	a.ErrorIs(c.FetchAndVerifyTransaction(txn, tampered), ErrInvalidProof)
+
+	// a proof for a neighbouring header
+	hdrProof, err := chain.LightBlockHeaderProof(testTxnRound + 1)
+	a.NoError(err)
+	a.ErrorIs(c.VerifyLightBlockHeader(hdr, hdrProof), ErrInvalidProof)
+
+	hdrProof, err = chain.LightBlockHeaderProof(testTxnRound)
+	a.NoError(err)
+	txnProof, err := chain.TransactionProof(txn.ID().String(), testTxnRound, crypto.Sha256)
+	a.NoError(err)
+
+	// a transaction that is not in the block
+	other := txn
+	other.Fee = basics.MicroAlgos{Raw: 1}
+	a.ErrorIs(c.VerifyTransaction(other, hdr, hdrProof, txnProof), ErrInvalidProof)
+
+	// a proof against the native sha512_256 commitment
+	wrongType := txnProof
+	wrongType.Hashtype = model.TransactionProofResponseHashtype(crypto.Sha512_256.String())
+	err = c.VerifyTransaction(txn, hdr, hdrProof, wrongType)
+	a.True(errors.Is(err, ErrInvalidProof))
+}
+
+func TestLightClientIntervalRetention(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	chain := makeTestChain(t, 4*testInterval)
+	store := MakeFileStore(filepath.Join(t.TempDir(), "lightclient.json"))
+
+	_, err := MakeClient(chain, store, chain.trusted(), testStrengthTarget, -1, logging.TestingLog(t))
+	a.Error(err)
+
+	c, err := MakeClient(chain, store, chain.trusted(), testStrengthTarget, 2, logging.TestingLog(t))
+	a.NoError(err)
+	for i := 0; i < 3; i++ {
+		_, err = c.SyncOnce()
+		a.NoError(err)
+	}
+	a.Equal(uint64(4*testInterval), c.LastVerifiedRound())
+
+	// the first interval was dropped, the chain still verifies from its end
+	_, err = c.Interval(testTxnRound)
+	a.ErrorIs(err, ErrRoundNotAttested)
+	interval, err := c.Interval(2*testInterval + 1)
+	a.NoError(err)
+	a.Equal(uint64(3*testInterval), interval.LastAttestedRound)
+
+	state, found, err := store.Load()
+	a.NoError(err)
+	a.True(found)
+	a.Len(state.Intervals, 2)
+	a.Equal(uint64(2*testInterval+1), state.Intervals[0].FirstAttestedRound)
+
+	// a lower limit applies to the persisted chain on resume
+	c, err = MakeClient(chain, store, chain.trusted(), testStrengthTarget, 1, logging.TestingLog(t))
+	a.NoError(err)
+	_, err = c.Interval(2*testInterval + 1)
+	a.ErrorIs(err, ErrRoundNotAttested)
+	_, err = c.Interval(4 * testInterval)
+	a.NoError(err)
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"errors"
+	"os"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Store persists the verified chain so that a Client can resume after restart.
+type Store interface {
+	// Load returns the persisted state, or false if nothing was saved yet.
+	Load() (State, bool, error)
+	Save(State) error
+}
+
+// FileStore keeps the state as JSON in a single file.
+type FileStore struct {
+	path string
+}
+
+// MakeFileStore creates a Store backed by the file at path.
+func MakeFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Load implements Store.
+func (s *FileStore) Load() (state State, found bool, err error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return State{}, false, nil
+	}
+	if err != nil {
+		return State{}, false, err
+	}
+	err = protocol.DecodeJSON(data, &state)
+	if err != nil {
+		return State{}, false, err
+	}
+	return state, true, nil
+}
+
+// Save implements Store. The file is replaced atomically, so a crash never
+// leaves a truncated chain behind.
+func (s *FileStore) Save(state State) error {
+	tmp := s.path + ".tmp"
+	err := os.WriteFile(tmp, protocol.EncodeJSON(&state), 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,268 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package stateproof
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/stateproof/verify"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// ErrWorkerNotRunning is returned by Status when the worker was not started or has been stopped.
+var ErrWorkerNotRunning = errors.New("state proof worker is not running")
+
+// Prover states reported in RoundStatus.
+const (
+	// ProverCached means the prover is held in the worker's in-memory cache.
+	ProverCached = "cached"
+	// ProverOnDisk means the prover is only in the database, e.g. because it
+	// was evicted from the cache while the state proof chain is stalled.
+	ProverOnDisk = "disk"
+	// ProverMissing means no prover exists for the round yet.
+	ProverMissing = "missing"
+)
+
+// VoterWeight is an online account selected as a voter for a state proof round.
+type VoterWeight struct {
+	Address basics.Address
+	Weight  uint64
+}
+
+// RoundStatus reports signature collection for a single state proof round.
+type RoundStatus struct {
+	Round       basics.Round
+	ProverState string
+
+	// SignedWeight is the weight of the signatures collected so far.
+	// ProvenWeight is the weight the proof must exceed to be built at all, and
+	// AcceptableWeight is the weight the network accepts as of the latest round.
+	SignedWeight     uint64
+	ProvenWeight     uint64
+	AcceptableWeight uint64
+	TotalWeight      uint64
+
+	Voters     int
+	Signatures int
+
+	// OurSigned and OurMissing split this node's participation keys that are
+	// voters for the round by whether their signature was collected.
+	// OurNotVoters are keys valid for the round that were not selected as voters.
+	OurSigned    []basics.Address
+	OurMissing   []basics.Address
+	OurNotVoters []basics.Address
+
+	// Missing lists voters whose signature was not collected, heaviest first.
+	Missing []VoterWeight
+}
+
+// WorkerStatus reports the progress of the worker on upcoming state proofs.
+type WorkerStatus struct {
+	LatestRound         basics.Round
+	NextStateProofRound basics.Round
+	CachedProvers       int
+	ProversCacheLength  int
+	Rounds              []RoundStatus
+}
+
+// Status reports, for each state proof round the worker is collecting
+// signatures for, how far it is from being provable and who has not signed.
+// The rounds covered follow the prover cache policy: the earliest pending
+// rounds up to the online threshold, plus the latest state proof round.
+func (spw *Worker) Status() (WorkerStatus, error) {
+	latest := spw.ledger.Latest()
+	latestHdr, err := spw.ledger.BlockHdr(latest)
+	if err != nil {
+		return WorkerStatus{}, err
+	}
+	proto := config.Consensus[latestHdr.CurrentProtocol]
+	nextRound := latestHdr.StateProofTracking[protocol.StateProofBasic].StateProofNextRound
+
+	var rounds []basics.Round
+	if proto.StateProofInterval != 0 && nextRound != 0 {
+		interval := basics.Round(proto.StateProofInterval)
+		latestStateProofRound := latest.RoundDownToMultipleOf(interval)
+		threshold := onlineProversThreshold(&proto, nextRound)
+		for rnd := nextRound; rnd <= latestStateProofRound; rnd += interval {
+			if rnd > threshold && rnd != latestStateProofRound {
+				continue
+			}
+			rounds = append(rounds, rnd)
+		}
+	}
+
+	// only the in-memory state is read under the lock, the database and the
+	// participation keys are read once it is released
+	spw.mu.Lock()
+	if spw.provers == nil || spw.db.Handle == nil {
+		spw.mu.Unlock()
+		return WorkerStatus{}, ErrWorkerNotRunning
+	}
+	dbs := spw.db
+	cachedProvers := len(spw.provers)
+	cached := make(map[basics.Round]proverSnapshot)
+	for _, rnd := range rounds {
+		if prover, ok := spw.provers[rnd]; ok {
+			cached[rnd] = makeProverSnapshot(prover)
+		}
+	}
+	spw.mu.Unlock()
+
+	status := WorkerStatus{
+		LatestRound:         latest,
+		NextStateProofRound: nextRound,
+		CachedProvers:       cachedProvers,
+		ProversCacheLength:  proversCacheLength,
+	}
+	for _, rnd := range rounds {
+		snapshot, ok := cached[rnd]
+		rs, err := spw.roundStatus(dbs, rnd, latest, snapshot, ok)
+		if err != nil {
+			return WorkerStatus{}, err
+		}
+		status.Rounds = append(status.Rounds, rs)
+	}
+	return status, nil
+}
+
+// proverSnapshot is what Status reports of a prover, copied so that it can be
+// read without holding the worker's lock
+type proverSnapshot struct {
+	signedWeight uint64
+	provenWeight uint64
+	votersHdr    bookkeeping.BlockHeader
+	participants []basics.Participant
+	addrToPos    map[basics.Address]uint64
+	present      []bool
+}
+
+// makeProverSnapshot must be called with spw.mu held if prover is cached.
+// The participants and their positions never change once a prover is
+// created, so only the signatures are copied.
+func makeProverSnapshot(prover spProver) proverSnapshot {
+	snapshot := proverSnapshot{
+		signedWeight: prover.SignedWeight(),
+		provenWeight: prover.ProvenWeight,
+		votersHdr:    prover.VotersHdr,
+		participants: prover.Participants,
+		addrToPos:    prover.AddrToPos,
+		present:      make([]bool, len(prover.Participants)),
+	}
+	for pos := range snapshot.present {
+		snapshot.present[pos], _ = prover.Present(uint64(pos))
+	}
+	return snapshot
+}
+
+// roundStatus reports on rnd using the snapshot of its prover when it is
+// cached, and the prover in dbs otherwise.
+func (spw *Worker) roundStatus(dbs db.Accessor, rnd basics.Round, latest basics.Round, snapshot proverSnapshot, cached bool) (RoundStatus, error) {
+	rs := RoundStatus{Round: rnd, ProverState: ProverCached}
+
+	var sigs []pendingSig
+	var prover spProver
+	err := dbs.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		sigs, err = getPendingSigsForRound(tx, rnd)
+		if err != nil || cached {
+			return err
+		}
+
+		prover, err = getProver(tx, rnd)
+		switch {
+		case err == nil:
+			rs.ProverState = ProverOnDisk
+		case errors.Is(err, sql.ErrNoRows):
+			rs.ProverState = ProverMissing
+			err = nil
+		}
+		return err
+	})
+	if err != nil {
+		return RoundStatus{}, err
+	}
+	rs.Signatures = len(sigs)
+
+	signedInDB := make(map[basics.Address]bool, len(sigs))
+	for i := range sigs {
+		signedInDB[sigs[i].signer] = true
+		if rs.ProverState == ProverOnDisk {
+			// the prover is a private copy, so it can be filled in without verification
+			prover.insertSig(&sigs[i], false)
+		}
+	}
+	if rs.ProverState == ProverOnDisk {
+		snapshot = makeProverSnapshot(prover)
+	}
+
+	signed := func(addr basics.Address) (voter bool, present bool) {
+		if rs.ProverState == ProverMissing {
+			return true, signedInDB[addr]
+		}
+		pos, ok := snapshot.addrToPos[addr]
+		if !ok {
+			return false, false
+		}
+		return true, snapshot.present[pos]
+	}
+
+	if rs.ProverState != ProverMissing {
+		rs.SignedWeight = snapshot.signedWeight
+		rs.ProvenWeight = snapshot.provenWeight
+		rs.AcceptableWeight = verify.AcceptableStateProofWeight(&snapshot.votersHdr, latest, spw.log)
+		rs.Voters = len(snapshot.participants)
+
+		for addr, pos := range snapshot.addrToPos {
+			weight := snapshot.participants[pos].Weight
+			rs.TotalWeight += weight
+			if !snapshot.present[pos] {
+				rs.Missing = append(rs.Missing, VoterWeight{Address: addr, Weight: weight})
+			}
+		}
+		sort.Slice(rs.Missing, func(i, j int) bool {
+			if rs.Missing[i].Weight != rs.Missing[j].Weight {
+				return rs.Missing[i].Weight > rs.Missing[j].Weight
+			}
+			return rs.Missing[i].Address.String() < rs.Missing[j].Address.String()
+		})
+	}
+
+	seen := make(map[basics.Address]bool)
+	for _, key := range spw.accts.StateProofKeys(rnd) {
+		if key.FirstValid > rnd || rnd > key.LastValid || seen[key.Account] {
+			continue
+		}
+		seen[key.Account] = true
+		voter, present := signed(key.Account)
+		switch {
+		case !voter:
+			rs.OurNotVoters = append(rs.OurNotVoters, key.Account)
+		case present:
+			rs.OurSigned = append(rs.OurSigned, key.Account)
+		default:
+			rs.OurMissing = append(rs.OurMissing, key.Account)
+		}
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package stateproof
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestWorkerStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var keys []account.Participation
+	for i := 0; i < 6; i++ {
+		var parent basics.Address
+		crypto.RandBytes(parent[:])
+		p := newPartKey(t, parent)
+		defer p.Close()
+		keys = append(keys, p.Participation)
+	}
+
+	// this node holds keys 0-2 and 5; voters are keys 0-4, so 3 and 4 never sign
+	// and 5 is not selected as a voter.
+	s := newWorkerStubs(t, append(keys[:3:3], keys[5]), 10)
+	s.keysForVoters = keys[:5]
+	w := newTestWorker(t, s)
+
+	_, err := w.Status()
+	a.ErrorIs(err, ErrWorkerNotRunning)
+
+	w.Start()
+	defer w.Stop()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	s.advanceRoundsWithoutStateProof(t, 1)
+	spRound := basics.Round(2 * proto.StateProofInterval)
+
+	checkRound := func(rs RoundStatus, proverState string) {
+		a.Equal(spRound, rs.Round)
+		a.Equal(proverState, rs.ProverState)
+		a.Equal(5, rs.Voters)
+		a.Equal(3, rs.Signatures)
+		a.Equal(uint64(3), rs.SignedWeight)
+		a.Equal(uint64(5), rs.TotalWeight)
+		a.NotZero(rs.ProvenWeight)
+		a.GreaterOrEqual(rs.AcceptableWeight, rs.ProvenWeight)
+		a.ElementsMatch([]basics.Address{keys[0].Parent, keys[1].Parent, keys[2].Parent}, rs.OurSigned)
+		a.Empty(rs.OurMissing)
+		a.Equal([]basics.Address{keys[5].Parent}, rs.OurNotVoters)
+		a.ElementsMatch([]VoterWeight{{Address: keys[3].Parent, Weight: 1}, {Address: keys[4].Parent, Weight: 1}}, rs.Missing)
+	}
+
+	status, err := w.Status()
+	a.NoError(err)
+	a.Equal(s.latest, status.LatestRound)
+	a.Equal(spRound, status.NextStateProofRound)
+	a.Equal(1, status.CachedProvers)
+	a.Equal(proversCacheLength, status.ProversCacheLength)
+	a.Len(status.Rounds, 1)
+	checkRound(status.Rounds[0], ProverCached)
+
+	// a prover evicted from the cache is read back from the database
+	w.mu.Lock()
+	delete(w.provers, spRound)
+	w.mu.Unlock()
+
+	status, err = w.Status()
+	a.NoError(err)
+	a.Zero(status.CachedProvers)
+	a.Len(status.Rounds, 1)
+	checkRound(status.Rounds[0], ProverOnDisk)
+}
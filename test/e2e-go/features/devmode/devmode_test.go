@@ -75,3 +75,110 @@ func TestDevMode(t *testing.T) {
 		prevTime = currTime
 	}
 }
+
+func TestDevModeLedgerSnapshots(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	var fixture fixtures.RestClientFixture
+	fixture.SetupNoStart(t, filepath.Join("nettemplates", "DevModeNetwork.json"))
+	fixture.Start()
+	defer fixture.Shutdown()
+	sender, err := fixture.GetRichestAccount()
+	require.NoError(t, err)
+	key := crypto.GenerateSignatureSecrets(crypto.Seed{})
+	receiver := basics.Address(key.SignatureVerifier)
+	txn := fixture.SendMoneyAndWait(0, 100000, 1000, sender.Address, receiver.String(), "")
+	require.NotNil(t, txn.ConfirmedRound)
+
+	client := fixture.LibGoalClient
+	snapshot, err := client.SaveLedgerSnapshot("baseline")
+	require.NoError(t, err)
+	require.Equal(t, *txn.ConfirmedRound, snapshot.Round)
+	baseline, err := fixture.AlgodClient.AccountInformation(receiver.String(), false)
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= 3; i++ {
+		fixture.SendMoneyAndWait(snapshot.Round+i, 100000+i, 1000, sender.Address, receiver.String(), "")
+	}
+	status, err := fixture.AlgodClient.Status()
+	require.NoError(t, err)
+	require.Equal(t, snapshot.Round+3, status.LastRound)
+
+	reverted, err := client.RevertLedgerSnapshot("baseline")
+	require.NoError(t, err)
+	require.Equal(t, snapshot.Round, reverted.Round)
+	status, err = fixture.AlgodClient.Status()
+	require.NoError(t, err)
+	require.Equal(t, snapshot.Round, status.LastRound)
+	info, err := fixture.AlgodClient.AccountInformation(receiver.String(), false)
+	require.NoError(t, err)
+	require.Equal(t, baseline.Amount, info.Amount)
+
+	// the reverted node keeps producing blocks from the snapshot round
+	txn = fixture.SendMoneyAndWait(snapshot.Round+1, 100000, 1000, sender.Address, receiver.String(), "")
+	require.Equal(t, snapshot.Round+1, *txn.ConfirmedRound)
+
+	snapshots, err := client.LedgerSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots.Snapshots, 1)
+	require.Equal(t, "baseline", snapshots.Snapshots[0].Name)
+}
+
+func TestDevModeAdvanceRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	var fixture fixtures.RestClientFixture
+	fixture.SetupNoStart(t, filepath.Join("nettemplates", "DevModeNetwork.json"))
+	fixture.Start()
+	defer fixture.Shutdown()
+
+	status, err := fixture.AlgodClient.Status()
+	require.NoError(t, err)
+	advanced, err := fixture.AlgodClient.AdvanceRounds(10)
+	require.NoError(t, err)
+	require.Equal(t, status.LastRound+10, advanced.Round)
+	status, err = fixture.AlgodClient.Status()
+	require.NoError(t, err)
+	require.Equal(t, advanced.Round, status.LastRound)
+
+	// the next block uses the exact timestamp, and the following one goes back to the clock
+	latest, err := fixture.AlgodClient.Block(status.LastRound)
+	require.NoError(t, err)
+	nextTimestamp := uint64(latest.Block["ts"].(float64)) + 3600
+	err = fixture.AlgodClient.SetNextBlockTimestamp(nextTimestamp)
+	require.NoError(t, err)
+	advanced, err = fixture.AlgodClient.AdvanceRounds(2)
+	require.NoError(t, err)
+	blk, err := fixture.AlgodClient.Block(advanced.Round - 1)
+	require.NoError(t, err)
+	require.Equal(t, nextTimestamp, uint64(blk.Block["ts"].(float64)))
+	err = fixture.AlgodClient.SetNextBlockTimestamp(0)
+	require.Error(t, err)
+
+	// blocks are written on their own once an interval is set
+	err = fixture.AlgodClient.SetBlockInterval(1)
+	require.NoError(t, err)
+	interval, err := fixture.AlgodClient.GetBlockInterval()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), interval.Interval)
+	status, err = fixture.AlgodClient.StatusAfterBlock(advanced.Round + 2)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, status.LastRound, advanced.Round+2)
+
+	err = fixture.AlgodClient.SetBlockInterval(0)
+	require.NoError(t, err)
+	status, err = fixture.AlgodClient.Status()
+	require.NoError(t, err)
+	time.Sleep(3 * time.Second)
+	after, err := fixture.AlgodClient.Status()
+	require.NoError(t, err)
+	require.Equal(t, status.LastRound, after.LastRound)
+}
@@ -17,6 +17,7 @@
 package partitionrecovery
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 	"time"
@@ -24,6 +25,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/netdeploy"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/test/framework/fixtures"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -296,3 +299,42 @@ func TestPartitionHalfOffline(t *testing.T) {
 	err = fixture.WaitForRound(status.LastRound+1, partitionRecoveryTime)
 	a.NoError(err)
 }
+
+func TestTopologyPartitionRecovery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	a := require.New(fixtures.SynchronizedTest(t))
+
+	// Overview of this test:
+	// Start a network of two relays, each serving a node with 50% stake
+	// Let it run for a few blocks.
+	// Take the link between the relays down to partition the network
+	// Bring the link up again and see if it recovers
+
+	var fixture fixtures.RestClientFixture
+	fixture.Setup(t, filepath.Join("nettemplates", "TwoRelaysTopology.json"))
+	defer fixture.Shutdown()
+
+	// Let the network make some progress
+	waitForRound := uint64(3)
+	err := fixture.WaitForRound(waitForRound, partitionRecoveryTime)
+	a.NoError(err)
+
+	err = fixture.RunTimeline(context.Background(), netdeploy.Timeline{Events: []netdeploy.TimelineEvent{
+		{At: "0s", Link: &netdeploy.TopologyLink{From: "Relay2", To: "Relay1", LinkProfile: network.LinkProfile{Down: true}}},
+		{At: inducePartitionTime.String(), Link: &netdeploy.TopologyLink{From: "Relay2", To: "Relay1"}},
+	}})
+	a.NoError(err)
+
+	// Now wait for us to make progress again.
+	status, err := fixture.LibGoalClient.Status()
+	a.NoError(err)
+
+	err = fixture.WaitForRound(status.LastRound+1, partitionRecoveryTime)
+	a.NoError(err)
+}
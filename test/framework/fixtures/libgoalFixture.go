@@ -18,6 +18,7 @@ package fixtures
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -395,6 +396,12 @@ func (f *LibGoalFixture) StartNode(nodeDir string) (libgoal.Client, error) {
 	return c, nil
 }
 
+// RunTimeline applies the events of the timeline to the fixture's network at their scheduled times,
+// returning once the last one is applied
+func (f *LibGoalFixture) RunTimeline(ctx context.Context, timeline netdeploy.Timeline) error {
+	return f.network.RunTimeline(ctx, f.binDir, timeline, true, nil)
+}
+
 // GetParticipationOnlyAccounts returns accounts that only have participation keys
 func (f *LibGoalFixture) GetParticipationOnlyAccounts(lg libgoal.Client) []account.Participation {
 	return f.clientPartKeys[lg.DataDir()]
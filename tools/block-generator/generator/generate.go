@@ -51,7 +51,7 @@ const (
 	paymentAcctCreateTx TxTypeID = "pay_create"
 	assetTx             TxTypeID = "asset"
 	//keyRegistrationTx TxTypeID = "keyreg"
-	//applicationCallTx TxTypeID = "appl"
+	applicationCallTx TxTypeID = "appl"
 
 	// Asset Tx IDs
 	assetCreate  TxTypeID = "asset_create"
@@ -60,6 +60,19 @@ const (
 	assetClose   TxTypeID = "asset_close"
 	assetDestroy TxTypeID = "asset_destroy"
 
+	// Application Tx IDs
+	appCreate    TxTypeID = "app_create"
+	appUpdate    TxTypeID = "app_update"
+	appDelete    TxTypeID = "app_delete"
+	appOptin     TxTypeID = "app_optin"
+	appCall      TxTypeID = "app_call"
+	appInner     TxTypeID = "app_inner"
+	appBoxCreate TxTypeID = "app_box_create"
+	appBoxWrite  TxTypeID = "app_box_write"
+	appBoxDelete TxTypeID = "app_box_delete"
+	// appFund is not configurable, it funds app accounts before they need it
+	appFund TxTypeID = "app_fund"
+
 	assetTotal = uint64(100000000000000000)
 
 	consensusTimeMilli int64  = 4500
@@ -78,6 +91,7 @@ type GenerationConfig struct {
 	// TX Distribution
 	PaymentTransactionFraction float32 `yaml:"tx_pay_fraction"`
 	AssetTransactionFraction   float32 `yaml:"tx_asset_fraction"`
+	AppTransactionFraction     float32 `yaml:"tx_app_fraction"`
 
 	// Payment configuration
 	PaymentNewAccountFraction float32 `yaml:"pay_acct_create_fraction"`
@@ -89,6 +103,27 @@ type GenerationConfig struct {
 	AssetOptinFraction   float32 `yaml:"asset_optin_fraction"`
 	AssetCloseFraction   float32 `yaml:"asset_close_fraction"`
 	AssetXferFraction    float32 `yaml:"asset_xfer_fraction"`
+
+	// Application configuration
+	AppCreateFraction    float32 `yaml:"app_create_fraction"`
+	AppUpdateFraction    float32 `yaml:"app_update_fraction"`
+	AppDeleteFraction    float32 `yaml:"app_delete_fraction"`
+	AppOptinFraction     float32 `yaml:"app_optin_fraction"`
+	AppCallFraction      float32 `yaml:"app_call_fraction"`
+	AppInnerFraction     float32 `yaml:"app_inner_fraction"`
+	AppBoxCreateFraction float32 `yaml:"app_box_create_fraction"`
+	AppBoxWriteFraction  float32 `yaml:"app_box_write_fraction"`
+	AppBoxDeleteFraction float32 `yaml:"app_box_delete_fraction"`
+
+	// Application programs and state. The programs are TEAL source files, by default a program
+	// writing the configured state is generated.
+	AppApprovalProgram string `yaml:"app_approval_program"`
+	AppClearProgram    string `yaml:"app_clear_program"`
+	AppGlobalKeys      uint64 `yaml:"app_global_keys"`
+	AppLocalKeys       uint64 `yaml:"app_local_keys"`
+	AppValueSize       uint64 `yaml:"app_value_size"`
+	AppBoxSize         uint64 `yaml:"app_box_size"`
+	AppInnerTxns       uint64 `yaml:"app_inner_txns"`
 }
 
 func sumIsCloseToOne(numbers ...float32) bool {
@@ -101,7 +136,7 @@ func sumIsCloseToOne(numbers ...float32) bool {
 
 // MakeGenerator initializes the Generator object.
 func MakeGenerator(dbround uint64, bkGenesis bookkeeping.Genesis, config GenerationConfig) (Generator, error) {
-	if !sumIsCloseToOne(config.PaymentTransactionFraction, config.AssetTransactionFraction) {
+	if !sumIsCloseToOne(config.PaymentTransactionFraction, config.AssetTransactionFraction, config.AppTransactionFraction) {
 		return nil, fmt.Errorf("transaction distribution ratios should equal 1")
 	}
 
@@ -113,6 +148,11 @@ func MakeGenerator(dbround uint64, bkGenesis bookkeeping.Genesis, config Generat
 		return nil, fmt.Errorf("asset configuration ratios should equal 1")
 	}
 
+	// the application configuration is optional, since most scenarios do not use it
+	if config.AppTransactionFraction > 0 && !sumIsCloseToOne(config.AppCreateFraction, config.AppUpdateFraction, config.AppDeleteFraction, config.AppOptinFraction, config.AppCallFraction, config.AppInnerFraction, config.AppBoxCreateFraction, config.AppBoxWriteFraction, config.AppBoxDeleteFraction) {
+		return nil, fmt.Errorf("application configuration ratios should equal 1")
+	}
+
 	var proto protocol.ConsensusVersion = "future"
 	gen := &generator{
 		config:                    config,
@@ -133,6 +173,13 @@ func MakeGenerator(dbround uint64, bkGenesis bookkeeping.Genesis, config Generat
 		roundOffset:               dbround,
 	}
 
+	if config.AppTransactionFraction > 0 {
+		err := gen.initializeApps()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	gen.feeSink[31] = 1
 	gen.rewardsPool[31] = 2
 	gen.genesisHash[31] = 3
@@ -151,6 +198,8 @@ func MakeGenerator(dbround uint64, bkGenesis bookkeeping.Genesis, config Generat
 			gen.transactionWeights = append(gen.transactionWeights, config.PaymentTransactionFraction)
 		case assetTx:
 			gen.transactionWeights = append(gen.transactionWeights, config.AssetTransactionFraction)
+		case applicationCallTx:
+			gen.transactionWeights = append(gen.transactionWeights, config.AppTransactionFraction)
 		}
 	}
 
@@ -178,6 +227,29 @@ func MakeGenerator(dbround uint64, bkGenesis bookkeeping.Genesis, config Generat
 		}
 	}
 
+	for _, val := range getAppTxOptions() {
+		switch val {
+		case appCreate:
+			gen.appTxWeights = append(gen.appTxWeights, config.AppCreateFraction)
+		case appUpdate:
+			gen.appTxWeights = append(gen.appTxWeights, config.AppUpdateFraction)
+		case appDelete:
+			gen.appTxWeights = append(gen.appTxWeights, config.AppDeleteFraction)
+		case appOptin:
+			gen.appTxWeights = append(gen.appTxWeights, config.AppOptinFraction)
+		case appCall:
+			gen.appTxWeights = append(gen.appTxWeights, config.AppCallFraction)
+		case appInner:
+			gen.appTxWeights = append(gen.appTxWeights, config.AppInnerFraction)
+		case appBoxCreate:
+			gen.appTxWeights = append(gen.appTxWeights, config.AppBoxCreateFraction)
+		case appBoxWrite:
+			gen.appTxWeights = append(gen.appTxWeights, config.AppBoxWriteFraction)
+		case appBoxDelete:
+			gen.appTxWeights = append(gen.appTxWeights, config.AppBoxDeleteFraction)
+		}
+	}
+
 	return gen, nil
 }
 
@@ -232,9 +304,24 @@ type generator struct {
 	// being created.
 	pendingAssets []*assetData
 
+	// apps is a minimal representation of the applications, their opted in accounts and boxes.
+	apps []*appData
+	// pendingApps is used to hold newly created apps so that they are not used before
+	// being created.
+	pendingApps []*appData
+	// programs of the applications, and the schemas of their state.
+	approvalProgram []byte
+	clearProgram    []byte
+	globalSchema    basics.StateSchema
+	localSchema     basics.StateSchema
+	// innerTxnCounter is the number of inner transactions issued so far in the current round,
+	// which count towards the transaction counter.
+	innerTxnCounter uint64
+
 	transactionWeights []float32
 	payTxWeights       []float32
 	assetTxWeights     []float32
+	appTxWeights       []float32
 
 	// Reporting information from transaction type to data
 	reportData Report
@@ -336,7 +423,7 @@ func (g *generator) WriteGenesis(output io.Writer) error {
 }
 
 func getTransactionOptions() []interface{} {
-	return []interface{}{paymentTx, assetTx}
+	return []interface{}{paymentTx, assetTx, applicationCallTx}
 }
 
 func (g *generator) generateTransaction(round uint64, intra uint64) (transactions.SignedTxn, transactions.ApplyData, error) {
@@ -350,6 +437,8 @@ func (g *generator) generateTransaction(round uint64, intra uint64) (transaction
 		return g.generatePaymentTxn(round, intra)
 	case assetTx:
 		return g.generateAssetTxn(round, intra)
+	case applicationCallTx:
+		return g.generateAppTxn(round, intra)
 	default:
 		return transactions.SignedTxn{}, transactions.ApplyData{}, fmt.Errorf("no generator available for %s", selection)
 	}
@@ -365,7 +454,8 @@ func (g *generator) txnForRound(round uint64) uint64 {
 
 // finishRound tells the generator it can apply any pending state.
 func (g *generator) finishRound(txnCount uint64) {
-	g.txnCounter += txnCount
+	g.txnCounter += txnCount + g.innerTxnCounter
+	g.innerTxnCounter = 0
 
 	g.timestamp += consensusTimeMilli
 	g.round++
@@ -373,6 +463,16 @@ func (g *generator) finishRound(txnCount uint64) {
 	// Apply pending assets...
 	g.assets = append(g.assets, g.pendingAssets...)
 	g.pendingAssets = nil
+
+	// ...and pending apps.
+	g.apps = append(g.apps, g.pendingApps...)
+	g.pendingApps = nil
+}
+
+// nextCreatableID returns the ID of an asset or app created by the transaction at index intra of the
+// current round, which follows the transactions and inner transactions before it.
+func (g *generator) nextCreatableID(intra uint64) uint64 {
+	return g.txnCounter + g.innerTxnCounter + intra + 1
 }
 
 // WriteBlock generates a block full of new transactions and writes it to the writer.
@@ -422,19 +522,34 @@ func (g *generator) WriteBlock(output io.Writer, round uint64) error {
 			CurrentProtocol: g.protocol,
 		},
 		UpgradeVote:        bookkeeping.UpgradeVote{},
-		TxnCounter:         g.txnCounter + numTxnForBlock,
 		StateProofTracking: nil,
 	}
 
 	// Generate the transactions
-	transactions := make([]transactions.SignedTxnInBlock, 0, numTxnForBlock)
-
+	txns := make([]transactions.SignedTxn, 0, numTxnForBlock)
+	ads := make([]transactions.ApplyData, 0, numTxnForBlock)
 	for i := uint64(0); i < numTxnForBlock; i++ {
 		txn, ad, err := g.generateTransaction(g.round, i)
 		if err != nil {
 			panic(fmt.Sprintf("failed to generate transaction: %v\n", err))
 		}
-		stib, err := header.EncodeSignedTxn(txn, ad)
+		txns = append(txns, txn)
+		ads = append(ads, ad)
+	}
+	header.TxnCounter = g.txnCounter + numTxnForBlock + g.innerTxnCounter
+
+	// The effects of application calls depend on their programs, evaluate them to fill in the ApplyData.
+	if g.config.AppTransactionFraction > 0 {
+		var err error
+		ads, err = g.evaluateApplyData(header, txns)
+		if err != nil {
+			return err
+		}
+	}
+
+	transactions := make([]transactions.SignedTxnInBlock, 0, numTxnForBlock)
+	for i := range txns {
+		stib, err := header.EncodeSignedTxn(txns[i], ads[i])
 		if err != nil {
 			panic(fmt.Sprintf("failed to encode transaction: %v\n", err))
 		}
@@ -604,7 +719,7 @@ func (g *generator) generateAssetTxnInternalHint(txType TxTypeID, round uint64,
 		senderAcct := indexToAccount(senderIndex)
 
 		total := assetTotal
-		assetID := g.txnCounter + intra + 1
+		assetID := g.nextCreatableID(intra)
 		assetName := fmt.Sprintf("asset #%d", assetID)
 		txn = g.makeAssetCreateTxn(g.makeTxnHeader(senderAcct, round, intra), total, false, assetName)
 		// Compute asset ID and initialize holdings
@@ -0,0 +1,507 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generator
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/eval"
+)
+
+// appBoxNameLen is the length of the box names, a prefix followed by a big-endian counter.
+const appBoxNameLen = 9
+
+// appFundBoxes is the number of boxes an app account is funded for at once.
+const appFundBoxes = 100
+
+type appData struct {
+	appID   uint64
+	creator uint64
+	// Set of accounts opted in to the app.
+	optins map[uint64]bool
+	// Names of the boxes of the app.
+	boxes     [][]byte
+	nextBoxID uint64
+	// Balance and minimum balance of the app account.
+	balance    uint64
+	minBalance uint64
+}
+
+func getAppTxOptions() []interface{} {
+	return []interface{}{appCreate, appUpdate, appDelete, appOptin, appCall, appInner, appBoxCreate, appBoxWrite, appBoxDelete}
+}
+
+// initializeApps validates the application configuration and assembles the programs.
+func (g *generator) initializeApps() error {
+	cfg := g.config
+	if cfg.AppGlobalKeys > g.params.MaxGlobalSchemaEntries {
+		return fmt.Errorf("app_global_keys %d is larger than %d", cfg.AppGlobalKeys, g.params.MaxGlobalSchemaEntries)
+	}
+	if cfg.AppLocalKeys > g.params.MaxLocalSchemaEntries {
+		return fmt.Errorf("app_local_keys %d is larger than %d", cfg.AppLocalKeys, g.params.MaxLocalSchemaEntries)
+	}
+	// keys are a prefix followed by a uint64
+	if maxValueSize := uint64(g.params.MaxAppSumKeyValueLens - 9); cfg.AppValueSize > maxValueSize {
+		return fmt.Errorf("app_value_size %d is larger than %d", cfg.AppValueSize, maxValueSize)
+	}
+	if cfg.AppInnerTxns > uint64(g.params.MaxInnerTransactions) {
+		return fmt.Errorf("app_inner_txns %d is larger than %d", cfg.AppInnerTxns, g.params.MaxInnerTransactions)
+	}
+	if cfg.AppBoxCreateFraction+cfg.AppBoxWriteFraction+cfg.AppBoxDeleteFraction > 0 {
+		if cfg.AppBoxSize == 0 {
+			return fmt.Errorf("app_box_size must be set to create boxes")
+		}
+		if g.boxReferences() > uint64(g.params.MaxAppBoxReferences) {
+			return fmt.Errorf("app_box_size %d needs more than %d box references", cfg.AppBoxSize, g.params.MaxAppBoxReferences)
+		}
+	}
+
+	approvalSource := generateApprovalProgram(cfg)
+	if cfg.AppApprovalProgram != "" {
+		data, err := os.ReadFile(cfg.AppApprovalProgram)
+		if err != nil {
+			return fmt.Errorf("failed to read approval program: %w", err)
+		}
+		approvalSource = string(data)
+	}
+	clearSource := "#pragma version 8\nint 1\n"
+	if cfg.AppClearProgram != "" {
+		data, err := os.ReadFile(cfg.AppClearProgram)
+		if err != nil {
+			return fmt.Errorf("failed to read clear program: %w", err)
+		}
+		clearSource = string(data)
+	}
+
+	ops, err := logic.AssembleString(approvalSource)
+	if err != nil {
+		return fmt.Errorf("failed to assemble approval program: %w", err)
+	}
+	g.approvalProgram = ops.Program
+	ops, err = logic.AssembleString(clearSource)
+	if err != nil {
+		return fmt.Errorf("failed to assemble clear program: %w", err)
+	}
+	g.clearProgram = ops.Program
+	if g.extraProgramPages() > uint32(g.params.MaxExtraAppProgramPages) {
+		return fmt.Errorf("programs of %d bytes do not fit in %d extra pages", len(g.approvalProgram)+len(g.clearProgram), g.params.MaxExtraAppProgramPages)
+	}
+
+	g.globalSchema = basics.StateSchema{NumByteSlice: cfg.AppGlobalKeys}
+	g.localSchema = basics.StateSchema{NumByteSlice: cfg.AppLocalKeys}
+	return nil
+}
+
+// generateApprovalProgram returns the TEAL source of an approval program writing the configured state. The
+// generated transactions pass the operation as the first application argument, and the box name as the second.
+func generateApprovalProgram(cfg GenerationConfig) string {
+	// the value of the state keys is the transaction ID, padded or truncated to the value size
+	value := `byte ""`
+	if cfg.AppValueSize > 0 {
+		value = fmt.Sprintf("txn TxID\nint %d\nbzero\nconcat\nextract 0 %d", cfg.AppValueSize, cfg.AppValueSize)
+	}
+	// box writes replace the start of the box with the transaction ID
+	boxWriteLen := cfg.AppBoxSize
+	if boxWriteLen > 32 {
+		boxWriteLen = 32
+	} else if boxWriteLen == 0 {
+		boxWriteLen = 1
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `#pragma version 8
+txn ApplicationID
+bz create
+txn OnCompletion
+int OptIn
+==
+bnz optin
+txn OnCompletion
+int NoOp
+==
+bnz noop
+// updates and deletes are only issued by the creator
+txn Sender
+global CreatorAddress
+==
+return
+
+create:
+callsub write_global
+int 1
+return
+
+optin:
+callsub write_local
+int 1
+return
+
+noop:
+txna ApplicationArgs 0
+byte "%s"
+==
+bnz call
+txna ApplicationArgs 0
+byte "%s"
+==
+bnz inner
+txna ApplicationArgs 0
+byte "%s"
+==
+bnz box_create
+txna ApplicationArgs 0
+byte "%s"
+==
+bnz box_write
+txna ApplicationArgs 0
+byte "%s"
+==
+bnz box_delete
+err
+
+call:
+callsub write_global
+txn Sender
+global CurrentApplicationID
+app_opted_in
+bz call_done
+callsub write_local
+call_done:
+int 1
+return
+
+inner:
+int 0
+store 1
+inner_loop:
+load 1
+int %d
+>=
+bnz inner_done
+itxn_begin
+int pay
+itxn_field TypeEnum
+txn Sender
+itxn_field Receiver
+int 0
+itxn_field Amount
+int 0
+itxn_field Fee
+itxn_submit
+load 1
+int 1
++
+store 1
+b inner_loop
+inner_done:
+int 1
+return
+
+box_create:
+txna ApplicationArgs 1
+int %d
+box_create
+return
+
+box_write:
+txna ApplicationArgs 1
+int 0
+txn TxID
+extract 0 %d
+box_replace
+int 1
+return
+
+box_delete:
+txna ApplicationArgs 1
+box_del
+return
+
+value:
+%s
+retsub
+
+write_global:
+int 0
+store 1
+global_loop:
+load 1
+int %d
+>=
+bnz global_done
+byte "g"
+load 1
+itob
+concat
+callsub value
+app_global_put
+load 1
+int 1
++
+store 1
+b global_loop
+global_done:
+retsub
+
+write_local:
+int 0
+store 1
+local_loop:
+load 1
+int %d
+>=
+bnz local_done
+txn Sender
+byte "l"
+load 1
+itob
+concat
+callsub value
+app_local_put
+load 1
+int 1
++
+store 1
+b local_loop
+local_done:
+retsub
+`, appCall, appInner, appBoxCreate, appBoxWrite, appBoxDelete,
+		cfg.AppInnerTxns, cfg.AppBoxSize, boxWriteLen, value, cfg.AppGlobalKeys, cfg.AppLocalKeys)
+	return sb.String()
+}
+
+// extraProgramPages returns the number of extra pages the programs need.
+func (g *generator) extraProgramPages() uint32 {
+	return uint32((len(g.approvalProgram) + len(g.clearProgram) - 1) / g.params.MaxAppProgramLen)
+}
+
+// boxReferences returns the number of box references needed to access a box.
+func (g *generator) boxReferences() uint64 {
+	size := appBoxNameLen + g.config.AppBoxSize
+	return (size + g.params.BytesPerBoxReference - 1) / g.params.BytesPerBoxReference
+}
+
+// boxMinBalance returns the minimum balance a box adds to its app account.
+func (g *generator) boxMinBalance() uint64 {
+	return g.params.BoxFlatMinBalance + g.params.BoxByteMinBalance*(appBoxNameLen+g.config.AppBoxSize)
+}
+
+// boxRefs returns the box references of a call accessing the named box, the first one naming it and the
+// others only adding to the I/O budget.
+func (g *generator) boxRefs(name []byte) []transactions.BoxRef {
+	refs := make([]transactions.BoxRef, g.boxReferences())
+	refs[0].Name = name
+	return refs
+}
+
+func (g *generator) generateAppTxn(round uint64, intra uint64) (transactions.SignedTxn, transactions.ApplyData, error) {
+	start := time.Now()
+	selection, err := weightedSelection(g.appTxWeights, getAppTxOptions(), appCall)
+	if err != nil {
+		return transactions.SignedTxn{}, transactions.ApplyData{}, err
+	}
+
+	actual, txn := g.generateAppTxnInternal(selection.(TxTypeID), round, intra)
+	defer g.recordData(actual, start)
+
+	if txn.Type == "" {
+		fmt.Println("Empty application transaction.")
+		os.Exit(1)
+	}
+
+	return signTxn(txn), transactions.ApplyData{}, nil
+}
+
+func (g *generator) generateAppTxnInternal(txType TxTypeID, round uint64, intra uint64) (actual TxTypeID, txn transactions.Transaction) {
+	return g.generateAppTxnInternalHint(txType, round, intra, nil)
+}
+
+func (g *generator) generateAppTxnInternalHint(txType TxTypeID, round uint64, intra uint64, hint *appData) (actual TxTypeID, txn transactions.Transaction) {
+	actual = txType
+	// If there are no apps the next operation needs to be a create.
+	if len(g.apps) == 0 {
+		actual = appCreate
+	}
+
+	var senderIndex uint64
+	if actual == appCreate {
+		numApps := uint64(len(g.apps)) + uint64(len(g.pendingApps))
+		senderIndex = numApps % g.config.NumGenesisAccounts
+		senderAcct := indexToAccount(senderIndex)
+
+		appID := g.nextCreatableID(intra)
+		txn = g.makeAppCreateTxn(g.makeTxnHeader(senderAcct, round, intra), g.approvalProgram, g.clearProgram, g.globalSchema, g.localSchema, g.extraProgramPages())
+		g.pendingApps = append(g.pendingApps, &appData{
+			appID:      appID,
+			creator:    senderIndex,
+			optins:     make(map[uint64]bool),
+			minBalance: g.params.MinBalance,
+		})
+	} else {
+		appIndex := rand.Intn(len(g.apps))
+		app := g.apps[appIndex]
+		if hint != nil {
+			app = hint
+			for i := range g.apps {
+				if g.apps[i] == hint {
+					appIndex = i
+				}
+			}
+		}
+		appAddr := basics.AppIndex(app.appID).Address()
+
+		// Fund the app account before it needs to hold boxes or to exist for inner transactions.
+		needsFunds := (actual == appBoxCreate && app.balance < app.minBalance+g.boxMinBalance()) ||
+			(actual == appInner && app.balance < app.minBalance)
+		if needsFunds {
+			actual = appFund
+		}
+
+		switch actual {
+		case appFund:
+			senderIndex = app.creator
+			amount := app.minBalance + appFundBoxes*g.boxMinBalance() - app.balance
+			if g.balances[senderIndex] < amount+g.params.MinTxnFee+g.params.MinBalance {
+				fmt.Printf("\n\nthe creator of app %d does not have enough algos to fund it\n\n", app.appID)
+				os.Exit(1)
+			}
+			txn = g.makePaymentTxn(g.makeTxnHeader(indexToAccount(senderIndex), round, intra), appAddr, amount, basics.Address{})
+			g.balances[senderIndex] -= amount
+			app.balance += amount
+		case appUpdate:
+			senderIndex = app.creator
+			txn = g.makeAppCallTxn(g.makeTxnHeader(indexToAccount(senderIndex), round, intra), app.appID, transactions.UpdateApplicationOC, nil, nil)
+			txn.ApprovalProgram = g.approvalProgram
+			txn.ClearStateProgram = g.clearProgram
+		case appDelete:
+			senderIndex = app.creator
+			txn = g.makeAppCallTxn(g.makeTxnHeader(indexToAccount(senderIndex), round, intra), app.appID, transactions.DeleteApplicationOC, nil, nil)
+
+			// Remove app by moving the last element to the deleted index then trimming the slice.
+			numApps := len(g.apps)
+			g.apps[appIndex] = g.apps[numApps-1]
+			g.apps = g.apps[:numApps-1]
+		case appOptin:
+			// select a random genesis account, which has the funds for the local state, to optin
+
+			// If every genesis account is opted in, call instead of optin
+			if uint64(len(app.optins)) == g.config.NumGenesisAccounts {
+				return g.generateAppTxnInternalHint(appCall, round, intra, app)
+			}
+
+			exists := true
+			for exists {
+				senderIndex = rand.Uint64() % g.config.NumGenesisAccounts
+				exists = app.optins[senderIndex]
+			}
+			txn = g.makeAppCallTxn(g.makeTxnHeader(indexToAccount(senderIndex), round, intra), app.appID, transactions.OptInOC, nil, nil)
+			app.optins[senderIndex] = true
+		case appCall:
+			senderIndex = rand.Uint64() % g.config.NumGenesisAccounts
+			txn = g.makeAppCallTxn(g.makeTxnHeader(indexToAccount(senderIndex), round, intra), app.appID, transactions.NoOpOC, [][]byte{[]byte(appCall)}, nil)
+		case appInner:
+			senderIndex = rand.Uint64() % g.config.NumGenesisAccounts
+			txn = g.makeAppCallTxn(g.makeTxnHeader(indexToAccount(senderIndex), round, intra), app.appID, transactions.NoOpOC, [][]byte{[]byte(appInner)}, nil)
+			// the fee of the inner transactions is pooled
+			txn.Fee.Raw *= 1 + g.config.AppInnerTxns
+			g.innerTxnCounter += g.config.AppInnerTxns
+		case appBoxCreate:
+			senderIndex = rand.Uint64() % g.config.NumGenesisAccounts
+			name := make([]byte, appBoxNameLen)
+			name[0] = 'b'
+			binary.BigEndian.PutUint64(name[1:], app.nextBoxID)
+			app.nextBoxID++
+			txn = g.makeAppCallTxn(g.makeTxnHeader(indexToAccount(senderIndex), round, intra), app.appID, transactions.NoOpOC, [][]byte{[]byte(appBoxCreate), name}, g.boxRefs(name))
+			app.boxes = append(app.boxes, name)
+			app.minBalance += g.boxMinBalance()
+		case appBoxWrite, appBoxDelete:
+			// If the app has no boxes, create one instead
+			if len(app.boxes) == 0 {
+				return g.generateAppTxnInternalHint(appBoxCreate, round, intra, app)
+			}
+
+			senderIndex = rand.Uint64() % g.config.NumGenesisAccounts
+			boxIndex := rand.Intn(len(app.boxes))
+			name := app.boxes[boxIndex]
+			txn = g.makeAppCallTxn(g.makeTxnHeader(indexToAccount(senderIndex), round, intra), app.appID, transactions.NoOpOC, [][]byte{[]byte(actual), name}, g.boxRefs(name))
+			if actual == appBoxDelete {
+				// Remove box by moving the last element to the deleted index then trimming the slice.
+				numBoxes := len(app.boxes)
+				app.boxes[boxIndex] = app.boxes[numBoxes-1]
+				app.boxes = app.boxes[:numBoxes-1]
+				app.minBalance -= g.boxMinBalance()
+			}
+		default:
+		}
+	}
+
+	if indexToAccount(senderIndex) != txn.Sender {
+		fmt.Printf("failed to properly set sender index.")
+		os.Exit(1)
+	}
+
+	if g.balances[senderIndex] < txn.Fee.ToUint64() {
+		fmt.Printf("\n\nthe sender account does not have enough algos for the transfer. idx %d, application transaction type %v, num %d\n\n", senderIndex, actual, g.reportData[actual].GenerationCount)
+		os.Exit(1)
+	}
+	g.balances[senderIndex] -= txn.Fee.ToUint64()
+	return
+}
+
+// evaluateApplyData runs the transactions of a block through an evaluator to compute their ApplyData,
+// including the state changes, logs and inner transactions of application calls.
+func (g *generator) evaluateApplyData(header bookkeeping.BlockHeader, txns []transactions.SignedTxn) ([]transactions.ApplyData, error) {
+	ev, err := eval.StartEvaluator(g.ledger, header, eval.EvaluatorOptions{
+		PaysetHint: len(txns),
+		Generate:   true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start evaluator for round %d: %w", header.Round, err)
+	}
+	for i := range txns {
+		err = ev.Transaction(txns[i], transactions.ApplyData{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate transaction %d of round %d: %w", i, header.Round, err)
+		}
+	}
+	vb, err := ev.GenerateBlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate round %d: %w", header.Round, err)
+	}
+
+	block := vb.Block()
+	if block.TxnCounter != header.TxnCounter {
+		return nil, fmt.Errorf("transaction counter of round %d is %d, expected %d", header.Round, block.TxnCounter, header.TxnCounter)
+	}
+	ads := make([]transactions.ApplyData, len(block.Payset))
+	for i := range block.Payset {
+		_, ads[i], err = block.DecodeSignedTxn(block.Payset[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ads, nil
+}
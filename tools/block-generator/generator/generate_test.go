@@ -362,3 +362,230 @@ func TestHandlers(t *testing.T) {
 		})
 	}
 }
+
+func makeAppGenerator(t *testing.T, config GenerationConfig) *generator {
+	config.NumGenesisAccounts = 10
+	config.GenesisAccountInitialBalance = 1000000000000
+	config.TxnPerBlock = 20
+	// payment and asset ratios are always validated
+	if config.PaymentTransactionFraction == 0 {
+		config.PaymentNewAccountFraction = 1.0
+	}
+	config.AssetCreateFraction = 1.0
+	publicGenerator, err := MakeGenerator(0, bookkeeping.Genesis{Network: "generator-app-test"}, config)
+	require.NoError(t, err)
+	return publicGenerator.(*generator)
+}
+
+func makeDefaultAppGenerator(t *testing.T) *generator {
+	return makeAppGenerator(t, GenerationConfig{
+		AppTransactionFraction: 1.0,
+		AppCallFraction:        1.0,
+		AppGlobalKeys:          2,
+		AppLocalKeys:           2,
+		AppValueSize:           8,
+		AppBoxSize:             100,
+		AppInnerTxns:           2,
+	})
+}
+
+func TestAppCallNoAppsOverride(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	g := makeDefaultAppGenerator(t)
+	defer g.ledger.Close()
+
+	// First app transaction must create.
+	appID := g.nextCreatableID(0)
+	actual, txn := g.generateAppTxnInternal(appCall, 1, 0)
+	require.Equal(t, appCreate, actual)
+	require.Equal(t, protocol.ApplicationCallTx, txn.Type)
+	require.Equal(t, basics.AppIndex(0), txn.ApplicationID)
+	require.Equal(t, g.approvalProgram, txn.ApprovalProgram)
+	require.Equal(t, uint64(2), txn.GlobalStateSchema.NumByteSlice)
+	require.Len(t, g.apps, 0)
+	require.Len(t, g.pendingApps, 1)
+
+	g.finishRound(1)
+	require.Len(t, g.apps, 1)
+	require.Len(t, g.pendingApps, 0)
+	require.Equal(t, appID, g.apps[0].appID)
+}
+
+func TestAppOptinEveryAccountOverride(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	g := makeDefaultAppGenerator(t)
+	defer g.ledger.Close()
+	g.generateAppTxnInternal(appCreate, 1, 0)
+	g.finishRound(1)
+
+	for i := uint64(0); i < g.config.NumGenesisAccounts; i++ {
+		actual, txn := g.generateAppTxnInternal(appOptin, 2, i)
+		require.Equal(t, appOptin, actual)
+		require.Equal(t, transactions.OptInOC, txn.OnCompletion)
+	}
+	require.Len(t, g.apps[0].optins, int(g.config.NumGenesisAccounts))
+
+	// All accounts are opted in, optin becomes a call.
+	actual, txn := g.generateAppTxnInternal(appOptin, 2, g.config.NumGenesisAccounts)
+	require.Equal(t, appCall, actual)
+	require.Equal(t, transactions.NoOpOC, txn.OnCompletion)
+}
+
+func TestAppBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	g := makeDefaultAppGenerator(t)
+	defer g.ledger.Close()
+	g.generateAppTxnInternal(appCreate, 1, 0)
+	g.finishRound(1)
+	app := g.apps[0]
+
+	// Without boxes, a write creates one, which first requires funding the app account.
+	actual, txn := g.generateAppTxnInternal(appBoxWrite, 2, 0)
+	require.Equal(t, appFund, actual)
+	require.Equal(t, protocol.PaymentTx, txn.Type)
+	require.Equal(t, basics.AppIndex(app.appID).Address(), txn.Receiver)
+	require.Equal(t, g.params.MinBalance+appFundBoxes*g.boxMinBalance(), txn.Amount.Raw)
+	require.Len(t, app.boxes, 0)
+
+	actual, txn = g.generateAppTxnInternal(appBoxWrite, 2, 1)
+	require.Equal(t, appBoxCreate, actual)
+	require.Len(t, app.boxes, 1)
+	require.Equal(t, [][]byte{[]byte(appBoxCreate), app.boxes[0]}, txn.ApplicationArgs)
+	require.Equal(t, app.boxes[0], txn.Boxes[0].Name)
+	require.Len(t, txn.Boxes, int(g.boxReferences()))
+
+	actual, txn = g.generateAppTxnInternal(appBoxWrite, 2, 2)
+	require.Equal(t, appBoxWrite, actual)
+	require.Equal(t, app.boxes[0], txn.ApplicationArgs[1])
+
+	actual, _ = g.generateAppTxnInternal(appBoxDelete, 2, 3)
+	require.Equal(t, appBoxDelete, actual)
+	require.Len(t, app.boxes, 0)
+	require.Equal(t, g.params.MinBalance, app.minBalance)
+}
+
+func TestAppInnerTxnCounter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	g := makeDefaultAppGenerator(t)
+	defer g.ledger.Close()
+	g.generateAppTxnInternal(appCreate, 1, 0)
+	g.finishRound(1)
+	txnCounter := g.txnCounter
+
+	// The app account must exist before it can issue inner transactions.
+	actual, _ := g.generateAppTxnInternal(appInner, 2, 0)
+	require.Equal(t, appFund, actual)
+
+	actual, txn := g.generateAppTxnInternal(appInner, 2, 1)
+	require.Equal(t, appInner, actual)
+	require.Equal(t, 3*g.params.MinTxnFee, txn.Fee.Raw)
+	require.Equal(t, uint64(2), g.innerTxnCounter)
+
+	// Inner transactions take creatable IDs.
+	require.Equal(t, g.txnCounter+2+2+1, g.nextCreatableID(2))
+	g.finishRound(2)
+	require.Equal(t, txnCounter+2+2, g.txnCounter)
+	require.Equal(t, uint64(0), g.innerTxnCounter)
+}
+
+func TestAppConfigValidation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	var testcases = []struct {
+		name   string
+		config GenerationConfig
+		err    string
+	}{
+		{
+			name:   "too many global keys",
+			config: GenerationConfig{AppGlobalKeys: 100},
+			err:    "app_global_keys 100 is larger than 64",
+		},
+		{
+			name:   "too many inner transactions",
+			config: GenerationConfig{AppInnerTxns: 1000},
+			err:    "app_inner_txns 1000 is larger than 16",
+		},
+		{
+			name:   "boxes without a size",
+			config: GenerationConfig{AppBoxCreateFraction: 0.5, AppCallFraction: 0.5},
+			err:    "app_box_size must be set to create boxes",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			config := tc.config
+			config.NumGenesisAccounts = 10
+			config.GenesisAccountInitialBalance = 1000000000000
+			config.AppTransactionFraction = 1.0
+			config.PaymentNewAccountFraction = 1.0
+			config.AssetCreateFraction = 1.0
+			if config.AppBoxCreateFraction == 0 {
+				config.AppCallFraction = 1.0
+			}
+			_, err := MakeGenerator(0, bookkeeping.Genesis{Network: "generator-app-test"}, config)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestWriteRoundApps(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	g := makeAppGenerator(t, GenerationConfig{
+		PaymentTransactionFraction: 0.2,
+		PaymentNewAccountFraction:  0.5,
+		PaymentFraction:            0.5,
+		AppTransactionFraction:     0.8,
+		AppCreateFraction:          0.05,
+		AppUpdateFraction:          0.05,
+		AppDeleteFraction:          0.02,
+		AppOptinFraction:           0.13,
+		AppCallFraction:            0.25,
+		AppInnerFraction:           0.1,
+		AppBoxCreateFraction:       0.15,
+		AppBoxWriteFraction:        0.15,
+		AppBoxDeleteFraction:       0.1,
+		AppGlobalKeys:              4,
+		AppLocalKeys:               2,
+		AppValueSize:               16,
+		AppBoxSize:                 2000,
+		AppInnerTxns:               3,
+	})
+	defer g.ledger.Close()
+
+	var creates, globalDeltas, inners int
+	for round := uint64(0); round <= 20; round++ {
+		var data bytes.Buffer
+		require.NoError(t, g.WriteBlock(&data, round))
+		var block rpcs.EncodedBlockCert
+		require.NoError(t, protocol.Decode(data.Bytes(), &block))
+		require.Equal(t, basics.Round(round), g.ledger.Latest())
+		if round == 0 {
+			continue
+		}
+		require.Len(t, block.Block.Payset, int(g.config.TxnPerBlock))
+		require.Equal(t, g.txnCounter, block.Block.TxnCounter)
+		for _, stib := range block.Block.Payset {
+			stxn, ad, err := block.Block.DecodeSignedTxn(stib)
+			require.NoError(t, err)
+			if stxn.Txn.Type != protocol.ApplicationCallTx {
+				continue
+			}
+			if stxn.Txn.ApplicationID == 0 {
+				require.NotZero(t, ad.ApplicationID)
+				creates++
+			}
+			if len(ad.EvalDelta.GlobalDelta) > 0 {
+				globalDeltas++
+			}
+			if len(ad.EvalDelta.InnerTxns) > 0 {
+				require.Len(t, ad.EvalDelta.InnerTxns, int(g.config.AppInnerTxns))
+				inners++
+			}
+		}
+	}
+	require.NotZero(t, creates)
+	require.NotZero(t, globalDeltas)
+	require.NotZero(t, inners)
+}
@@ -95,3 +95,30 @@ func (g *generator) makeAssetTransferTxn(header transactions.Header, receiver ba
 func (g *generator) makeAssetAcceptanceTxn(header transactions.Header, index uint64) transactions.Transaction {
 	return g.makeAssetTransferTxn(header, header.Sender, 0, basics.Address{}, index)
 }
+
+func (g *generator) makeAppCreateTxn(header transactions.Header, approval, clear []byte, globalSchema, localSchema basics.StateSchema, extraPages uint32) transactions.Transaction {
+	return transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: header,
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApprovalProgram:   approval,
+			ClearStateProgram: clear,
+			GlobalStateSchema: globalSchema,
+			LocalStateSchema:  localSchema,
+			ExtraProgramPages: extraPages,
+		},
+	}
+}
+
+func (g *generator) makeAppCallTxn(header transactions.Header, index uint64, onCompletion transactions.OnCompletion, args [][]byte, boxes []transactions.BoxRef) transactions.Transaction {
+	return transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: header,
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID:   basics.AppIndex(index),
+			OnCompletion:    onCompletion,
+			ApplicationArgs: args,
+			Boxes:           boxes,
+		},
+	}
+}
@@ -25,6 +25,8 @@ import (
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
 )
 
 var blockDBfile = flag.String("blockdb", "", "Block DB filename")
@@ -34,9 +36,30 @@ var endRound = flag.Int("end", 0, "Sample blocks ending at this round (inclusive
 var outDir = flag.String("outdir", ".", "Write blocks to this directory")
 var randSeed = flag.Int("seed", 0, "Random seed, otherwise will use time")
 
+// blockQuery reads a block along with the format it is stored in. Block databases written before
+// the format column was added only hold raw blocks, see setBlockQuery.
+var blockQuery = "SELECT blkdata, format FROM blocks WHERE rnd=?"
+
+func setBlockQuery(db *sql.DB) error {
+	var count int
+	err := db.QueryRow("SELECT COUNT(*) FROM pragma_table_info('blocks') WHERE name='format'").Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		blockQuery = fmt.Sprintf("SELECT blkdata, %d FROM blocks WHERE rnd=?", blockdb.BlockFormatRaw)
+	}
+	return nil
+}
+
 func getBlockToFile(db *sql.DB, rnd int64) error {
 	var buf []byte
-	err := db.QueryRow("SELECT blkdata FROM blocks WHERE rnd=?", rnd).Scan(&buf)
+	var format blockdb.BlockFormat
+	err := db.QueryRow(blockQuery, rnd).Scan(&buf, &format)
+	if err != nil {
+		return err
+	}
+	buf, err = blockdb.DecompressBlockData(format, buf)
 	if err != nil {
 		return err
 	}
@@ -66,6 +89,10 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	err = setBlockQuery(db)
+	if err != nil {
+		panic(err)
+	}
 
 	seed := int64(*randSeed)
 	if seed == 0 {
@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// backupSchema is the name under which the backup file is attached to the database connection.
+const backupSchema = "backup"
+
+// Backup copies the content of every table in the database into a new database file.
+// The copy is taken within a single transaction, so it reflects a consistent view of the
+// database. Only the table content is copied; the backup file is meant to be read back
+// with Restore, and does not retain indexes or constraints.
+func (db *Accessor) Backup(ctx context.Context, filename string) error {
+	if db.readOnly {
+		return fmt.Errorf("read-only database was used to attempt and perform a backup")
+	}
+	if _, err := os.Stat(filename); err == nil {
+		return fmt.Errorf("backup file %s already exists", filename)
+	}
+
+	return db.withAttachedBackup(ctx, filename, func(tx *sql.Tx) error {
+		tables, err := listTables(ctx, tx, "main")
+		if err != nil {
+			return err
+		}
+		for _, table := range tables {
+			_, err = tx.ExecContext(ctx, fmt.Sprintf("CREATE TABLE %s.%s AS SELECT * FROM main.%s", backupSchema, quoteIdentifier(table), quoteIdentifier(table)))
+			if err != nil {
+				return fmt.Errorf("unable to back up table %s: %w", table, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Restore replaces the content of the database tables with the content stored in a backup
+// file created by Backup. Tables that did not exist when the backup was taken are emptied.
+// The restore is performed within a single transaction, so readers observe either the
+// original content or the restored one.
+func (db *Accessor) Restore(ctx context.Context, filename string) error {
+	if db.readOnly {
+		return fmt.Errorf("read-only database was used to attempt and perform a restore")
+	}
+	if _, err := os.Stat(filename); err != nil {
+		return err
+	}
+
+	return db.withAttachedBackup(ctx, filename, func(tx *sql.Tx) error {
+		tables, err := listTables(ctx, tx, "main")
+		if err != nil {
+			return err
+		}
+		backupTables, err := listTables(ctx, tx, backupSchema)
+		if err != nil {
+			return err
+		}
+		existing := make(map[string]bool, len(tables))
+		for _, table := range tables {
+			existing[table] = true
+		}
+		for _, table := range backupTables {
+			if !existing[table] {
+				return fmt.Errorf("backup table %s does not exist in the database", table)
+			}
+		}
+
+		inBackup := make(map[string]bool, len(backupTables))
+		for _, table := range backupTables {
+			inBackup[table] = true
+		}
+		for _, table := range tables {
+			_, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM main.%s", quoteIdentifier(table)))
+			if err != nil {
+				return fmt.Errorf("unable to clear table %s: %w", table, err)
+			}
+			if !inBackup[table] {
+				continue
+			}
+			_, err = tx.ExecContext(ctx, fmt.Sprintf("INSERT INTO main.%s SELECT * FROM %s.%s", quoteIdentifier(table), backupSchema, quoteIdentifier(table)))
+			if err != nil {
+				return fmt.Errorf("unable to restore table %s: %w", table, err)
+			}
+		}
+		return nil
+	})
+}
+
+// withAttachedBackup attaches the given file to a dedicated connection, and runs fn within a
+// transaction on that connection. Attaching has to happen outside of a transaction, which is
+// why the regular Atomic helpers cannot be used here.
+func (db *Accessor) withAttachedBackup(ctx context.Context, filename string, fn func(tx *sql.Tx) error) (err error) {
+	conn, err := db.Handle.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, fmt.Sprintf("ATTACH DATABASE ? AS %s", backupSchema), filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, detachErr := conn.ExecContext(context.Background(), fmt.Sprintf("DETACH DATABASE %s", backupSchema))
+		if err == nil {
+			err = detachErr
+		}
+	}()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = fn(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// listTables returns the names of the user tables in the given schema, in a deterministic order.
+func listTables(ctx context.Context, tx *sql.Tx, schema string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT name FROM %s.sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%%' ORDER BY name", schema))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, name)
+	}
+	return tables, rows.Err()
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
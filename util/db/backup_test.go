@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBackupRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	acc, err := MakeAccessor(filepath.Join(dir, "main.sqlite"), false, false)
+	require.NoError(t, err)
+	defer acc.Close()
+
+	countRows := func(table string) (count int) {
+		err := acc.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			return tx.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count)
+		})
+		require.NoError(t, err)
+		return
+	}
+
+	err = acc.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("CREATE TABLE items (id INTEGER PRIMARY KEY, data BLOB)")
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO items (id, data) VALUES (1, ?), (2, ?)", []byte{1}, []byte{2})
+		return err
+	})
+	require.NoError(t, err)
+
+	backupFile := filepath.Join(dir, "backup.sqlite")
+	require.NoError(t, acc.Backup(context.Background(), backupFile))
+	require.Error(t, acc.Backup(context.Background(), backupFile))
+
+	err = acc.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec("INSERT INTO items (id, data) VALUES (3, ?)", []byte{3})
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("UPDATE items SET data = ? WHERE id = 1", []byte{9})
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("CREATE TABLE extra (id INTEGER)")
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO extra (id) VALUES (1)")
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, countRows("items"))
+
+	require.NoError(t, acc.Restore(context.Background(), backupFile))
+	require.Equal(t, 2, countRows("items"))
+	require.Equal(t, 0, countRows("extra"))
+
+	var data []byte
+	err = acc.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRow("SELECT data FROM items WHERE id = 1").Scan(&data)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, data)
+
+	// the connection used for the restore must not keep the backup attached
+	require.NoError(t, acc.Restore(context.Background(), backupFile))
+	require.Error(t, acc.Restore(context.Background(), filepath.Join(dir, "missing.sqlite")))
+}
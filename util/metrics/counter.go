@@ -217,3 +217,22 @@ func (counter *Counter) AddMetric(values map[string]float64) {
 		values[sanitizeTelemetryName(counter.name+suffix)] = float64(sum)
 	}
 }
+
+// Snapshot returns the values of the counter, one point per set of labels
+func (counter *Counter) Snapshot() []MetricSnapshot {
+	counter.Lock()
+	defer counter.Unlock()
+
+	snapshot := MetricSnapshot{Name: counter.name, Description: counter.description, Type: CounterType}
+	if len(counter.values) == 0 {
+		snapshot.Points = []MetricPoint{{}}
+	}
+	for _, l := range counter.values {
+		value := l.counter
+		if len(l.labels) == 0 {
+			value += atomic.LoadUint64(&counter.intValue)
+		}
+		snapshot.Points = append(snapshot.Points, MetricPoint{Labels: copyLabels(l.labels), Value: float64(value)})
+	}
+	return []MetricSnapshot{snapshot}
+}
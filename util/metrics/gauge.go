@@ -93,3 +93,13 @@ func (gauge *Gauge) AddMetric(values map[string]float64) {
 
 	values[sanitizeTelemetryName(gauge.name)] = float64(value)
 }
+
+// Snapshot returns the value of the gauge
+func (gauge *Gauge) Snapshot() []MetricSnapshot {
+	return []MetricSnapshot{{
+		Name:        gauge.name,
+		Description: gauge.description,
+		Type:        GaugeType,
+		Points:      []MetricPoint{{Value: float64(atomic.LoadUint64(&gauge.value))}},
+	}}
+}
@@ -0,0 +1,249 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// DefaultLatencyBuckets are histogram buckets, in seconds, suited to latencies
+// between a millisecond and a minute
+var DefaultLatencyBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Histogram counts observations, such as latencies, in configurable buckets
+type Histogram struct {
+	deadlock.Mutex
+	name        string
+	description string
+	// buckets are the sorted upper bounds of the buckets, not including +Inf
+	buckets       []float64
+	values        []*histogramValues
+	valuesIndices map[string]int // map each set of formatted labels to its values
+}
+
+type histogramValues struct {
+	// counts holds the number of observations in each bucket, the last
+	// one being +Inf. They are accumulated when written.
+	counts          []uint64
+	count           uint64
+	sum             float64
+	labels          map[string]string
+	formattedLabels string
+}
+
+// MakeHistogram creates a new histogram with the provided name, description
+// and bucket upper bounds. If buckets is empty, DefaultLatencyBuckets is used.
+func MakeHistogram(metric MetricName, buckets []float64) *Histogram {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	h := &Histogram{
+		name:          metric.Name,
+		description:   metric.Description,
+		buckets:       append([]float64(nil), buckets...),
+		valuesIndices: make(map[string]int),
+	}
+	sort.Float64s(h.buckets)
+	h.Register(nil)
+	return h
+}
+
+// Register registers the histogram with the default/specific registry
+func (h *Histogram) Register(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Register(h)
+	} else {
+		reg.Register(h)
+	}
+}
+
+// Deregister deregisters the histogram with the default/specific registry
+func (h *Histogram) Deregister(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Deregister(h)
+	} else {
+		reg.Deregister(h)
+	}
+}
+
+// Observe adds x to the histogram
+func (h *Histogram) Observe(x float64, labels map[string]string) {
+	h.Lock()
+	defer h.Unlock()
+
+	formattedLabels := formatLabels(labels)
+	idx, has := h.valuesIndices[formattedLabels]
+	if !has {
+		h.values = append(h.values, &histogramValues{
+			counts:          make([]uint64, len(h.buckets)+1),
+			labels:          copyLabels(labels),
+			formattedLabels: formattedLabels,
+		})
+		idx = len(h.values) - 1
+		h.valuesIndices[formattedLabels] = idx
+	}
+
+	val := h.values[idx]
+	val.counts[sort.SearchFloat64s(h.buckets, x)]++
+	val.count++
+	val.sum += x
+}
+
+// ObserveSince adds the number of seconds between Time t and now to the histogram
+func (h *Histogram) ObserveSince(t time.Time, labels map[string]string) {
+	h.Observe(time.Since(t).Seconds(), labels)
+}
+
+// WriteMetric writes the metric into the output stream
+func (h *Histogram) WriteMetric(buf *strings.Builder, parentLabels string) {
+	h.Lock()
+	defer h.Unlock()
+
+	writeHelpAndType(buf, h.name, h.description, "histogram")
+	values := h.values
+	if len(values) == 0 {
+		// report an empty histogram using parentLabels and no tags
+		values = []*histogramValues{{counts: make([]uint64, len(h.buckets)+1)}}
+	}
+	for _, val := range values {
+		labels := joinLabels(parentLabels, val.formattedLabels)
+		var cumulative uint64
+		for i, count := range val.counts {
+			cumulative += count
+			le := "+Inf"
+			if i < len(h.buckets) {
+				le = formatFloat(h.buckets[i])
+			}
+			writeSample(buf, h.name+"_bucket", joinLabels(labels, `le="`+le+`"`), strconv.FormatUint(cumulative, 10))
+		}
+		writeSample(buf, h.name+"_sum", labels, formatFloat(val.sum))
+		writeSample(buf, h.name+"_count", labels, strconv.FormatUint(val.count, 10))
+	}
+}
+
+// AddMetric adds the number and sum of observations into the map
+func (h *Histogram) AddMetric(values map[string]float64) {
+	h.Lock()
+	defer h.Unlock()
+
+	for _, val := range h.values {
+		var suffix string
+		if len(val.formattedLabels) > 0 {
+			suffix = ":" + val.formattedLabels
+		}
+		values[sanitizeTelemetryName(h.name+"_count"+suffix)] = float64(val.count)
+		values[sanitizeTelemetryName(h.name+"_sum"+suffix)] = val.sum
+	}
+}
+
+// Snapshot returns the buckets of the histogram, one point per set of labels
+func (h *Histogram) Snapshot() []MetricSnapshot {
+	h.Lock()
+	defer h.Unlock()
+
+	snapshot := MetricSnapshot{Name: h.name, Description: h.description, Type: HistogramType}
+	values := h.values
+	if len(values) == 0 {
+		values = []*histogramValues{{counts: make([]uint64, len(h.buckets)+1)}}
+	}
+	for _, val := range values {
+		snapshot.Points = append(snapshot.Points, MetricPoint{
+			Labels:       copyLabels(val.labels),
+			Count:        val.count,
+			Sum:          val.sum,
+			Bounds:       append([]float64(nil), h.buckets...),
+			BucketCounts: append([]uint64(nil), val.counts...),
+		})
+	}
+	return []MetricSnapshot{snapshot}
+}
+
+// formatLabels formats labels in the Prometheus exposition format, sorted by
+// name so that the same labels always format the same way
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf strings.Builder
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(name + `="` + labelValueEscaper.Replace(labels[name]) + `"`)
+	}
+	return buf.String()
+}
+
+// labelValueEscaper escapes a label value as the Prometheus exposition format
+// requires; unlike Go quoting, every other character is written as is
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func joinLabels(a, b string) string {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+	return a + "," + b
+}
+
+func formatFloat(x float64) string {
+	switch {
+	case math.IsInf(x, 1):
+		return "+Inf"
+	case math.IsInf(x, -1):
+		return "-Inf"
+	case math.IsNaN(x):
+		return "NaN"
+	}
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+func writeHelpAndType(buf *strings.Builder, name, description, metricType string) {
+	buf.WriteString("# HELP ")
+	buf.WriteString(name)
+	buf.WriteString(" ")
+	buf.WriteString(description)
+	buf.WriteString("\n# TYPE ")
+	buf.WriteString(name)
+	buf.WriteString(" ")
+	buf.WriteString(metricType)
+	buf.WriteString("\n")
+}
+
+func writeSample(buf *strings.Builder, name, labels, value string) {
+	buf.WriteString(name)
+	if len(labels) > 0 {
+		buf.WriteString("{" + labels + "}")
+	}
+	buf.WriteString(" ")
+	buf.WriteString(value)
+	buf.WriteString("\n")
+}
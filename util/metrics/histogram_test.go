@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestHistogram(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	reg := MakeRegistry()
+	h := MakeHistogram(MetricName{Name: "algod_test_seconds", Description: "test histogram"}, []float64{1, 0.1, 10})
+	h.Deregister(nil)
+	h.Register(reg)
+
+	var buf strings.Builder
+	reg.WriteMetrics(&buf, `host="a"`)
+	require.Equal(t, `# HELP algod_test_seconds test histogram
+# TYPE algod_test_seconds histogram
+algod_test_seconds_bucket{host="a",le="0.1"} 0
+algod_test_seconds_bucket{host="a",le="1"} 0
+algod_test_seconds_bucket{host="a",le="10"} 0
+algod_test_seconds_bucket{host="a",le="+Inf"} 0
+algod_test_seconds_sum{host="a"} 0
+algod_test_seconds_count{host="a"} 0
+`, buf.String())
+
+	for _, x := range []float64{0.05, 0.1, 0.5, 2, 20} {
+		h.Observe(x, nil)
+	}
+	h.Observe(3, map[string]string{"step": "soft", "kind": "a"})
+
+	buf.Reset()
+	reg.WriteMetrics(&buf, "")
+	require.Equal(t, `# HELP algod_test_seconds test histogram
+# TYPE algod_test_seconds histogram
+algod_test_seconds_bucket{le="0.1"} 2
+algod_test_seconds_bucket{le="1"} 3
+algod_test_seconds_bucket{le="10"} 4
+algod_test_seconds_bucket{le="+Inf"} 5
+algod_test_seconds_sum 22.65
+algod_test_seconds_count 5
+algod_test_seconds_bucket{kind="a",step="soft",le="0.1"} 0
+algod_test_seconds_bucket{kind="a",step="soft",le="1"} 0
+algod_test_seconds_bucket{kind="a",step="soft",le="10"} 1
+algod_test_seconds_bucket{kind="a",step="soft",le="+Inf"} 1
+algod_test_seconds_sum{kind="a",step="soft"} 3
+algod_test_seconds_count{kind="a",step="soft"} 1
+`, buf.String())
+
+	values := make(map[string]float64)
+	reg.AddMetrics(values)
+	require.Equal(t, map[string]float64{
+		"algod_test_seconds_count":                      5,
+		"algod_test_seconds_sum":                        22.65,
+		"algod_test_seconds_count_kind__a__step__soft_": 1,
+		"algod_test_seconds_sum_kind__a__step__soft_":   3,
+	}, values)
+}
+
+func TestHistogramDefaultBuckets(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h := MakeHistogram(MetricName{Name: "algod_test_default_seconds", Description: "test histogram"}, nil)
+	defer h.Deregister(nil)
+	require.Equal(t, DefaultLatencyBuckets, h.buckets)
+
+	var buf strings.Builder
+	h.WriteMetric(&buf, "")
+	require.Equal(t, len(DefaultLatencyBuckets)+1, strings.Count(buf.String(), "algod_test_default_seconds_bucket"))
+}
+
+func TestFormatLabels(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, "", formatLabels(nil))
+	// only backslash, double quote and newline are escaped
+	labels := map[string]string{"b": "tab\there é", "a": "C:\\dir \"x\"\nend"}
+	require.Equal(t, `a="C:\\dir \"x\"\nend",b="tab`+"\t"+`here é"`, formatLabels(labels))
+}
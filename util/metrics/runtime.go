@@ -135,3 +135,34 @@ func (rm *RuntimeMetrics) AddMetric(m map[string]float64) {
 		}
 	}
 }
+
+// Snapshot returns the runtime metrics, cumulative ones as counters
+func (rm *RuntimeMetrics) Snapshot() []MetricSnapshot {
+	rm.Lock()
+	defer rm.Unlock()
+
+	metrics.Read(rm.samples)
+	snapshots := make([]MetricSnapshot, 0, len(rm.samples))
+	for i, s := range rm.samples {
+		var value float64
+		switch s.Value.Kind() {
+		case metrics.KindUint64:
+			value = float64(s.Value.Uint64())
+		case metrics.KindFloat64:
+			value = s.Value.Float64()
+		default:
+			continue
+		}
+		metricType := GaugeType
+		if rm.descriptions[i].Cumulative {
+			metricType = CounterType
+		}
+		snapshots = append(snapshots, MetricSnapshot{
+			Name:        "algod_go" + sanitizePrometheusName(s.Name),
+			Description: rm.descriptions[i].Description,
+			Type:        metricType,
+			Points:      []MetricPoint{{Value: value}},
+		})
+	}
+	return snapshots
+}
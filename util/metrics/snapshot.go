@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+// MetricType tells how the values of a metric are to be interpreted
+type MetricType int
+
+const (
+	// GaugeType is a value that can go up and down
+	GaugeType MetricType = iota
+	// CounterType is a monotonically increasing total
+	CounterType
+	// HistogramType counts observations in buckets
+	HistogramType
+	// SummaryType reports quantiles of recent observations
+	SummaryType
+)
+
+// MetricSnapshot holds the current values of a metric, for exporters that
+// need the metric types and labels which the AddMetric map flattens away.
+type MetricSnapshot struct {
+	Name        string
+	Description string
+	Type        MetricType
+	Points      []MetricPoint
+}
+
+// MetricPoint is the value of a metric for one set of labels
+type MetricPoint struct {
+	Labels map[string]string
+
+	// Value is the value of a gauge or counter
+	Value float64
+
+	// Count and Sum are the number and sum of observations of a histogram or summary
+	Count uint64
+	Sum   float64
+
+	// Bounds are the upper bounds of a histogram's buckets and BucketCounts
+	// the number of observations in each bucket, not accumulated. The last
+	// bucket count is for observations above all bounds.
+	Bounds       []float64
+	BucketCounts []uint64
+
+	// Quantiles are the quantiles a summary reports and QuantileValues their
+	// values over the recent observations, NaN if there were none
+	Quantiles      []float64
+	QuantileValues []float64
+}
+
+// SnapshotMetric is implemented by metrics which can report their values
+// along with their type and labels
+type SnapshotMetric interface {
+	Snapshot() []MetricSnapshot
+}
+
+// Snapshot returns the values of all the metrics registered to this registry.
+// Metrics which do not implement SnapshotMetric are reported as unlabeled
+// gauges, from their AddMetric values.
+func (r *Registry) Snapshot() []MetricSnapshot {
+	r.metricsMu.Lock()
+	defer r.metricsMu.Unlock()
+
+	var snapshots []MetricSnapshot
+	for _, m := range r.metrics {
+		if sm, ok := m.(SnapshotMetric); ok {
+			snapshots = append(snapshots, sm.Snapshot()...)
+			continue
+		}
+		values := make(map[string]float64)
+		m.AddMetric(values)
+		for name, value := range values {
+			snapshots = append(snapshots, MetricSnapshot{
+				Name:   name,
+				Type:   GaugeType,
+				Points: []MetricPoint{{Value: value}},
+			})
+		}
+	}
+	return snapshots
+}
+
+func copyLabels(labels map[string]string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+	copied := make(map[string]string, len(labels))
+	for k, v := range labels {
+		copied[k] = v
+	}
+	return copied
+}
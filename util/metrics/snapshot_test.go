@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testAddOnlyMetric only implements the Metric interface
+type testAddOnlyMetric struct{}
+
+func (testAddOnlyMetric) WriteMetric(buf *strings.Builder, parentLabels string) {}
+
+func (testAddOnlyMetric) AddMetric(values map[string]float64) {
+	values["add_only"] = 4
+}
+
+func TestRegistrySnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	reg := MakeRegistry()
+
+	counter := &Counter{name: "requests", description: "requests served", labels: make(map[string]int), valuesIndices: make(map[int]int)}
+	counter.Register(reg)
+	counter.AddUint64(2, nil)
+	counter.AddUint64(3, map[string]string{"code": "404"})
+
+	gauge := &Gauge{name: "peers", description: "connected peers"}
+	gauge.Register(reg)
+	gauge.Set(7)
+
+	histogram := &Histogram{name: "latency", buckets: []float64{1, 2}, valuesIndices: make(map[string]int)}
+	histogram.Register(reg)
+	histogram.Observe(0.5, map[string]string{"op": "read"})
+	histogram.Observe(1.5, map[string]string{"op": "read"})
+	histogram.Observe(5, map[string]string{"op": "read"})
+
+	summary := &Summary{name: "size", quantiles: []float64{0.5}, window: 8, valuesIndices: make(map[string]int)}
+	summary.Register(reg)
+
+	tc := NewTagCounter("msgs_{TAG}", "messages of type {TAG}")
+	tc.Add("TX", 6)
+	reg.Register(tc)
+
+	reg.Register(testAddOnlyMetric{})
+
+	snapshots := make(map[string]MetricSnapshot)
+	for _, s := range reg.Snapshot() {
+		snapshots[s.Name] = s
+	}
+	require.Len(t, snapshots, 6)
+
+	require.Equal(t, CounterType, snapshots["requests"].Type)
+	require.Equal(t, "requests served", snapshots["requests"].Description)
+	require.ElementsMatch(t, []MetricPoint{
+		{Value: 2},
+		{Labels: map[string]string{"code": "404"}, Value: 3},
+	}, snapshots["requests"].Points)
+
+	require.Equal(t, GaugeType, snapshots["peers"].Type)
+	require.Equal(t, []MetricPoint{{Value: 7}}, snapshots["peers"].Points)
+
+	require.Equal(t, HistogramType, snapshots["latency"].Type)
+	require.Equal(t, []MetricPoint{{
+		Labels:       map[string]string{"op": "read"},
+		Count:        3,
+		Sum:          7,
+		Bounds:       []float64{1, 2},
+		BucketCounts: []uint64{1, 1, 1},
+	}}, snapshots["latency"].Points)
+
+	// a summary without observations has NaN quantiles
+	require.Equal(t, SummaryType, snapshots["size"].Type)
+	require.Len(t, snapshots["size"].Points, 1)
+	require.True(t, math.IsNaN(snapshots["size"].Points[0].QuantileValues[0]))
+
+	require.Equal(t, CounterType, snapshots["msgs_TX"].Type)
+	require.Equal(t, "messages of type TX", snapshots["msgs_TX"].Description)
+	require.Equal(t, []MetricPoint{{Value: 6}}, snapshots["msgs_TX"].Points)
+
+	require.Equal(t, GaugeType, snapshots["add_only"].Type)
+	require.Equal(t, []MetricPoint{{Value: 4}}, snapshots["add_only"].Points)
+}
@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// DefaultSummaryQuantiles are the quantiles reported by a summary unless others are given
+var DefaultSummaryQuantiles = []float64{0.5, 0.9, 0.99}
+
+// DefaultSummaryWindow is the number of most recent observations quantiles
+// are computed over unless another window is given
+const DefaultSummaryWindow = 1024
+
+// Summary reports quantiles of the most recent observations, along with the
+// number and sum of all observations
+type Summary struct {
+	deadlock.Mutex
+	name          string
+	description   string
+	quantiles     []float64
+	window        int
+	values        []*summaryValues
+	valuesIndices map[string]int // map each set of formatted labels to its values
+}
+
+type summaryValues struct {
+	// recent is a ring buffer of the last window observations
+	recent          []float64
+	next            int
+	count           uint64
+	sum             float64
+	labels          map[string]string
+	formattedLabels string
+}
+
+// MakeSummary creates a new summary with the provided name and description,
+// reporting quantiles over the last window observations. If quantiles is
+// empty DefaultSummaryQuantiles is used, and if window is zero DefaultSummaryWindow.
+func MakeSummary(metric MetricName, quantiles []float64, window int) *Summary {
+	if len(quantiles) == 0 {
+		quantiles = DefaultSummaryQuantiles
+	}
+	if window <= 0 {
+		window = DefaultSummaryWindow
+	}
+	s := &Summary{
+		name:          metric.Name,
+		description:   metric.Description,
+		quantiles:     append([]float64(nil), quantiles...),
+		window:        window,
+		valuesIndices: make(map[string]int),
+	}
+	sort.Float64s(s.quantiles)
+	s.Register(nil)
+	return s
+}
+
+// Register registers the summary with the default/specific registry
+func (s *Summary) Register(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Register(s)
+	} else {
+		reg.Register(s)
+	}
+}
+
+// Deregister deregisters the summary with the default/specific registry
+func (s *Summary) Deregister(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Deregister(s)
+	} else {
+		reg.Deregister(s)
+	}
+}
+
+// Observe adds x to the summary
+func (s *Summary) Observe(x float64, labels map[string]string) {
+	s.Lock()
+	defer s.Unlock()
+
+	formattedLabels := formatLabels(labels)
+	idx, has := s.valuesIndices[formattedLabels]
+	if !has {
+		s.values = append(s.values, &summaryValues{labels: copyLabels(labels), formattedLabels: formattedLabels})
+		idx = len(s.values) - 1
+		s.valuesIndices[formattedLabels] = idx
+	}
+
+	val := s.values[idx]
+	if len(val.recent) < s.window {
+		val.recent = append(val.recent, x)
+	} else {
+		val.recent[val.next] = x
+		val.next = (val.next + 1) % s.window
+	}
+	val.count++
+	val.sum += x
+}
+
+// ObserveSince adds the number of seconds between Time t and now to the summary
+func (s *Summary) ObserveSince(t time.Time, labels map[string]string) {
+	s.Observe(time.Since(t).Seconds(), labels)
+}
+
+// quantiles returns the quantiles of the recent observations, using the
+// nearest rank method. They are NaN if there were no observations.
+func (val *summaryValues) quantiles(qs []float64) []float64 {
+	res := make([]float64, len(qs))
+	if len(val.recent) == 0 {
+		for i := range res {
+			res[i] = math.NaN()
+		}
+		return res
+	}
+
+	sorted := append([]float64(nil), val.recent...)
+	sort.Float64s(sorted)
+	for i, q := range qs {
+		rank := int(math.Ceil(q*float64(len(sorted)))) - 1
+		if rank < 0 {
+			rank = 0
+		}
+		if rank >= len(sorted) {
+			rank = len(sorted) - 1
+		}
+		res[i] = sorted[rank]
+	}
+	return res
+}
+
+// WriteMetric writes the metric into the output stream
+func (s *Summary) WriteMetric(buf *strings.Builder, parentLabels string) {
+	s.Lock()
+	defer s.Unlock()
+
+	writeHelpAndType(buf, s.name, s.description, "summary")
+	values := s.values
+	if len(values) == 0 {
+		// report an empty summary using parentLabels and no tags
+		values = []*summaryValues{{}}
+	}
+	for _, val := range values {
+		labels := joinLabels(parentLabels, val.formattedLabels)
+		for i, q := range val.quantiles(s.quantiles) {
+			writeSample(buf, s.name, joinLabels(labels, `quantile="`+formatFloat(s.quantiles[i])+`"`), formatFloat(q))
+		}
+		writeSample(buf, s.name+"_sum", labels, formatFloat(val.sum))
+		writeSample(buf, s.name+"_count", labels, strconv.FormatUint(val.count, 10))
+	}
+}
+
+// AddMetric adds the number and sum of observations, and the quantiles of the
+// recent ones, into the map
+func (s *Summary) AddMetric(values map[string]float64) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, val := range s.values {
+		var suffix string
+		if len(val.formattedLabels) > 0 {
+			suffix = ":" + val.formattedLabels
+		}
+		values[sanitizeTelemetryName(s.name+"_count"+suffix)] = float64(val.count)
+		values[sanitizeTelemetryName(s.name+"_sum"+suffix)] = val.sum
+		for i, q := range val.quantiles(s.quantiles) {
+			values[sanitizeTelemetryName(s.name+"_"+formatFloat(s.quantiles[i])+suffix)] = q
+		}
+	}
+}
+
+// Snapshot returns the quantiles of the summary, one point per set of labels
+func (s *Summary) Snapshot() []MetricSnapshot {
+	s.Lock()
+	defer s.Unlock()
+
+	snapshot := MetricSnapshot{Name: s.name, Description: s.description, Type: SummaryType}
+	values := s.values
+	if len(values) == 0 {
+		values = []*summaryValues{{}}
+	}
+	for _, val := range values {
+		snapshot.Points = append(snapshot.Points, MetricPoint{
+			Labels:         copyLabels(val.labels),
+			Count:          val.count,
+			Sum:            val.sum,
+			Quantiles:      append([]float64(nil), s.quantiles...),
+			QuantileValues: val.quantiles(s.quantiles),
+		})
+	}
+	return []MetricSnapshot{snapshot}
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSummary(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	reg := MakeRegistry()
+	s := MakeSummary(MetricName{Name: "algod_test_summary_seconds", Description: "test summary"}, []float64{0.9, 0.5}, 10)
+	s.Deregister(nil)
+	s.Register(reg)
+
+	var buf strings.Builder
+	reg.WriteMetrics(&buf, "")
+	require.Equal(t, `# HELP algod_test_summary_seconds test summary
+# TYPE algod_test_summary_seconds summary
+algod_test_summary_seconds{quantile="0.5"} NaN
+algod_test_summary_seconds{quantile="0.9"} NaN
+algod_test_summary_seconds_sum 0
+algod_test_summary_seconds_count 0
+`, buf.String())
+
+	// only the last 10 observations count towards the quantiles
+	for i := 1; i <= 20; i++ {
+		s.Observe(float64(i), map[string]string{"handler": "status"})
+	}
+
+	buf.Reset()
+	reg.WriteMetrics(&buf, `host="a"`)
+	require.Equal(t, `# HELP algod_test_summary_seconds test summary
+# TYPE algod_test_summary_seconds summary
+algod_test_summary_seconds{host="a",handler="status",quantile="0.5"} 15
+algod_test_summary_seconds{host="a",handler="status",quantile="0.9"} 19
+algod_test_summary_seconds_sum{host="a",handler="status"} 210
+algod_test_summary_seconds_count{host="a",handler="status"} 20
+`, buf.String())
+
+	values := make(map[string]float64)
+	reg.AddMetrics(values)
+	require.Equal(t, map[string]float64{
+		"algod_test_summary_seconds_count_handler__status_": 20,
+		"algod_test_summary_seconds_sum_handler__status_":   210,
+		"algod_test_summary_seconds_0_5_handler__status_":   15,
+		"algod_test_summary_seconds_0_9_handler__status_":   19,
+	}, values)
+}
@@ -181,3 +181,32 @@ func (tc *TagCounter) AddMetric(values map[string]float64) {
 		values[sanitizeTelemetryName(name)] = float64(count)
 	}
 }
+
+// Snapshot returns one counter per tag, named as in WriteMetric
+func (tc *TagCounter) Snapshot() []MetricSnapshot {
+	tagp := tc.tagptr.Load()
+	if tagp == nil {
+		return nil
+	}
+	isTemplate := strings.Contains(tc.Name, "{TAG}")
+	tags := tagp.(map[string]*uint64)
+	snapshots := make([]MetricSnapshot, 0, len(tags))
+	for tag, tagcount := range tags {
+		if tagcount == nil {
+			continue
+		}
+		var name string
+		if isTemplate {
+			name = strings.ReplaceAll(tc.Name, "{TAG}", tag)
+		} else {
+			name = tc.Name + "_" + tag
+		}
+		snapshots = append(snapshots, MetricSnapshot{
+			Name:        name,
+			Description: strings.ReplaceAll(tc.Description, "{TAG}", tag),
+			Type:        CounterType,
+			Points:      []MetricPoint{{Value: float64(atomic.LoadUint64(tagcount))}},
+		})
+	}
+	return snapshots
+}